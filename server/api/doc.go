@@ -0,0 +1,23 @@
+// Package api implements a stable layer on top of the player and world
+// packages for extensions that should keep working across updates of
+// Dragonfly. The types in the package wrap a small, deliberately chosen part
+// of the player.Player and world.World API, and Handler exposes a subset of
+// the events of player.Handler.
+//
+// The package follows semantic versioning independently of the rest of
+// Dragonfly, as reported by Version. Within a major version, exported
+// identifiers of the package are never removed and their behaviour is never
+// changed in a way that breaks existing code. Changes to the player.Handler
+// interface, such as new events or changed arguments, are absorbed by the
+// package and do not require changes to implementations of Handler.
+//
+// The guarantee covers the identifiers declared in the package only. Values of
+// other packages used in the API, such as world.DamageSource, world.Block,
+// cube.Pos and event.Context, are not wrapped and change together with the
+// rest of Dragonfly.
+package api
+
+// Version is the semantic version of the api package. The major version is
+// increased for every change that may break code using the package, the minor
+// version for every addition to it and the patch version for fixes.
+const Version = "1.0.0"
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// Handler handles events of a Player. Unlike player.Handler, Handler only
+// changes between major versions of the package. Implementations may embed
+// NopHandler so that they only need to implement the events they handle and
+// keep compiling when events are added in a minor version.
+type Handler interface {
+	// HandleMove handles the player moving to the position passed. ctx.Cancel()
+	// may be called to cancel the movement.
+	HandleMove(ctx *event.Context, p Player, pos mgl64.Vec3)
+	// HandleChat handles the player sending a chat message. ctx.Cancel() may be
+	// called to cancel the message, and the message may be changed.
+	HandleChat(ctx *event.Context, p Player, message *string)
+	// HandleHurt handles the player being hurt. ctx.Cancel() may be called to
+	// cancel the damage, and the damage may be changed.
+	HandleHurt(ctx *event.Context, p Player, damage *float64, src world.DamageSource)
+	// HandleDeath handles the player dying.
+	HandleDeath(p Player, src world.DamageSource)
+	// HandleBlockBreak handles the player breaking the block at the position
+	// passed. ctx.Cancel() may be called to cancel the block being broken.
+	HandleBlockBreak(ctx *event.Context, p Player, pos cube.Pos)
+	// HandleBlockPlace handles the player placing the block passed at the
+	// position passed. ctx.Cancel() may be called to cancel the block being
+	// placed.
+	HandleBlockPlace(ctx *event.Context, p Player, pos cube.Pos, b world.Block)
+	// HandleAttackEntity handles the player attacking the entity passed.
+	// ctx.Cancel() may be called to cancel the attack.
+	HandleAttackEntity(ctx *event.Context, p Player, e world.Entity)
+	// HandleQuit handles the player leaving the server.
+	HandleQuit(p Player)
+}
+
+// NopHandler implements Handler without doing anything in any of its methods.
+type NopHandler struct{}
+
+// Compile time check to make sure NopHandler implements Handler.
+var _ Handler = NopHandler{}
+
+func (NopHandler) HandleMove(*event.Context, Player, mgl64.Vec3)                   {}
+func (NopHandler) HandleChat(*event.Context, Player, *string)                      {}
+func (NopHandler) HandleHurt(*event.Context, Player, *float64, world.DamageSource) {}
+func (NopHandler) HandleDeath(Player, world.DamageSource)                          {}
+func (NopHandler) HandleBlockBreak(*event.Context, Player, cube.Pos)               {}
+func (NopHandler) HandleBlockPlace(*event.Context, Player, cube.Pos, world.Block)  {}
+func (NopHandler) HandleAttackEntity(*event.Context, Player, world.Entity)         {}
+func (NopHandler) HandleQuit(Player)                                               {}
+
+// Handle attaches the Handler passed to the player.Player passed. The
+// player.Handler of the player is kept: Every event is passed to it first,
+// after which events that are part of Handler are passed to the Handler
+// passed. The Handler stays attached when the player.Handler of the player is
+// changed later using player.Player.Handle.
+func Handle(p *player.Player, h Handler) {
+	if h == nil {
+		h = NopHandler{}
+	}
+	ap := PlayerOf(p)
+	p.WrapHandler(func(prev player.Handler) player.Handler {
+		return handler{Handler: prev, p: ap, h: h}
+	})
+}
+
+// handler adapts a Handler to a player.Handler, passing all events to the
+// player.Handler it embeds before passing them to the Handler.
+type handler struct {
+	player.Handler
+	p Player
+	h Handler
+}
+
+// HandleMove ...
+func (h handler) HandleMove(ctx *event.Context, newPos mgl64.Vec3, newYaw, newPitch float64) {
+	h.Handler.HandleMove(ctx, newPos, newYaw, newPitch)
+	h.h.HandleMove(ctx, h.p, newPos)
+}
+
+// HandleChat ...
+func (h handler) HandleChat(ctx *event.Context, message *string) {
+	h.Handler.HandleChat(ctx, message)
+	h.h.HandleChat(ctx, h.p, message)
+}
+
+// HandleHurt ...
+func (h handler) HandleHurt(ctx *event.Context, damage *float64, attackImmunity *time.Duration, src world.DamageSource) {
+	h.Handler.HandleHurt(ctx, damage, attackImmunity, src)
+	h.h.HandleHurt(ctx, h.p, damage, src)
+}
+
+// HandleDeath ...
+func (h handler) HandleDeath(src world.DamageSource, keepInv *bool) {
+	h.Handler.HandleDeath(src, keepInv)
+	h.h.HandleDeath(h.p, src)
+}
+
+// HandleBlockBreak ...
+func (h handler) HandleBlockBreak(ctx *event.Context, pos cube.Pos, drops *[]item.Stack, xp *int) {
+	h.Handler.HandleBlockBreak(ctx, pos, drops, xp)
+	h.h.HandleBlockBreak(ctx, h.p, pos)
+}
+
+// HandleBlockPlace ...
+func (h handler) HandleBlockPlace(ctx *event.Context, pos cube.Pos, b world.Block) {
+	h.Handler.HandleBlockPlace(ctx, pos, b)
+	h.h.HandleBlockPlace(ctx, h.p, pos, b)
+}
+
+// HandleAttackEntity ...
+func (h handler) HandleAttackEntity(ctx *event.Context, e world.Entity, force, height *float64, critical *bool) {
+	h.Handler.HandleAttackEntity(ctx, e, force, height, critical)
+	h.h.HandleAttackEntity(ctx, h.p, e)
+}
+
+// HandleQuit ...
+func (h handler) HandleQuit() {
+	h.Handler.HandleQuit()
+	h.h.HandleQuit(h.p)
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Player is a stable facade of a player.Player. Player values may be compared
+// and are equal if they wrap the same player.Player.
+type Player struct {
+	p *player.Player
+}
+
+// PlayerOf returns the Player facade of the player.Player passed.
+func PlayerOf(p *player.Player) Player {
+	return Player{p: p}
+}
+
+// Unwrap returns the player.Player wrapped by the Player. Code that uses the
+// player.Player directly is not covered by the stability guarantees of the
+// package.
+func (p Player) Unwrap() *player.Player {
+	return p.p
+}
+
+// Name returns the name of the player.
+func (p Player) Name() string {
+	return p.p.Name()
+}
+
+// UUID returns the UUID of the player.
+func (p Player) UUID() uuid.UUID {
+	return p.p.UUID()
+}
+
+// XUID returns the XBOX Live user ID of the player. It is empty if the player
+// is not connected to a client or if authentication is disabled.
+func (p Player) XUID() string {
+	return p.p.XUID()
+}
+
+// Position returns the current position of the player.
+func (p Player) Position() mgl64.Vec3 {
+	return p.p.Position()
+}
+
+// Teleport teleports the player to the position passed in its current world.
+func (p Player) Teleport(pos mgl64.Vec3) {
+	p.p.Teleport(pos)
+}
+
+// World returns the World that the player is currently in.
+func (p Player) World() World {
+	return WorldOf(p.p.World())
+}
+
+// Health returns the current health of the player.
+func (p Player) Health() float64 {
+	return p.p.Health()
+}
+
+// GameMode returns the game mode of the player.
+func (p Player) GameMode() world.GameMode {
+	return p.p.GameMode()
+}
+
+// SetGameMode changes the game mode of the player.
+func (p Player) SetGameMode(mode world.GameMode) {
+	p.p.SetGameMode(mode)
+}
+
+// Message sends a message to the player, formatted following the rules of
+// fmt.Sprintln without a newline at the end.
+func (p Player) Message(a ...any) {
+	p.p.Message(a...)
+}
+
+// Messagef sends a message to the player, formatted following the rules of
+// fmt.Sprintf.
+func (p Player) Messagef(format string, a ...any) {
+	p.p.Messagef(format, a...)
+}
+
+// Latency returns the round-trip latency of the connection of the player.
+func (p Player) Latency() time.Duration {
+	return p.p.Latency()
+}
+
+// Disconnect disconnects the player, showing the message passed to it.
+func (p Player) Disconnect(msg ...any) {
+	p.p.Disconnect(msg...)
+}
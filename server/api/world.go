@@ -0,0 +1,81 @@
+package api
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// World is a stable facade of a world.World. World values may be compared and
+// are equal if they wrap the same world.World.
+type World struct {
+	w *world.World
+}
+
+// WorldOf returns the World facade of the world.World passed.
+func WorldOf(w *world.World) World {
+	return World{w: w}
+}
+
+// Unwrap returns the world.World wrapped by the World. Code that uses the
+// world.World directly is not covered by the stability guarantees of the
+// package.
+func (w World) Unwrap() *world.World {
+	return w.w
+}
+
+// Name returns the name of the world.
+func (w World) Name() string {
+	return w.w.Name()
+}
+
+// Dimension returns the dimension of the world.
+func (w World) Dimension() world.Dimension {
+	return w.w.Dimension()
+}
+
+// Spawn returns the spawn position of the world.
+func (w World) Spawn() cube.Pos {
+	return w.w.Spawn()
+}
+
+// Block returns the block at the position passed.
+func (w World) Block(pos cube.Pos) world.Block {
+	return w.w.Block(pos)
+}
+
+// SetBlock sets the block at the position passed, updating the blocks around
+// it.
+func (w World) SetBlock(pos cube.Pos, b world.Block) {
+	w.w.SetBlock(pos, b, nil)
+}
+
+// Time returns the time of day in the world in ticks.
+func (w World) Time() int {
+	return w.w.Time()
+}
+
+// SetTime changes the time of day in the world to the amount of ticks passed.
+func (w World) SetTime(t int) {
+	w.w.SetTime(t)
+}
+
+// Entities returns all entities currently in the world.
+func (w World) Entities() []world.Entity {
+	return w.w.Entities()
+}
+
+// AddEntity adds an entity to the world at its current position.
+func (w World) AddEntity(e world.Entity) {
+	w.w.AddEntity(e)
+}
+
+// PlaySound plays a sound at the position passed to all players nearby.
+func (w World) PlaySound(pos mgl64.Vec3, s world.Sound) {
+	w.w.PlaySound(pos, s)
+}
+
+// AddParticle shows a particle at the position passed to all players nearby.
+func (w World) AddParticle(pos mgl64.Vec3, p world.Particle) {
+	w.w.AddParticle(pos, p)
+}
@@ -60,19 +60,54 @@ type Replaceable interface {
 	ReplaceableBy(b world.Block) bool
 }
 
+// BreakRestricted represents a block that may only be broken by some users, such as a Grave that may only be broken
+// by its owner.
+type BreakRestricted interface {
+	// CanBreak checks if the item.User passed is able to break the block.
+	CanBreak(u item.User) bool
+}
+
 // EntityLander represents a block that reacts to an entity landing on it after falling.
 type EntityLander interface {
 	// EntityLand is called when an entity lands on the block.
 	EntityLand(pos cube.Pos, w *world.World, e world.Entity, distance *float64)
 }
 
+// Bouncy represents a block that makes entities that land on it bounce back up, such as slime.
+type Bouncy interface {
+	// Bounce returns the vertical velocity of the entity passed after it landed on the block with the vertical
+	// velocity passed.
+	Bounce(e world.Entity, vel float64) float64
+}
+
+// Sticky represents a block that slows down the entities that move on it or along its sides, such as honey.
+type Sticky interface {
+	// SpeedFactor returns the factor that the horizontal velocity of entities moving on the block is multiplied
+	// with.
+	SpeedFactor() float64
+	// JumpFactor returns the factor that the jump velocity of entities standing on the block is multiplied with.
+	JumpFactor() float64
+	// SlideVelocity returns the maximum velocity at which entities slide down along the sides of the block.
+	SlideVelocity() float64
+}
+
 // EntityInsider represents a block that reacts to an entity going inside its 1x1x1 axis
 // aligned bounding box.
 type EntityInsider interface {
-	// EntityInside is called when an entity goes inside the block's 1x1x1 axis aligned bounding box.
+	// EntityInside is called when an entity goes inside the block's 1x1x1 axis aligned bounding box. It is called
+	// every tick that the entity moves while it is inside the block, for players as well as for other entities.
 	EntityInside(pos cube.Pos, w *world.World, e world.Entity)
 }
 
+// EntityVelocityModifier represents a block that changes the velocity of entities moving through it, such as a
+// bubble column pushing entities up. The movement of players is computed client-side, so EntityVelocity is only
+// called for entities of which the movement is computed by the server.
+type EntityVelocityModifier interface {
+	// EntityVelocity returns the new velocity of an entity inside the block with the velocity passed. It is called
+	// while the movement of the entity is computed, so it must not modify the entity passed.
+	EntityVelocity(pos cube.Pos, w *world.World, e world.Entity, vel mgl64.Vec3) mgl64.Vec3
+}
+
 // Frictional represents a block that may have a custom friction value, friction is used for entity drag when the
 // entity is on ground. If a block does not implement this interface, it should be assumed that its friction is 0.6.
 type Frictional interface {
@@ -0,0 +1,147 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"sync"
+	"time"
+)
+
+const (
+	// brewDuration is the time it takes for a brewing stand to finish brewing potions.
+	brewDuration = time.Second * 20
+	// brewFuel is the amount of brews that a single piece of blaze powder fuels.
+	brewFuel = 20
+)
+
+// brewer is a struct that may be embedded by blocks that can brew potions, such as brewing stands. The inventory of a
+// brewer holds the reagent in the first slot, the three potions in the next slots and the fuel in the last slot.
+type brewer struct {
+	mu sync.Mutex
+
+	viewers   map[ContainerViewer]struct{}
+	inventory *inventory.Inventory
+
+	duration   time.Duration
+	fuelAmount int32
+	fuelTotal  int32
+}
+
+// newBrewer creates a new initialised brewer and returns it.
+func newBrewer() *brewer {
+	b := &brewer{viewers: make(map[ContainerViewer]struct{})}
+	b.inventory = inventory.New(5, func(slot int, _, item item.Stack) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for viewer := range b.viewers {
+			viewer.ViewSlotChange(slot, item)
+		}
+	})
+	return b
+}
+
+// Duration returns the remaining duration of the brew that the brewer is currently processing. Zero is returned if
+// the brewer is not brewing.
+func (b *brewer) Duration() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.duration
+}
+
+// Fuel returns the amount of brews that the brewer is able to perform with its remaining fuel, and the total amount
+// of brews that the last piece of fuel provided.
+func (b *brewer) Fuel() (fuel, total int32) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.fuelAmount, b.fuelTotal
+}
+
+// Inventory returns the inventory of the brewer.
+func (b *brewer) Inventory() *inventory.Inventory {
+	return b.inventory
+}
+
+// AddViewer adds a viewer to the brewer, so that it is updated whenever the inventory of the brewer is changed.
+func (b *brewer) AddViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the brewer, so that slot updates in the inventory are no longer sent to it.
+func (b *brewer) RemoveViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.viewers, v)
+}
+
+// setDuration sets the remaining brew duration of the brewer.
+func (b *brewer) setDuration(duration time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.duration = duration
+}
+
+// setFuel sets the remaining fuel and the total fuel of the brewer.
+func (b *brewer) setFuel(fuel, total int32) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.fuelAmount, b.fuelTotal = fuel, total
+}
+
+// tickBrewing ticks the brewer, refuelling it with blaze powder if needed and brewing the potions in it for the
+// necessary duration. True is returned if the potions in the brewer were brewed during the tick.
+func (b *brewer) tickBrewing() (brewed bool) {
+	b.mu.Lock()
+
+	prevDuration, prevFuelAmount, prevFuelTotal := b.duration, b.fuelAmount, b.fuelTotal
+
+	// We don't need to validate errors here since we know the bounds of the brewer.
+	reagent, _ := b.inventory.Item(0)
+	fuel, _ := b.inventory.Item(4)
+
+	if _, ok := fuel.Item().(item.BlazePowder); ok && b.fuelAmount <= 0 {
+		b.fuelAmount, b.fuelTotal = brewFuel, brewFuel
+		defer b.inventory.SetItem(4, fuel.Grow(-1))
+	}
+
+	// Find the potions that can be brewed with the reagent. The brew is cancelled as soon as none of the potions can
+	// be brewed anymore, for example because the reagent was taken out of the brewer.
+	var products [3]item.Stack
+	canBrew := false
+	for i := range products {
+		potion, _ := b.inventory.Item(i + 1)
+		if product, ok := item.Brew(potion, reagent); ok {
+			products[i], canBrew = product, true
+		}
+	}
+
+	switch {
+	case !canBrew:
+		b.duration = 0
+	case b.duration > 0:
+		b.duration -= time.Millisecond * 50
+		if b.duration <= 0 {
+			b.duration, brewed = 0, true
+			for i, product := range products {
+				if !product.Empty() {
+					defer b.inventory.SetItem(i+1, product)
+				}
+			}
+			defer b.inventory.SetItem(0, reagent.Grow(-1))
+		}
+	case b.fuelAmount > 0:
+		b.fuelAmount--
+		b.duration = brewDuration
+	}
+
+	// Update the viewers on the new brew duration and fuel.
+	for v := range b.viewers {
+		v.ViewBrewingUpdate(prevDuration, b.duration, prevFuelAmount, b.fuelAmount, prevFuelTotal, b.fuelTotal)
+	}
+
+	b.mu.Unlock()
+	return brewed
+}
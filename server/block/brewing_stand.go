@@ -0,0 +1,142 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// BrewingStand is a block used for brewing potions, splash potions and lingering potions. It is fuelled by blaze
+// powder.
+// The empty value of BrewingStand is not valid. It must be created using block.NewBrewingStand().
+type BrewingStand struct {
+	transparent
+	sourceWaterDisplacer
+	*brewer
+
+	// LeftSlot is true if the left slot of the brewing stand holds a potion.
+	LeftSlot bool
+	// MiddleSlot is true if the middle slot of the brewing stand holds a potion.
+	MiddleSlot bool
+	// RightSlot is true if the right slot of the brewing stand holds a potion.
+	RightSlot bool
+}
+
+// NewBrewingStand creates a new initialised brewing stand. The inventory is properly initialised.
+func NewBrewingStand() BrewingStand {
+	return BrewingStand{brewer: newBrewer()}
+}
+
+// Model ...
+func (BrewingStand) Model() world.BlockModel {
+	return model.BrewingStand{}
+}
+
+// LightEmissionLevel ...
+func (BrewingStand) LightEmissionLevel() uint8 {
+	return 1
+}
+
+// SideClosed ...
+func (BrewingStand) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// Tick is called to brew the potions in the brewing stand and to update the potions shown on it.
+func (b BrewingStand) Tick(_ int64, pos cube.Pos, w *world.World) {
+	if b.brewer == nil {
+		return
+	}
+	b.tickBrewing()
+
+	left, _ := b.inventory.Item(1)
+	middle, _ := b.inventory.Item(2)
+	right, _ := b.inventory.Item(3)
+	if b.LeftSlot != !left.Empty() || b.MiddleSlot != !middle.Empty() || b.RightSlot != !right.Empty() {
+		b.LeftSlot, b.MiddleSlot, b.RightSlot = !left.Empty(), !middle.Empty(), !right.Empty()
+		w.SetBlock(pos, b, nil)
+	}
+}
+
+// Activate ...
+func (b BrewingStand) Activate(pos cube.Pos, _ cube.Face, _ *world.World, u item.User, _ *item.UseContext) bool {
+	if opener, ok := u.(ContainerOpener); ok {
+		opener.OpenBlockContainer(pos)
+		return true
+	}
+	return false
+}
+
+// UseOnBlock ...
+func (b BrewingStand) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, b)
+	if !used {
+		return false
+	}
+
+	place(w, pos, NewBrewingStand(), user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (b BrewingStand) BreakInfo() BreakInfo {
+	return newBreakInfo(0.5, pickaxeHarvestable, pickaxeEffective, oneOf(b))
+}
+
+// EncodeNBT ...
+func (b BrewingStand) EncodeNBT() map[string]any {
+	if b.brewer == nil {
+		//noinspection GoAssignmentToReceiver
+		b = NewBrewingStand()
+	}
+	fuel, total := b.Fuel()
+	return map[string]any{
+		"CookTime":   int16(b.Duration().Milliseconds() / 50),
+		"FuelAmount": int16(fuel),
+		"FuelTotal":  int16(total),
+		"Items":      nbtconv.InvToNBT(b.inventory),
+		"id":         "BrewingStand",
+	}
+}
+
+// DecodeNBT ...
+func (b BrewingStand) DecodeNBT(data map[string]any) any {
+	left, middle, right := b.LeftSlot, b.MiddleSlot, b.RightSlot
+
+	//noinspection GoAssignmentToReceiver
+	b = NewBrewingStand()
+	b.LeftSlot, b.MiddleSlot, b.RightSlot = left, middle, right
+	b.setDuration(nbtconv.TickDuration[int16](data, "CookTime"))
+	b.setFuel(int32(nbtconv.Int16(data, "FuelAmount")), int32(nbtconv.Int16(data, "FuelTotal")))
+	nbtconv.InvFromNBT(b.inventory, nbtconv.Slice(data, "Items"))
+	return b
+}
+
+// EncodeItem ...
+func (BrewingStand) EncodeItem() (name string, meta int16) {
+	return "minecraft:brewing_stand", 0
+}
+
+// EncodeBlock ...
+func (b BrewingStand) EncodeBlock() (string, map[string]any) {
+	return "minecraft:brewing_stand", map[string]any{
+		"brewing_stand_slot_a_bit": boolByte(b.LeftSlot),
+		"brewing_stand_slot_b_bit": boolByte(b.MiddleSlot),
+		"brewing_stand_slot_c_bit": boolByte(b.RightSlot),
+	}
+}
+
+// allBrewingStands ...
+func allBrewingStands() (stands []world.Block) {
+	for _, left := range []bool{false, true} {
+		for _, middle := range []bool{false, true} {
+			for _, right := range []bool{false, true} {
+				stands = append(stands, BrewingStand{LeftSlot: left, MiddleSlot: middle, RightSlot: right})
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,116 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+)
+
+// BubbleColumn is a column of bubbles formed in water above soul sand or magma. Entities inside a bubble column are
+// pushed upwards above soul sand, or dragged downwards above magma, which may be used to build elevators. The water
+// that the bubble column forms in is kept in the liquid layer of the block.
+type BubbleColumn struct {
+	empty
+	transparent
+	sourceWaterDisplacer
+
+	// DragDown specifies if the bubble column drags entities down instead of pushing them up.
+	DragDown bool
+}
+
+// EntityVelocity pushes the entity up or drags it down, depending on the direction of the bubble column. Entities
+// move faster at the top of the column, where bubbles reach the surface of the water.
+func (b BubbleColumn) EntityVelocity(pos cube.Pos, w *world.World, _ world.Entity, vel mgl64.Vec3) mgl64.Vec3 {
+	_, surface := w.Block(pos.Side(cube.FaceUp)).(Air)
+	switch {
+	case b.DragDown && surface:
+		vel[1] = math.Max(-0.9, vel[1]-0.03)
+	case b.DragDown:
+		vel[1] = math.Max(-0.3, vel[1]-0.03)
+	case surface:
+		vel[1] = math.Min(1.8, vel[1]+0.1)
+	default:
+		vel[1] = math.Min(0.7, vel[1]+0.06)
+	}
+	return vel
+}
+
+// SideClosed ...
+func (BubbleColumn) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// HasLiquidDrops ...
+func (BubbleColumn) HasLiquidDrops() bool {
+	return false
+}
+
+// NeighbourUpdateTick removes the bubble column if the water it is in was removed or if the block below no longer
+// supports it, and extends the column upwards through water source blocks.
+func (b BubbleColumn) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	drag, ok := bubbleColumnSource(w, pos.Side(cube.FaceDown))
+	if !ok || !waterSource(w, pos) {
+		w.SetBlock(pos, nil, nil)
+		return
+	}
+	if drag != b.DragDown {
+		w.SetBlock(pos, BubbleColumn{DragDown: drag}, nil)
+		return
+	}
+	formBubbleColumn(w, pos.Side(cube.FaceUp), drag)
+}
+
+// EncodeBlock ...
+func (b BubbleColumn) EncodeBlock() (string, map[string]any) {
+	return "minecraft:bubble_column", map[string]any{"drag_down": b.DragDown}
+}
+
+// formBubbleColumn forms a bubble column at the position passed and the water source blocks above it, if the position
+// holds a water source block.
+func formBubbleColumn(w *world.World, pos cube.Pos, drag bool) {
+	for ; pos[1] <= w.Range()[1]; pos = pos.Side(cube.FaceUp) {
+		switch b := w.Block(pos).(type) {
+		case Water:
+			if !waterSource(w, pos) {
+				return
+			}
+		case BubbleColumn:
+			if b.DragDown == drag {
+				return
+			}
+		default:
+			return
+		}
+		w.SetBlock(pos, BubbleColumn{DragDown: drag}, nil)
+	}
+}
+
+// bubbleColumnSource checks if the block at the position passed supports a bubble column above it. If so, the
+// direction of the bubble column is returned.
+func bubbleColumnSource(w *world.World, pos cube.Pos) (drag bool, ok bool) {
+	switch b := w.Block(pos).(type) {
+	case SoulSand:
+		return false, true
+	case Magma:
+		return true, true
+	case BubbleColumn:
+		return b.DragDown, true
+	}
+	return false, false
+}
+
+// waterSource checks if the position passed holds a water source block.
+func waterSource(w *world.World, pos cube.Pos) bool {
+	l, ok := w.Liquid(pos)
+	if !ok {
+		return false
+	}
+	water, ok := l.(Water)
+	return ok && water.Depth == 8 && !water.Falling
+}
+
+// allBubbleColumns returns all possible states of a bubble column.
+func allBubbleColumns() (b []world.Block) {
+	return []world.Block{BubbleColumn{}, BubbleColumn{DragDown: true}}
+}
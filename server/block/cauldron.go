@@ -0,0 +1,167 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/potion"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"math/rand"
+	"time"
+)
+
+// Cauldron is a block that can hold water, lava or powder snow. It is filled using buckets and water bottles, and
+// slowly fills up with water or powder snow when it rains or snows.
+type Cauldron struct {
+	transparent
+
+	// Liquid is the liquid held by the cauldron. It is ignored if the Level is 0.
+	Liquid CauldronLiquid
+	// Level is how full the cauldron is, ranging from 0 (empty) to 6 (full).
+	Level int
+}
+
+// Model ...
+func (Cauldron) Model() world.BlockModel {
+	return model.Cauldron{}
+}
+
+// SideClosed ...
+func (Cauldron) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// LightEmissionLevel ...
+func (c Cauldron) LightEmissionLevel() uint8 {
+	if c.Level > 0 && c.Liquid == LavaCauldronLiquid() {
+		return 15
+	}
+	return 0
+}
+
+// BreakInfo ...
+func (c Cauldron) BreakInfo() BreakInfo {
+	return newBreakInfo(2, pickaxeHarvestable, pickaxeEffective, oneOf(Cauldron{}))
+}
+
+// Activate ...
+func (c Cauldron) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	switch it := held.Item().(type) {
+	case item.Bucket:
+		if it.Empty() {
+			if c.Level != 6 || c.Liquid == PowderSnowCauldronLiquid() {
+				return false
+			}
+			var liq world.Liquid = Water{Depth: 8, Still: true}
+			if c.Liquid == LavaCauldronLiquid() {
+				liq = Lava{Depth: 8, Still: true}
+			}
+			w.SetBlock(pos, Cauldron{}, nil)
+			w.PlaySound(pos.Vec3Centre(), sound.BucketFill{Liquid: liq})
+			ctx.NewItem = item.NewStack(item.Bucket{Content: item.LiquidBucketContent(liq)}, 1)
+			ctx.NewItemSurvivalOnly = true
+			ctx.SubtractFromCount(1)
+			return true
+		}
+		liq, ok := it.Content.Liquid()
+		if !ok {
+			return false
+		}
+		content := WaterCauldronLiquid()
+		if _, lava := liq.(Lava); lava {
+			content = LavaCauldronLiquid()
+		}
+		w.SetBlock(pos, Cauldron{Liquid: content, Level: 6}, nil)
+		w.PlaySound(pos.Vec3Centre(), sound.BucketEmpty{Liquid: liq})
+		ctx.NewItem = item.NewStack(item.Bucket{}, 1)
+		ctx.NewItemSurvivalOnly = true
+		ctx.SubtractFromCount(1)
+		return true
+	case item.Potion:
+		if it.Type != potion.Water() || (c.Level > 0 && c.Liquid != WaterCauldronLiquid()) || c.Level == 6 {
+			return false
+		}
+		w.SetBlock(pos, Cauldron{Liquid: WaterCauldronLiquid(), Level: min(c.Level+2, 6)}, nil)
+		ctx.NewItem = item.NewStack(item.GlassBottle{}, 1)
+		ctx.NewItemSurvivalOnly = true
+		ctx.SubtractFromCount(1)
+		return true
+	}
+	return false
+}
+
+// FillBottle ...
+func (c Cauldron) FillBottle() (world.Block, item.Stack, bool) {
+	if c.Level < 2 || c.Liquid != WaterCauldronLiquid() {
+		return c, item.Stack{}, false
+	}
+	if c.Level -= 2; c.Level == 0 {
+		c = Cauldron{}
+	}
+	return c, item.NewStack(item.Potion{Type: potion.Water()}, 1), true
+}
+
+// PrecipitationTick slowly fills the cauldron with water when it rains, or with powder snow when it snows.
+func (c Cauldron) PrecipitationTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	content, chance := WaterCauldronLiquid(), 0.05
+	if w.SnowingAt(pos.Side(cube.FaceUp)) {
+		content, chance = PowderSnowCauldronLiquid(), 0.1
+	}
+	if (c.Level > 0 && c.Liquid != content) || c.Level == 6 || r.Float64() >= chance {
+		return
+	}
+	w.SetBlock(pos, Cauldron{Liquid: content, Level: c.Level + 1}, nil)
+}
+
+// EntityInside ...
+func (c Cauldron) EntityInside(pos cube.Pos, _ *world.World, e world.Entity) {
+	if c.Level == 0 || e.Position()[1] >= float64(pos[1])+0.25+float64(c.Level)*0.125 {
+		return
+	}
+	switch c.Liquid {
+	case WaterCauldronLiquid():
+		if flammable, ok := e.(flammableEntity); ok && flammable.OnFireDuration() > 0 {
+			flammable.Extinguish()
+		}
+	case LavaCauldronLiquid():
+		if fallEntity, ok := e.(fallDistanceEntity); ok {
+			fallEntity.ResetFallDistance()
+		}
+		if flammable, ok := e.(flammableEntity); ok {
+			if l, ok := e.(livingEntity); ok && !l.AttackImmune() {
+				l.Hurt(4, LavaDamageSource{})
+			}
+			flammable.SetOnFire(15 * time.Second)
+		}
+	}
+}
+
+// EncodeItem ...
+func (Cauldron) EncodeItem() (name string, meta int16) {
+	return "minecraft:cauldron", 0
+}
+
+// EncodeBlock ...
+func (c Cauldron) EncodeBlock() (string, map[string]any) {
+	if c.Level == 0 {
+		return "minecraft:cauldron", map[string]any{"cauldron_liquid": "water", "fill_level": int32(0)}
+	}
+	name := "minecraft:cauldron"
+	if c.Liquid == LavaCauldronLiquid() {
+		name = "minecraft:lava_cauldron"
+	}
+	return name, map[string]any{"cauldron_liquid": c.Liquid.String(), "fill_level": int32(c.Level)}
+}
+
+// allCauldrons ...
+func allCauldrons() (all []world.Block) {
+	all = append(all, Cauldron{})
+	for _, liquid := range CauldronLiquids() {
+		for level := 1; level <= 6; level++ {
+			all = append(all, Cauldron{Liquid: liquid, Level: level})
+		}
+	}
+	return
+}
@@ -0,0 +1,46 @@
+package block
+
+// CauldronLiquid represents a type of content that a cauldron may be filled with.
+type CauldronLiquid struct {
+	cauldronLiquid
+}
+
+type cauldronLiquid uint8
+
+// WaterCauldronLiquid is the water that a cauldron may be filled with using buckets, bottles or by rain.
+func WaterCauldronLiquid() CauldronLiquid {
+	return CauldronLiquid{0}
+}
+
+// LavaCauldronLiquid is the lava that a cauldron may be filled with using a bucket.
+func LavaCauldronLiquid() CauldronLiquid {
+	return CauldronLiquid{1}
+}
+
+// PowderSnowCauldronLiquid is the powder snow that a cauldron is slowly filled with when it snows.
+func PowderSnowCauldronLiquid() CauldronLiquid {
+	return CauldronLiquid{2}
+}
+
+// Uint8 returns the cauldron liquid as a uint8.
+func (c cauldronLiquid) Uint8() uint8 {
+	return uint8(c)
+}
+
+// String ...
+func (c cauldronLiquid) String() string {
+	switch c {
+	case 0:
+		return "water"
+	case 1:
+		return "lava"
+	case 2:
+		return "powder_snow"
+	}
+	panic("unknown cauldron liquid")
+}
+
+// CauldronLiquids ...
+func CauldronLiquids() []CauldronLiquid {
+	return []CauldronLiquid{WaterCauldronLiquid(), LavaCauldronLiquid(), PowderSnowCauldronLiquid()}
+}
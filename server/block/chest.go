@@ -32,6 +32,16 @@ type Chest struct {
 	inventory *inventory.Inventory
 	viewerMu  *sync.RWMutex
 	viewers   map[ContainerViewer]struct{}
+
+	// paired specifies if the chest is paired with another chest to form a double chest. pairX and pairZ are
+	// the X and Z coordinates of the chest that it is paired with.
+	paired       bool
+	pairX, pairZ int
+	// pairLead specifies if the chest holds the first half of the inventory of the double chest.
+	pairLead bool
+	// pairInv is the 54 slot inventory of the double chest that the chest is a part of. It is nil if the chest
+	// is not paired, or if the pair was not yet set up after loading the chest.
+	pairInv *inventory.Inventory
 }
 
 // NewChest creates a new initialised chest. The inventory is properly initialised.
@@ -51,12 +61,28 @@ func NewChest() Chest {
 	}
 }
 
-// Inventory returns the inventory of the chest. The size of the inventory will be 27 or 54, depending on
-// whether the chest is single or double.
+// Inventory returns the inventory of the chest. The size of the inventory is always 27, even if the chest is
+// paired with another chest. The inventory of a double chest may be obtained using PairedInventory.
 func (c Chest) Inventory() *inventory.Inventory {
 	return c.inventory
 }
 
+// PairedInventory returns the 54 slot inventory of the double chest that the chest is a part of, holding the
+// items of both chests. False is returned if the chest is not paired with another chest.
+func (c Chest) PairedInventory() (*inventory.Inventory, bool) {
+	return c.pairInv, c.pairInv != nil
+}
+
+// Paired checks if the chest is paired with another chest to form a double chest.
+func (c Chest) Paired() bool {
+	return c.paired
+}
+
+// pairPos returns the position of the chest that the chest at the position passed is paired with.
+func (c Chest) pairPos(pos cube.Pos) cube.Pos {
+	return cube.Pos{c.pairX, pos[1], c.pairZ}
+}
+
 // WithName returns the chest after applying a specific name to the block.
 func (c Chest) WithName(a ...any) world.Item {
 	c.CustomName = strings.TrimSuffix(fmt.Sprintln(a...), "\n")
@@ -68,18 +94,26 @@ func (Chest) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
 	return false
 }
 
-// open opens the chest, displaying the animation and playing a sound.
+// open opens the chest, displaying the animation and playing a sound. If the chest is paired, the chest it is
+// paired with is opened too.
 func (c Chest) open(w *world.World, pos cube.Pos) {
 	for _, v := range w.Viewers(pos.Vec3()) {
 		v.ViewBlockAction(pos, OpenAction{})
+		if c.paired {
+			v.ViewBlockAction(c.pairPos(pos), OpenAction{})
+		}
 	}
 	w.PlaySound(pos.Vec3Centre(), sound.ChestOpen{})
 }
 
-// close closes the chest, displaying the animation and playing a sound.
+// close closes the chest, displaying the animation and playing a sound. If the chest is paired, the chest it
+// is paired with is closed too.
 func (c Chest) close(w *world.World, pos cube.Pos) {
 	for _, v := range w.Viewers(pos.Vec3()) {
 		v.ViewBlockAction(pos, CloseAction{})
+		if c.paired {
+			v.ViewBlockAction(c.pairPos(pos), CloseAction{})
+		}
 	}
 	w.PlaySound(pos.Vec3Centre(), sound.ChestClose{})
 }
@@ -111,7 +145,28 @@ func (c Chest) RemoveViewer(v ContainerViewer, w *world.World, pos cube.Pos) {
 // Activate ...
 func (c Chest) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
 	if opener, ok := u.(ContainerOpener); ok {
-		if d, ok := w.Block(pos.Side(cube.FaceUp)).(LightDiffuser); ok && d.LightDiffusionLevel() == 0 {
+		if c.paired {
+			if c.pairInv == nil {
+				// The chest was loaded from the world, so the pair still needs to be set up.
+				if ch, pair, ok := c.pair(w, pos, c.pairPos(pos)); ok {
+					w.SetBlock(pos, ch, nil)
+					w.SetBlock(c.pairPos(pos), pair, nil)
+					c = ch
+				} else {
+					c.paired, c.pairLead = false, false
+					w.SetBlock(pos, c, nil)
+				}
+			}
+			if c.paired && !c.pairValid(w, pos) {
+				// The other half of the double chest is gone, so the chest is opened as a single chest.
+				c = c.unpair()
+				w.SetBlock(pos, c, nil)
+			}
+			if c.paired && !chestOpenable(w, c.pairPos(pos)) {
+				return true
+			}
+		}
+		if chestOpenable(w, pos) {
 			opener.OpenBlockContainer(pos)
 		}
 		return true
@@ -119,6 +174,13 @@ func (c Chest) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User,
 	return false
 }
 
+// chestOpenable checks if the chest at the position passed may be opened, which is only the case if the block
+// above it does not diffuse light.
+func chestOpenable(w *world.World, pos cube.Pos) bool {
+	d, ok := w.Block(pos.Side(cube.FaceUp)).(LightDiffuser)
+	return ok && d.LightDiffusionLevel() == 0
+}
+
 // UseOnBlock ...
 func (c Chest) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
 	pos, _, used = firstReplaceable(w, pos, face, c)
@@ -129,13 +191,153 @@ func (c Chest) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.W
 	c = NewChest()
 	c.Facing = user.Rotation().Direction().Opposite()
 
+	// Pair the chest with a chest on its left or right side facing the same direction, if there is one.
+	var pair Chest
+	pairPos, paired := cube.Pos{}, false
+	for _, dir := range []cube.Direction{c.Facing.RotateLeft(), c.Facing.RotateRight()} {
+		pairPos = pos.Side(dir.Face())
+		if ch, ok := w.Block(pairPos).(Chest); ok && !ch.paired {
+			if c, pair, paired = c.pair(w, pos, pairPos); paired {
+				break
+			}
+		}
+	}
+
 	place(w, pos, c, user, ctx)
+	if placed(ctx) && paired {
+		w.SetBlock(pairPos, pair, nil)
+	}
 	return placed(ctx)
 }
 
+// pair pairs the chest at the position passed with the chest at pairPos, so that they form a double chest. The
+// chests returned share the inventory of the double chest and its viewers. False is returned if there is no chest
+// at pairPos that the chest can be paired with.
+func (c Chest) pair(w *world.World, pos, pairPos cube.Pos) (Chest, Chest, bool) {
+	pair, ok := w.Block(pairPos).(Chest)
+	if !ok || pair.Facing != c.Facing || pos.Side(c.Facing.RotateLeft().Face()) != pairPos && pos.Side(c.Facing.RotateRight().Face()) != pairPos {
+		return c, pair, false
+	}
+	if pair.paired && pair.pairPos(pairPos) != pos {
+		return c, pair, false
+	}
+	m := new(sync.RWMutex)
+	v := make(map[ContainerViewer]struct{}, 1)
+
+	var left, right *inventory.Inventory
+	double := inventory.New(54, func(slot int, _, it item.Stack) {
+		half := left
+		if slot >= 27 {
+			half, slot = right, slot-27
+		}
+		if half == nil {
+			return
+		}
+		if current, _ := half.Item(slot); !current.Equal(it) {
+			_ = half.SetItem(slot, it)
+		}
+	})
+	half := func(offset int) *inventory.Inventory {
+		return inventory.New(27, func(slot int, _, it item.Stack) {
+			if current, _ := double.Item(slot + offset); !current.Equal(it) {
+				_ = double.SetItem(slot+offset, it)
+			}
+			m.RLock()
+			defer m.RUnlock()
+			for viewer := range v {
+				viewer.ViewSlotChange(slot+offset, it)
+			}
+		})
+	}
+	left, right = half(0), half(27)
+
+	// Viewers of either chest are still viewing the old inventories, which are replaced below, so their containers
+	// are closed before the items are moved to the double chest.
+	c.closeViewers()
+	pair.closeViewers()
+
+	// The chest of which the pair is on its right side holds the second half of the inventory.
+	c.pairLead = pos.Side(c.Facing.RotateRight().Face()) != pairPos
+	pair.pairLead = !c.pairLead
+	first, second := &c, &pair
+	if !c.pairLead {
+		first, second = second, first
+	}
+	for slot, it := range first.inventory.Slots() {
+		_ = left.SetItem(slot, it)
+	}
+	for slot, it := range second.inventory.Slots() {
+		_ = right.SetItem(slot, it)
+	}
+	first.inventory, second.inventory = left, right
+
+	c.paired, c.pairX, c.pairZ, c.pairInv = true, pairPos[0], pairPos[2], double
+	pair.paired, pair.pairX, pair.pairZ, pair.pairInv = true, pos[0], pos[2], double
+	c.viewerMu, pair.viewerMu = m, m
+	c.viewers, pair.viewers = v, v
+	return c, pair, true
+}
+
+// closeViewers closes the container of all viewers of the chest that are able to close it.
+func (c Chest) closeViewers() {
+	if c.viewerMu == nil {
+		return
+	}
+	c.viewerMu.RLock()
+	viewers := make([]ContainerViewer, 0, len(c.viewers))
+	for v := range c.viewers {
+		viewers = append(viewers, v)
+	}
+	c.viewerMu.RUnlock()
+
+	for _, v := range viewers {
+		if closer, ok := v.(interface{ CloseContainer() }); ok {
+			closer.CloseContainer()
+		}
+	}
+}
+
 // BreakInfo ...
 func (c Chest) BreakInfo() BreakInfo {
-	return newBreakInfo(2.5, alwaysHarvestable, axeEffective, oneOf(c))
+	return newBreakInfo(2.5, alwaysHarvestable, axeEffective, oneOf(c)).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		if !c.paired {
+			return
+		}
+		// Unpair the chest that this chest was paired with, so that it becomes a single chest again.
+		pairPos := c.pairPos(pos)
+		if pair, ok := w.Block(pairPos).(Chest); ok && pair.paired && pair.pairPos(pairPos) == pos {
+			w.SetBlock(pairPos, pair.unpair(), nil)
+		}
+	})
+}
+
+// NeighbourUpdateTick ...
+func (c Chest) NeighbourUpdateTick(pos, changedNeighbour cube.Pos, w *world.World) {
+	if c.paired && c.pairInv != nil && changedNeighbour == c.pairPos(pos) && !c.pairValid(w, pos) {
+		// The other half of the double chest was removed without being broken, for example by an explosion or
+		// by a structure being placed over it.
+		w.SetBlock(pos, c.unpair(), nil)
+	}
+}
+
+// pairValid checks if the chest at the position passed is still paired with the chest at its pair position.
+func (c Chest) pairValid(w *world.World, pos cube.Pos) bool {
+	pairPos := c.pairPos(pos)
+	pair, ok := w.Block(pairPos).(Chest)
+	return ok && pair.paired && pair.pairPos(pairPos) == pos
+}
+
+// unpair returns a single chest holding the items of the half of the double chest that the chest holds. The
+// viewers of the double chest are closed, and the chest gets a new 27 slot inventory, so that it no longer
+// forwards slot changes to the double chest.
+func (c Chest) unpair() Chest {
+	c.closeViewers()
+	single := NewChest()
+	single.Facing, single.CustomName = c.Facing, c.CustomName
+	for slot, it := range c.inventory.Slots() {
+		_ = single.inventory.SetItem(slot, it)
+	}
+	return single
 }
 
 // FuelInfo ...
@@ -155,6 +357,11 @@ func (c Chest) DecodeNBT(data map[string]any) any {
 	c = NewChest()
 	c.Facing = facing
 	c.CustomName = nbtconv.String(data, "CustomName")
+	if _, ok := data["pairx"]; ok {
+		c.paired = true
+		c.pairX, c.pairZ = int(nbtconv.Int32(data, "pairx")), int(nbtconv.Int32(data, "pairz"))
+		c.pairLead = nbtconv.Bool(data, "pairlead")
+	}
 	nbtconv.InvFromNBT(c.inventory, nbtconv.Slice(data, "Items"))
 	return c
 }
@@ -174,6 +381,10 @@ func (c Chest) EncodeNBT() map[string]any {
 	if c.CustomName != "" {
 		m["CustomName"] = c.CustomName
 	}
+	if c.paired {
+		m["pairx"], m["pairz"] = int32(c.pairX), int32(c.pairZ)
+		m["pairlead"] = boolByte(c.pairLead)
+	}
 	return m
 }
 
@@ -0,0 +1,44 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Cobweb is a block that greatly slows down entities moving through it. It may be broken quickly using a sword or
+// shears.
+type Cobweb struct {
+	empty
+	transparent
+}
+
+// EntityVelocity slows down the entity moving through the cobweb.
+func (Cobweb) EntityVelocity(_ cube.Pos, _ *world.World, _ world.Entity, vel mgl64.Vec3) mgl64.Vec3 {
+	return mgl64.Vec3{vel[0] * 0.25, vel[1] * 0.05, vel[2] * 0.25}
+}
+
+// BreakInfo ...
+func (c Cobweb) BreakInfo() BreakInfo {
+	return newBreakInfo(4, func(t item.Tool) bool {
+		return t.ToolType() == item.TypeShears || t.ToolType() == item.TypeSword
+	}, func(t item.Tool) bool {
+		return t.ToolType() == item.TypeShears || t.ToolType() == item.TypeSword
+	}, func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
+		if t.ToolType() == item.TypeShears || hasSilkTouch(enchantments) {
+			return []item.Stack{item.NewStack(c, 1)}
+		}
+		return []item.Stack{item.NewStack(item.String{}, 1)}
+	})
+}
+
+// EncodeItem ...
+func (Cobweb) EncodeItem() (name string, meta int16) {
+	return "minecraft:web", 0
+}
+
+// EncodeBlock ...
+func (Cobweb) EncodeBlock() (string, map[string]any) {
+	return "minecraft:web", nil
+}
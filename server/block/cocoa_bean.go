@@ -79,7 +79,7 @@ func (c CocoaBean) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *wor
 
 // RandomTick ...
 func (c CocoaBean) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
-	if c.Age < 2 && r.Intn(5) == 0 {
+	if c.Age < 2 && r.Float64() < 0.2*w.GrowthModifier(c) {
 		c.Age++
 		w.SetBlock(pos, c, nil)
 	}
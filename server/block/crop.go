@@ -4,6 +4,8 @@ import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
+	"math"
+	"math/rand"
 )
 
 // Crop is an interface for all crops that are grown on farmland. A crop has a random chance to grow during random ticks.
@@ -88,8 +90,19 @@ func (c crop) CalculateGrowthChance(pos cube.Pos, w *world.World) float64 {
 		}
 	}
 
-	chance := 1 / (25/points + 1)
-	return chance
+	chance := 1 / (25/points + 1) * w.GrowthModifier(block)
+	return math.Min(chance, 1)
+}
+
+// growthSteps returns the amount of times that a block growing during every random tick, such as sugar cane, grows
+// in a single random tick, given the growth modifier of the block in its world. A modifier of 0.5 makes the block
+// grow half of the time, whereas a modifier of 1.5 makes it grow once, and a second time half of the time.
+func growthSteps(modifier float64, r *rand.Rand) int {
+	n := int(modifier)
+	if r.Float64() < modifier-float64(n) {
+		n++
+	}
+	return n
 }
 
 // sameCrop checks if both blocks are crops and that they are the same type.
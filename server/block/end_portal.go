@@ -0,0 +1,58 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// EndPortal is the block found inside an activated end portal frame. Entities that enter it are transported to the
+// End, or back to the Overworld when already in the End.
+type EndPortal struct {
+	transparent
+	empty
+}
+
+// EntityInside ...
+func (EndPortal) EntityInside(_ cube.Pos, _ *world.World, e world.Entity) {
+	if t, ok := e.(portalTraveller); ok {
+		t.EnterPortal(world.End)
+	}
+}
+
+// HasLiquidDrops ...
+func (EndPortal) HasLiquidDrops() bool {
+	return false
+}
+
+// LightEmissionLevel ...
+func (EndPortal) LightEmissionLevel() uint8 {
+	return 15
+}
+
+// EncodeBlock ...
+func (EndPortal) EncodeBlock() (string, map[string]any) {
+	return "minecraft:end_portal", nil
+}
+
+// endPlatform is the position of the obsidian platform that entities arrive at when travelling to the End.
+var endPlatform = cube.Pos{100, 49, 0}
+
+// endPortalTarget returns the position entities travelling through an end portal arrive at in dst. If dst is the
+// End, the obsidian platform is (re)created and used. In any other case, the spawn of dst is used.
+func endPortalTarget(dst *world.World) mgl64.Vec3 {
+	if dst.Dimension() != world.End {
+		return dst.Spawn().Vec3Middle()
+	}
+	opts := &world.SetOpts{DisableBlockUpdates: true}
+	for x := -2; x <= 2; x++ {
+		for z := -2; z <= 2; z++ {
+			pos := endPlatform.Add(cube.Pos{x, 0, z})
+			dst.SetBlock(pos.Side(cube.FaceDown), Obsidian{}, opts)
+			for y := 0; y < 3; y++ {
+				dst.SetBlock(pos.Add(cube.Pos{0, y, 0}), nil, opts)
+			}
+		}
+	}
+	return endPlatform.Vec3Middle()
+}
@@ -0,0 +1,99 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// EndPortalFrame is an indestructible block that makes up the frame of an end portal. When all twelve frames of a
+// portal have an eye of ender inserted, the portal is activated.
+type EndPortalFrame struct {
+	transparent
+
+	// Facing is the direction the frame is facing. Frames of a valid end portal face towards its centre.
+	Facing cube.Direction
+	// Eye specifies if an eye of ender was inserted into the frame.
+	Eye bool
+}
+
+// Model ...
+func (f EndPortalFrame) Model() world.BlockModel {
+	return model.EndPortalFrame{Eye: f.Eye}
+}
+
+// Activate ...
+func (f EndPortalFrame) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	if _, ok := held.Item().(item.EyeOfEnder); !ok || f.Eye {
+		return false
+	}
+	f.Eye = true
+	ctx.SubtractFromCount(1)
+	w.SetBlock(pos, f, nil)
+
+	inwards, side := f.Facing.Face(), f.Facing.RotateRight().Face()
+	centre := pos.Side(inwards).Side(inwards)
+	for _, c := range []cube.Pos{centre, centre.Side(side), centre.Side(side.Opposite())} {
+		if endPortalComplete(c, w) {
+			for x := -1; x <= 1; x++ {
+				for z := -1; z <= 1; z++ {
+					w.SetBlock(c.Add(cube.Pos{x, 0, z}), EndPortal{}, nil)
+				}
+			}
+			break
+		}
+	}
+	return true
+}
+
+// endPortalComplete checks if the centre passed is surrounded by twelve end portal frames facing towards it, all of
+// which have an eye of ender inserted.
+func endPortalComplete(centre cube.Pos, w *world.World) bool {
+	for _, d := range cube.Directions() {
+		edge := centre.Side(d.Face()).Side(d.Face())
+		side := d.RotateRight().Face()
+		for _, pos := range []cube.Pos{edge, edge.Side(side), edge.Side(side.Opposite())} {
+			if f, ok := w.Block(pos).(EndPortalFrame); !ok || !f.Eye || f.Facing != d.Opposite() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// UseOnBlock ...
+func (f EndPortalFrame) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, f)
+	if !used {
+		return
+	}
+	f.Facing = user.Rotation().Direction().Opposite()
+	place(w, pos, f, user, ctx)
+	return placed(ctx)
+}
+
+// LightEmissionLevel ...
+func (EndPortalFrame) LightEmissionLevel() uint8 {
+	return 1
+}
+
+// EncodeItem ...
+func (EndPortalFrame) EncodeItem() (name string, meta int16) {
+	return "minecraft:end_portal_frame", 0
+}
+
+// EncodeBlock ...
+func (f EndPortalFrame) EncodeBlock() (string, map[string]any) {
+	return "minecraft:end_portal_frame", map[string]any{"direction": int32(horizontalDirection(f.Facing)), "end_portal_eye_bit": boolByte(f.Eye)}
+}
+
+// allEndPortalFrames ...
+func allEndPortalFrames() (frames []world.Block) {
+	for _, d := range cube.Directions() {
+		frames = append(frames, EndPortalFrame{Facing: d}, EndPortalFrame{Facing: d, Eye: true})
+	}
+	return
+}
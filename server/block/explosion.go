@@ -3,6 +3,8 @@ package block
 import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/block/cube/trace"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/internal/bitset"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/df-mc/dragonfly/server/world/particle"
@@ -26,6 +28,9 @@ type ExplosionConfig struct {
 	// DisableItemDrops, when set to true, will prevent any item entities from dropping as a result of blocks being
 	// destroyed.
 	DisableItemDrops bool
+	// ItemDropChance is the chance, between 0 and 1, that a block destroyed by the explosion drops its items. If left
+	// as 0, the chance defaults to 1/Size, so that larger explosions drop fewer items.
+	ItemDropChance float64
 
 	// Sound is the sound to play when the explosion is created. If set to nil, this will default to the sound of a
 	// regular explosion.
@@ -79,6 +84,9 @@ func (c ExplosionConfig) Explode(w *world.World, explosionPos mgl64.Vec3) {
 	if c.Size == 0 {
 		c.Size = 4
 	}
+	if c.ItemDropChance == 0 {
+		c.ItemDropChance = 1 / c.Size
+	}
 
 	r, d := rand.New(c.Rand), c.Size*2
 	box := cube.Box(
@@ -90,22 +98,20 @@ func (c ExplosionConfig) Explode(w *world.World, explosionPos mgl64.Vec3) {
 		math.Ceil(explosionPos[2]+d+1),
 	)
 
+	affectedEntities := make([]world.Entity, 0, 32)
 	for _, e := range w.EntitiesWithin(box.Grow(2), nil) {
 		pos := e.Position()
 		if !e.Type().BBox(e).Translate(pos).IntersectsWith(box) {
 			continue
 		}
-		dist := pos.Sub(pos).Len()
-		if dist >= d {
-			continue
-		}
-		if explodable, ok := e.(ExplodableEntity); ok {
-			impact := (1 - dist/d) * exposure(pos, e)
-			explodable.Explode(explosionPos, impact, c)
+		if dist := pos.Sub(explosionPos).Len(); dist < d {
+			affectedEntities = append(affectedEntities, e)
 		}
 	}
 
 	affectedBlocks := make([]cube.Pos, 0, 32)
+	// Many rays pass through the same blocks, so a Volume is used to make sure every block is only affected once.
+	affected := bitset.NewVolume(cube.PosFromVec3(box.Min()), cube.PosFromVec3(box.Max()))
 	for _, ray := range rays {
 		pos := explosionPos
 		for blastForce := c.Size * (0.7 + r.Float64()*0.6); blastForce > 0.0; blastForce -= 0.225 {
@@ -123,18 +129,31 @@ func (c ExplosionConfig) Explode(w *world.World, explosionPos mgl64.Vec3) {
 			}
 
 			pos = pos.Add(ray)
-			if blastForce -= (resistance/5 + 0.3) * 0.3; blastForce > 0 {
+			if blastForce -= (resistance/5 + 0.3) * 0.3; blastForce > 0 && affected.Add(current) {
 				affectedBlocks = append(affectedBlocks, current)
 			}
 		}
 	}
+
+	ctx := event.C()
+	if w.Handler().HandleExplosion(ctx, explosionPos, &affectedEntities, &affectedBlocks, &c.ItemDropChance, &c.SpawnFire); ctx.Cancelled() {
+		return
+	}
+
+	for _, e := range affectedEntities {
+		if explodable, ok := e.(ExplodableEntity); ok {
+			pos := e.Position()
+			impact := (1 - pos.Sub(explosionPos).Len()/d) * exposure(pos, e)
+			explodable.Explode(explosionPos, impact, c)
+		}
+	}
 	for _, pos := range affectedBlocks {
 		bl := w.Block(pos)
 		if explodable, ok := bl.(Explodable); ok {
 			explodable.Explode(explosionPos, pos, w, c)
 		} else if breakable, ok := bl.(Breakable); ok {
 			w.SetBlock(pos, nil, nil)
-			if !c.DisableItemDrops && 1/c.Size > r.Float64() {
+			if !c.DisableItemDrops && c.ItemDropChance > r.Float64() {
 				for _, drop := range breakable.BreakInfo().Drops(item.ToolNone{}, nil) {
 					dropItem(w, drop, pos.Vec3Centre())
 				}
@@ -144,7 +163,8 @@ func (c ExplosionConfig) Explode(w *world.World, explosionPos mgl64.Vec3) {
 	if c.SpawnFire {
 		for _, pos := range affectedBlocks {
 			if r.Intn(3) == 0 {
-				if _, ok := w.Block(pos).(Air); ok && w.Block(pos.Side(cube.FaceDown)).Model().FaceSolid(pos, cube.FaceUp, w) {
+				below := pos.Side(cube.FaceDown)
+				if _, ok := w.Block(pos).(Air); ok && w.Block(below).Model().FaceSolid(below, cube.FaceUp, w) {
 					w.SetBlock(pos, Fire{}, nil)
 				}
 			}
@@ -12,15 +12,15 @@ type Farmland struct {
 	tilledGrass
 
 	// Hydration is how much moisture the farmland block has. Hydration starts at 0 & caps at 7. During a random tick
-	// update, if there is water within 4 blocks from the farmland block, hydration is set to 7. Otherwise, it
-	// decrements until it turns into dirt.
+	// update, if there is water within 4 blocks from the farmland block or if it is raining on it, hydration is set
+	// to 7. Otherwise, it decrements until it turns into dirt.
 	Hydration int
 }
 
 // SoilFor ...
 func (f Farmland) SoilFor(block world.Block) bool {
 	switch block.(type) {
-	case TallGrass, DoubleTallGrass, Flower, DoubleFlower, NetherSprouts:
+	case TallGrass, DoubleTallGrass, Flower, DoubleFlower, NetherSprouts, SweetBerryBush:
 		return true
 	}
 	return false
@@ -51,8 +51,11 @@ func (f Farmland) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
 	}
 }
 
-// hydrated checks for water within 4 blocks in each direction from the farmland.
+// hydrated checks for water within 4 blocks in each direction from the farmland, or if it is raining on the farmland.
 func (f Farmland) hydrated(pos cube.Pos, w *world.World) bool {
+	if w.RainingAt(pos.Side(cube.FaceUp)) {
+		return true
+	}
 	posX, posY, posZ := pos.X(), pos.Y(), pos.Z()
 	for y := 0; y <= 1; y++ {
 		for x := -4; x <= 4; x++ {
@@ -52,8 +52,7 @@ func max(a, b int) int {
 // infinitelyBurning returns true if fire can infinitely burn at the specified position.
 func infinitelyBurning(pos cube.Pos, w *world.World) bool {
 	switch block := w.Block(pos.Side(cube.FaceDown)).(type) {
-	// TODO: Magma Block
-	case Netherrack:
+	case Netherrack, Magma:
 		return true
 	case Bedrock:
 		return block.InfiniteBurning
@@ -220,6 +219,13 @@ func (f Fire) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
 
 // NeighbourUpdateTick ...
 func (f Fire) NeighbourUpdateTick(pos, neighbour cube.Pos, w *world.World) {
+	if neighbour == pos && f.Type == NormalFire() {
+		// The fire was just placed: It might be inside an obsidian frame, in which case a nether portal is lit.
+		if frame, ok := findPortalFrame(pos, w); ok {
+			frame.light(w)
+			return
+		}
+	}
 	below := w.Block(pos.Side(cube.FaceDown))
 	if diffuser, ok := below.(LightDiffuser); (ok && diffuser.LightDiffusionLevel() != 15) && (!neighboursFlammable(pos, w) || f.Type == SoulFire()) {
 		w.SetBlock(pos, nil, nil)
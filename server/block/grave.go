@@ -0,0 +1,132 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/particle"
+	"github.com/google/uuid"
+	"time"
+)
+
+// Grave is a block that holds the items of a player that died. Until the grave expires, only the owner of the
+// grave is able to collect the items by interacting with it or breaking it. After it expires, any player is able to
+// collect them. Graves are not destroyed by explosions.
+// Graves are encoded as the reserved6 block state, which is not used by any vanilla block, so that they never replace
+// a real block when a world is loaded. Its texture may be changed using a resource pack.
+type Grave struct {
+	solid
+
+	// Owner is the UUID of the player that the items in the grave belong to.
+	Owner uuid.UUID
+	// Expiry is the time after which players other than the owner are able to collect the items in the grave.
+	Expiry time.Time
+
+	inventory *inventory.Inventory
+}
+
+// NewGrave creates a new Grave holding the items passed. Only the owner passed is able to collect the items for the
+// duration passed.
+func NewGrave(owner uuid.UUID, items []item.Stack, protection time.Duration) Grave {
+	return Grave{Owner: owner, Expiry: time.Now().Add(protection), inventory: graveInventory(items)}
+}
+
+// graveInventory creates an inventory holding the items passed.
+func graveInventory(items []item.Stack) *inventory.Inventory {
+	inv := inventory.New(max(len(items), 1), nil)
+	for i, it := range items {
+		_ = inv.SetItem(i, it)
+	}
+	return inv
+}
+
+// Items returns the items stored in the grave.
+func (g Grave) Items() []item.Stack {
+	if g.inventory == nil {
+		return nil
+	}
+	return g.inventory.Items()
+}
+
+// Expired checks if the grave has expired, meaning any player is able to collect the items in it.
+func (g Grave) Expired() bool {
+	return time.Now().After(g.Expiry)
+}
+
+// collectable checks if the user passed is able to collect the items in the grave, which is the case if it is the
+// owner of the grave or if the grave has expired.
+func (g Grave) collectable(u item.User) bool {
+	if g.Expired() {
+		return true
+	}
+	owner, ok := u.(interface{ UUID() uuid.UUID })
+	return ok && owner.UUID() == g.Owner
+}
+
+// dropItems drops all items held by the grave at the position passed.
+func (g Grave) dropItems(pos cube.Pos, w *world.World) {
+	for _, it := range g.Items() {
+		dropItem(w, it, pos.Vec3Centre())
+	}
+}
+
+// Activate drops the items in the grave and removes it if the user is the owner of the grave or if the grave has
+// expired.
+func (g Grave) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	if !g.collectable(u) {
+		return false
+	}
+	w.SetBlock(pos, nil, nil)
+	w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: g})
+	g.dropItems(pos, w)
+	return true
+}
+
+// CanBreak checks if the user passed is able to break the grave, which is the case if it is the owner of the grave or
+// if the grave has expired.
+func (g Grave) CanBreak(u item.User) bool {
+	return g.collectable(u)
+}
+
+// BreakInfo ...
+func (g Grave) BreakInfo() BreakInfo {
+	return newBreakInfo(2.5, alwaysHarvestable, pickaxeEffective, simpleDrops()).withBlastResistance(3600000).withBreakHandler(func(pos cube.Pos, w *world.World, _ item.User) {
+		g.dropItems(pos, w)
+	})
+}
+
+// DecodeNBT ...
+func (g Grave) DecodeNBT(data map[string]any) any {
+	g.Owner, _ = uuid.Parse(nbtconv.String(data, "Owner"))
+	g.Expiry = time.Unix(nbtconv.Int64(data, "Expiry"), 0)
+	var items []item.Stack
+	for _, itemData := range nbtconv.Slice(data, "Items") {
+		m, _ := itemData.(map[string]any)
+		if it := nbtconv.Item(m, nil); !it.Empty() {
+			items = append(items, it)
+		}
+	}
+	g.inventory = graveInventory(items)
+	return g
+}
+
+// EncodeNBT ...
+func (g Grave) EncodeNBT() map[string]any {
+	var items []map[string]any
+	for _, it := range g.Items() {
+		items = append(items, nbtconv.WriteItem(it, true))
+	}
+	return map[string]any{
+		"id":     "Grave",
+		"Owner":  g.Owner.String(),
+		"Expiry": g.Expiry.Unix(),
+		"Items":  items,
+	}
+}
+
+// EncodeBlock ...
+func (Grave) EncodeBlock() (string, map[string]any) {
+	return "minecraft:reserved6", nil
+}
@@ -20,12 +20,15 @@ const (
 	hashBlueIce
 	hashBone
 	hashBookshelf
+	hashBrewingStand
 	hashBricks
+	hashBubbleColumn
 	hashCactus
 	hashCake
 	hashCalcite
 	hashCarpet
 	hashCarrot
+	hashCauldron
 	hashChain
 	hashChest
 	hashChiseledQuartz
@@ -33,6 +36,7 @@ const (
 	hashCoal
 	hashCoalOre
 	hashCobblestone
+	hashCobweb
 	hashCocoaBean
 	hashComposter
 	hashConcrete
@@ -59,6 +63,8 @@ const (
 	hashEmeraldOre
 	hashEnchantingTable
 	hashEndBricks
+	hashEndPortal
+	hashEndPortalFrame
 	hashEndStone
 	hashEnderChest
 	hashFarmland
@@ -75,10 +81,13 @@ const (
 	hashGoldOre
 	hashGranite
 	hashGrass
+	hashGrave
 	hashGravel
 	hashGrindstone
 	hashHayBale
+	hashHoney
 	hashHoneycomb
+	hashHopper
 	hashInvisibleBedrock
 	hashIron
 	hashIronBars
@@ -96,6 +105,7 @@ const (
 	hashLitPumpkin
 	hashLog
 	hashLoom
+	hashMagma
 	hashMelon
 	hashMelonSeeds
 	hashMossCarpet
@@ -118,6 +128,7 @@ const (
 	hashPlanks
 	hashPodzol
 	hashPolishedBlackstoneBrick
+	hashPortal
 	hashPotato
 	hashPrismarine
 	hashPumpkin
@@ -136,9 +147,11 @@ const (
 	hashSeaLantern
 	hashSeaPickle
 	hashShroomlight
+	hashShulkerBox
 	hashSign
 	hashSkull
 	hashSlab
+	hashSlime
 	hashSmithingTable
 	hashSmoker
 	hashSnow
@@ -154,6 +167,7 @@ const (
 	hashStoneBricks
 	hashStonecutter
 	hashSugarCane
+	hashSweetBerryBush
 	hashTNT
 	hashTallGrass
 	hashTerracotta
@@ -238,10 +252,18 @@ func (Bookshelf) Hash() uint64 {
 	return hashBookshelf
 }
 
+func (b BrewingStand) Hash() uint64 {
+	return hashBrewingStand | uint64(boolByte(b.LeftSlot))<<8 | uint64(boolByte(b.MiddleSlot))<<9 | uint64(boolByte(b.RightSlot))<<10
+}
+
 func (Bricks) Hash() uint64 {
 	return hashBricks
 }
 
+func (b BubbleColumn) Hash() uint64 {
+	return hashBubbleColumn | uint64(boolByte(b.DragDown))<<8
+}
+
 func (c Cactus) Hash() uint64 {
 	return hashCactus | uint64(c.Age)<<8
 }
@@ -262,6 +284,10 @@ func (c Carrot) Hash() uint64 {
 	return hashCarrot | uint64(c.Growth)<<8
 }
 
+func (c Cauldron) Hash() uint64 {
+	return hashCauldron | uint64(c.Liquid.Uint8())<<8 | uint64(c.Level)<<10
+}
+
 func (c Chain) Hash() uint64 {
 	return hashChain | uint64(c.Axis)<<8
 }
@@ -290,6 +316,10 @@ func (c Cobblestone) Hash() uint64 {
 	return hashCobblestone | uint64(boolByte(c.Mossy))<<8
 }
 
+func (Cobweb) Hash() uint64 {
+	return hashCobweb
+}
+
 func (c CocoaBean) Hash() uint64 {
 	return hashCocoaBean | uint64(c.Facing)<<8 | uint64(c.Age)<<10
 }
@@ -394,6 +424,14 @@ func (EndBricks) Hash() uint64 {
 	return hashEndBricks
 }
 
+func (EndPortal) Hash() uint64 {
+	return hashEndPortal
+}
+
+func (f EndPortalFrame) Hash() uint64 {
+	return hashEndPortalFrame | uint64(f.Facing)<<8 | uint64(boolByte(f.Eye))<<10
+}
+
 func (EndStone) Hash() uint64 {
 	return hashEndStone
 }
@@ -458,6 +496,10 @@ func (Grass) Hash() uint64 {
 	return hashGrass
 }
 
+func (Grave) Hash() uint64 {
+	return hashGrave
+}
+
 func (Gravel) Hash() uint64 {
 	return hashGravel
 }
@@ -470,10 +512,18 @@ func (h HayBale) Hash() uint64 {
 	return hashHayBale | uint64(h.Axis)<<8
 }
 
+func (Honey) Hash() uint64 {
+	return hashHoney
+}
+
 func (Honeycomb) Hash() uint64 {
 	return hashHoneycomb
 }
 
+func (h Hopper) Hash() uint64 {
+	return hashHopper | uint64(h.Facing)<<8 | uint64(boolByte(h.Powered))<<11
+}
+
 func (InvisibleBedrock) Hash() uint64 {
 	return hashInvisibleBedrock
 }
@@ -542,6 +592,10 @@ func (l Loom) Hash() uint64 {
 	return hashLoom | uint64(l.Facing)<<8
 }
 
+func (Magma) Hash() uint64 {
+	return hashMagma
+}
+
 func (Melon) Hash() uint64 {
 	return hashMelon
 }
@@ -630,6 +684,10 @@ func (b PolishedBlackstoneBrick) Hash() uint64 {
 	return hashPolishedBlackstoneBrick | uint64(boolByte(b.Cracked))<<8
 }
 
+func (p Portal) Hash() uint64 {
+	return hashPortal | uint64(p.Axis)<<8
+}
+
 func (p Potato) Hash() uint64 {
 	return hashPotato | uint64(p.Growth)<<8
 }
@@ -702,6 +760,10 @@ func (Shroomlight) Hash() uint64 {
 	return hashShroomlight
 }
 
+func (s ShulkerBox) Hash() uint64 {
+	return hashShulkerBox | uint64(s.Type.Uint8())<<8
+}
+
 func (s Sign) Hash() uint64 {
 	return hashSign | uint64(s.Wood.Uint8())<<8 | uint64(s.Attach.Uint8())<<12
 }
@@ -714,6 +776,10 @@ func (s Slab) Hash() uint64 {
 	return hashSlab | s.Block.Hash()<<8 | uint64(boolByte(s.Top))<<24 | uint64(boolByte(s.Double))<<25
 }
 
+func (Slime) Hash() uint64 {
+	return hashSlime
+}
+
 func (SmithingTable) Hash() uint64 {
 	return hashSmithingTable
 }
@@ -774,6 +840,10 @@ func (c SugarCane) Hash() uint64 {
 	return hashSugarCane | uint64(c.Age)<<8
 }
 
+func (b SweetBerryBush) Hash() uint64 {
+	return hashSweetBerryBush | uint64(b.Age)<<8
+}
+
 func (TNT) Hash() uint64 {
 	return hashTNT
 }
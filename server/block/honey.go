@@ -0,0 +1,53 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Honey is a translucent, sticky block. Entities walking on it are slowed down and can barely jump, while entities
+// falling along its sides slide down slowly. Landing on Honey reduces fall damage.
+type Honey struct {
+	transparent
+}
+
+// BreakInfo ...
+func (h Honey) BreakInfo() BreakInfo {
+	return newBreakInfo(0, alwaysHarvestable, nothingEffective, oneOf(h))
+}
+
+// Model ...
+func (Honey) Model() world.BlockModel {
+	return model.Honey{}
+}
+
+// SpeedFactor ...
+func (Honey) SpeedFactor() float64 {
+	return 0.4
+}
+
+// JumpFactor ...
+func (Honey) JumpFactor() float64 {
+	return 0.5
+}
+
+// SlideVelocity ...
+func (Honey) SlideVelocity() float64 {
+	return 0.05
+}
+
+// EntityLand ...
+func (Honey) EntityLand(_ cube.Pos, _ *world.World, _ world.Entity, distance *float64) {
+	*distance *= 0.2
+}
+
+// EncodeItem ...
+func (Honey) EncodeItem() (name string, meta int16) {
+	return "minecraft:honey_block", 0
+}
+
+// EncodeBlock ...
+func (Honey) EncodeBlock() (string, map[string]any) {
+	return "minecraft:honey_block", nil
+}
@@ -0,0 +1,331 @@
+package block
+
+import (
+	"fmt"
+	"github.com/df-mc/atomic"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"strings"
+	"sync"
+)
+
+// hopperTransferCooldown is the amount of ticks that a hopper waits after transferring an item before it is able to
+// transfer another item.
+const hopperTransferCooldown = 8
+
+// Hopper is a container block that transfers items. It pulls items from the container above it and collects item
+// entities that land on top of it, and pushes items into the container that it is facing.
+// The empty value of Hopper is not valid. It must be created using block.NewHopper().
+type Hopper struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Facing is the direction that the hopper pushes items in. A hopper is never facing up.
+	Facing cube.Face
+	// Powered specifies if the hopper is locked, which stops it from transferring items. Powered hoppers still
+	// accept items from other hoppers.
+	Powered bool
+	// CustomName is the custom name of the hopper. This name is displayed when the hopper is opened, and may
+	// include colour codes.
+	CustomName string
+
+	inventory *inventory.Inventory
+	viewerMu  *sync.RWMutex
+	viewers   map[ContainerViewer]struct{}
+	// cooldown is the amount of ticks left until the hopper is able to transfer an item again.
+	cooldown *atomic.Int64
+}
+
+// NewHopper creates a new initialised hopper. The inventory is properly initialised.
+func NewHopper() Hopper {
+	m := new(sync.RWMutex)
+	v := make(map[ContainerViewer]struct{}, 1)
+	return Hopper{
+		inventory: inventory.New(5, func(slot int, _, item item.Stack) {
+			m.RLock()
+			defer m.RUnlock()
+			for viewer := range v {
+				viewer.ViewSlotChange(slot, item)
+			}
+		}),
+		viewerMu: m,
+		viewers:  v,
+		cooldown: atomic.NewInt64(0),
+	}
+}
+
+// Model ...
+func (Hopper) Model() world.BlockModel {
+	return model.Hopper{}
+}
+
+// Inventory returns the inventory of the hopper. The size of the inventory will be 5.
+func (h Hopper) Inventory() *inventory.Inventory {
+	return h.inventory
+}
+
+// WithName returns the hopper after applying a specific name to the block.
+func (h Hopper) WithName(a ...any) world.Item {
+	h.CustomName = strings.TrimSuffix(fmt.Sprintln(a...), "\n")
+	return h
+}
+
+// SideClosed ...
+func (Hopper) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// AddViewer adds a viewer to the hopper, so that it is updated whenever the inventory of the hopper is changed.
+func (h Hopper) AddViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	h.viewerMu.Lock()
+	defer h.viewerMu.Unlock()
+	h.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the hopper, so that slot updates in the inventory are no longer sent to
+// it.
+func (h Hopper) RemoveViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	h.viewerMu.Lock()
+	defer h.viewerMu.Unlock()
+	delete(h.viewers, v)
+}
+
+// Activate ...
+func (h Hopper) Activate(pos cube.Pos, _ cube.Face, _ *world.World, u item.User, _ *item.UseContext) bool {
+	if opener, ok := u.(ContainerOpener); ok {
+		opener.OpenBlockContainer(pos)
+		return true
+	}
+	return false
+}
+
+// UseOnBlock ...
+func (h Hopper) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, h)
+	if !used {
+		return
+	}
+	//noinspection GoAssignmentToReceiver
+	h = NewHopper()
+	h.Facing = cube.FaceDown
+	if face != cube.FaceUp && face != cube.FaceDown {
+		h.Facing = face.Opposite()
+	}
+
+	place(w, pos, h, user, ctx)
+	return placed(ctx)
+}
+
+// Tick transfers an item out of and into the hopper every 8 ticks, unless the hopper is powered.
+func (h Hopper) Tick(_ int64, pos cube.Pos, w *world.World) {
+	if h.cooldown.Load() > 0 {
+		h.cooldown.Add(-1)
+		return
+	}
+	if h.Powered {
+		return
+	}
+	pushed := h.push(pos, w)
+	pulled := h.pull(pos, w)
+	if pushed || pulled {
+		h.cooldown.Store(hopperTransferCooldown - 1)
+	}
+}
+
+// push pushes a single item from the hopper into the container that the hopper is facing. True is returned if an
+// item was pushed.
+func (h Hopper) push(pos cube.Pos, w *world.World) bool {
+	dest, ok := w.Block(pos.Side(h.Facing)).(Container)
+	if !ok {
+		return false
+	}
+	for slot, it := range h.inventory.Slots() {
+		if it.Empty() {
+			continue
+		}
+		if !hopperInsert(dest, h.Facing, it.Grow(1-it.Count())) {
+			continue
+		}
+		_ = h.inventory.SetItem(slot, it.Grow(-1))
+		return true
+	}
+	return false
+}
+
+// hopperInsert inserts a single item into the container passed from a hopper facing the face passed. Items are
+// inserted into the input slot of smelters and brewing stands when coming from above, and into the fuel slot or
+// potion slots when coming from the side. True is returned if the item was inserted.
+func hopperInsert(c Container, face cube.Face, it item.Stack) bool {
+	inv := c.Inventory()
+	switch c.(type) {
+	case Furnace, BlastFurnace, Smoker:
+		slot := 0
+		if face != cube.FaceDown {
+			if _, ok := item.FuelInfoOf(it.Item()); !ok {
+				return false
+			}
+			slot = 1
+		}
+		existing, _ := inv.Item(slot)
+		if !existing.Empty() && (!existing.Comparable(it) || existing.Count() >= existing.MaxCount()) {
+			return false
+		}
+		if existing.Empty() {
+			existing = it.Grow(-it.Count())
+		}
+		_ = inv.SetItem(slot, existing.Grow(1))
+		return true
+	case BrewingStand:
+		slots := []int{0}
+		if face != cube.FaceDown {
+			// Hoppers on the side of a brewing stand insert blaze powder into the fuel slot and potions into the
+			// potion slots.
+			switch it.Item().(type) {
+			case item.BlazePowder:
+				slots = []int{4}
+			case item.Potion, item.SplashPotion, item.LingeringPotion, item.GlassBottle:
+				slots = []int{1, 2, 3}
+			default:
+				return false
+			}
+		} else if !item.BrewingReagent(it.Item()) {
+			return false
+		}
+		for _, slot := range slots {
+			existing, _ := inv.Item(slot)
+			if existing.Empty() {
+				_ = inv.SetItem(slot, it.Grow(1-it.Count()))
+				return true
+			}
+			if existing.Comparable(it) && existing.Count() < existing.MaxCount() {
+				_ = inv.SetItem(slot, existing.Grow(1))
+				return true
+			}
+		}
+		return false
+	case ShulkerBox:
+		if _, ok := it.Item().(ShulkerBox); ok {
+			// Shulker boxes cannot be stored inside other shulker boxes.
+			return false
+		}
+	}
+	n, _ := inv.AddItem(it)
+	return n > 0
+}
+
+// pull pulls a single item into the hopper from the container above it. If there is no container above the
+// hopper, it collects item entities on top of it instead. True is returned if an item was pulled.
+func (h Hopper) pull(pos cube.Pos, w *world.World) bool {
+	src, ok := w.Block(pos.Side(cube.FaceUp)).(Container)
+	if !ok {
+		return h.collect(pos, w)
+	}
+	inv := src.Inventory()
+	slots, offset := inv.Slots(), 0
+	switch src.(type) {
+	case Furnace, BlastFurnace, Smoker:
+		// Hoppers only pull the output out of smelters, which is held in the last slot.
+		slots, offset = slots[2:], 2
+	case BrewingStand:
+		// Hoppers only pull the potions out of brewing stands.
+		slots, offset = slots[1:4], 1
+	}
+	for slot, it := range slots {
+		if it.Empty() {
+			continue
+		}
+		if n, _ := h.inventory.AddItem(it.Grow(1 - it.Count())); n == 0 {
+			continue
+		}
+		_ = inv.SetItem(slot+offset, it.Grow(-1))
+		return true
+	}
+	return false
+}
+
+// collect collects the item entities on top of the hopper. True is returned if any items were collected.
+func (h Hopper) collect(pos cube.Pos, w *world.World) bool {
+	var collected bool
+	box := cube.Box(0, 0.625, 0, 1, 2, 1).Translate(pos.Vec3())
+	for _, e := range w.EntitiesWithin(box, nil) {
+		i, ok := e.(interface {
+			world.Entity
+			Item() item.Stack
+		})
+		if !ok {
+			continue
+		}
+		it := i.Item()
+		n, _ := h.inventory.AddItem(it)
+		if n == 0 {
+			continue
+		}
+		collected = true
+		if n < it.Count() {
+			create := w.EntityRegistry().Config().Item
+			w.AddEntity(create(it.Grow(-n), i.Position(), mgl64.Vec3{}))
+		}
+		_ = i.Close()
+	}
+	return collected
+}
+
+// BreakInfo ...
+func (h Hopper) BreakInfo() BreakInfo {
+	return newBreakInfo(3, pickaxeHarvestable, pickaxeEffective, oneOf(h)).withBlastResistance(24)
+}
+
+// DecodeNBT ...
+func (h Hopper) DecodeNBT(data map[string]any) any {
+	facing, powered := h.Facing, h.Powered
+	//noinspection GoAssignmentToReceiver
+	h = NewHopper()
+	h.Facing, h.Powered = facing, powered
+	h.CustomName = nbtconv.String(data, "CustomName")
+	h.cooldown.Store(int64(nbtconv.Int32(data, "TransferCooldown")))
+	nbtconv.InvFromNBT(h.inventory, nbtconv.Slice(data, "Items"))
+	return h
+}
+
+// EncodeNBT ...
+func (h Hopper) EncodeNBT() map[string]any {
+	if h.inventory == nil {
+		facing, powered, customName := h.Facing, h.Powered, h.CustomName
+		//noinspection GoAssignmentToReceiver
+		h = NewHopper()
+		h.Facing, h.Powered, h.CustomName = facing, powered, customName
+	}
+	m := map[string]any{
+		"Items":            nbtconv.InvToNBT(h.inventory),
+		"TransferCooldown": int32(h.cooldown.Load()),
+		"id":               "Hopper",
+	}
+	if h.CustomName != "" {
+		m["CustomName"] = h.CustomName
+	}
+	return m
+}
+
+// EncodeItem ...
+func (Hopper) EncodeItem() (name string, meta int16) {
+	return "minecraft:hopper", 0
+}
+
+// EncodeBlock ...
+func (h Hopper) EncodeBlock() (string, map[string]any) {
+	return "minecraft:hopper", map[string]any{"facing_direction": int32(h.Facing), "toggle_bit": boolByte(h.Powered)}
+}
+
+// allHoppers ...
+func allHoppers() (hoppers []world.Block) {
+	for _, f := range cube.Faces() {
+		hoppers = append(hoppers, Hopper{Facing: f})
+		hoppers = append(hoppers, Hopper{Facing: f, Powered: true})
+	}
+	return
+}
@@ -123,8 +123,8 @@ func (k Kelp) NeighbourUpdateTick(pos, changed cube.Pos, w *world.World) {
 
 // RandomTick ...
 func (k Kelp) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
-	// Every random tick, there's a 14% chance for Kelp to grow if its age is below 25.
-	if r.Intn(100) < 15 && k.Age < 25 {
+	// Every random tick, there's a 15% chance for Kelp to grow if its age is below 25.
+	if r.Float64() < 0.15*w.GrowthModifier(k) && k.Age < 25 {
 		abovePos := pos.Side(cube.FaceUp)
 
 		liquid, ok := w.Liquid(abovePos)
@@ -0,0 +1,44 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// leashable represents an entity that may be leashed to a fence.
+type leashable interface {
+	world.Entity
+	LeashHolder() world.Entity
+	Leash(holder world.Entity) bool
+}
+
+// attachLeashes moves the leashes of all entities leashed to the user passed to a leash knot on the fence at the
+// position passed. If the fence has no leash knot yet, one is created. False is returned if no entities were
+// leashed to the user.
+func attachLeashes(pos cube.Pos, w *world.World, u item.User) bool {
+	var leashed []leashable
+	for _, e := range w.EntitiesWithin(cube.Box(-10, -10, -10, 10, 10, 10).Translate(u.Position()), nil) {
+		if l, ok := e.(leashable); ok && l.LeashHolder() == world.Entity(u) {
+			leashed = append(leashed, l)
+		}
+	}
+	if len(leashed) == 0 {
+		return false
+	}
+	var knot world.Entity
+	for _, e := range w.EntitiesWithin(cube.Box(0, 0, 0, 1, 1, 1).Translate(pos.Vec3()), nil) {
+		if e.Type().EncodeEntity() == "minecraft:leash_knot" {
+			knot = e
+			break
+		}
+	}
+	if knot == nil {
+		knot = w.EntityRegistry().Config().LeashKnot(pos)
+		w.AddEntity(knot)
+	}
+	for _, l := range leashed {
+		l.Leash(knot)
+	}
+	return true
+}
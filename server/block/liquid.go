@@ -3,6 +3,7 @@ package block
 import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/internal/bitset"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
 	"math"
@@ -67,7 +68,9 @@ func tickLiquid(b world.Liquid, pos cube.Pos, w *world.World) {
 		return
 	}
 	if source(b) || !canFlowBelow {
-		paths := calculateLiquidPaths(b, pos, w, displacer)
+		paths := world.LiquidFlow(w, pos, b, liquidSearchRadius, func() []liquidPath {
+			return calculateLiquidPaths(b, pos, w, displacer)
+		})
 		if len(paths) == 0 {
 			spreadOutwards(b, pos, w, displacer)
 			return
@@ -197,6 +200,7 @@ func calculateLiquidPaths(b world.Liquid, pos cube.Pos, w *world.World, displace
 		liquidQueuePool.Put(queue)
 	}()
 	queue.PushBack(liquidNode{x: pos[0], z: pos[2], depth: int8(b.LiquidDepth())})
+	queue.visit(pos)
 	decay := int8(b.SpreadDecay())
 
 	paths := make([]liquidPath, 0, 3)
@@ -208,6 +212,11 @@ func calculateLiquidPaths(b world.Liquid, pos cube.Pos, w *world.World, displace
 		}
 		node := queue.Front()
 		neighA, neighB, neighC, neighD := node.neighbours(decay * 2)
+		if first {
+			// Every neighbour of the source starts its own branch. Positions are only visited once per branch, so that
+			// the shortest path in every direction is still found.
+			neighA.branch, neighB.branch, neighC.branch, neighD.branch = 0, 1, 2, 3
+		}
 		if !first || (displacer == nil || !displacer.SideClosed(pos, cube.Pos{neighA.x, pos[1], neighA.z}, w)) {
 			if spreadNeighbour(b, pos, w, neighA, queue) {
 				queue.shortestPath = neighA.Len()
@@ -249,6 +258,10 @@ func spreadNeighbour(b world.Liquid, src cube.Pos, w *world.World, node liquidNo
 		return false
 	}
 	pos := cube.Pos{node.x, src[1], node.z}
+	if !queue.visited[node.branch].Add(pos) {
+		// This position was already reached through a path in the same branch that is at most as long as this one.
+		return false
+	}
 	if !canFlowInto(b, w, pos, true) {
 		// Can't flow into this block, can't spread any further.
 		return false
@@ -290,15 +303,16 @@ func canFlowInto(b world.Liquid, w *world.World, pos cube.Pos, sideways bool) bo
 type liquidNode struct {
 	x, z     int
 	depth    int8
+	branch   int8
 	previous *liquidNode
 }
 
 // neighbours returns the four horizontal neighbours of the node with decreased depth.
 func (node liquidNode) neighbours(decay int8) (a, b, c, d liquidNode) {
-	return liquidNode{x: node.x - 1, z: node.z, depth: node.depth - decay, previous: &node},
-		liquidNode{x: node.x + 1, z: node.z, depth: node.depth - decay, previous: &node},
-		liquidNode{x: node.x, z: node.z - 1, depth: node.depth - decay, previous: &node},
-		liquidNode{x: node.x, z: node.z + 1, depth: node.depth - decay, previous: &node}
+	return liquidNode{x: node.x - 1, z: node.z, depth: node.depth - decay, branch: node.branch, previous: &node},
+		liquidNode{x: node.x + 1, z: node.z, depth: node.depth - decay, branch: node.branch, previous: &node},
+		liquidNode{x: node.x, z: node.z - 1, depth: node.depth - decay, branch: node.branch, previous: &node},
+		liquidNode{x: node.x, z: node.z + 1, depth: node.depth - decay, branch: node.branch, previous: &node}
 }
 
 // Len returns the length of the path created by the node.
@@ -334,18 +348,37 @@ func (node liquidNode) Path(src cube.Pos) liquidPath {
 // liquidQueuePool is use to re-use liquid node queues.
 var liquidQueuePool = sync.Pool{
 	New: func() any {
-		return &liquidQueue{
+		q := &liquidQueue{
 			nodes:        make([]liquidNode, 0, 64),
 			shortestPath: math.MaxInt8,
 		}
+		for i := range q.visited {
+			q.visited[i] = bitset.NewVolume(cube.Pos{-liquidSearchRadius, 0, -liquidSearchRadius}, cube.Pos{liquidSearchRadius, 0, liquidSearchRadius})
+		}
+		return q
 	},
 }
 
+// liquidSearchRadius is the maximum horizontal distance from the source that liquid paths are calculated for. Liquid
+// depth runs out long before this distance is reached.
+const liquidSearchRadius = 8
+
 // liquidQueue represents a queue that may be used to push nodes into and take them out of it.
 type liquidQueue struct {
 	nodes        []liquidNode
 	i            int
 	shortestPath int
+	// visited holds the positions visited by each of the four branches of the search.
+	visited [4]*bitset.Volume
+}
+
+// visit moves the visited volumes of the queue so that they are centred around the source position passed, and
+// marks that position as visited in all branches.
+func (q *liquidQueue) visit(src cube.Pos) {
+	for _, v := range q.visited {
+		v.Reset(src.Sub(cube.Pos{liquidSearchRadius, 0, liquidSearchRadius}))
+		v.Add(src)
+	}
 }
 
 func (q *liquidQueue) PushBack(node liquidNode) {
@@ -0,0 +1,38 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Magma is a light-emitting block found in the Nether. Fire on top of magma burns infinitely, and magma forms a
+// bubble column that drags entities down in the water above it.
+type Magma struct {
+	solid
+	bassDrum
+}
+
+// LightEmissionLevel ...
+func (Magma) LightEmissionLevel() uint8 {
+	return 3
+}
+
+// NeighbourUpdateTick forms a bubble column that drags entities down in the water above the magma.
+func (Magma) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	formBubbleColumn(w, pos.Side(cube.FaceUp), true)
+}
+
+// BreakInfo ...
+func (m Magma) BreakInfo() BreakInfo {
+	return newBreakInfo(0.5, pickaxeHarvestable, pickaxeEffective, oneOf(m))
+}
+
+// EncodeItem ...
+func (Magma) EncodeItem() (name string, meta int16) {
+	return "minecraft:magma", 0
+}
+
+// EncodeBlock ...
+func (Magma) EncodeBlock() (string, map[string]any) {
+	return "minecraft:magma", nil
+}
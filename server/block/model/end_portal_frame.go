@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// EndPortalFrame is a model used by end portal frames.
+type EndPortalFrame struct {
+	// Eye specifies if the frame has an eye of ender inserted, which extends the box upwards.
+	Eye bool
+}
+
+// BBox ...
+func (f EndPortalFrame) BBox(cube.Pos, *world.World) []cube.BBox {
+	if f.Eye {
+		return []cube.BBox{cube.Box(0, 0, 0, 1, 0.8125, 1), cube.Box(0.25, 0.8125, 0.25, 0.75, 1, 0.75)}
+	}
+	return []cube.BBox{cube.Box(0, 0, 0, 1, 0.8125, 1)}
+}
+
+// FaceSolid ...
+func (f EndPortalFrame) FaceSolid(_ cube.Pos, face cube.Face, _ *world.World) bool {
+	return face == cube.FaceDown
+}
@@ -3,6 +3,7 @@ package block
 import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
 )
 
@@ -22,6 +23,11 @@ func (NetherBrickFence) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
 	return false
 }
 
+// Activate attaches the leashes of all entities leashed to the user to the NetherBrickFence.
+func (NetherBrickFence) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	return attachLeashes(pos, w, u)
+}
+
 // Model ...
 func (n NetherBrickFence) Model() world.BlockModel {
 	return model.Fence{}
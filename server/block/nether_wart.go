@@ -24,7 +24,7 @@ func (n NetherWart) HasLiquidDrops() bool {
 
 // RandomTick ...
 func (n NetherWart) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
-	if n.Age < 3 && r.Float64() < 0.1 {
+	if n.Age < 3 && r.Float64() < 0.1*w.GrowthModifier(n) {
 		n.Age++
 		w.SetBlock(pos, n, nil)
 	}
@@ -0,0 +1,322 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"time"
+)
+
+// Portal is the translucent part of a nether portal. Entities standing inside of it are transported to the Nether,
+// or back to the Overworld when already in the Nether.
+type Portal struct {
+	transparent
+	empty
+
+	// Axis is the axis along which the portal is directed. It is either cube.X or cube.Z.
+	Axis cube.Axis
+}
+
+// maxPortalSize is the maximum width and height of the inside of a nether portal frame.
+const maxPortalSize = 21
+
+// portalSearchRadius is the horizontal radius in blocks that is searched for an existing nether portal to link to
+// before a new one is created.
+const portalSearchRadius = 16
+
+// portalTraveller represents an entity that may travel through portals.
+type portalTraveller interface {
+	// EnterPortal is called every time the entity is inside a portal of a specific Dimension.
+	EnterPortal(dim world.Dimension)
+}
+
+// PortalTeleportCause is used for teleports caused by an entity travelling through a portal.
+type PortalTeleportCause struct {
+	// Dimension is the Dimension of the portal that the entity travelled through.
+	Dimension world.Dimension
+}
+
+func (PortalTeleportCause) ConservesMotion() bool   { return true }
+func (PortalTeleportCause) Cooldown() time.Duration { return time.Second }
+
+// EntityInside ...
+func (Portal) EntityInside(_ cube.Pos, _ *world.World, e world.Entity) {
+	if t, ok := e.(portalTraveller); ok {
+		t.EnterPortal(world.Nether)
+	}
+}
+
+// NeighbourUpdateTick ...
+func (p Portal) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	step := portalStep(p.Axis)
+	for _, side := range []cube.Pos{pos.Add(step), pos.Sub(step), pos.Side(cube.FaceUp), pos.Side(cube.FaceDown)} {
+		if b := w.Block(side); b != p && !portalFrameBlock(b) {
+			// Part of the frame or the portal itself was broken, so the rest of the portal breaks with it.
+			w.SetBlock(pos, nil, nil)
+			return
+		}
+	}
+}
+
+// HasLiquidDrops ...
+func (Portal) HasLiquidDrops() bool {
+	return false
+}
+
+// LightEmissionLevel ...
+func (Portal) LightEmissionLevel() uint8 {
+	return 11
+}
+
+// EncodeBlock ...
+func (p Portal) EncodeBlock() (string, map[string]any) {
+	return "minecraft:portal", map[string]any{"portal_axis": p.Axis.String()}
+}
+
+// allPortals ...
+func allPortals() []world.Block {
+	return []world.Block{Portal{Axis: cube.X}, Portal{Axis: cube.Z}}
+}
+
+// portalFrame is the inside of an obsidian frame that may be filled with portal blocks.
+type portalFrame struct {
+	axis          cube.Axis
+	origin        cube.Pos
+	width, height int
+}
+
+// light fills the inside of the portal frame with portal blocks.
+func (f portalFrame) light(w *world.World) {
+	for i := 0; i < f.width; i++ {
+		for h := 0; h < f.height; h++ {
+			w.SetBlock(f.pos(i, h), Portal{Axis: f.axis}, &world.SetOpts{DisableBlockUpdates: true})
+		}
+	}
+}
+
+// pos returns the position of the inside of the frame i blocks along its axis and h blocks up from its origin.
+func (f portalFrame) pos(i, h int) cube.Pos {
+	step := portalStep(f.axis)
+	return f.origin.Add(cube.Pos{step[0] * i, h, step[2] * i})
+}
+
+// findPortalFrame attempts to find an obsidian frame around the position passed in which a nether portal may be
+// lit. If no valid frame could be found along either horizontal axis, false is returned.
+func findPortalFrame(pos cube.Pos, w *world.World) (portalFrame, bool) {
+	if w.Dimension() == world.End {
+		return portalFrame{}, false
+	}
+	for _, axis := range []cube.Axis{cube.X, cube.Z} {
+		if f, ok := findPortalFrameAlong(pos, axis, w); ok {
+			return f, true
+		}
+	}
+	return portalFrame{}, false
+}
+
+// findPortalFrameAlong attempts to find an obsidian frame around the position passed that is directed along a
+// specific axis.
+func findPortalFrameAlong(pos cube.Pos, axis cube.Axis, w *world.World) (portalFrame, bool) {
+	step := portalStep(axis)
+	for n := 0; portalInterior(w.Block(pos.Side(cube.FaceDown))); n++ {
+		if n >= maxPortalSize {
+			return portalFrame{}, false
+		}
+		pos = pos.Side(cube.FaceDown)
+	}
+	if !portalFrameBlock(w.Block(pos.Side(cube.FaceDown))) {
+		return portalFrame{}, false
+	}
+	for n := 0; !portalFrameBlock(w.Block(pos.Sub(step))); n++ {
+		next := pos.Sub(step)
+		if n >= maxPortalSize || !portalInterior(w.Block(next)) || !portalFrameBlock(w.Block(next.Side(cube.FaceDown))) {
+			return portalFrame{}, false
+		}
+		pos = next
+	}
+
+	f := portalFrame{axis: axis, origin: pos}
+	for portalInterior(w.Block(f.pos(f.width, 0))) {
+		if !portalFrameBlock(w.Block(f.pos(f.width, -1))) {
+			return portalFrame{}, false
+		}
+		if f.width++; f.width > maxPortalSize {
+			return portalFrame{}, false
+		}
+	}
+	if f.width < 2 || !portalFrameBlock(w.Block(f.pos(f.width, 0))) {
+		return portalFrame{}, false
+	}
+
+	for ; f.height <= maxPortalSize; f.height++ {
+		if f.frameRow(f.height, w) {
+			break
+		}
+		if !portalFrameBlock(w.Block(f.pos(-1, f.height))) || !portalFrameBlock(w.Block(f.pos(f.width, f.height))) {
+			return portalFrame{}, false
+		}
+		for i := 0; i < f.width; i++ {
+			if !portalInterior(w.Block(f.pos(i, f.height))) {
+				return portalFrame{}, false
+			}
+		}
+	}
+	if f.height < 3 || f.height > maxPortalSize {
+		return portalFrame{}, false
+	}
+	return f, true
+}
+
+// frameRow checks if the row h blocks up from the origin of the frame consists of frame blocks only.
+func (f portalFrame) frameRow(h int, w *world.World) bool {
+	for i := 0; i < f.width; i++ {
+		if !portalFrameBlock(w.Block(f.pos(i, h))) {
+			return false
+		}
+	}
+	return true
+}
+
+// portalStep returns a unit offset along the horizontal axis passed.
+func portalStep(axis cube.Axis) cube.Pos {
+	if axis == cube.Z {
+		return cube.Pos{0, 0, 1}
+	}
+	return cube.Pos{1, 0, 0}
+}
+
+// portalFrameBlock checks if a block may be part of the frame of a nether portal.
+func portalFrameBlock(b world.Block) bool {
+	o, ok := b.(Obsidian)
+	return ok && !o.Crying
+}
+
+// portalInterior checks if a block may be inside a nether portal frame when it is lit.
+func portalInterior(b world.Block) bool {
+	switch b.(type) {
+	case Air, Fire, Portal:
+		return true
+	}
+	return false
+}
+
+// PortalTarget returns the World and position that an entity at a position in the World passed ends up at when
+// travelling through a portal of a specific Dimension. Nether portals link to the nearest nether portal around the
+// coordinate-scaled position in the destination World, creating a new portal if none could be found. End portals
+// lead to the obsidian platform in the End, or to the spawn of the destination World when used inside the End.
+// False is returned if the World has no destination for portals of the Dimension passed.
+func PortalTarget(w *world.World, pos mgl64.Vec3, dim world.Dimension) (*world.World, mgl64.Vec3, bool) {
+	dst := w.PortalDestination(dim)
+	if dst == w {
+		return nil, mgl64.Vec3{}, false
+	}
+	switch dim {
+	case world.Nether:
+		if w.Dimension() == world.End {
+			return nil, mgl64.Vec3{}, false
+		}
+		return dst, netherPortalTarget(w, dst, pos), true
+	case world.End:
+		return dst, endPortalTarget(dst), true
+	}
+	return nil, mgl64.Vec3{}, false
+}
+
+// netherPortalTarget finds or creates a nether portal in dst that is linked to the position passed in src.
+func netherPortalTarget(src, dst *world.World, pos mgl64.Vec3) mgl64.Vec3 {
+	x, z := pos[0], pos[2]
+	if src.Dimension() != world.Nether && dst.Dimension() == world.Nether {
+		x, z = x/8, z/8
+	} else if src.Dimension() == world.Nether && dst.Dimension() != world.Nether {
+		x, z = x*8, z*8
+	}
+	r := dst.Range()
+	y := max(min(int(math.Floor(pos[1])), r[1]-3), r[0]+1)
+
+	target := cube.Pos{int(math.Floor(x)), y, int(math.Floor(z))}
+	if p, ok := nearestPortal(dst, target); ok {
+		return p.Vec3Middle()
+	}
+	return createPortal(dst, target)
+}
+
+// nearestPortal searches for the portal block closest to the target position passed, returning the lowest portal
+// block in its column.
+func nearestPortal(w *world.World, target cube.Pos) (cube.Pos, bool) {
+	found, ok := w.LocateBlock(target, portalSearchRadius, func(b world.Block) bool {
+		_, portal := b.(Portal)
+		return portal
+	})
+	if !ok {
+		return cube.Pos{}, false
+	}
+	for {
+		if _, ok := w.Block(found.Side(cube.FaceDown)).(Portal); !ok {
+			return found, true
+		}
+		found = found.Side(cube.FaceDown)
+	}
+}
+
+// createPortal creates a new nether portal as close to the target position as possible and returns the position
+// that entities arrive at.
+func createPortal(w *world.World, target cube.Pos) mgl64.Vec3 {
+	r := w.Range()
+	origin := target
+	for d := 0; d <= r.Height(); d++ {
+		if up := target.Add(cube.Pos{0, d, 0}); portalSpace(w, up) {
+			origin = up
+			break
+		}
+		if down := target.Sub(cube.Pos{0, d, 0}); portalSpace(w, down) {
+			origin = down
+			break
+		}
+	}
+
+	opts := &world.SetOpts{DisableBlockUpdates: true}
+	for i := -1; i <= 2; i++ {
+		for h := -1; h <= 3; h++ {
+			pos := origin.Add(cube.Pos{i, h, 0})
+			if i == -1 || i == 2 || h == -1 || h == 3 {
+				w.SetBlock(pos, Obsidian{}, opts)
+				continue
+			}
+			w.SetBlock(pos, Portal{Axis: cube.X}, opts)
+		}
+	}
+	// Make sure the portal can be walked out of on both sides, adding a platform if there is nothing to stand on.
+	for i := 0; i <= 1; i++ {
+		for _, dz := range []int{-1, 1} {
+			below := origin.Add(cube.Pos{i, -1, dz})
+			if !w.Block(below).Model().FaceSolid(below, cube.FaceUp, w) {
+				w.SetBlock(below, Obsidian{}, opts)
+			}
+			for h := 0; h < 3; h++ {
+				w.SetBlock(origin.Add(cube.Pos{i, h, dz}), nil, opts)
+			}
+		}
+	}
+	return origin.Vec3Middle().Add(mgl64.Vec3{0.5})
+}
+
+// portalSpace checks if a 2x3 nether portal may be created at the position passed without replacing any blocks.
+func portalSpace(w *world.World, pos cube.Pos) bool {
+	r := w.Range()
+	if pos[1]-1 < r[0] || pos[1]+3 > r[1] {
+		return false
+	}
+	below := pos.Side(cube.FaceDown)
+	if !w.Block(below).Model().FaceSolid(below, cube.FaceUp, w) {
+		return false
+	}
+	for i := 0; i <= 1; i++ {
+		for h := 0; h < 3; h++ {
+			if _, ok := w.Block(pos.Add(cube.Pos{i, h, 0})).(Air); !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -2,6 +2,7 @@ package block
 
 import (
 	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/potion"
 	"github.com/df-mc/dragonfly/server/world"
 )
 
@@ -26,6 +27,7 @@ func init() {
 	world.RegisterBlock(Coal{})
 	world.RegisterBlock(Cobblestone{Mossy: true})
 	world.RegisterBlock(Cobblestone{})
+	world.RegisterBlock(Cobweb{})
 	world.RegisterBlock(CraftingTable{})
 	world.RegisterBlock(DeadBush{})
 	world.RegisterBlock(DeepslateBricks{Cracked: true})
@@ -44,6 +46,7 @@ func init() {
 	world.RegisterBlock(Emerald{})
 	world.RegisterBlock(EnchantingTable{})
 	world.RegisterBlock(EndBricks{})
+	world.RegisterBlock(EndPortal{})
 	world.RegisterBlock(EndStone{})
 	world.RegisterBlock(FletchingTable{})
 	world.RegisterBlock(GlassPane{})
@@ -53,13 +56,16 @@ func init() {
 	world.RegisterBlock(Granite{Polished: true})
 	world.RegisterBlock(Granite{})
 	world.RegisterBlock(Grass{})
+	world.RegisterBlock(Grave{})
 	world.RegisterBlock(Gravel{})
+	world.RegisterBlock(Honey{})
 	world.RegisterBlock(Honeycomb{})
 	world.RegisterBlock(InvisibleBedrock{})
 	world.RegisterBlock(IronBars{})
 	world.RegisterBlock(Iron{})
 	world.RegisterBlock(Jukebox{})
 	world.RegisterBlock(Lapis{})
+	world.RegisterBlock(Magma{})
 	world.RegisterBlock(Melon{})
 	world.RegisterBlock(MossCarpet{})
 	world.RegisterBlock(MudBricks{})
@@ -89,6 +95,7 @@ func init() {
 	world.RegisterBlock(Sand{})
 	world.RegisterBlock(SeaLantern{})
 	world.RegisterBlock(Shroomlight{})
+	world.RegisterBlock(Slime{})
 	world.RegisterBlock(SmithingTable{})
 	world.RegisterBlock(Snow{})
 	world.RegisterBlock(SoulSand{})
@@ -120,6 +127,8 @@ func init() {
 	registerAll(allBlackstone())
 	registerAll(allBlastFurnaces())
 	registerAll(allBoneBlock())
+	registerAll(allBrewingStands())
+	registerAll(allBubbleColumns())
 	registerAll(allCactus())
 	registerAll(allCake())
 	registerAll(allCarpet())
@@ -127,6 +136,7 @@ func init() {
 	registerAll(allChains())
 	registerAll(allChests())
 	registerAll(allCocoaBeans())
+	registerAll(allCauldrons())
 	registerAll(allComposters())
 	registerAll(allConcrete())
 	registerAll(allConcretePowder())
@@ -136,6 +146,7 @@ func init() {
 	registerAll(allDoors())
 	registerAll(allDoubleFlowers())
 	registerAll(allDoubleTallGrass())
+	registerAll(allEndPortalFrames())
 	registerAll(allEnderChests())
 	registerAll(allFarmland())
 	registerAll(allFence())
@@ -147,6 +158,7 @@ func init() {
 	registerAll(allGlazedTerracotta())
 	registerAll(allGrindstones())
 	registerAll(allHayBales())
+	registerAll(allHoppers())
 	registerAll(allItemFrames())
 	registerAll(allKelp())
 	registerAll(allLadders())
@@ -162,6 +174,7 @@ func init() {
 	registerAll(allNetherBricks())
 	registerAll(allNetherWart())
 	registerAll(allPlanks())
+	registerAll(allPortals())
 	registerAll(allPotato())
 	registerAll(allPrismarine())
 	registerAll(allPumpkinStems())
@@ -170,6 +183,7 @@ func init() {
 	registerAll(allQuartz())
 	registerAll(allSandstones())
 	registerAll(allSeaPickles())
+	registerAll(allShulkerBoxes())
 	registerAll(allSigns())
 	registerAll(allSkulls())
 	registerAll(allSlabs())
@@ -181,6 +195,7 @@ func init() {
 	registerAll(allStoneBricks())
 	registerAll(allStonecutters())
 	registerAll(allSugarCane())
+	registerAll(allSweetBerryBushes())
 	registerAll(allTallGrass())
 	registerAll(allTorches())
 	registerAll(allTrapdoors())
@@ -208,6 +223,7 @@ func init() {
 	world.RegisterItem(BlueIce{})
 	world.RegisterItem(Bone{})
 	world.RegisterItem(Bookshelf{})
+	world.RegisterItem(BrewingStand{})
 	world.RegisterItem(Bricks{})
 	world.RegisterItem(Cactus{})
 	world.RegisterItem(Cake{})
@@ -220,7 +236,9 @@ func init() {
 	world.RegisterItem(Coal{})
 	world.RegisterItem(Cobblestone{Mossy: true})
 	world.RegisterItem(Cobblestone{})
+	world.RegisterItem(Cobweb{})
 	world.RegisterItem(CocoaBean{})
+	world.RegisterItem(Cauldron{})
 	world.RegisterItem(Composter{})
 	world.RegisterItem(CraftingTable{})
 	world.RegisterItem(DeadBush{})
@@ -241,6 +259,7 @@ func init() {
 	world.RegisterItem(EnchantingTable{})
 	world.RegisterItem(EndBricks{})
 	world.RegisterItem(EndStone{})
+	world.RegisterItem(EndPortalFrame{})
 	world.RegisterItem(EnderChest{})
 	world.RegisterItem(Farmland{})
 	world.RegisterItem(Furnace{})
@@ -254,7 +273,9 @@ func init() {
 	world.RegisterItem(Gravel{})
 	world.RegisterItem(Grindstone{})
 	world.RegisterItem(HayBale{})
+	world.RegisterItem(Honey{})
 	world.RegisterItem(Honeycomb{})
+	world.RegisterItem(Hopper{})
 	world.RegisterItem(InvisibleBedrock{})
 	world.RegisterItem(IronBars{})
 	world.RegisterItem(Iron{})
@@ -266,6 +287,7 @@ func init() {
 	world.RegisterItem(Lapis{})
 	world.RegisterItem(LitPumpkin{})
 	world.RegisterItem(Loom{})
+	world.RegisterItem(Magma{})
 	world.RegisterItem(MelonSeeds{})
 	world.RegisterItem(Melon{})
 	world.RegisterItem(MossCarpet{})
@@ -308,6 +330,7 @@ func init() {
 	world.RegisterItem(SeaLantern{})
 	world.RegisterItem(SeaPickle{})
 	world.RegisterItem(Shroomlight{})
+	world.RegisterItem(Slime{})
 	world.RegisterItem(SmithingTable{})
 	world.RegisterItem(Smoker{})
 	world.RegisterItem(Snow{})
@@ -320,6 +343,7 @@ func init() {
 	world.RegisterItem(Stone{Smooth: true})
 	world.RegisterItem(Stone{})
 	world.RegisterItem(SugarCane{})
+	world.RegisterItem(SweetBerryBush{})
 	world.RegisterItem(TNT{})
 	world.RegisterItem(Terracotta{})
 	world.RegisterItem(Tuff{})
@@ -347,6 +371,9 @@ func init() {
 	for _, t := range AnvilTypes() {
 		world.RegisterItem(Anvil{Type: t})
 	}
+	for _, t := range ShulkerBoxTypes() {
+		world.RegisterItem(ShulkerBox{Type: t})
+	}
 	for _, c := range item.Colours() {
 		world.RegisterItem(Banner{Colour: c})
 		world.RegisterItem(Carpet{Colour: c})
@@ -425,6 +452,9 @@ func init() {
 	for _, t := range DeepslateTypes() {
 		world.RegisterItem(Deepslate{Type: t})
 	}
+
+	// Nether wart is a block, so the potion mix using it is registered here rather than in the item package.
+	item.RegisterPotionMix(item.PotionMix{Input: potion.Water(), Reagent: NetherWart{}, Output: potion.Awkward()})
 }
 
 func registerAll(blocks []world.Block) {
@@ -0,0 +1,218 @@
+package block
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/block/model"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"strings"
+	"sync"
+)
+
+// ShulkerBox is a dyeable block that stores items. Unlike other containers, a shulker box keeps its contents when it
+// is broken, which are then stored in the item dropped. Shulker boxes cannot be stored inside other shulker boxes.
+// The empty value of ShulkerBox is not valid. It must be created using block.NewShulkerBox().
+type ShulkerBox struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Type is the type of the shulker box, which specifies its colour.
+	Type ShulkerBoxType
+	// Facing is the direction that the shulker box opens towards.
+	Facing cube.Face
+	// CustomName is the custom name of the shulker box. This name is displayed when the shulker box is opened, and
+	// may include colour codes.
+	CustomName string
+
+	inventory *inventory.Inventory
+	viewerMu  *sync.RWMutex
+	viewers   map[ContainerViewer]struct{}
+}
+
+// NewShulkerBox creates a new initialised shulker box. The inventory is properly initialised.
+func NewShulkerBox() ShulkerBox {
+	m := new(sync.RWMutex)
+	v := make(map[ContainerViewer]struct{}, 1)
+	return ShulkerBox{
+		inventory: inventory.New(27, func(slot int, _, item item.Stack) {
+			m.RLock()
+			defer m.RUnlock()
+			for viewer := range v {
+				viewer.ViewSlotChange(slot, item)
+			}
+		}),
+		viewerMu: m,
+		viewers:  v,
+	}
+}
+
+// Model ...
+func (ShulkerBox) Model() world.BlockModel {
+	return model.Solid{}
+}
+
+// MaxCount always returns 1.
+func (ShulkerBox) MaxCount() int {
+	return 1
+}
+
+// Inventory returns the inventory of the shulker box. The size of the inventory will be 27.
+func (s ShulkerBox) Inventory() *inventory.Inventory {
+	return s.inventory
+}
+
+// WithName returns the shulker box after applying a specific name to the block.
+func (s ShulkerBox) WithName(a ...any) world.Item {
+	s.CustomName = strings.TrimSuffix(fmt.Sprintln(a...), "\n")
+	return s
+}
+
+// SideClosed ...
+func (ShulkerBox) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// open opens the shulker box, displaying the animation and playing a sound.
+func (s ShulkerBox) open(w *world.World, pos cube.Pos) {
+	for _, v := range w.Viewers(pos.Vec3()) {
+		v.ViewBlockAction(pos, OpenAction{})
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.ShulkerBoxOpen{})
+}
+
+// close closes the shulker box, displaying the animation and playing a sound.
+func (s ShulkerBox) close(w *world.World, pos cube.Pos) {
+	for _, v := range w.Viewers(pos.Vec3()) {
+		v.ViewBlockAction(pos, CloseAction{})
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.ShulkerBoxClose{})
+}
+
+// AddViewer adds a viewer to the shulker box, so that it is updated whenever the inventory of the shulker box is
+// changed.
+func (s ShulkerBox) AddViewer(v ContainerViewer, w *world.World, pos cube.Pos) {
+	s.viewerMu.Lock()
+	defer s.viewerMu.Unlock()
+	if len(s.viewers) == 0 {
+		s.open(w, pos)
+	}
+	s.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the shulker box, so that slot updates in the inventory are no longer sent to
+// it.
+func (s ShulkerBox) RemoveViewer(v ContainerViewer, w *world.World, pos cube.Pos) {
+	s.viewerMu.Lock()
+	defer s.viewerMu.Unlock()
+	if len(s.viewers) == 0 {
+		return
+	}
+	delete(s.viewers, v)
+	if len(s.viewers) == 0 {
+		s.close(w, pos)
+	}
+}
+
+// Activate ...
+func (s ShulkerBox) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	if opener, ok := u.(ContainerOpener); ok {
+		if d, ok := w.Block(pos.Side(s.Facing)).(LightDiffuser); !ok || d.LightDiffusionLevel() != 0 {
+			// Shulker boxes can only be opened if their lid is not obstructed by a block.
+			return true
+		}
+		opener.OpenBlockContainer(pos)
+		return true
+	}
+	return false
+}
+
+// UseOnBlock ...
+func (s ShulkerBox) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, s)
+	if !used {
+		return
+	}
+	place(w, pos, s.withContents(face), user, ctx)
+	return placed(ctx)
+}
+
+// withContents returns a new shulker box with the same type and name as the shulker box, facing the face passed. The
+// items in the inventory of the shulker box are copied into that of the new shulker box, so that the two never share
+// an inventory.
+func (s ShulkerBox) withContents(face cube.Face) ShulkerBox {
+	b := NewShulkerBox()
+	b.Type, b.Facing, b.CustomName = s.Type, face, s.CustomName
+	if s.inventory != nil {
+		for slot, it := range s.inventory.Slots() {
+			_ = b.inventory.SetItem(slot, it)
+		}
+	}
+	return b
+}
+
+// BreakInfo ...
+func (s ShulkerBox) BreakInfo() BreakInfo {
+	return newBreakInfo(2, alwaysHarvestable, pickaxeEffective, func(item.Tool, []item.Enchantment) []item.Stack {
+		return []item.Stack{item.NewStack(s.withContents(cube.FaceUp), 1)}
+	})
+}
+
+// DecodeNBT ...
+func (s ShulkerBox) DecodeNBT(data map[string]any) any {
+	t := s.Type
+	//noinspection GoAssignmentToReceiver
+	s = NewShulkerBox()
+	s.Type = t
+	s.Facing = cube.Face(nbtconv.Uint8(data, "facing"))
+	s.CustomName = nbtconv.String(data, "CustomName")
+	nbtconv.InvFromNBT(s.inventory, nbtconv.Slice(data, "Items"))
+	return s
+}
+
+// EncodeNBT ...
+func (s ShulkerBox) EncodeNBT() map[string]any {
+	if s.inventory == nil {
+		t, facing, customName := s.Type, s.Facing, s.CustomName
+		//noinspection GoAssignmentToReceiver
+		s = NewShulkerBox()
+		s.Type, s.Facing, s.CustomName = t, facing, customName
+	}
+	m := map[string]any{
+		"Items":  nbtconv.InvToNBT(s.inventory),
+		"facing": uint8(s.Facing),
+		"id":     "ShulkerBox",
+	}
+	if s.CustomName != "" {
+		m["CustomName"] = s.CustomName
+	}
+	return m
+}
+
+// EncodeItem ...
+func (s ShulkerBox) EncodeItem() (name string, meta int16) {
+	if c, ok := s.Type.Colour(); ok {
+		return "minecraft:shulker_box", int16(c.Uint8())
+	}
+	return "minecraft:undyed_shulker_box", 0
+}
+
+// EncodeBlock ...
+func (s ShulkerBox) EncodeBlock() (string, map[string]any) {
+	if c, ok := s.Type.Colour(); ok {
+		return "minecraft:shulker_box", map[string]any{"color": c.String()}
+	}
+	return "minecraft:undyed_shulker_box", nil
+}
+
+// allShulkerBoxes ...
+func allShulkerBoxes() (boxes []world.Block) {
+	for _, t := range ShulkerBoxTypes() {
+		boxes = append(boxes, ShulkerBox{Type: t})
+	}
+	return
+}
@@ -0,0 +1,42 @@
+package block
+
+import "github.com/df-mc/dragonfly/server/item"
+
+// ShulkerBoxType represents a type of shulker box. Shulker boxes are either undyed or dyed in one of the colours.
+type ShulkerBoxType struct {
+	shulkerBox
+}
+
+type shulkerBox uint8
+
+// NormalShulkerBox is the undyed, purple variant of shulker box.
+func NormalShulkerBox() ShulkerBoxType {
+	return ShulkerBoxType{0}
+}
+
+// DyedShulkerBox returns a variant of shulker box dyed in the colour passed.
+func DyedShulkerBox(c item.Colour) ShulkerBoxType {
+	return ShulkerBoxType{shulkerBox(c.Uint8() + 1)}
+}
+
+// Uint8 ...
+func (s shulkerBox) Uint8() uint8 {
+	return uint8(s)
+}
+
+// Colour returns the colour of the shulker box. False is returned if the shulker box is not dyed.
+func (s shulkerBox) Colour() (item.Colour, bool) {
+	if s == 0 {
+		return item.Colour{}, false
+	}
+	return item.Colours()[s-1], true
+}
+
+// ShulkerBoxTypes ...
+func ShulkerBoxTypes() []ShulkerBoxType {
+	types := []ShulkerBoxType{NormalShulkerBox()}
+	for _, c := range item.Colours() {
+		types = append(types, DyedShulkerBox(c))
+	}
+	return types
+}
@@ -0,0 +1,61 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Slime is a translucent block that makes entities landing on it bounce back up. Entities that land on it do not
+// take fall damage, unless they are sneaking.
+type Slime struct {
+	solid
+	transparent
+}
+
+// BreakInfo ...
+func (s Slime) BreakInfo() BreakInfo {
+	return newBreakInfo(0, alwaysHarvestable, nothingEffective, oneOf(s))
+}
+
+// Friction ...
+func (Slime) Friction() float64 {
+	return 0.8
+}
+
+// EntityLand ...
+func (Slime) EntityLand(_ cube.Pos, _ *world.World, e world.Entity, distance *float64) {
+	if s, ok := e.(sneakingEntity); !ok || !s.Sneaking() {
+		*distance = 0
+	}
+}
+
+// Bounce makes entities that are not sneaking bounce back up with the vertical velocity they landed with, damped
+// slightly. Entities that would bounce up with a velocity lower than minSlimeBounce stay on the block, so that
+// entities resting on it, such as items, do not keep bouncing.
+func (Slime) Bounce(e world.Entity, vel float64) float64 {
+	if s, ok := e.(sneakingEntity); ok && s.Sneaking() {
+		return 0
+	}
+	if bounce := -vel * 0.8; bounce >= minSlimeBounce {
+		return bounce
+	}
+	return 0
+}
+
+// minSlimeBounce is the minimum vertical velocity with which an entity bounces off a Slime block.
+const minSlimeBounce = 0.1
+
+// EncodeItem ...
+func (Slime) EncodeItem() (name string, meta int16) {
+	return "minecraft:slime", 0
+}
+
+// EncodeBlock ...
+func (Slime) EncodeBlock() (string, map[string]any) {
+	return "minecraft:slime", nil
+}
+
+// sneakingEntity is an entity that is able to sneak, such as a player.
+type sneakingEntity interface {
+	Sneaking() bool
+}
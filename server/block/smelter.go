@@ -117,14 +117,14 @@ func (s *smelter) tickSmelting(requirement, decrement time.Duration, lit bool, s
 
 	// Initialize some default smelt info, and update it if we can smelt the item.
 	var inputInfo item.SmeltInfo
-	if i, ok := input.Item().(item.Smeltable); ok && supported(i.SmeltInfo()) {
-		inputInfo = i.SmeltInfo()
+	if info, ok := item.SmeltInfoOf(input.Item()); ok && supported(info) {
+		inputInfo = info
 	}
 
 	// Initialize some default fuel info, and update it if it can be used as fuel.
 	var fuelInfo item.FuelInfo
-	if f, ok := fuel.Item().(item.Fuel); ok {
-		fuelInfo = f.FuelInfo()
+	if info, ok := item.FuelInfoOf(fuel.Item()); ok {
+		fuelInfo = info
 		if fuelInfo.Residue.Empty() {
 			// If we don't have a custom residue set, then we just decrement the fuel by one.
 			fuelInfo.Residue = fuel.Grow(-1)
@@ -1,6 +1,7 @@
 package block
 
 import (
+	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/df-mc/dragonfly/server/world/sound"
 )
@@ -10,14 +11,17 @@ type SoulSand struct {
 	solid
 }
 
-// TODO: Implement bubble columns.
-
 // SoilFor ...
 func (s SoulSand) SoilFor(block world.Block) bool {
 	flower, ok := block.(Flower)
 	return ok && flower.Type == WitherRose()
 }
 
+// NeighbourUpdateTick forms a bubble column in the water above the soul sand.
+func (s SoulSand) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	formBubbleColumn(w, pos.Side(cube.FaceUp), false)
+}
+
 // Instrument ...
 func (s SoulSand) Instrument() sound.Instrument {
 	return sound.CowBell()
@@ -2,6 +2,7 @@ package block
 
 import (
 	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/bitset"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/df-mc/dragonfly/server/world/particle"
@@ -90,6 +91,8 @@ func (s Sponge) absorbWater(pos cube.Pos, w *world.World) int {
 
 	queue := make([]distanceToSponge, 0)
 	queue = append(queue, distanceToSponge{pos, 0})
+	visited := bitset.NewVolumeAround(pos, 8, 8)
+	visited.Add(pos)
 
 	// A sponge can only absorb up to 65 water blocks.
 	replaced := 0
@@ -103,6 +106,9 @@ func (s Sponge) absorbWater(pos cube.Pos, w *world.World) int {
 		queue = queue[1:]
 
 		next.block.Neighbours(func(neighbour cube.Pos) {
+			if !visited.Add(neighbour) {
+				return
+			}
 			liquid, found := w.Liquid(neighbour)
 			if found {
 				if _, isWater := liquid.(Water); isWater {
@@ -43,17 +43,23 @@ func (c SugarCane) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 
 // RandomTick ...
 func (c SugarCane) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
-	if c.Age < 15 {
-		c.Age++
-	} else if c.Age == 15 {
-		c.Age = 0
-		if c.canGrowHere(pos.Side(cube.FaceDown), w, false) {
-			for y := 1; y < 3; y++ {
-				if _, ok := w.Block(pos.Add(cube.Pos{0, y})).(Air); ok {
-					w.SetBlock(pos.Add(cube.Pos{0, y}), SugarCane{}, nil)
-					break
-				} else if _, ok := w.Block(pos.Add(cube.Pos{0, y})).(SugarCane); !ok {
-					break
+	steps := growthSteps(w.GrowthModifier(c), r)
+	if steps == 0 {
+		return
+	}
+	for i := 0; i < steps; i++ {
+		if c.Age < 15 {
+			c.Age++
+		} else if c.Age == 15 {
+			c.Age = 0
+			if c.canGrowHere(pos.Side(cube.FaceDown), w, false) {
+				for y := 1; y < 3; y++ {
+					if _, ok := w.Block(pos.Add(cube.Pos{0, y})).(Air); ok {
+						w.SetBlock(pos.Add(cube.Pos{0, y}), SugarCane{}, nil)
+						break
+					} else if _, ok := w.Block(pos.Add(cube.Pos{0, y})).(SugarCane); !ok {
+						break
+					}
 				}
 			}
 		}
@@ -0,0 +1,171 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/particle"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// SweetBerryBush is a plant that grows sweet berries, which may be harvested once the bush has matured. Entities
+// moving through a sweet berry bush are slowed down and hurt once the bush has started growing.
+type SweetBerryBush struct {
+	transparent
+	empty
+
+	// Age is the stage of growth of the bush. It ranges from 0 to 3. Berries may be harvested from the bush once
+	// its age is 2 or higher.
+	Age int
+}
+
+// EntityVelocity slows down the entity moving through the bush.
+func (SweetBerryBush) EntityVelocity(_ cube.Pos, _ *world.World, _ world.Entity, vel mgl64.Vec3) mgl64.Vec3 {
+	return mgl64.Vec3{vel[0] * 0.8, vel[1] * 0.75, vel[2] * 0.8}
+}
+
+// EntityInside hurts the entity moving through the bush if the bush has started growing. Entities standing still in
+// the bush are not hurt.
+func (b SweetBerryBush) EntityInside(_ cube.Pos, _ *world.World, e world.Entity) {
+	if b.Age == 0 {
+		return
+	}
+	if m, ok := e.(interface{ Velocity() mgl64.Vec3 }); ok {
+		if vel := m.Velocity(); math.Abs(vel[0]) < minBerryBushDamageSpeed && math.Abs(vel[2]) < minBerryBushDamageSpeed {
+			return
+		}
+	}
+	if l, ok := e.(livingEntity); ok && !l.AttackImmune() {
+		l.Hurt(1, DamageSource{Block: b})
+	}
+}
+
+// minBerryBushDamageSpeed is the minimum horizontal speed along the X or Z axis with which an entity must move
+// through a SweetBerryBush to be hurt by it.
+const minBerryBushDamageSpeed = 0.003
+
+// Activate harvests the berries of the bush if it has matured.
+func (b SweetBerryBush) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	if b.Age < 2 {
+		return false
+	}
+	if held, _ := u.HeldItems(); b.Age < 3 {
+		if _, ok := held.Item().(item.BoneMeal); ok {
+			// Bone meal is used on the bush to grow it instead of harvesting it.
+			return false
+		}
+	}
+	dropItem(w, item.NewStack(SweetBerryBush{}, b.berries()), pos.Vec3Centre())
+	w.SetBlock(pos, SweetBerryBush{Age: 1}, nil)
+	return true
+}
+
+// berries returns a random amount of berries harvested from the bush.
+func (b SweetBerryBush) berries() int {
+	switch b.Age {
+	case 2:
+		return rand.Intn(2) + 1
+	case 3:
+		return rand.Intn(2) + 2
+	}
+	return 1
+}
+
+// BoneMeal ...
+func (b SweetBerryBush) BoneMeal(pos cube.Pos, w *world.World) bool {
+	if b.Age == 3 {
+		return false
+	}
+	b.Age++
+	w.SetBlock(pos, b, nil)
+	return true
+}
+
+// RandomTick ...
+func (b SweetBerryBush) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	if b.Age < 3 && w.Light(pos.Side(cube.FaceUp)) >= 9 && r.Float64() < 0.2*w.GrowthModifier(b) {
+		b.Age++
+		w.SetBlock(pos, b, nil)
+	}
+}
+
+// NeighbourUpdateTick ...
+func (b SweetBerryBush) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !supportsVegetation(b, w.Block(pos.Side(cube.FaceDown))) {
+		w.SetBlock(pos, nil, nil)
+		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: b})
+	}
+}
+
+// UseOnBlock ...
+func (b SweetBerryBush) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, b)
+	if !used {
+		return false
+	}
+	if !supportsVegetation(b, w.Block(pos.Side(cube.FaceDown))) {
+		return false
+	}
+
+	place(w, pos, SweetBerryBush{}, user, ctx)
+	return placed(ctx)
+}
+
+// AlwaysConsumable ...
+func (SweetBerryBush) AlwaysConsumable() bool {
+	return false
+}
+
+// ConsumeDuration ...
+func (SweetBerryBush) ConsumeDuration() time.Duration {
+	return item.DefaultConsumeDuration
+}
+
+// Consume ...
+func (SweetBerryBush) Consume(_ *world.World, consumer item.Consumer) item.Stack {
+	consumer.Saturate(2, 0.4)
+	return item.Stack{}
+}
+
+// HasLiquidDrops ...
+func (SweetBerryBush) HasLiquidDrops() bool {
+	return true
+}
+
+// FlammabilityInfo ...
+func (SweetBerryBush) FlammabilityInfo() FlammabilityInfo {
+	return newFlammabilityInfo(60, 100, false)
+}
+
+// BreakInfo ...
+func (b SweetBerryBush) BreakInfo() BreakInfo {
+	return newBreakInfo(0, alwaysHarvestable, nothingEffective, func(item.Tool, []item.Enchantment) []item.Stack {
+		return []item.Stack{item.NewStack(SweetBerryBush{}, b.berries())}
+	})
+}
+
+// CompostChance ...
+func (SweetBerryBush) CompostChance() float64 {
+	return 0.3
+}
+
+// EncodeItem ...
+func (SweetBerryBush) EncodeItem() (name string, meta int16) {
+	return "minecraft:sweet_berries", 0
+}
+
+// EncodeBlock ...
+func (b SweetBerryBush) EncodeBlock() (string, map[string]any) {
+	return "minecraft:sweet_berry_bush", map[string]any{"growth": int32(b.Age)}
+}
+
+// allSweetBerryBushes ...
+func allSweetBerryBushes() (bushes []world.Block) {
+	for age := 0; age <= 3; age++ {
+		bushes = append(bushes, SweetBerryBush{Age: age})
+	}
+	return
+}
@@ -30,6 +30,11 @@ func (WoodFence) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
 	return false
 }
 
+// Activate attaches the leashes of all entities leashed to the user to the WoodFence.
+func (WoodFence) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	return attachLeashes(pos, w, u)
+}
+
 // FlammabilityInfo ...
 func (w WoodFence) FlammabilityInfo() FlammabilityInfo {
 	if !w.Wood.Flammable() {
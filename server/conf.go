@@ -6,6 +6,7 @@ import (
 	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/internal/packbuilder"
 	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/player/economy"
 	"github.com/df-mc/dragonfly/server/player/playerdb"
 	"github.com/df-mc/dragonfly/server/session"
 	"github.com/df-mc/dragonfly/server/world"
@@ -19,6 +20,7 @@ import (
 	"golang.org/x/exp/slices"
 	"os"
 	"path/filepath"
+	"time"
 )
 
 // Config contains options for starting a Minecraft server.
@@ -52,6 +54,10 @@ type Config struct {
 	// players cannot. By returning false in the Allow method, for example if
 	// the player has been banned, will prevent the player from joining.
 	Allower Allower
+	// Handler is the Handler that handles events in the lifecycle of the
+	// server, such as the worlds being loaded and the server shutting down.
+	// If left as nil, a NopHandler is used.
+	Handler Handler
 	// AuthDisabled specifies if XBOX Live authentication should be disabled.
 	// Note that this should generally only be done for testing purposes or for
 	// local games. Allowing players to join without authentication is generally
@@ -63,6 +69,17 @@ type Config struct {
 	// MaxChunkRadius is the maximum view distance that each player may have,
 	// measured in chunks. A chunk radius generally leads to more memory usage.
 	MaxChunkRadius int
+	// MaxChunksPerTick is the maximum amount of chunks sent to each player
+	// every tick. Lower values spread out chunk sending over a longer time,
+	// which prevents lag spikes when players join, at the cost of chunks
+	// appearing slower. If set to 0, 4 chunks are sent per tick.
+	MaxChunksPerTick int
+	// Timeout is the duration without any packets from a player after which
+	// its connection, once closed, is considered to have timed out. The
+	// player is then disconnected with session.DisconnectReasonTimeout
+	// rather than session.DisconnectReasonQuit. If set to 0, it defaults to 5
+	// seconds.
+	Timeout time.Duration
 	// JoinMessage, QuitMessage and ShutdownMessage are the messages to send for
 	// when a player joins or quits the server and when the server shuts down,
 	// kicking all online players. JoinMessage and QuitMessage may have a '%v'
@@ -73,14 +90,31 @@ type Config struct {
 	// data. If left as nil, player data will be newly created every time a
 	// player joins the server and no data will be stored.
 	PlayerProvider player.Provider
+	// Economy is the economy.Economy holding the balances of players. It may
+	// be passed to systems that charge currency, such as merchants offering
+	// Trades with a Price (see entity.MerchantBehaviourConfig). If left
+	// as nil, balances are kept in memory and lost when the server closes.
+	Economy economy.Economy
 	// WorldProvider is the world.Provider used for storing and loading world
 	// data. If left as nil, world data will be newly created every time and
 	// chunks will always be newly generated when loaded. The world provider
 	// will be used for storing/loading the default overworld, nether and end.
 	WorldProvider world.Provider
+	// OpenWorldProvider is a function that opens the WorldProvider again
+	// after it was closed. It is used by Server.ReloadWorlds to read the
+	// worlds from disk again. If left as nil, ReloadWorlds only works if
+	// WorldProvider is also nil.
+	OpenWorldProvider func() (world.Provider, error)
 	// ReadOnlyWorld specifies if the standard worlds should be read only. If
 	// set to true, the WorldProvider won't be saved to at all.
 	ReadOnlyWorld bool
+	// WorldSaveInterval is the interval at which chunks of the standard worlds
+	// that were modified are saved while they remain loaded. If left as 0,
+	// chunks are only saved when unloaded or when the server is closed.
+	WorldSaveInterval time.Duration
+	// WorldSaveRate is the maximum amount of chunks saved per second at the
+	// WorldSaveInterval. If left as 0, there is no limit.
+	WorldSaveRate int
 	// Generator should return a function that specifies the world.Generator to
 	// use for every world.Dimension (world.Overworld, world.Nether and
 	// world.End). If left empty, Generator will be set to a flat world for each
@@ -95,8 +129,42 @@ type Config struct {
 	RandomTickSpeed int
 	// Entities is a world.EntityRegistry with all entity types registered that
 	// may be added to the Server's worlds. If no entity types are registered,
-	// Entities will be set to entity.DefaultRegistry.
+	// Entities will be set to entity.DefaultRegistry. Custom entity types may
+	// be registered using entity.DefaultRegistry.With. Spawn eggs are
+	// registered for all custom types that implement
+	// world.SpawnableEntityType.
 	Entities world.EntityRegistry
+	// SpawnChunkRadius is the radius in chunks around the spawn of the
+	// overworld that is kept loaded at all times. If left as 0, no chunks are
+	// kept loaded when no players are nearby.
+	SpawnChunkRadius int
+	// SpawnProtectionRadius is the radius in blocks around the spawn of the
+	// overworld in which only operators may edit blocks. If left as 0, spawn
+	// protection is disabled.
+	SpawnProtectionRadius int
+	// SpawnLocator selects the position in the overworld that players spawn
+	// at if they do not have a spawn position of their own. If left as nil,
+	// players spawn on a safe surface at the spawn of the overworld.
+	SpawnLocator world.SpawnLocator
+	// MobSpawning holds the settings of the natural spawning of entities in
+	// the Server's worlds. If MobSpawning.Spawns is left as nil, no entities
+	// spawn naturally. entity.DefaultSpawns may be used to spawn the mobs
+	// implemented by Dragonfly.
+	MobSpawning world.MobSpawning
+	// DeathMessages specifies if a message is broadcast in the global chat
+	// when a player in one of the Server's worlds dies. If left as false, no
+	// death messages are broadcast.
+	DeathMessages bool
+	// Physics is the world.Physics profile used by entities in the Server's
+	// worlds, which includes the knock back dealt by attacks. If nil,
+	// world.DefaultPhysics is used. Fields of a non-nil Physics are used as
+	// they are, so world.DefaultPhysics should be used as a starting point to
+	// change only some of them.
+	Physics *world.Physics
+	// Operators is a list of XUIDs or names of players that are made operator
+	// when joining the server. Operators are able to edit blocks within the
+	// spawn protection radius.
+	Operators []string
 }
 
 // Logger is used to report information and errors from a dragonfly Server. Any
@@ -125,11 +193,22 @@ func (conf Config) New() *Server {
 	if conf.PlayerProvider == nil {
 		conf.PlayerProvider = player.NopProvider{}
 	}
+	if conf.Economy == nil {
+		conf.Economy = economy.NewMemory(nil)
+	}
 	if conf.Allower == nil {
 		conf.Allower = allower{}
 	}
+	if conf.Handler == nil {
+		conf.Handler = NopHandler{}
+	}
 	if conf.WorldProvider == nil {
 		conf.WorldProvider = world.NopProvider{}
+		if conf.OpenWorldProvider == nil {
+			conf.OpenWorldProvider = func() (world.Provider, error) {
+				return world.NopProvider{}, nil
+			}
+		}
 	}
 	if conf.Generator == nil {
 		conf.Generator = loadGenerator
@@ -137,9 +216,16 @@ func (conf Config) New() *Server {
 	if conf.MaxChunkRadius == 0 {
 		conf.MaxChunkRadius = 12
 	}
+	if conf.MaxChunksPerTick == 0 {
+		conf.MaxChunksPerTick = 4
+	}
+	if conf.Timeout == 0 {
+		conf.Timeout = time.Second * 5
+	}
 	if len(conf.Entities.Types()) == 0 {
 		conf.Entities = entity.DefaultRegistry
 	}
+	entity.RegisterSpawnEggs(conf.Entities)
 	if !conf.DisableResourceBuilding {
 		if pack, ok := packbuilder.BuildResourcePack(); ok {
 			conf.Resources = append(conf.Resources, pack)
@@ -147,6 +233,7 @@ func (conf Config) New() *Server {
 	}
 	// Copy resources so that the slice can't be edited afterwards.
 	conf.Resources = slices.Clone(conf.Resources)
+	conf.Operators = slices.Clone(conf.Operators)
 
 	srv := &Server{
 		conf:     conf,
@@ -154,13 +241,18 @@ func (conf Config) New() *Server {
 		p:        make(map[uuid.UUID]*player.Player),
 		world:    &world.World{}, nether: &world.World{}, end: &world.World{},
 	}
+	srv.handler.Store(conf.Handler)
 	srv.world = srv.createWorld(world.Overworld, &srv.nether, &srv.end)
 	srv.nether = srv.createWorld(world.Nether, &srv.world, &srv.end)
 	srv.end = srv.createWorld(world.End, &srv.nether, &srv.world)
 
 	srv.registerTargetFunc()
+	srv.registerSummonCommand()
+	srv.registerLocateCommand()
+	srv.registerReportCommand()
 	srv.checkNetIsolation()
 
+	srv.Handler().HandleReady(srv)
 	return srv
 }
 
@@ -193,6 +285,9 @@ type UserConfig struct {
 		// server. Leave this empty to disable it. %v is the placeholder for the
 		// username of the player
 		QuitMessage string
+		// Operators is a list of XUIDs or names of players that are made
+		// operator when joining the server.
+		Operators []string
 	}
 	World struct {
 		// SaveData controls whether a world's data will be saved and loaded.
@@ -203,6 +298,44 @@ type UserConfig struct {
 		SaveData bool
 		// Folder is the folder that the data of the world resides in.
 		Folder string
+		// ReadOnly controls whether changes made to the world are discarded
+		// instead of saved to disk. Changes are lost when the chunk they were
+		// made in is unloaded.
+		ReadOnly bool
+		// InMemory controls whether changes made to the world are kept in
+		// memory instead of saved to disk. Unlike with ReadOnly, changes are
+		// kept until the server is closed.
+		InMemory bool
+		// SaveInterval is the interval in seconds at which chunks modified
+		// since they were last saved are saved to disk. Set this to 0 to only
+		// save chunks when they are unloaded or when the server is closed.
+		SaveInterval int
+		// SaveRate is the maximum amount of chunks saved to disk per second
+		// when saving modified chunks. Set this to 0 to disable the limit.
+		SaveRate int
+		// SpawnChunkRadius is the radius in chunks around the world spawn that
+		// is kept loaded at all times. Set this to 0 to disable it.
+		SpawnChunkRadius int
+		// SpawnProtectionRadius is the radius in blocks around the world spawn
+		// in which only operators may edit blocks. Set this to 0 to disable it.
+		SpawnProtectionRadius int
+		// SpawnRadius is the radius in blocks around the world spawn in which
+		// players without a spawn position of their own are randomly spawned.
+		SpawnRadius int
+		// MobSpawning controls whether mobs such as zombies and cows spawn
+		// naturally around players.
+		MobSpawning bool
+		// Generator is the name of the generator preset used to generate new
+		// chunks in the overworld. The Nether and End always use their
+		// standard generator. The built-in presets are 'default', 'flat', 'void',
+		// 'terrain' and 'amplified'. Other presets may be registered using
+		// RegisterGeneratorPreset.
+		Generator string
+		// GeneratorSettings is passed to the generator preset. For the 'flat'
+		// preset, it holds the layers from the bottom up, such as
+		// 'minecraft:bedrock,2*minecraft:dirt,minecraft:grass;plains'. For the
+		// 'terrain' and 'amplified' presets, it holds the seed.
+		GeneratorSettings string
 	}
 	Players struct {
 		// MaxCount is the maximum amount of players allowed to join the server
@@ -222,6 +355,9 @@ type UserConfig struct {
 		// Folder controls where the player data will be stored by the default
 		// LevelDB player provider if it is enabled.
 		Folder string
+		// DeathMessages controls whether a message is broadcast in the chat
+		// when a player dies.
+		DeathMessages bool
 	}
 	Resources struct {
 		// AutoBuildPack is if the server should automatically generate a
@@ -248,16 +384,43 @@ func (uc UserConfig) Config(log Logger) (Config, error) {
 		AuthDisabled:            !uc.Server.AuthEnabled,
 		MaxPlayers:              uc.Players.MaxCount,
 		MaxChunkRadius:          uc.Players.MaximumChunkRadius,
+		DeathMessages:           uc.Players.DeathMessages,
 		JoinMessage:             uc.Server.JoinMessage,
 		QuitMessage:             uc.Server.QuitMessage,
 		ShutdownMessage:         uc.Server.ShutdownMessage,
 		DisableResourceBuilding: !uc.Resources.AutoBuildPack,
+		ReadOnlyWorld:           uc.World.ReadOnly,
+		WorldSaveInterval:       time.Duration(uc.World.SaveInterval) * time.Second,
+		WorldSaveRate:           uc.World.SaveRate,
+		SpawnChunkRadius:        uc.World.SpawnChunkRadius,
+		SpawnProtectionRadius:   uc.World.SpawnProtectionRadius,
+		SpawnLocator:            world.SpreadSpawnLocator{Radius: uc.World.SpawnRadius},
+		Operators:               uc.Server.Operators,
 	}
-	if uc.World.SaveData {
-		conf.WorldProvider, err = mcdb.New(log, uc.World.Folder, opt.FlateCompression)
-		if err != nil {
-			return conf, fmt.Errorf("create world provider: %w", err)
+	if uc.World.MobSpawning {
+		conf.MobSpawning.Spawns = entity.DefaultSpawns
+	}
+	conf.Generator, err = presetGenerator(uc.World.Generator, uc.World.GeneratorSettings)
+	if err != nil {
+		return conf, fmt.Errorf("load generator: %w", err)
+	}
+	conf.OpenWorldProvider = func() (world.Provider, error) {
+		var p world.Provider = world.NopProvider{}
+		if uc.World.SaveData {
+			db, err := mcdb.New(log, uc.World.Folder, opt.FlateCompression)
+			if err != nil {
+				return nil, err
+			}
+			p = db
 		}
+		if uc.World.InMemory {
+			p = world.NewMemoryProvider(p)
+		}
+		return p, nil
+	}
+	conf.WorldProvider, err = conf.OpenWorldProvider()
+	if err != nil {
+		return conf, fmt.Errorf("create world provider: %w", err)
 	}
 	conf.Resources, err = loadResources(uc.Resources.Folder)
 	if err != nil {
@@ -315,6 +478,11 @@ func DefaultConfig() UserConfig {
 	c.Server.QuitMessage = "%v has left the game"
 	c.World.SaveData = true
 	c.World.Folder = "world"
+	c.World.SaveInterval = 300
+	c.World.SaveRate = 64
+	c.World.SpawnChunkRadius = 4
+	c.World.SpawnRadius = 5
+	c.World.Generator = "default"
 	c.Players.MaximumChunkRadius = 32
 	c.Players.SaveData = true
 	c.Players.Folder = "players"
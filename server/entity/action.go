@@ -41,9 +41,27 @@ type PickedUpAction struct {
 	action
 }
 
+// LoveAction is a world.EntityAction that makes an entity display heart particles, such as animals that are ready to
+// breed.
+type LoveAction struct{ action }
+
+// TameSucceedAction is a world.EntityAction that makes an entity display heart particles after it was tamed.
+type TameSucceedAction struct{ action }
+
+// TameFailAction is a world.EntityAction that makes an entity display smoke particles after an attempt to tame it
+// failed.
+type TameFailAction struct{ action }
+
+// EatGrassAction is a world.EntityAction that makes an entity display the animation of eating grass. It is used by
+// sheep.
+type EatGrassAction struct{ action }
+
 // FireworkExplosionAction is a world.EntityAction that makes a Firework rocket display an explosion particle.
 type FireworkExplosionAction struct{ action }
 
+// TotemUseAction is a world.EntityAction that makes an entity display the animation of a totem of undying being used.
+type TotemUseAction struct{ action }
+
 // action implements the Action interface. Structures in this package may embed it to gets its functionality
 // out of the box.
 type action struct{}
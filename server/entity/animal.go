@@ -0,0 +1,254 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"math/rand"
+	"sync"
+)
+
+const (
+	// babyAge is the age of a newly born animal. The animal grows up once its
+	// age reaches 0, which takes 20 minutes.
+	babyAge = -24000
+	// breedCooldown is the age of an animal right after it has bred. It may
+	// breed again once its age reaches 0, which takes 5 minutes.
+	breedCooldown = 6000
+	// loveDuration is the amount of ticks that an animal stays in love after
+	// it is fed.
+	loveDuration = 600
+)
+
+// Breedable is implemented by the MobBehaviour of Mobs that age and may be
+// bred, such as animals. AnimalBehaviour implements Breedable, so behaviours
+// embedding it do as well.
+type Breedable interface {
+	MobBehaviour
+	// Age returns the age of the Mob in ticks. A negative age means the Mob is
+	// a baby.
+	Age() int
+	// SetAge changes the age of the Mob in ticks.
+	SetAge(age int)
+	// Baby checks if the Mob is a baby.
+	Baby() bool
+	// InLove checks if the Mob is in love and looking for a partner to breed
+	// with.
+	InLove() bool
+	// SetInLove makes the Mob fall in love or stop being in love.
+	SetInLove(love bool)
+}
+
+// AnimalBehaviourConfig holds settings that influence the way an
+// AnimalBehaviour behaves.
+type AnimalBehaviourConfig struct {
+	// Food checks if the item passed may be fed to the animal. Adult animals
+	// that are fed fall in love and breed with other animals of the same type
+	// that are in love. Feeding a baby animal makes it grow up faster.
+	Food func(it world.Item) bool
+	// Breed creates the offspring of the two animals passed. The animal
+	// returned is made a baby and added to the world of its parents, so it
+	// should be created at the position of the first animal.
+	Breed func(a, b *Mob) *Mob
+}
+
+// New creates an AnimalBehaviour using conf. It may be passed to
+// MobConfig.Behaviour.
+func (conf AnimalBehaviourConfig) New() *AnimalBehaviour {
+	return &AnimalBehaviour{conf: conf}
+}
+
+// AnimalBehaviour implements the ageing and breeding of animals such as cows
+// and pigs. It is used together with a BreedGoal, which makes animals that
+// are in love find a partner.
+type AnimalBehaviour struct {
+	conf AnimalBehaviourConfig
+
+	mu   sync.Mutex
+	age  int
+	love int
+
+	// sentBaby and sentLove hold the values of Baby and InLove that were last
+	// shown to viewers.
+	sentBaby, sentLove bool
+}
+
+// Age returns the age of the animal in ticks. A negative age means the animal
+// is a baby that grows up once its age reaches 0. A positive age means the
+// animal has recently bred and cannot breed again until its age reaches 0.
+func (a *AnimalBehaviour) Age() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.age
+}
+
+// SetAge changes the age of the animal in ticks. Passing a negative age turns
+// the animal into a baby.
+func (a *AnimalBehaviour) SetAge(age int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.age = age
+}
+
+// Baby checks if the animal is a baby.
+func (a *AnimalBehaviour) Baby() bool {
+	return a.Age() < 0
+}
+
+// InLove checks if the animal is currently in love, meaning it will breed with
+// another animal of the same type that is in love.
+func (a *AnimalBehaviour) InLove() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.love > 0
+}
+
+// SetInLove makes the animal fall in love or stop being in love. Babies and
+// animals that have recently bred cannot fall in love.
+func (a *AnimalBehaviour) SetInLove(love bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !love {
+		a.love = 0
+	} else if a.age == 0 {
+		a.love = loveDuration
+	}
+}
+
+// EncodeNBT encodes the age of the animal and the time it stays in love to the
+// data passed.
+func (a *AnimalBehaviour) EncodeNBT(_ *Mob, data map[string]any) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	data["Age"], data["InLove"] = int32(a.age), int32(a.love)
+}
+
+// DecodeNBT decodes the age of the animal and the time it stays in love from
+// the data passed.
+func (a *AnimalBehaviour) DecodeNBT(_ *Mob, data map[string]any) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.age, a.love = int(nbtconv.Int32(data, "Age")), int(nbtconv.Int32(data, "InLove"))
+}
+
+// Tick ages the animal and shows heart particles while it is in love.
+func (a *AnimalBehaviour) Tick(m *Mob) {
+	a.mu.Lock()
+	if a.age < 0 {
+		a.age++
+	} else if a.age > 0 {
+		a.age--
+	}
+	if a.love > 0 {
+		a.love--
+	}
+	baby, love, hearts := a.age < 0, a.love > 0, a.love%10 == 1
+	changed := baby != a.sentBaby || love != a.sentLove
+	a.sentBaby, a.sentLove = baby, love
+	a.mu.Unlock()
+
+	if !changed && !hearts {
+		return
+	}
+	for _, v := range m.World().Viewers(m.Position()) {
+		if changed {
+			v.ViewEntityState(m)
+		}
+		if hearts {
+			v.ViewEntityAction(m, LoveAction{})
+		}
+	}
+}
+
+// Interact feeds the item held by the user to the animal if it is one of its
+// food items. Adult animals that are able to breed fall in love, while babies
+// grow up faster.
+func (a *AnimalBehaviour) Interact(_ *Mob, user item.User, ctx *item.UseContext) bool {
+	held, _ := user.HeldItems()
+	if held.Empty() || a.conf.Food == nil || !a.conf.Food(held.Item()) {
+		return false
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	switch {
+	case a.age < 0:
+		// Feeding a baby makes it grow up 10% faster.
+		a.age -= a.age / 10
+	case a.age == 0 && a.love == 0:
+		a.love = loveDuration
+	default:
+		return false
+	}
+	ctx.CountSub = 1
+	return true
+}
+
+// breed creates offspring of the animal and its partner and adds it to the
+// world, after which both animals stop being in love.
+func (a *AnimalBehaviour) breed(m, partner *Mob) {
+	other, ok := animalOf(partner)
+	if !ok || a.conf.Breed == nil {
+		return
+	}
+	child := a.conf.Breed(m, partner)
+	if child == nil {
+		return
+	}
+	if c, ok := animalOf(child); ok {
+		c.SetAge(babyAge)
+	}
+	a.bred()
+	other.bred()
+
+	w, pos := m.World(), m.Position()
+	w.AddEntity(child)
+	for _, orb := range NewExperienceOrbs(pos, rand.Intn(7)+1) {
+		w.AddEntity(orb)
+	}
+}
+
+// bred resets the love of the animal and prevents it from breeding again for
+// a while.
+func (a *AnimalBehaviour) bred() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.love, a.age = 0, breedCooldown
+}
+
+// animalBehaviour returns the AnimalBehaviour itself. It allows behaviours
+// that embed an AnimalBehaviour to be used as animals.
+func (a *AnimalBehaviour) animalBehaviour() *AnimalBehaviour {
+	return a
+}
+
+// animalOf returns the AnimalBehaviour of the Mob passed. False is returned if
+// the Mob is not an animal.
+func animalOf(m *Mob) (*AnimalBehaviour, bool) {
+	an, ok := m.Behaviour().(interface{ animalBehaviour() *AnimalBehaviour })
+	if !ok {
+		return nil, false
+	}
+	return an.animalBehaviour(), true
+}
+
+// animalBBox returns the bounding box of an animal with the width and height
+// passed. The bounding box of babies is half as big as that of adults.
+func animalBBox(e world.Entity, width, height float64) cube.BBox {
+	if m, ok := e.(*Mob); ok {
+		if a, ok := animalOf(m); ok && a.Baby() {
+			width, height = width/2, height/2
+		}
+	}
+	return cube.Box(-width/2, 0, -width/2, width/2, height, width/2)
+}
+
+// addAnimalGoals adds the Goals shared by all animals to the Mob passed.
+func addAnimalGoals(m *Mob, food func(it world.Item) bool) {
+	m.Goals().Add(1, NewPanicGoal(1.25))
+	m.Goals().Add(2, NewBreedGoal(1))
+	m.Goals().Add(3, NewTemptGoal(1.25, food))
+	m.Goals().Add(4, NewFollowParentGoal(1.1))
+	m.Goals().Add(6, NewWanderGoal(1))
+	m.Goals().Add(7, NewLookAtPlayerGoal(6))
+}
@@ -75,6 +75,10 @@ func (ArrowType) DecodeNBT(m map[string]any) world.Entity {
 	}
 	arr.fireDuration = time.Duration(nbtconv.Int16(m, "Fire")) * time.Second / 20
 	b.conf.KnockBackForceAddend = (enchantment.Punch{}).KnockBackMultiplier() * float64(nbtconv.Uint8(m, "enchantPunch"))
+	b.conf.PiercingLevel = int(nbtconv.Uint8(m, "enchantPierce"))
+	// The entities that were pierced can't be identified after the arrow is
+	// loaded, so only their count is kept, which limits further piercing.
+	b.pierced = make([]world.Entity, nbtconv.Uint8(m, "PiercedEntities"))
 	if _, ok := m["StuckToBlockPos"]; ok {
 		b.collisionPos = nbtconv.Pos(m, "StuckToBlockPos")
 		b.collided = true
@@ -87,16 +91,18 @@ func (ArrowType) EncodeNBT(e world.Entity) map[string]any {
 	b := a.conf.Behaviour.(*ProjectileBehaviour)
 	yaw, pitch := a.Rotation().Elem()
 	data := map[string]any{
-		"Pos":          nbtconv.Vec3ToFloat32Slice(a.Position()),
-		"Yaw":          float32(yaw),
-		"Pitch":        float32(pitch),
-		"Motion":       nbtconv.Vec3ToFloat32Slice(a.Velocity()),
-		"Damage":       float32(b.conf.Damage),
-		"Fire":         int16(a.OnFireDuration() * 20),
-		"enchantPunch": byte(b.conf.KnockBackForceAddend / (enchantment.Punch{}).KnockBackMultiplier()),
-		"auxValue":     int32(b.conf.Potion.Uint8() + 1),
-		"player":       boolByte(!b.conf.DisablePickup),
-		"isCreative":   boolByte(b.conf.PickupItem.Empty()),
+		"Pos":             nbtconv.Vec3ToFloat32Slice(a.Position()),
+		"Yaw":             float32(yaw),
+		"Pitch":           float32(pitch),
+		"Motion":          nbtconv.Vec3ToFloat32Slice(a.Velocity()),
+		"Damage":          float32(b.conf.Damage),
+		"Fire":            int16(a.OnFireDuration() * 20),
+		"enchantPunch":    byte(b.conf.KnockBackForceAddend / (enchantment.Punch{}).KnockBackMultiplier()),
+		"enchantPierce":   byte(b.conf.PiercingLevel),
+		"PiercedEntities": byte(len(b.pierced)),
+		"auxValue":        int32(b.conf.Potion.Uint8() + 1),
+		"player":          boolByte(!b.conf.DisablePickup),
+		"isCreative":      boolByte(b.conf.PickupItem.Empty()),
 	}
 	// TODO: Save critical flag if Minecraft ever saves it?
 	if b.collided {
@@ -0,0 +1,56 @@
+package attribute
+
+import "math"
+
+// Attribute is a property of an entity, such as its maximum health or movement speed. The value of an Attribute of
+// an entity is computed from a base value and the Modifiers applied to it, for example by status effects or
+// equipment.
+type Attribute struct {
+	name          string
+	min, max, def float64
+}
+
+var (
+	// MaxHealth is the maximum health of an entity. The health of an entity is limited to this value.
+	MaxHealth = Attribute{name: "minecraft:health", min: 1, max: 1024, def: 20}
+	// MovementSpeed is the speed of an entity in blocks per tick while walking.
+	MovementSpeed = Attribute{name: "minecraft:movement", max: math.MaxFloat32, def: 0.1}
+	// AttackDamage is the damage dealt by an entity when it attacks without a weapon. The damage of a weapon held
+	// is added on top of it.
+	AttackDamage = Attribute{name: "minecraft:attack_damage", max: 2048, def: 1}
+	// KnockBackResistance is the fraction of knock back that is resisted by an entity. A value of 1 means the
+	// entity is not knocked back at all.
+	KnockBackResistance = Attribute{name: "minecraft:knockback_resistance", max: 1}
+)
+
+// Attributes returns all Attributes that entities may have.
+func Attributes() []Attribute {
+	return []Attribute{MaxHealth, MovementSpeed, AttackDamage, KnockBackResistance}
+}
+
+// Name returns the name that the Attribute is identified with over the network, such as 'minecraft:movement'.
+func (a Attribute) Name() string {
+	return a.name
+}
+
+// Min returns the minimum value of the Attribute. The value of the Attribute is never lower than this, regardless
+// of the Modifiers applied.
+func (a Attribute) Min() float64 {
+	return a.min
+}
+
+// Max returns the maximum value of the Attribute. The value of the Attribute is never higher than this, regardless
+// of the Modifiers applied.
+func (a Attribute) Max() float64 {
+	return a.max
+}
+
+// Default returns the default base value of the Attribute.
+func (a Attribute) Default() float64 {
+	return a.def
+}
+
+// clamp limits the value passed to the minimum and maximum value of the Attribute.
+func (a Attribute) clamp(v float64) float64 {
+	return math.Max(a.min, math.Min(a.max, v))
+}
@@ -0,0 +1,162 @@
+package attribute
+
+import (
+	"golang.org/x/exp/slices"
+	"sync"
+)
+
+// Map holds the base values and Modifiers of the Attributes of an entity. Attributes that were never changed have
+// their default base value and no Modifiers. A Map is safe for concurrent use.
+type Map struct {
+	mu        sync.Mutex
+	base      map[Attribute]float64
+	modifiers map[Attribute][]Modifier
+	f         func(a Attribute, v float64)
+}
+
+// NewMap creates a new Map. The function passed is called with the new value of an Attribute every time it changes.
+// It may be nil.
+func NewMap(f func(a Attribute, v float64)) *Map {
+	if f == nil {
+		f = func(Attribute, float64) {}
+	}
+	return &Map{base: make(map[Attribute]float64), modifiers: make(map[Attribute][]Modifier), f: f}
+}
+
+// Base returns the base value of an Attribute, which is its value without any Modifiers applied.
+func (m *Map) Base(a Attribute) float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.baseOf(a)
+}
+
+// SetBase changes the base value of an Attribute.
+func (m *Map) SetBase(a Attribute, v float64) {
+	m.change(a, func() {
+		m.base[a] = v
+	})
+}
+
+// Value returns the value of an Attribute, which is its base value with all of its Modifiers applied, limited to
+// the minimum and maximum value of the Attribute.
+func (m *Map) Value(a Attribute) float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.valueOf(a)
+}
+
+// ValueWithBase returns the value of an Attribute as if its base value was the value passed, with all of its
+// Modifiers applied. It may be used to apply the Modifiers of an Attribute to an amount that is not stored in the
+// Map, such as the attack damage of a held weapon.
+func (m *Map) ValueWithBase(a Attribute, base float64) float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.compute(a, base)
+}
+
+// Modifiers returns all Modifiers applied to an Attribute.
+func (m *Map) Modifiers(a Attribute) []Modifier {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.modifiers[a])
+}
+
+// Modifier looks up the Modifier with the name passed that is applied to an Attribute. False is returned if no
+// such Modifier exists.
+func (m *Map) Modifier(a Attribute, name string) (Modifier, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if i := m.index(a, name); i != -1 {
+		return m.modifiers[a][i], true
+	}
+	return Modifier{}, false
+}
+
+// AddModifier applies a Modifier to an Attribute. If a Modifier with the same name was already applied to the
+// Attribute, it is replaced.
+func (m *Map) AddModifier(a Attribute, mod Modifier) {
+	m.change(a, func() {
+		if i := m.index(a, mod.Name); i != -1 {
+			m.modifiers[a][i] = mod
+			return
+		}
+		m.modifiers[a] = append(m.modifiers[a], mod)
+	})
+}
+
+// RemoveModifier removes the Modifier with the name passed from an Attribute. RemoveModifier does nothing if no
+// such Modifier was applied.
+func (m *Map) RemoveModifier(a Attribute, name string) {
+	m.change(a, func() {
+		if i := m.index(a, name); i != -1 {
+			m.modifiers[a] = slices.Delete(m.modifiers[a], i, i+1)
+		}
+	})
+}
+
+// Attributes returns all Attributes in the Map together with their values.
+func (m *Map) Attributes() map[Attribute]float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	values := make(map[Attribute]float64, len(Attributes()))
+	for _, a := range Attributes() {
+		values[a] = m.valueOf(a)
+	}
+	return values
+}
+
+// change calls the function passed while holding the lock of the Map and calls the function of the Map if the
+// value of the Attribute changed as a result.
+func (m *Map) change(a Attribute, f func()) {
+	m.mu.Lock()
+	before := m.valueOf(a)
+	f()
+	after := m.valueOf(a)
+	m.mu.Unlock()
+
+	if before != after {
+		m.f(a, after)
+	}
+}
+
+// index returns the index of the Modifier with the name passed in the Modifiers of an Attribute, or -1 if it does
+// not exist.
+func (m *Map) index(a Attribute, name string) int {
+	return slices.IndexFunc(m.modifiers[a], func(mod Modifier) bool {
+		return mod.Name == name
+	})
+}
+
+// baseOf returns the base value of an Attribute.
+func (m *Map) baseOf(a Attribute) float64 {
+	if v, ok := m.base[a]; ok {
+		return v
+	}
+	return a.def
+}
+
+// valueOf computes the value of an Attribute from its base value and Modifiers.
+func (m *Map) valueOf(a Attribute) float64 {
+	return m.compute(a, m.baseOf(a))
+}
+
+// compute computes the value of an Attribute from the base value passed and its Modifiers.
+func (m *Map) compute(a Attribute, v float64) float64 {
+	for _, mod := range m.modifiers[a] {
+		if mod.Operation == OperationAdd {
+			v += mod.Amount
+		}
+	}
+	base := v
+	for _, mod := range m.modifiers[a] {
+		if mod.Operation == OperationMultiplyBase {
+			v += base * mod.Amount
+		}
+	}
+	for _, mod := range m.modifiers[a] {
+		if mod.Operation == OperationMultiply {
+			v *= 1 + mod.Amount
+		}
+	}
+	return a.clamp(v)
+}
@@ -0,0 +1,26 @@
+package attribute
+
+// Operation is the way in which the Amount of a Modifier is applied to the value of an Attribute.
+type Operation uint8
+
+const (
+	// OperationAdd adds the Amount of the Modifier to the base value of the Attribute.
+	OperationAdd Operation = iota
+	// OperationMultiplyBase adds the base value of the Attribute, after all OperationAdd Modifiers were applied,
+	// multiplied by the Amount of the Modifier to the value. An Amount of 0.5 increases the value by 50% of the base.
+	OperationMultiplyBase
+	// OperationMultiply multiplies the value of the Attribute by 1 + the Amount of the Modifier. It is applied after
+	// all other Modifiers, so an Amount of 0.5 increases the final value by 50%.
+	OperationMultiply
+)
+
+// Modifier changes the value of an Attribute. Modifiers are identified by their Name, so that adding a Modifier with
+// the same Name as an existing one replaces it.
+type Modifier struct {
+	// Name is the name of the Modifier, such as 'sprinting'. It should be unique for the source of the Modifier.
+	Name string
+	// Operation is the Operation with which the Amount is applied.
+	Operation Operation
+	// Amount is the amount with which the value of the Attribute is changed.
+	Amount float64
+}
@@ -0,0 +1,83 @@
+package entity
+
+// BreedGoal is a Goal that makes an animal that is in love move to another
+// animal of the same type that is in love, after which the two breed. It only
+// has effect on Mobs with an AnimalBehaviour.
+type BreedGoal struct {
+	speed   float64
+	partner *Mob
+	ticks   int
+	repath  int
+}
+
+// NewBreedGoal creates a BreedGoal that makes an animal move to its partner
+// with the speed modifier passed.
+func NewBreedGoal(speed float64) *BreedGoal {
+	return &BreedGoal{speed: speed}
+}
+
+// Controls returns GoalControlMove and GoalControlLook.
+func (g *BreedGoal) Controls() GoalControl {
+	return GoalControlMove | GoalControlLook
+}
+
+// CanStart returns true if the animal is in love and another animal of the
+// same type that is in love is within 8 blocks.
+func (g *BreedGoal) CanStart(m *Mob) bool {
+	if a, ok := animalOf(m); !ok || !a.InLove() {
+		return false
+	}
+	g.partner = nil
+	pos, nearest := m.Position(), 64.0
+	for _, e := range m.World().EntitiesWithin(m.Type().BBox(m).Translate(pos).Grow(8), nil) {
+		other, ok := e.(*Mob)
+		if !ok || other == m || other.Type() != m.Type() || other.Dead() {
+			continue
+		}
+		if a, ok := animalOf(other); !ok || !a.InLove() {
+			continue
+		}
+		diff := other.Position().Sub(pos)
+		if d := diff.Dot(diff); d < nearest {
+			g.partner, nearest = other, d
+		}
+	}
+	return g.partner != nil
+}
+
+// CanContinue returns true as long as both animals are still in love.
+func (g *BreedGoal) CanContinue(m *Mob) bool {
+	a, _ := animalOf(m)
+	other, _ := animalOf(g.partner)
+	return a.InLove() && other.InLove() && !g.partner.Dead() && g.ticks < 60
+}
+
+// Start starts moving to the partner.
+func (g *BreedGoal) Start(*Mob) {
+	g.ticks, g.repath = 0, 0
+}
+
+// Stop forgets the partner and stops the movement of the animal.
+func (g *BreedGoal) Stop(m *Mob) {
+	g.partner = nil
+	m.StopMoving()
+}
+
+// Tick moves the animal to its partner. Once the two have been close to each
+// other for 3 seconds, they breed.
+func (g *BreedGoal) Tick(m *Mob) {
+	m.LookAt(EyePosition(g.partner))
+	diff := g.partner.Position().Sub(m.Position())
+	if diff.Dot(diff) > 9 {
+		if g.repath--; g.repath <= 0 {
+			g.repath = 10
+			navigateTo(m, g.partner.Position(), g.speed)
+		}
+		g.ticks = 0
+		return
+	}
+	if g.ticks++; g.ticks == 60 {
+		a, _ := animalOf(m)
+		a.breed(m, g.partner)
+	}
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// catVariants is the amount of variants that cats have.
+const catVariants = 11
+
+// NewCat creates a wild cat with a random variant at the position passed.
+func NewCat(pos mgl64.Vec3) *Mob {
+	return NewCatWithVariant(pos, rand.Intn(catVariants))
+}
+
+// NewCatWithVariant creates a wild cat with the variant passed at the
+// position passed. The variant ranges from 0 to 10 and decides the colour of
+// the fur of the cat. Cats may be tamed using raw cod or salmon, after which
+// they follow their owner, may be told to sit and may be bred using fish.
+func NewCatWithVariant(pos mgl64.Vec3, variant int) *Mob {
+	b := &CatBehaviour{variant: variant}
+	b.TameableBehaviour = TameableBehaviourConfig{
+		Animal: AnimalBehaviourConfig{
+			Food: catFood,
+			Breed: func(a, partner *Mob) *Mob {
+				variant := a.Behaviour().(*CatBehaviour).Variant()
+				if rand.Intn(2) == 0 {
+					variant = partner.Behaviour().(*CatBehaviour).Variant()
+				}
+				return breedTamed(a, NewCatWithVariant(a.Position(), variant))
+			},
+		},
+		TameItem: catFood,
+	}.New()
+
+	m := MobConfig{
+		MaxHealth:  10,
+		Speed:      0.3,
+		Experience: rand.Intn(3) + 1,
+		Behaviour:  b,
+	}.New(CatType{}, pos)
+
+	m.Goals().Add(1, NewSitGoal())
+	m.Goals().Add(2, NewBreedGoal(1))
+	m.Goals().Add(3, NewTemptGoal(0.6, catFood))
+	m.Goals().Add(4, NewFollowOwnerGoal(1, 10, 5))
+	m.Goals().Add(5, NewFollowParentGoal(1.1))
+	m.Goals().Add(6, NewWanderGoal(0.8))
+	m.Goals().Add(7, NewLookAtPlayerGoal(10))
+	return m
+}
+
+// CatBehaviour implements the behaviour of cats, which have one of several
+// variants, in addition to the behaviour of other tameable animals.
+type CatBehaviour struct {
+	*TameableBehaviour
+	variant int
+}
+
+// Variant returns the variant of the cat, which decides the colour of its
+// fur.
+func (c *CatBehaviour) Variant() int {
+	return c.variant
+}
+
+// EncodeNBT encodes the variant of the cat to the data passed, in addition to
+// the state of its TameableBehaviour.
+func (c *CatBehaviour) EncodeNBT(m *Mob, data map[string]any) {
+	c.TameableBehaviour.EncodeNBT(m, data)
+	data["Variant"] = int32(c.variant)
+}
+
+// catFood checks if the item passed is raw fish that may be fed to cats.
+func catFood(it world.Item) bool {
+	switch i := it.(type) {
+	case item.Cod:
+		return !i.Cooked
+	case item.Salmon:
+		return !i.Cooked
+	}
+	return false
+}
+
+// CatType is a world.EntityType implementation for cats.
+type CatType struct{}
+
+func (CatType) EncodeEntity() string              { return "minecraft:cat" }
+func (CatType) Spawn(pos mgl64.Vec3) world.Entity { return NewCat(pos) }
+func (CatType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.48, 0.56)
+}
+
+func (CatType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMobNBT(NewCatWithVariant(nbtconv.Vec3(data, "Pos"), int(nbtconv.Int32(data, "Variant"))), data)
+}
+
+func (CatType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Mob))
+}
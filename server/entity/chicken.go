@@ -0,0 +1,128 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"sync"
+)
+
+// NewChicken creates a chicken at the position passed. Chickens may be bred
+// using seeds, lay eggs every 5 to 10 minutes and fall slowly.
+func NewChicken(pos mgl64.Vec3) *Mob {
+	m := MobConfig{
+		MaxHealth: 4,
+		Speed:     0.25,
+		Drops: func(m *Mob) []item.Stack {
+			drops := []item.Stack{item.NewStack(item.Chicken{Cooked: m.OnFireDuration() > 0}, 1)}
+			if n := rand.Intn(3); n > 0 {
+				drops = append(drops, item.NewStack(item.Feather{}, n))
+			}
+			return drops
+		},
+		Experience: rand.Intn(3) + 1,
+		Behaviour: &chickenBehaviour{
+			AnimalBehaviour: AnimalBehaviourConfig{
+				Food: chickenFood,
+				Breed: func(a, _ *Mob) *Mob {
+					return NewChicken(a.Position())
+				},
+			}.New(),
+			eggTime: chickenEggTime(),
+		},
+	}.New(ChickenType{}, pos)
+	addAnimalGoals(m, chickenFood)
+	return m
+}
+
+// chickenBehaviour implements the behaviour of chickens, which lay eggs and
+// fall slowly in addition to the behaviour of other animals.
+type chickenBehaviour struct {
+	*AnimalBehaviour
+
+	mu      sync.Mutex
+	eggTime int
+}
+
+// Tick slows down the fall of the chicken and lays an egg once the egg timer
+// runs out.
+func (c *chickenBehaviour) Tick(m *Mob) {
+	c.AnimalBehaviour.Tick(m)
+	if vel := m.Velocity(); !m.OnGround() && vel[1] < 0 {
+		vel[1] *= 0.6
+		m.SetVelocity(vel)
+	}
+	if c.Baby() {
+		return
+	}
+	c.mu.Lock()
+	c.eggTime--
+	lay := c.eggTime <= 0
+	if lay {
+		c.eggTime = chickenEggTime()
+	}
+	c.mu.Unlock()
+
+	if lay {
+		w, pos := m.World(), m.Position()
+		w.PlaySound(pos, sound.ItemThrow{})
+		w.AddEntity(NewItem(item.NewStack(item.Egg{}, 1), pos))
+	}
+}
+
+// EncodeNBT encodes the time until the chicken lays its next egg to the data
+// passed, in addition to the state of its AnimalBehaviour.
+func (c *chickenBehaviour) EncodeNBT(m *Mob, data map[string]any) {
+	c.AnimalBehaviour.EncodeNBT(m, data)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data["EggLayTime"] = int32(c.eggTime)
+}
+
+// DecodeNBT decodes the time until the chicken lays its next egg from the data
+// passed, in addition to the state of its AnimalBehaviour.
+func (c *chickenBehaviour) DecodeNBT(m *Mob, data map[string]any) {
+	c.AnimalBehaviour.DecodeNBT(m, data)
+	if t := int(nbtconv.Int32(data, "EggLayTime")); t > 0 {
+		c.mu.Lock()
+		c.eggTime = t
+		c.mu.Unlock()
+	}
+}
+
+// chickenEggTime returns a random amount of ticks until a chicken lays its
+// next egg.
+func chickenEggTime() int {
+	return rand.Intn(6000) + 6000
+}
+
+// chickenFood checks if the item passed may be fed to chickens.
+func chickenFood(it world.Item) bool {
+	switch it.(type) {
+	case block.WheatSeeds, block.BeetrootSeeds, block.MelonSeeds, block.PumpkinSeeds:
+		return true
+	}
+	return false
+}
+
+// ChickenType is a world.EntityType implementation for chickens.
+type ChickenType struct{}
+
+func (ChickenType) EncodeEntity() string              { return "minecraft:chicken" }
+func (ChickenType) Spawn(pos mgl64.Vec3) world.Entity { return NewChicken(pos) }
+func (ChickenType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.4, 0.7)
+}
+
+func (ChickenType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMobNBT(NewChicken(nbtconv.Vec3(data, "Pos")), data)
+}
+
+func (ChickenType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Mob))
+}
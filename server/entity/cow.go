@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// NewCow creates a cow at the position passed. Cows may be bred using wheat
+// and milked using a bucket.
+func NewCow(pos mgl64.Vec3) *Mob {
+	m := MobConfig{
+		MaxHealth: 10,
+		Speed:     0.2,
+		Drops: func(m *Mob) []item.Stack {
+			drops := []item.Stack{item.NewStack(item.Beef{Cooked: m.OnFireDuration() > 0}, rand.Intn(3)+1)}
+			if n := rand.Intn(3); n > 0 {
+				drops = append(drops, item.NewStack(item.Leather{}, n))
+			}
+			return drops
+		},
+		Experience: rand.Intn(3) + 1,
+		Behaviour: &cowBehaviour{AnimalBehaviour: AnimalBehaviourConfig{
+			Food: wheatFood,
+			Breed: func(a, _ *Mob) *Mob {
+				return NewCow(a.Position())
+			},
+		}.New()},
+	}.New(CowType{}, pos)
+	addAnimalGoals(m, wheatFood)
+	return m
+}
+
+// cowBehaviour implements the behaviour of cows, which may be milked in
+// addition to the behaviour of other animals.
+type cowBehaviour struct {
+	*AnimalBehaviour
+}
+
+// Interact milks the cow if the user holds an empty bucket, or feeds the cow
+// otherwise.
+func (c *cowBehaviour) Interact(m *Mob, user item.User, ctx *item.UseContext) bool {
+	held, _ := user.HeldItems()
+	if b, ok := held.Item().(item.Bucket); ok && b.Empty() && !c.Baby() {
+		ctx.CountSub = 1
+		ctx.NewItem = item.NewStack(item.Bucket{Content: item.MilkBucketContent()}, 1)
+		return true
+	}
+	return c.AnimalBehaviour.Interact(m, user, ctx)
+}
+
+// wheatFood checks if the item passed is wheat.
+func wheatFood(it world.Item) bool {
+	_, ok := it.(item.Wheat)
+	return ok
+}
+
+// CowType is a world.EntityType implementation for cows.
+type CowType struct{}
+
+func (CowType) EncodeEntity() string              { return "minecraft:cow" }
+func (CowType) Spawn(pos mgl64.Vec3) world.Entity { return NewCow(pos) }
+func (CowType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.9, 1.4)
+}
+
+func (CowType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMobNBT(NewCow(nbtconv.Vec3(data, "Pos")), data)
+}
+
+func (CowType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Mob))
+}
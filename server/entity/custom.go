@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// CustomType is a world.EntityType for entities that are not part of vanilla
+// Minecraft, but are instead defined in the behaviour and resource packs of
+// the server. A CustomType must be registered to the world.EntityRegistry of a
+// World, for example using world.EntityRegistry.With, after which entities of
+// the type may be created using Config.New or MobConfig.New.
+type CustomType struct {
+	// Identifier is the namespaced identifier of the entity, such as
+	// 'myserver:dragon_boss'. It must match the identifier of the entity in
+	// the resource pack of the server.
+	Identifier string
+	// Base is the identifier of the vanilla entity that the entity is based
+	// on, such as 'minecraft:zombie'. Base may be left empty.
+	Base string
+	// RuntimeID is the runtime ID under which the entity is registered with
+	// clients. Every CustomType registered should have a unique RuntimeID.
+	RuntimeID int32
+	// Width and Height are the width and height of the bounding box of the
+	// entity.
+	Width, Height float64
+	// Offset is the offset added to the position of the entity when it is
+	// sent to clients.
+	Offset float64
+}
+
+func (t CustomType) EncodeEntity() string     { return t.Identifier }
+func (t CustomType) BaseEncodeEntity() string { return t.Base }
+func (t CustomType) NetworkRuntimeID() int32  { return t.RuntimeID }
+func (t CustomType) NetworkOffset() float64   { return t.Offset }
+func (t CustomType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-t.Width/2, 0, -t.Width/2, t.Width/2, t.Height, t.Width/2)
+}
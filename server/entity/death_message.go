@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/entity/effect"
+	"github.com/df-mc/dragonfly/server/item/enchantment"
+	"github.com/df-mc/dragonfly/server/world"
+	"strings"
+)
+
+// DeathMessageSource is a world.DamageSource that provides its own death
+// message. Damage sources implemented outside the entity package may implement
+// it to be given a death message by DeathMessage.
+type DeathMessageSource interface {
+	world.DamageSource
+	// DeathMessage returns the message shown when an entity with the name
+	// passed is killed by the source.
+	DeathMessage(name string) string
+}
+
+// DeathMessage returns the message shown when an entity with the name passed
+// is killed by the world.DamageSource passed. If no message is known for the
+// source, a generic message is returned.
+func DeathMessage(name string, src world.DamageSource) string {
+	switch s := src.(type) {
+	case DeathMessageSource:
+		return s.DeathMessage(name)
+	case AttackDamageSource:
+		if s.Attacker != nil {
+			return fmt.Sprintf("%v was slain by %v", name, displayName(s.Attacker))
+		}
+	case ProjectileDamageSource:
+		if s.Owner != nil {
+			return fmt.Sprintf("%v was shot by %v", name, displayName(s.Owner))
+		}
+		return name + " was shot"
+	case enchantment.ThornsDamageSource:
+		if s.Owner != nil {
+			return fmt.Sprintf("%v was killed trying to hurt %v", name, displayName(s.Owner))
+		}
+	case block.DamageSource:
+		switch s.Block.(type) {
+		case block.Cactus:
+			return name + " was pricked to death"
+		case block.SweetBerryBush:
+			return name + " was poked to death by a sweet berry bush"
+		case block.Anvil:
+			return name + " was squashed by a falling anvil"
+		}
+		return name + " was squashed by a falling block"
+	case block.FireDamageSource:
+		return name + " burned to death"
+	case block.LavaDamageSource:
+		return name + " tried to swim in lava"
+	case effect.WitherDamageSource:
+		return name + " withered away"
+	case effect.InstantDamageSource, effect.PoisonDamageSource:
+		return name + " was killed by magic"
+	case FallDamageSource:
+		return name + " hit the ground too hard"
+	case GlideDamageSource:
+		return name + " experienced kinetic energy"
+	case VoidDamageSource:
+		return name + " fell out of the world"
+	case SuffocationDamageSource:
+		return name + " suffocated in a wall"
+	case DrowningDamageSource:
+		return name + " drowned"
+	case LightningDamageSource:
+		return name + " was struck by lightning"
+	case ExplosionDamageSource:
+		return name + " blew up"
+	case BorderDamageSource:
+		return name + " left the confines of this world"
+	}
+	return name + " died"
+}
+
+// displayName returns the name of the entity passed as used in death messages:
+// The name of a player, the name tag of an entity if it has one or the name of
+// its type otherwise.
+func displayName(e world.Entity) string {
+	if n, ok := e.(interface{ Name() string }); ok {
+		return n.Name()
+	}
+	if n, ok := e.(interface{ NameTag() string }); ok && n.NameTag() != "" {
+		return n.NameTag()
+	}
+	words := strings.Split(strings.TrimPrefix(e.Type().EncodeEntity(), "minecraft:"), "_")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,210 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"sync"
+)
+
+// Display is a display-only entity that shows a block, an item or text at a
+// position in the world. A Display has no physics, AI or collision and is
+// never ticked, which makes it cheap to use in large numbers for decorations,
+// previews of the contents of crates or animations. Displays are not saved
+// with the world and should be (re-)added by whatever created them.
+type Display struct {
+	mu    sync.Mutex
+	pos   mgl64.Vec3
+	rot   cube.Rotation
+	scale float64
+	name  string
+
+	b  world.Block
+	it item.Stack
+}
+
+// NewBlockDisplay creates a Display that shows the world.Block passed at a
+// position.
+func NewBlockDisplay(b world.Block, pos mgl64.Vec3) *Display {
+	return &Display{pos: pos, scale: 1, b: b}
+}
+
+// NewItemDisplay creates a Display that shows the item.Stack passed floating
+// at a position.
+func NewItemDisplay(it item.Stack, pos mgl64.Vec3) *Display {
+	return &Display{pos: pos, scale: 1, it: it}
+}
+
+// NewTextDisplay creates a Display that shows the text passed at a position.
+func NewTextDisplay(text string, pos mgl64.Vec3) *Display {
+	return &Display{pos: pos, scale: 1, name: text}
+}
+
+// Type returns DisplayType.
+func (d *Display) Type() world.EntityType {
+	return DisplayType{}
+}
+
+// Block returns the world.Block shown by the Display. False is returned if the
+// Display does not show a block.
+func (d *Display) Block() (world.Block, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.b, d.b != nil
+}
+
+// Item returns the item.Stack shown by the Display. False is returned if the
+// Display does not show an item.
+func (d *Display) Item() (item.Stack, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.it, !d.it.Empty()
+}
+
+// SetBlock changes the Display to show the world.Block passed. Viewers have to
+// spawn the Display again, so this should not be called every tick.
+func (d *Display) SetBlock(b world.Block) {
+	d.mu.Lock()
+	d.b, d.it = b, item.Stack{}
+	d.mu.Unlock()
+	d.respawn()
+}
+
+// SetItem changes the Display to show the item.Stack passed. Viewers have to
+// spawn the Display again, so this should not be called every tick.
+func (d *Display) SetItem(it item.Stack) {
+	d.mu.Lock()
+	d.b, d.it = nil, it
+	d.mu.Unlock()
+	d.respawn()
+}
+
+// NameTag returns the text shown above the Display. For a Display created
+// using NewTextDisplay, this is the text displayed.
+func (d *Display) NameTag() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.name
+}
+
+// SetNameTag changes the text shown above the Display. The text is removed if
+// an empty string is passed.
+func (d *Display) SetNameTag(s string) {
+	d.mu.Lock()
+	d.name = s
+	d.mu.Unlock()
+	d.updateState()
+}
+
+// Scale returns the scale of the Display. The default scale is 1.
+func (d *Display) Scale() float64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.scale
+}
+
+// SetScale changes the scale of the Display.
+func (d *Display) SetScale(s float64) {
+	d.mu.Lock()
+	d.scale = s
+	d.mu.Unlock()
+	d.updateState()
+}
+
+// Position returns the current position of the Display.
+func (d *Display) Position() mgl64.Vec3 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.pos
+}
+
+// Rotation returns the current rotation of the Display. Not all clients show
+// the rotation of blocks displayed.
+func (d *Display) Rotation() cube.Rotation {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.rot
+}
+
+// Move moves the Display to a new position and rotation. Viewers interpolate
+// the movement, so that calling Move every tick results in a smooth
+// animation.
+func (d *Display) Move(pos mgl64.Vec3, rot cube.Rotation) {
+	d.mu.Lock()
+	d.pos, d.rot = pos, rot
+	d.mu.Unlock()
+
+	for _, v := range d.World().Viewers(pos) {
+		v.ViewEntityMovement(d, pos, rot.Yaw(), rot.Pitch(), false)
+	}
+}
+
+// Teleport immediately moves the Display to a new position without viewers
+// interpolating the movement.
+func (d *Display) Teleport(pos mgl64.Vec3) {
+	d.mu.Lock()
+	d.pos = pos
+	d.mu.Unlock()
+
+	for _, v := range d.World().Viewers(pos) {
+		v.ViewEntityTeleport(d, pos)
+	}
+}
+
+// SetPosition moves the Display to an absolute position and rotation. If
+// smooth is true, the Display is moved as with Move, rotating it in the
+// shortest direction. If smooth is false, it snaps to the new position and
+// rotation as with Teleport.
+func (d *Display) SetPosition(pos mgl64.Vec3, rot cube.Rotation, smooth bool) {
+	d.mu.Lock()
+	rot = wrapRotation(rot)
+	d.rot = rot
+	d.mu.Unlock()
+
+	if smooth {
+		d.Move(pos, rot)
+		return
+	}
+	d.Teleport(pos)
+}
+
+// Immobile always returns true.
+func (d *Display) Immobile() bool {
+	return true
+}
+
+// World returns the world of the Display.
+func (d *Display) World() *world.World {
+	w, _ := world.OfEntity(d)
+	return w
+}
+
+// Close removes the Display from the world.
+func (d *Display) Close() error {
+	d.World().RemoveEntity(d)
+	return nil
+}
+
+// updateState updates the state of the Display to all of its viewers.
+func (d *Display) updateState() {
+	for _, v := range d.World().Viewers(d.Position()) {
+		v.ViewEntityState(d)
+	}
+}
+
+// respawn hides the Display from all of its viewers and shows it again, so
+// that a change of its contents becomes visible.
+func (d *Display) respawn() {
+	for _, v := range d.World().Viewers(d.Position()) {
+		v.HideEntity(d)
+		v.ViewEntity(d)
+	}
+}
+
+// DisplayType is a world.EntityType implementation for Display.
+type DisplayType struct{}
+
+func (DisplayType) EncodeEntity() string        { return "dragonfly:display" }
+func (DisplayType) BBox(world.Entity) cube.BBox { return cube.BBox{} }
+func (DisplayType) NetworkEncodeEntity() string { return "minecraft:falling_block" }
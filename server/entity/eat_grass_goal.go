@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world/particle"
+	"math/rand"
+)
+
+// EatGrassGoal is a Goal that makes a Mob eat the grass block below it or the
+// tall grass at its feet every now and then, turning the grass block into dirt
+// or removing the tall grass. It is used by sheep to regrow their wool.
+type EatGrassGoal struct {
+	eat   func(m *Mob)
+	ticks int
+}
+
+// NewEatGrassGoal creates an EatGrassGoal that calls the function passed each
+// time the Mob eats grass.
+func NewEatGrassGoal(eat func(m *Mob)) *EatGrassGoal {
+	return &EatGrassGoal{eat: eat}
+}
+
+// Controls returns GoalControlMove and GoalControlLook.
+func (g *EatGrassGoal) Controls() GoalControl {
+	return GoalControlMove | GoalControlLook
+}
+
+// CanStart randomly returns true if the Mob is standing on or in grass. Baby
+// animals eat grass more often than adults.
+func (g *EatGrassGoal) CanStart(m *Mob) bool {
+	chance := 1000
+	if a, ok := animalOf(m); ok && a.Baby() {
+		chance = 50
+	}
+	if rand.Intn(chance) != 0 {
+		return false
+	}
+	_, ok := g.grass(m)
+	return ok
+}
+
+// CanContinue returns true until the Mob has finished eating.
+func (g *EatGrassGoal) CanContinue(*Mob) bool {
+	return g.ticks > 0
+}
+
+// Start stops the Mob and shows the eating animation.
+func (g *EatGrassGoal) Start(m *Mob) {
+	g.ticks = 40
+	m.StopMoving()
+	for _, v := range m.World().Viewers(m.Position()) {
+		v.ViewEntityAction(m, EatGrassAction{})
+	}
+}
+
+// Stop does nothing.
+func (g *EatGrassGoal) Stop(*Mob) {}
+
+// Tick eats the grass once the eating animation has finished.
+func (g *EatGrassGoal) Tick(m *Mob) {
+	if g.ticks--; g.ticks != 4 {
+		return
+	}
+	pos, ok := g.grass(m)
+	if !ok {
+		return
+	}
+	w := m.World()
+	b := w.Block(pos)
+	w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: b})
+	if _, ok := b.(block.Grass); ok {
+		w.SetBlock(pos, block.Dirt{}, nil)
+	} else {
+		w.SetBlock(pos, nil, nil)
+	}
+	g.eat(m)
+}
+
+// grass returns the position of the grass that the Mob can eat. False is
+// returned if there is no grass.
+func (g *EatGrassGoal) grass(m *Mob) (cube.Pos, bool) {
+	pos := cube.PosFromVec3(m.Position())
+	w := m.World()
+	if _, ok := w.Block(pos).(block.TallGrass); ok {
+		return pos, true
+	}
+	if _, ok := w.Block(pos.Side(cube.FaceDown)).(block.Grass); ok {
+		return pos.Side(cube.FaceDown), true
+	}
+	return cube.Pos{}, false
+}
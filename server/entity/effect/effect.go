@@ -1,6 +1,7 @@
 package effect
 
 import (
+	"github.com/df-mc/dragonfly/server/entity/attribute"
 	"github.com/df-mc/dragonfly/server/world"
 	"image/color"
 	"time"
@@ -145,6 +146,12 @@ func ResultingColour(effects []Effect) (color.RGBA, bool) {
 	return color.RGBA{R: uint8(r / n), G: uint8(g / n), B: uint8(b / n), A: uint8(a / n)}, ambient
 }
 
+// attributable represents an entity whose health and speed are computed from attributes. Effects change
+// these attributes using modifiers, so that they can be reverted without affecting other changes made.
+type attributable interface {
+	Attributes() *attribute.Map
+}
+
 // living represents a living entity that has health and the ability to move around.
 type living interface {
 	world.Entity
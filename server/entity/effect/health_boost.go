@@ -1,6 +1,7 @@
 package effect
 
 import (
+	"github.com/df-mc/dragonfly/server/entity/attribute"
 	"github.com/df-mc/dragonfly/server/world"
 	"image/color"
 )
@@ -12,6 +13,12 @@ type HealthBoost struct {
 
 // Start ...
 func (HealthBoost) Start(e world.Entity, lvl int) {
+	if a, ok := e.(attributable); ok {
+		a.Attributes().AddModifier(attribute.MaxHealth, attribute.Modifier{
+			Name: "effect.health_boost", Operation: attribute.OperationAdd, Amount: 4 * float64(lvl),
+		})
+		return
+	}
 	if l, ok := e.(living); ok {
 		l.SetMaxHealth(l.MaxHealth() + 4*float64(lvl))
 	}
@@ -19,6 +26,10 @@ func (HealthBoost) Start(e world.Entity, lvl int) {
 
 // End ...
 func (HealthBoost) End(e world.Entity, lvl int) {
+	if a, ok := e.(attributable); ok {
+		a.Attributes().RemoveModifier(attribute.MaxHealth, "effect.health_boost")
+		return
+	}
 	if l, ok := e.(living); ok {
 		l.SetMaxHealth(l.MaxHealth() - 4*float64(lvl))
 	}
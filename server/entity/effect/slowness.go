@@ -1,6 +1,7 @@
 package effect
 
 import (
+	"github.com/df-mc/dragonfly/server/entity/attribute"
 	"github.com/df-mc/dragonfly/server/world"
 	"image/color"
 )
@@ -13,6 +14,12 @@ type Slowness struct {
 
 // Start ...
 func (Slowness) Start(e world.Entity, lvl int) {
+	if a, ok := e.(attributable); ok {
+		a.Attributes().AddModifier(attribute.MovementSpeed, attribute.Modifier{
+			Name: "effect.slowness", Operation: attribute.OperationMultiply, Amount: -float64(lvl) * 0.15,
+		})
+		return
+	}
 	slowness := 1 - float64(lvl)*0.15
 	if slowness <= 0 {
 		slowness = 0.00001
@@ -24,6 +31,10 @@ func (Slowness) Start(e world.Entity, lvl int) {
 
 // End ...
 func (Slowness) End(e world.Entity, lvl int) {
+	if a, ok := e.(attributable); ok {
+		a.Attributes().RemoveModifier(attribute.MovementSpeed, "effect.slowness")
+		return
+	}
 	slowness := 1 - float64(lvl)*0.15
 	if slowness <= 0 {
 		slowness = 0.00001
@@ -1,6 +1,7 @@
 package effect
 
 import (
+	"github.com/df-mc/dragonfly/server/entity/attribute"
 	"github.com/df-mc/dragonfly/server/world"
 	"image/color"
 )
@@ -12,6 +13,12 @@ type Speed struct {
 
 // Start ...
 func (Speed) Start(e world.Entity, lvl int) {
+	if a, ok := e.(attributable); ok {
+		a.Attributes().AddModifier(attribute.MovementSpeed, attribute.Modifier{
+			Name: "effect.speed", Operation: attribute.OperationMultiply, Amount: float64(lvl) * 0.2,
+		})
+		return
+	}
 	speed := 1 + float64(lvl)*0.2
 	if l, ok := e.(living); ok {
 		l.SetSpeed(l.Speed() * speed)
@@ -20,6 +27,10 @@ func (Speed) Start(e world.Entity, lvl int) {
 
 // End ...
 func (Speed) End(e world.Entity, lvl int) {
+	if a, ok := e.(attributable); ok {
+		a.Attributes().RemoveModifier(attribute.MovementSpeed, "effect.speed")
+		return
+	}
 	speed := 1 + float64(lvl)*0.2
 	if l, ok := e.(living); ok {
 		l.SetSpeed(l.Speed() / speed)
@@ -1,6 +1,8 @@
 package effect
 
 import (
+	"github.com/df-mc/dragonfly/server/entity/attribute"
+	"github.com/df-mc/dragonfly/server/world"
 	"image/color"
 )
 
@@ -9,6 +11,22 @@ type Strength struct {
 	nopLasting
 }
 
+// Start ...
+func (s Strength) Start(e world.Entity, lvl int) {
+	if a, ok := e.(attributable); ok {
+		a.Attributes().AddModifier(attribute.AttackDamage, attribute.Modifier{
+			Name: "effect.strength", Operation: attribute.OperationMultiplyBase, Amount: s.Multiplier(lvl),
+		})
+	}
+}
+
+// End ...
+func (Strength) End(e world.Entity, _ int) {
+	if a, ok := e.(attributable); ok {
+		a.Attributes().RemoveModifier(attribute.AttackDamage, "effect.strength")
+	}
+}
+
 // Multiplier returns the damage multiplier of the effect.
 func (Strength) Multiplier(lvl int) float64 {
 	return 0.3 * float64(lvl)
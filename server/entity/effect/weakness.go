@@ -1,6 +1,8 @@
 package effect
 
 import (
+	"github.com/df-mc/dragonfly/server/entity/attribute"
+	"github.com/df-mc/dragonfly/server/world"
 	"image/color"
 )
 
@@ -9,6 +11,22 @@ type Weakness struct {
 	nopLasting
 }
 
+// Start ...
+func (w Weakness) Start(e world.Entity, lvl int) {
+	if a, ok := e.(attributable); ok {
+		a.Attributes().AddModifier(attribute.AttackDamage, attribute.Modifier{
+			Name: "effect.weakness", Operation: attribute.OperationMultiply, Amount: -w.Multiplier(lvl),
+		})
+	}
+}
+
+// End ...
+func (Weakness) End(e world.Entity, _ int) {
+	if a, ok := e.(attributable); ok {
+		a.Attributes().RemoveModifier(attribute.AttackDamage, "effect.weakness")
+	}
+}
+
 // Multiplier returns the damage multiplier of the effect.
 func (Weakness) Multiplier(lvl int) float64 {
 	v := 0.2 * float64(lvl)
@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"go.uber.org/atomic"
+)
+
+// EndCrystal is a stationary entity that explodes when it is damaged or caught in an explosion. End crystals are
+// placed on obsidian or bedrock.
+type EndCrystal struct {
+	transform
+
+	showBase bool
+	// detonated is set when the EndCrystal is damaged. The EndCrystal explodes during the next tick.
+	detonated atomic.Bool
+}
+
+// NewEndCrystal creates a new EndCrystal at the position passed. If showBase is true, the bedrock base below the
+// crystal is shown.
+func NewEndCrystal(pos mgl64.Vec3, showBase bool) *EndCrystal {
+	c := &EndCrystal{showBase: showBase}
+	c.transform = newTransform(c, pos)
+	return c
+}
+
+// Type returns EndCrystalType.
+func (*EndCrystal) Type() world.EntityType {
+	return EndCrystalType{}
+}
+
+// ShowBase checks if the bedrock base below the EndCrystal is shown.
+func (c *EndCrystal) ShowBase() bool {
+	return c.showBase
+}
+
+// Hurt detonates the EndCrystal, regardless of the damage or source passed. The EndCrystal explodes during the next
+// tick.
+func (c *EndCrystal) Hurt(float64, world.DamageSource) (float64, bool) {
+	c.detonated.Store(true)
+	return 0, true
+}
+
+// Explode detonates the EndCrystal when it is caught in an explosion.
+func (c *EndCrystal) Explode(mgl64.Vec3, float64, block.ExplosionConfig) {
+	c.detonated.Store(true)
+}
+
+// Tick explodes the EndCrystal if it was detonated.
+func (c *EndCrystal) Tick(w *world.World, _ int64) {
+	if !c.detonated.CAS(true, false) {
+		return
+	}
+	pos := c.Position()
+	_ = c.Close()
+	block.ExplosionConfig{Size: 6}.Explode(w, pos)
+}
+
+// EndCrystalType is a world.EntityType implementation for EndCrystal.
+type EndCrystalType struct{}
+
+func (EndCrystalType) EncodeEntity() string   { return "minecraft:ender_crystal" }
+func (EndCrystalType) NetworkOffset() float64 { return 0 }
+func (EndCrystalType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-1, 0, -1, 1, 2, 1)
+}
+
+func (EndCrystalType) DecodeNBT(m map[string]any) world.Entity {
+	return NewEndCrystal(nbtconv.Vec3(m, "Pos"), nbtconv.Bool(m, "ShowBottom"))
+}
+
+func (EndCrystalType) EncodeNBT(e world.Entity) map[string]any {
+	c := e.(*EndCrystal)
+	return map[string]any{
+		"Pos":        nbtconv.Vec3ToFloat32Slice(c.Position()),
+		"ShowBottom": boolByte(c.ShowBase()),
+	}
+}
@@ -26,8 +26,9 @@ var enderPearlConf = ProjectileBehaviourConfig{
 
 // teleporter represents a living entity that can teleport.
 type teleporter interface {
-	// Teleport teleports the entity to the position given.
-	Teleport(pos mgl64.Vec3)
+	// TeleportWithCause teleports the entity to the position given in the world.World passed, returning true if the
+	// teleport was successful.
+	TeleportWithCause(w *world.World, pos mgl64.Vec3, cause world.TeleportCause) bool
 	Living
 }
 
@@ -35,8 +36,9 @@ type teleporter interface {
 func teleport(e *Ent, target trace.Result) {
 	if user, ok := e.Owner().(teleporter); ok {
 		e.World().PlaySound(user.Position(), sound.Teleport{})
-		user.Teleport(target.Position())
-		user.Hurt(5, FallDamageSource{})
+		if user.TeleportWithCause(e.World(), target.Position(), EnderPearlTeleportCause{Pearl: e}) {
+			user.Hurt(5, FallDamageSource{})
+		}
 	}
 }
 
@@ -25,7 +25,13 @@ type Config struct {
 
 // New creates a new Ent using conf. The entity has a type and a position.
 func (conf Config) New(t world.EntityType, pos mgl64.Vec3) *Ent {
-	return &Ent{t: t, pos: pos, conf: conf}
+	e := &Ent{t: t, pos: pos, conf: conf}
+	e.metadata = NewMetadata(func() {
+		for _, v := range e.World().Viewers(e.Position()) {
+			v.ViewEntityState(e)
+		}
+	})
+	return e
 }
 
 // Ent is a world.Entity implementation that allows entity implementations to
@@ -43,6 +49,9 @@ type Ent struct {
 	name string
 
 	fireDuration time.Duration
+	metadata     *Metadata
+	portal       portalTravel
+	teleports    teleportState
 }
 
 // Explode propagates the explosion behaviour of the underlying Behaviour.
@@ -54,6 +63,12 @@ func (e *Ent) Explode(src mgl64.Vec3, impact float64, conf block.ExplosionConfig
 	}
 }
 
+// Metadata returns the Metadata of the Ent, which may be used to change the
+// way the Ent is shown to viewers.
+func (e *Ent) Metadata() *Metadata {
+	return e.metadata
+}
+
 // Type returns the world.EntityType passed to Config.New.
 func (e *Ent) Type() world.EntityType {
 	return e.t
@@ -125,11 +140,50 @@ func (e *Ent) SetVelocity(v mgl64.Vec3) {
 	e.vel = v
 }
 
-// Rotation always returns an empty cube.Rotation.
+// Rotation returns the rotation of the entity.
 func (e *Ent) Rotation() cube.Rotation {
+	e.mu.Lock()
+	defer e.mu.Unlock()
 	return e.rot
 }
 
+// SetPosition moves the entity to an absolute position and rotation. If smooth is true, viewers interpolate the
+// position and rotation of the entity towards the new values, rotating it in the shortest direction. Movements over
+// large distances are always shown as a teleport. If smooth is false, the entity snaps to the new position and
+// rotation immediately.
+func (e *Ent) SetPosition(pos mgl64.Vec3, rot cube.Rotation, smooth bool) {
+	e.mu.Lock()
+	e.rot = wrapRotation(rot)
+	e.pos, rot = pos, e.rot
+	e.mu.Unlock()
+
+	for _, v := range e.World().Viewers(pos) {
+		if smooth {
+			v.ViewEntityMovement(e, pos, rot.Yaw(), rot.Pitch(), false)
+		} else {
+			v.ViewEntityTeleport(e, pos)
+		}
+	}
+}
+
+// TeleportWithCause teleports the Ent to a position in the world.World
+// passed, or in its current world if w is nil.
+// world.Handler.HandleEntityTeleport is called with the world.TeleportCause
+// passed, and cancelling it prevents the teleport. The cause also decides if
+// the velocity of the Ent is kept and for how long teleports with a cause of
+// the same type are ignored afterwards. TeleportWithCause returns true if the
+// Ent was teleported.
+func (e *Ent) TeleportWithCause(w *world.World, pos mgl64.Vec3, cause world.TeleportCause) bool {
+	return e.teleports.teleport(e, w, pos, cause, func(pos mgl64.Vec3, keepVel bool) {
+		e.mu.Lock()
+		e.pos = pos
+		if !keepVel {
+			e.vel = mgl64.Vec3{}
+		}
+		e.mu.Unlock()
+	})
+}
+
 // World returns the world of the entity.
 func (e *Ent) World() *world.World {
 	w, _ := world.OfEntity(e)
@@ -143,6 +197,17 @@ func (e *Ent) OnFireDuration() time.Duration {
 	return e.fireDuration
 }
 
+// SetPiercingLevel changes the amount of entities that the Ent is able to pass
+// through if its Behaviour is a ProjectileBehaviour. It has no effect on other
+// entities.
+func (e *Ent) SetPiercingLevel(level int) {
+	if b, ok := e.conf.Behaviour.(*ProjectileBehaviour); ok {
+		e.mu.Lock()
+		b.conf.PiercingLevel = level
+		e.mu.Unlock()
+	}
+}
+
 // SetOnFire ...
 func (e *Ent) SetOnFire(duration time.Duration) {
 	if duration < 0 {
@@ -199,9 +264,24 @@ func (e *Ent) Tick(w *world.World, current int64) {
 	}
 	e.SetOnFire(e.OnFireDuration() - time.Second/20)
 
+	if pos, ok := ridingPosition(e); ok {
+		// The Ent moves along with its vehicle, so its own movement is not performed.
+		e.mu.Lock()
+		e.pos, e.vel = pos, mgl64.Vec3{}
+		e.mu.Unlock()
+		return
+	}
 	if m := e.conf.Behaviour.Tick(e); m != nil {
 		m.Send()
 	}
+	e.portal.tick(e, w, e.Position(), e.TeleportWithCause)
+}
+
+// EnterPortal marks the Ent as being inside a portal of a specific
+// Dimension. The Ent is transported to the destination of the portal during
+// its next tick.
+func (e *Ent) EnterPortal(dim world.Dimension) {
+	e.portal.enter(dim)
 }
 
 // Close closes the Ent and removes the associated entity from the world.
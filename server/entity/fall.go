@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/effect"
+	"github.com/df-mc/dragonfly/server/world"
+	"math"
+)
+
+// FallDamage returns the damage that the entity passed takes when it lands at its current position after falling
+// the distance passed in blocks. Entities that land in a liquid take no damage. If the entity lands on a block
+// implementing block.EntityLander, such as a hay bale, the block may reduce the distance or make the entity bounce.
+// Jump boost reduces the damage by its level. Reductions from slow falling and feather falling are not included:
+// Entities with slow falling should not track their fall distance, while feather falling is applied when the
+// entity is hurt by a FallDamageSource.
+func FallDamage(e world.Entity, w *world.World, distance float64) float64 {
+	pos := cube.PosFromVec3(e.Position())
+	if _, ok := w.Liquid(pos); ok {
+		return 0
+	}
+	b := w.Block(pos)
+	if len(b.Model().BBox(pos, w)) == 0 {
+		pos = pos.Side(cube.FaceDown)
+		b = w.Block(pos)
+	}
+	if l, ok := b.(block.EntityLander); ok {
+		l.EntityLand(pos, w, e, &distance)
+	}
+	dmg := distance - 3
+	if eff, ok := e.(interface {
+		Effect(e effect.Type) (effect.Effect, bool)
+	}); ok {
+		if boost, ok := eff.Effect(effect.JumpBoost{}); ok {
+			dmg -= float64(boost.Level())
+		}
+	}
+	if dmg < 0.5 {
+		return 0
+	}
+	return math.Ceil(dmg)
+}
@@ -78,7 +78,7 @@ func (f *Firework) Tick(w *world.World, current int64) {
 
 			// The client will propel itself to match the firework's velocity since we set the appropriate metadata.
 			f.pos = f.owner.Position()
-			f.vel.Add(vel.Add(dV.Mul(0.1).Add(dV.Mul(1.5).Sub(vel).Mul(0.5))))
+			f.vel = vel.Add(dV.Mul(0.1).Add(dV.Mul(1.5).Sub(vel).Mul(0.5)))
 		}
 	} else {
 		f.vel[0] *= 1.15
@@ -159,8 +159,8 @@ func (FireworkType) BBox(world.Entity) cube.BBox { return cube.BBox{} }
 func (FireworkType) DecodeNBT(m map[string]any) world.Entity {
 	f := NewFirework(
 		nbtconv.Vec3(m, "Pos"),
-		float64(nbtconv.Float32(m, "Pitch")),
 		float64(nbtconv.Float32(m, "Yaw")),
+		float64(nbtconv.Float32(m, "Pitch")),
 		nbtconv.MapItem(m, "Item").Item().(item.Firework),
 	)
 	f.vel = nbtconv.Vec3(m, "Motion")
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// FleeGoal is a Goal that makes a Mob run away from nearby entities, such as
+// players or entities that attacked it.
+type FleeGoal struct {
+	speed    float64
+	distance float64
+	from     func(e world.Entity) bool
+	threat   world.Entity
+}
+
+// NewFleeGoal creates a FleeGoal that makes a Mob run away with the speed
+// modifier passed from entities within the distance passed for which from
+// returns true.
+func NewFleeGoal(speed, distance float64, from func(e world.Entity) bool) *FleeGoal {
+	return &FleeGoal{speed: speed, distance: distance, from: from}
+}
+
+// Controls returns GoalControlMove.
+func (g *FleeGoal) Controls() GoalControl {
+	return GoalControlMove
+}
+
+// CanStart returns true if an entity to flee from is within the distance of the
+// Mob.
+func (g *FleeGoal) CanStart(m *Mob) bool {
+	g.threat = nearestEntity(m, g.distance, g.from)
+	return g.threat != nil
+}
+
+// CanContinue returns true as long as the entity fled from is within the
+// distance of the Mob.
+func (g *FleeGoal) CanContinue(m *Mob) bool {
+	return validTarget(m, g.threat) && g.threat.Position().Sub(m.Position()).Len() <= g.distance
+}
+
+// Start starts running away from the entity.
+func (g *FleeGoal) Start(*Mob) {}
+
+// Stop stops the movement of the Mob.
+func (g *FleeGoal) Stop(m *Mob) {
+	g.threat = nil
+	m.StopMoving()
+}
+
+// Tick moves the Mob in the direction opposite of the entity it flees from
+// once it has reached the position it was previously fleeing to.
+func (g *FleeGoal) Tick(m *Mob) {
+	if m.Moving() {
+		return
+	}
+	pos := m.Position()
+	dir := pos.Sub(g.threat.Position())
+	dir[1] = 0
+	if dir.Len() == 0 {
+		return
+	}
+	navigateTo(m, pos.Add(dir.Normalize().Mul(g.distance)), g.speed)
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// FollowOwnerGoal is a Goal that makes a Mob follow its owner, as set using
+// Mob.SetOwner. If the owner gets too far away, the Mob teleports to it.
+type FollowOwnerGoal struct {
+	speed         float64
+	start, stop   float64
+	repath        int
+	owner         world.Entity
+	teleportRange float64
+}
+
+// NewFollowOwnerGoal creates a FollowOwnerGoal that makes a Mob follow its
+// owner with the speed modifier passed once the owner is further away than
+// start blocks. The Mob stops following once it is within stop blocks of its
+// owner.
+func NewFollowOwnerGoal(speed, start, stop float64) *FollowOwnerGoal {
+	return &FollowOwnerGoal{speed: speed, start: start, stop: stop, teleportRange: 12}
+}
+
+// Controls returns GoalControlMove and GoalControlLook.
+func (g *FollowOwnerGoal) Controls() GoalControl {
+	return GoalControlMove | GoalControlLook
+}
+
+// CanStart returns true if the Mob has an owner in the same world that is
+// further away than the start distance.
+func (g *FollowOwnerGoal) CanStart(m *Mob) bool {
+	owner := m.Owner()
+	if !g.sameWorld(m, owner) || owner.Position().Sub(m.Position()).Len() <= g.start {
+		return false
+	}
+	g.owner = owner
+	return true
+}
+
+// CanContinue returns true as long as the owner is further away than the stop
+// distance.
+func (g *FollowOwnerGoal) CanContinue(m *Mob) bool {
+	return g.sameWorld(m, g.owner) && g.owner == m.Owner() && g.owner.Position().Sub(m.Position()).Len() > g.stop
+}
+
+// Start starts following the owner.
+func (g *FollowOwnerGoal) Start(*Mob) {
+	g.repath = 0
+}
+
+// Stop stops the movement of the Mob.
+func (g *FollowOwnerGoal) Stop(m *Mob) {
+	g.owner = nil
+	m.StopMoving()
+}
+
+// Tick moves the Mob towards its owner, or teleports it to the owner if it is
+// too far away.
+func (g *FollowOwnerGoal) Tick(m *Mob) {
+	m.LookAt(EyePosition(g.owner))
+	if g.repath--; g.repath > 0 {
+		return
+	}
+	g.repath = 10
+	if ownerPos := g.owner.Position(); ownerPos.Sub(m.Position()).Len() >= g.teleportRange {
+		m.StopMoving()
+		m.Teleport(ownerPos)
+		return
+	}
+	navigateTo(m, g.owner.Position(), g.speed)
+}
+
+// sameWorld checks if the owner passed is non-nil and in the same world as
+// the Mob.
+func (g *FollowOwnerGoal) sameWorld(m *Mob, owner world.Entity) bool {
+	if owner == nil {
+		return false
+	}
+	w, ok := world.OfEntity(owner)
+	return ok && w == m.World()
+}
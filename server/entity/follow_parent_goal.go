@@ -0,0 +1,79 @@
+package entity
+
+// FollowParentGoal is a Goal that makes a baby animal follow the nearest
+// adult animal of the same type. It only has effect on Mobs with an
+// AnimalBehaviour.
+type FollowParentGoal struct {
+	speed  float64
+	parent *Mob
+	repath int
+}
+
+// NewFollowParentGoal creates a FollowParentGoal that makes a baby follow its
+// parent with the speed modifier passed.
+func NewFollowParentGoal(speed float64) *FollowParentGoal {
+	return &FollowParentGoal{speed: speed}
+}
+
+// Controls returns GoalControlMove.
+func (g *FollowParentGoal) Controls() GoalControl {
+	return GoalControlMove
+}
+
+// CanStart returns true if the animal is a baby and an adult of the same type
+// is between 3 and 8 blocks away.
+func (g *FollowParentGoal) CanStart(m *Mob) bool {
+	if a, ok := animalOf(m); !ok || !a.Baby() {
+		return false
+	}
+	g.parent = nil
+	pos, nearest := m.Position(), 64.0
+	for _, e := range m.World().EntitiesWithin(m.Type().BBox(m).Translate(pos).Grow(8), nil) {
+		other, ok := e.(*Mob)
+		if !ok || other == m || other.Type() != m.Type() || other.Dead() {
+			continue
+		}
+		if a, ok := animalOf(other); !ok || a.Baby() {
+			continue
+		}
+		diff := other.Position().Sub(pos)
+		if d := diff.Dot(diff); d < nearest {
+			g.parent, nearest = other, d
+		}
+	}
+	if g.parent == nil {
+		return false
+	}
+	diff := g.parent.Position().Sub(pos)
+	return diff.Dot(diff) >= 9
+}
+
+// CanContinue returns true as long as the animal is a baby and the parent is
+// between 3 and 16 blocks away.
+func (g *FollowParentGoal) CanContinue(m *Mob) bool {
+	a, _ := animalOf(m)
+	if !a.Baby() || g.parent.Dead() {
+		return false
+	}
+	d := g.parent.Position().Sub(m.Position()).Len()
+	return d >= 3 && d <= 16
+}
+
+// Start starts following the parent.
+func (g *FollowParentGoal) Start(*Mob) {
+	g.repath = 0
+}
+
+// Stop forgets the parent and stops the movement of the animal.
+func (g *FollowParentGoal) Stop(m *Mob) {
+	g.parent = nil
+	m.StopMoving()
+}
+
+// Tick moves the animal towards its parent.
+func (g *FollowParentGoal) Tick(m *Mob) {
+	if g.repath--; g.repath <= 0 {
+		g.repath = 10
+		navigateTo(m, g.parent.Position(), g.speed)
+	}
+}
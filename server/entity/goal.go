@@ -0,0 +1,153 @@
+package entity
+
+import (
+	"golang.org/x/exp/slices"
+)
+
+// Goal is a single behaviour of a Mob, such as wandering around or attacking
+// its target. Goals are added to a GoalSelector with a priority, after which
+// the GoalSelector decides which of them run every tick.
+type Goal interface {
+	// Controls returns the GoalControl flags of the things the Goal controls
+	// while it is running. Two Goals that control the same thing never run at
+	// the same time.
+	Controls() GoalControl
+	// CanStart checks if the Goal can start running for the Mob passed.
+	CanStart(m *Mob) bool
+	// CanContinue checks if the Goal, which is currently running, should
+	// continue running for the Mob passed.
+	CanContinue(m *Mob) bool
+	// Start is called when the Goal starts running.
+	Start(m *Mob)
+	// Stop is called when the Goal stops running, either because CanContinue
+	// returned false or because a Goal with a higher priority took over.
+	Stop(m *Mob)
+	// Tick is called every tick while the Goal is running.
+	Tick(m *Mob)
+}
+
+// GoalControl is a set of flags that specify what a Goal controls while it is
+// running.
+type GoalControl uint8
+
+const (
+	// GoalControlMove is set for Goals that move the Mob.
+	GoalControlMove GoalControl = 1 << iota
+	// GoalControlLook is set for Goals that change where the Mob is looking.
+	GoalControlLook
+	// GoalControlTarget is set for Goals that select the target of the Mob.
+	GoalControlTarget
+)
+
+// GoalSelector runs the Goals of a Mob. Every tick, Goals that can start are
+// started in order of priority, stopping running Goals with a lower priority
+// that control the same things. A GoalSelector is not safe for concurrent use.
+type GoalSelector struct {
+	goals []*prioritisedGoal
+}
+
+// prioritisedGoal is a Goal added to a GoalSelector with a specific priority.
+type prioritisedGoal struct {
+	Goal
+	priority int
+	running  bool
+	removed  bool
+}
+
+// Add adds a Goal to the GoalSelector with a priority. Goals with a lower
+// priority value take precedence over Goals with a higher value.
+func (s *GoalSelector) Add(priority int, g Goal) {
+	s.goals = append(s.goals, &prioritisedGoal{Goal: g, priority: priority})
+	slices.SortStableFunc(s.goals, func(a, b *prioritisedGoal) bool {
+		return a.priority < b.priority
+	})
+}
+
+// Remove removes a Goal from the GoalSelector, stopping it first if it was
+// running.
+func (s *GoalSelector) Remove(m *Mob, g Goal) {
+	i := slices.IndexFunc(s.goals, func(pg *prioritisedGoal) bool {
+		return pg.Goal == g
+	})
+	if i == -1 {
+		return
+	}
+	pg := s.goals[i]
+	s.goals = slices.Delete(s.goals, i, i+1)
+	pg.removed = true
+	if pg.running {
+		pg.running = false
+		pg.Stop(m)
+	}
+}
+
+// Running returns all Goals of the GoalSelector that are currently running.
+func (s *GoalSelector) Running() []Goal {
+	running := make([]Goal, 0, len(s.goals))
+	for _, g := range s.goals {
+		if g.running {
+			running = append(running, g.Goal)
+		}
+	}
+	return running
+}
+
+// Stop stops all Goals of the GoalSelector that are currently running.
+func (s *GoalSelector) Stop(m *Mob) {
+	for _, g := range s.goals {
+		if g.running {
+			g.running = false
+			g.Stop(m)
+		}
+	}
+}
+
+// Tick stops running Goals that can no longer continue, starts Goals that can
+// start and ticks all Goals that are running afterwards. Goals may be added to
+// or removed from the GoalSelector while it is ticking.
+func (s *GoalSelector) Tick(m *Mob) {
+	// Goals may call Add or Remove while being ticked, so a snapshot of the
+	// goals is used.
+	goals := slices.Clone(s.goals)
+	for _, g := range goals {
+		if g.running && !g.CanContinue(m) {
+			g.running = false
+			g.Stop(m)
+		}
+	}
+	for i, g := range goals {
+		if g.removed || g.running || !s.available(g) || !g.CanStart(m) {
+			continue
+		}
+		// Stop any goals with a lower priority that control the same things as this goal.
+		for _, other := range goals[i+1:] {
+			if other.running && other.Controls()&g.Controls() != 0 {
+				other.running = false
+				other.Stop(m)
+			}
+		}
+		if !g.removed {
+			g.running = true
+			g.Start(m)
+		}
+	}
+	for _, g := range goals {
+		if g.running {
+			g.Tick(m)
+		}
+	}
+}
+
+// available checks if the goal passed could be started, meaning no running
+// goal with an equal or higher priority controls the same things.
+func (s *GoalSelector) available(g *prioritisedGoal) bool {
+	for _, other := range s.goals {
+		if other == g {
+			continue
+		}
+		if other.running && other.priority <= g.priority && other.Controls()&g.Controls() != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -62,6 +62,7 @@ func (it *Item) SetPickupDelay(d time.Duration) {
 // Tick ticks the entity, performing movement.
 func (it *Item) Tick(w *world.World, current int64) {
 	it.mu.Lock()
+	it.vel = it.float(w, it.pos, it.vel)
 	m := it.c.TickMovement(it, it.pos, it.vel, 0, 0)
 	it.pos, it.vel = m.pos, m.vel
 	it.mu.Unlock()
@@ -84,19 +85,44 @@ func (it *Item) Tick(w *world.World, current int64) {
 	}
 }
 
+// float makes the item entity float to the surface if it is in water and pushes it in the direction that the
+// water flows in. The new velocity of the item entity is returned.
+func (it *Item) float(w *world.World, pos, vel mgl64.Vec3) mgl64.Vec3 {
+	blockPos := cube.PosFromVec3(pos.Add(mgl64.Vec3{0, 0.125}))
+	l, ok := w.Liquid(blockPos)
+	if !ok {
+		return vel
+	}
+	if _, water := l.(block.Water); !water {
+		return vel
+	}
+	// Cancel out the gravity applied by the MovementComputer this tick, so that the item entity rises slowly
+	// until it reaches the surface.
+	gravity, _, _ := it.c.forces(w, pos)
+	vel[1] += gravity
+	if vel[1] < 0.06 {
+		vel[1] += 0.0005
+	}
+	return vel.Add(liquidFlow(w, blockPos, l).Mul(0.014))
+}
+
 // checkNearby checks the entities of the chunks around for item collectors and other item stacks. If a
 // collector is found in range, the item will be picked up. If another item stack with the same item type is
-// found in range, the item stacks will merge.
+// found in range, the item stacks will merge. If no collector is close enough to pick up the item, it is
+// attracted by the nearest collector around it instead.
 func (it *Item) checkNearby(w *world.World, pos mgl64.Vec3) {
 	bbox := it.Type().BBox(it)
 	grown := bbox.GrowVec3(mgl64.Vec3{1, 0.5, 1}).Translate(pos)
-	for _, e := range w.EntitiesWithin(bbox.Translate(pos).Grow(2), nil) {
+
+	var nearest Collector
+	for _, e := range w.EntitiesWithin(bbox.Translate(pos).Grow(pickupAttractionRadius), nil) {
 		if e == it {
 			// Skip the item entity itself.
 			continue
 		}
+		collector, isCollector := e.(Collector)
 		if e.Type().BBox(e).Translate(e.Position()).IntersectsWith(grown) {
-			if collector, ok := e.(Collector); ok {
+			if isCollector {
 				// A collector was within range to pick up the entity.
 				it.collect(w, collector, pos)
 				return
@@ -107,6 +133,30 @@ func (it *Item) checkNearby(w *world.World, pos mgl64.Vec3) {
 				}
 			}
 		}
+		if isCollector && collector.GameMode().AllowsInteraction() && (nearest == nil || e.Position().Sub(pos).LenSqr() < nearest.Position().Sub(pos).LenSqr()) {
+			nearest = collector
+		}
+	}
+	if nearest != nil {
+		it.attract(nearest, pos)
+	}
+}
+
+// pickupAttractionRadius is the radius around an item entity in which collectors attract it.
+const pickupAttractionRadius = 2
+
+// attract pulls the item entity towards the collector passed, so that it moves towards collectors that are
+// close, but not yet close enough to pick it up.
+func (it *Item) attract(collector Collector, pos mgl64.Vec3) {
+	vec := collector.Position()
+	if o, ok := collector.(Eyed); ok {
+		vec[1] += o.EyeHeight() / 2
+	}
+	vec = vec.Sub(pos).Mul(1.0 / pickupAttractionRadius)
+	if dist := vec.LenSqr(); dist < 1 && dist > 0 {
+		it.mu.Lock()
+		it.vel = it.vel.Add(vec.Normalize().Mul(0.1 * math.Pow(1-math.Sqrt(dist), 2)))
+		it.mu.Unlock()
 	}
 }
 
@@ -0,0 +1,219 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+)
+
+// leashLength is the distance in blocks at which a leashed Mob is pulled towards the holder of its leash.
+const leashLength = 6
+
+// leashBreakDistance is the distance in blocks at which the leash of a Mob breaks.
+const leashBreakDistance = 10
+
+// LeashKnot is an entity attached to a fence that holds the leashes of Mobs leashed to the fence. A LeashKnot is
+// removed when the fence it is attached to is broken or once no more Mobs are leashed to it.
+type LeashKnot struct {
+	pos cube.Pos
+}
+
+// NewLeashKnot creates a new LeashKnot attached to the fence at the position passed.
+func NewLeashKnot(pos cube.Pos) *LeashKnot {
+	return &LeashKnot{pos: pos}
+}
+
+// Type returns LeashKnotType.
+func (*LeashKnot) Type() world.EntityType {
+	return LeashKnotType{}
+}
+
+// Fence returns the position of the fence that the LeashKnot is attached to.
+func (k *LeashKnot) Fence() cube.Pos {
+	return k.pos
+}
+
+// Position returns the position of the LeashKnot, which is the centre of the fence it is attached to.
+func (k *LeashKnot) Position() mgl64.Vec3 {
+	return k.pos.Vec3Middle()
+}
+
+// Rotation always returns an empty cube.Rotation.
+func (*LeashKnot) Rotation() cube.Rotation {
+	return cube.Rotation{}
+}
+
+// World returns the world that the LeashKnot is in.
+func (k *LeashKnot) World() *world.World {
+	w, _ := world.OfEntity(k)
+	return w
+}
+
+// Interact moves the leashes of all Mobs leashed to the user to the LeashKnot. If no Mobs are leashed to the user,
+// the LeashKnot is removed and the leashes of all Mobs leashed to it are broken instead.
+func (k *LeashKnot) Interact(user item.User, _ *item.UseContext) bool {
+	w := k.World()
+	mobs := leashedTo(user, w, user.Position())
+	for _, m := range mobs {
+		m.Leash(k)
+	}
+	if len(mobs) == 0 {
+		_ = k.Close()
+	}
+	return true
+}
+
+// Tick removes the LeashKnot if the fence it is attached to was broken or if no Mobs are leashed to it anymore.
+func (k *LeashKnot) Tick(w *world.World, current int64) {
+	switch w.Block(k.pos).(type) {
+	case block.WoodFence, block.NetherBrickFence:
+		if current%20 != 0 || len(leashedTo(k, w, k.Position())) > 0 {
+			return
+		}
+	}
+	_ = k.Close()
+}
+
+// Close breaks the leashes of all Mobs leashed to the LeashKnot and removes it from the world.
+func (k *LeashKnot) Close() error {
+	w := k.World()
+	for _, m := range leashedTo(k, w, k.Position()) {
+		m.Unleash()
+	}
+	w.RemoveEntity(k)
+	return nil
+}
+
+// leashHolderTimeout is the amount of ticks that a Mob loaded from NBT waits for the entity holding its leash to be
+// found before the leash breaks.
+const leashHolderTimeout = 100
+
+// savedLeash is the holder of the leash of a Mob as it was saved, before the holder is found in the world after the
+// Mob is loaded.
+type savedLeash struct {
+	// knot is true if the Mob was leashed to a LeashKnot attached to the fence at the position fence.
+	knot  bool
+	fence cube.Pos
+	// holder is the UUID of the entity that held the leash if the Mob was not leashed to a LeashKnot.
+	holder uuid.UUID
+	ticks  int
+}
+
+// encodeLeash encodes the holder of the leash of the Mob passed to the data passed.
+func encodeLeash(m *Mob, data map[string]any) {
+	m.mu.Lock()
+	holder, saved := m.leashHolder, m.savedLeash
+	m.mu.Unlock()
+	switch h := holder.(type) {
+	case nil:
+		if saved != nil && saved.knot {
+			data["LeashFence"] = nbtconv.PosToInt32Slice(saved.fence)
+		} else if saved != nil {
+			data["LeashHolder"] = saved.holder.String()
+		}
+	case *LeashKnot:
+		data["LeashFence"] = nbtconv.PosToInt32Slice(h.Fence())
+	case interface{ UUID() uuid.UUID }:
+		data["LeashHolder"] = h.UUID().String()
+	}
+}
+
+// decodeLeash decodes the holder of the leash of a Mob from the data passed. The holder is found in the world once
+// the Mob is ticked.
+func decodeLeash(data map[string]any) *savedLeash {
+	if _, ok := data["LeashFence"]; ok {
+		return &savedLeash{knot: true, fence: nbtconv.Pos(data, "LeashFence")}
+	}
+	if id, err := uuid.Parse(nbtconv.String(data, "LeashHolder")); err == nil {
+		return &savedLeash{holder: id}
+	}
+	return nil
+}
+
+// restoreLeash attempts to find the holder of the saved leash of the Mob in the world passed. If the Mob was
+// leashed to a fence, the LeashKnot on the fence is recreated if needed. The leash breaks if the fence no longer
+// exists or if the entity holding it could not be found in time.
+func (m *Mob) restoreLeash(w *world.World) {
+	m.mu.Lock()
+	saved := m.savedLeash
+	m.mu.Unlock()
+	if saved == nil {
+		return
+	}
+	var holder world.Entity
+	if saved.knot {
+		switch w.Block(saved.fence).(type) {
+		case block.WoodFence, block.NetherBrickFence:
+			holder = leashKnotAt(w, saved.fence)
+		}
+	} else {
+		box := cube.Box(-leashBreakDistance, -leashBreakDistance, -leashBreakDistance, leashBreakDistance, leashBreakDistance, leashBreakDistance).Translate(m.Position())
+		for _, e := range w.EntitiesWithin(box, nil) {
+			if u, ok := e.(interface{ UUID() uuid.UUID }); ok && u.UUID() == saved.holder {
+				holder = e
+				break
+			}
+		}
+		if saved.ticks++; holder == nil && saved.ticks < leashHolderTimeout {
+			return
+		}
+	}
+	m.mu.Lock()
+	m.savedLeash = nil
+	m.mu.Unlock()
+	if holder == nil {
+		// The holder of the leash no longer exists, so the leash breaks.
+		w.AddEntity(NewItem(item.NewStack(item.Lead{}, 1), m.Position().Add(mgl64.Vec3{0, 0.5})))
+		return
+	}
+	m.Leash(holder)
+}
+
+// leashKnotAt returns the LeashKnot attached to the fence at the position passed, adding a new LeashKnot to the
+// world if none exists yet.
+func leashKnotAt(w *world.World, fence cube.Pos) *LeashKnot {
+	for _, e := range w.EntitiesWithin(cube.Box(0, 0, 0, 1, 1, 1).Translate(fence.Vec3()), nil) {
+		if k, ok := e.(*LeashKnot); ok && k.Fence() == fence {
+			return k
+		}
+	}
+	k := NewLeashKnot(fence)
+	w.AddEntity(k)
+	return k
+}
+
+// leashedTo returns all Mobs close to the position passed that are leashed to the holder passed.
+func leashedTo(holder world.Entity, w *world.World, pos mgl64.Vec3) []*Mob {
+	var mobs []*Mob
+	box := cube.Box(-leashBreakDistance, -leashBreakDistance, -leashBreakDistance, leashBreakDistance, leashBreakDistance, leashBreakDistance).Translate(pos)
+	for _, e := range w.EntitiesWithin(box, nil) {
+		if m, ok := e.(*Mob); ok && m.LeashHolder() == holder {
+			mobs = append(mobs, m)
+		}
+	}
+	return mobs
+}
+
+// LeashKnotType is a world.EntityType implementation for LeashKnot.
+type LeashKnotType struct{}
+
+func (LeashKnotType) EncodeEntity() string { return "minecraft:leash_knot" }
+func (LeashKnotType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.1875, -0.25, -0.1875, 0.1875, 0.25, 0.1875)
+}
+
+func (LeashKnotType) DecodeNBT(m map[string]any) world.Entity {
+	return NewLeashKnot(nbtconv.Pos(m, "Fence"))
+}
+
+func (LeashKnotType) EncodeNBT(e world.Entity) map[string]any {
+	k := e.(*LeashKnot)
+	return map[string]any{
+		"Pos":   nbtconv.Vec3ToFloat32Slice(k.Position()),
+		"Fence": nbtconv.PosToInt32Slice(k.Fence()),
+	}
+}
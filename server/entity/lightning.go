@@ -83,6 +83,9 @@ func (li *Lightning) Tick(w *world.World, _ int64) {
 
 		bb := li.Type().BBox(li).GrowVec3(mgl64.Vec3{3, 6, 3}).Translate(li.pos.Add(mgl64.Vec3{0, 3}))
 		for _, e := range w.EntitiesWithin(bb, nil) {
+			if s, ok := e.(Strikable); ok {
+				s.StruckByLightning(li)
+			}
 			// Only damage entities that weren't already dead.
 			if l, ok := e.(Living); ok && l.Health() > 0 {
 				if li.damage > 0 {
@@ -112,6 +115,12 @@ func (li *Lightning) Tick(w *world.World, _ int64) {
 	}
 }
 
+// Strikable represents an entity that reacts to being struck by lightning, such as a creeper becoming charged.
+type Strikable interface {
+	// StruckByLightning is called when the entity is struck by the Lightning passed.
+	StruckByLightning(li *Lightning)
+}
+
 // fire returns a fire block.
 func fire() world.Block {
 	f, ok := world.BlockByName("minecraft:fire", map[string]any{"age": int32(0)})
@@ -125,6 +134,7 @@ func fire() world.Block {
 type LightningType struct{}
 
 func (LightningType) EncodeEntity() string                  { return "minecraft:lightning_bolt" }
+func (LightningType) Spawn(pos mgl64.Vec3) world.Entity     { return NewLightning(pos) }
 func (LightningType) BBox(world.Entity) cube.BBox           { return cube.BBox{} }
 func (LightningType) DecodeNBT(map[string]any) world.Entity { return nil }
 func (LightningType) EncodeNBT(world.Entity) map[string]any {
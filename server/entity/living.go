@@ -2,10 +2,19 @@ package entity
 
 import (
 	"github.com/df-mc/dragonfly/server/entity/effect"
+	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
 )
 
+// Undead is implemented by entities that may be undead, such as zombies and skeletons. Undead entities take
+// additional damage from weapons enchanted with enchantment.Smite.
+type Undead interface {
+	world.Entity
+	// Undead checks if the entity is undead.
+	Undead() bool
+}
+
 // Living represents an entity that is alive and that has health. It is able to take damage and will die upon
 // taking fatal damage.
 type Living interface {
@@ -55,3 +64,13 @@ type Living interface {
 	// SetSpeed sets the speed of an entity to a new value.
 	SetSpeed(float64)
 }
+
+// Interactable represents an entity that reacts to users using items on it, such as animals that may be fed or
+// sheared.
+type Interactable interface {
+	world.Entity
+	// Interact handles the user passed using the item in its main hand on the entity. If the entity handled the
+	// interaction, true is returned and the UseContext passed holds the result of the interaction, such as the
+	// amount of items consumed.
+	Interact(user item.User, ctx *item.UseContext) bool
+}
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"math/rand"
+)
+
+// LookAtPlayerGoal is a Goal that makes a Mob look at a nearby player for a
+// short duration every now and then.
+type LookAtPlayerGoal struct {
+	distance float64
+	player   world.Entity
+	ticks    int
+}
+
+// NewLookAtPlayerGoal creates a LookAtPlayerGoal that makes a Mob look at
+// players within the distance passed.
+func NewLookAtPlayerGoal(distance float64) *LookAtPlayerGoal {
+	return &LookAtPlayerGoal{distance: distance}
+}
+
+// Controls returns GoalControlLook.
+func (g *LookAtPlayerGoal) Controls() GoalControl {
+	return GoalControlLook
+}
+
+// CanStart randomly returns true if a player is within the distance of the
+// Mob.
+func (g *LookAtPlayerGoal) CanStart(m *Mob) bool {
+	if rand.Intn(50) != 0 {
+		return false
+	}
+	g.player = nearestEntity(m, g.distance, isPlayer)
+	return g.player != nil
+}
+
+// CanContinue returns true for 2-4 seconds after the goal was started, as long
+// as the player remains within the distance of the Mob.
+func (g *LookAtPlayerGoal) CanContinue(m *Mob) bool {
+	return g.ticks > 0 && validTarget(m, g.player) && g.player.Position().Sub(m.Position()).Len() <= g.distance
+}
+
+// Start starts looking at the player.
+func (g *LookAtPlayerGoal) Start(*Mob) {
+	g.ticks = 40 + rand.Intn(40)
+}
+
+// Stop forgets the player that the Mob was looking at.
+func (g *LookAtPlayerGoal) Stop(*Mob) {
+	g.player = nil
+}
+
+// Tick makes the Mob look at the eyes of the player.
+func (g *LookAtPlayerGoal) Tick(m *Mob) {
+	g.ticks--
+	m.LookAt(EyePosition(g.player))
+}
+
+// isPlayer checks if the world.Entity passed is a player.
+func isPlayer(e world.Entity) bool {
+	return e.Type().EncodeEntity() == "minecraft:player"
+}
@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// MeleeAttackGoal is a Goal that makes a Mob chase its target and attack it
+// once it is within reach, dealing the attack damage of the Mob.
+type MeleeAttackGoal struct {
+	speed    float64
+	cooldown int
+	repath   int
+}
+
+// NewMeleeAttackGoal creates a MeleeAttackGoal that makes a Mob chase its
+// target with the speed modifier passed.
+func NewMeleeAttackGoal(speed float64) *MeleeAttackGoal {
+	return &MeleeAttackGoal{speed: speed}
+}
+
+// Controls returns GoalControlMove and GoalControlLook.
+func (g *MeleeAttackGoal) Controls() GoalControl {
+	return GoalControlMove | GoalControlLook
+}
+
+// CanStart returns true if the Mob has a target that can be attacked.
+func (g *MeleeAttackGoal) CanStart(m *Mob) bool {
+	return validTarget(m, m.Target())
+}
+
+// CanContinue returns true as long as the target of the Mob can be attacked.
+func (g *MeleeAttackGoal) CanContinue(m *Mob) bool {
+	return validTarget(m, m.Target())
+}
+
+// Start starts chasing the target.
+func (g *MeleeAttackGoal) Start(*Mob) {
+	g.repath = 0
+}
+
+// Stop stops the movement of the Mob.
+func (g *MeleeAttackGoal) Stop(m *Mob) {
+	m.StopMoving()
+}
+
+// Tick moves the Mob towards its target and attacks it once it is within
+// reach.
+func (g *MeleeAttackGoal) Tick(m *Mob) {
+	target := m.Target()
+	if target == nil {
+		return
+	}
+	pos, targetPos := m.Position(), target.Position()
+	m.LookAt(EyePosition(target))
+
+	if g.repath--; g.repath <= 0 {
+		g.repath = 10
+		navigateTo(m, targetPos, g.speed)
+	}
+	if g.cooldown > 0 {
+		g.cooldown--
+		return
+	}
+	width := m.Type().BBox(m).Width()
+	reach := width*2*width*2 + target.Type().BBox(target).Width()
+	if diff := targetPos.Sub(pos); diff.Dot(diff) > reach {
+		return
+	}
+	g.cooldown = 20
+	m.SwingArm()
+	if l, ok := target.(Living); ok {
+		if _, vulnerable := l.Hurt(m.AttackDamage(), AttackDamageSource{Attacker: m}); vulnerable {
+			l.KnockBack(pos, 0.4, 0.4)
+		}
+	}
+}
+
+// validTarget checks if the world.Entity passed may be targeted by the Mob.
+// Entities in other worlds, dead entities, the owner of the Mob and players
+// that cannot take damage are not valid targets.
+func validTarget(m *Mob, e world.Entity) bool {
+	if e == nil || e == world.Entity(m) || e == m.Owner() {
+		return false
+	}
+	if w, ok := world.OfEntity(e); !ok || w != m.World() {
+		return false
+	}
+	if l, ok := e.(Living); ok && l.Dead() {
+		return false
+	}
+	if g, ok := e.(interface{ GameMode() world.GameMode }); ok && !g.GameMode().AllowsTakingDamage() {
+		return false
+	}
+	return true
+}
+
+// nearestEntity returns the nearest entity within the distance passed around
+// the Mob that is a valid target and for which the filter passed returns true.
+// Nil is returned if no such entity exists.
+func nearestEntity(m *Mob, distance float64, filter func(e world.Entity) bool) world.Entity {
+	pos := m.Position()
+	var (
+		nearest     world.Entity
+		nearestDist = distance * distance
+	)
+	for _, e := range m.World().EntitiesWithin(m.Type().BBox(m).Translate(pos).Grow(distance), nil) {
+		if !validTarget(m, e) || !filter(e) {
+			continue
+		}
+		diff := e.Position().Sub(pos)
+		if d := diff.Dot(diff); d <= nearestDist {
+			nearest, nearestDist = e, d
+		}
+	}
+	return nearest
+}
@@ -0,0 +1,422 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/player/economy"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/google/uuid"
+	"sync"
+)
+
+// merchantTierExperience holds the amount of experience that a Merchant needs
+// to reach each tier, from novice (0) up to master (4).
+var merchantTierExperience = [...]int{0, 10, 70, 150, 250}
+
+// MaxMerchantTier is the highest tier that a Merchant can reach.
+const MaxMerchantTier = len(merchantTierExperience) - 1
+
+// MerchantTierExperience returns the amount of experience that a Merchant
+// needs to reach the tier passed.
+func MerchantTierExperience(tier int) int {
+	if tier < 0 || tier > MaxMerchantTier {
+		return 0
+	}
+	return merchantTierExperience[tier]
+}
+
+// Trade is an offer of a Merchant, in which a customer gives one or two items
+// in exchange for another item.
+type Trade struct {
+	// Input is the item that the customer has to give in exchange for the
+	// Output. The count of the stack is the amount that has to be given.
+	Input item.Stack
+	// SecondInput is an optional second item that the customer has to give.
+	// It may be left empty.
+	SecondInput item.Stack
+	// Output is the item that the customer receives.
+	Output item.Stack
+	// Uses is the amount of times the Trade has been used since the Merchant
+	// last restocked.
+	Uses int
+	// MaxUses is the amount of times the Trade may be used before the Merchant
+	// has to restock. If left as 0, the Trade may be used an unlimited amount
+	// of times.
+	MaxUses int
+	// Tier is the tier that the Merchant must have reached for the Trade to be
+	// offered, ranging from 0 (novice) to MaxMerchantTier (master).
+	Tier int
+	// Experience is the amount of experience that the Merchant gains every
+	// time the Trade is used.
+	Experience int
+	// Price is the amount of currency withdrawn from the balance of the
+	// customer every time the Trade is used, on top of the input items. The
+	// Trade cannot be used if the balance of the customer is too low. Prices
+	// are not shown in the trading window, so they are best mentioned in the
+	// name of the Output.
+	Price int64
+}
+
+// Exhausted checks if the Trade has been used its maximum amount of times and
+// cannot be used until the Merchant restocks.
+func (t Trade) Exhausted() bool {
+	return t.MaxUses > 0 && t.Uses >= t.MaxUses
+}
+
+// Merchant is implemented by the MobBehaviour of Mobs that offer Trades to
+// players, such as villagers. MerchantBehaviour implements Merchant, so
+// behaviours embedding it do as well.
+type Merchant interface {
+	MobBehaviour
+	// MerchantName returns the name displayed at the top of the trading
+	// window.
+	MerchantName() string
+	// Trades returns all Trades of the Merchant, including those that are not
+	// yet unlocked.
+	Trades() []Trade
+	// Tier returns the tier that the Merchant has reached.
+	Tier() int
+	// Experience returns the amount of experience that the Merchant has
+	// gained by trading.
+	Experience() int
+	// Customer returns the entity currently trading with the Merchant, or nil
+	// if no entity is trading with it.
+	Customer() world.Entity
+	// StartTrading makes the entity passed the customer of the Merchant.
+	// False is returned if another entity is already trading with it.
+	StartTrading(m *Mob, customer world.Entity) bool
+	// StopTrading stops the Merchant from trading with its customer.
+	StopTrading(m *Mob)
+	// UseTrade uses the Trade with the index passed for the customer passed.
+	// The Trade is returned as it was before it was used. False is returned
+	// if the customer is not trading with the Merchant or if the Trade cannot
+	// be used.
+	UseTrade(m *Mob, customer world.Entity, index int) (Trade, bool)
+	// RevertTrade reverts a use of the Trade with the index passed by the
+	// customer passed, returning its Price to the customer. It is called if
+	// the trade could not be completed after UseTrade returned true.
+	RevertTrade(m *Mob, customer world.Entity, index int)
+}
+
+// MerchantBehaviourConfig holds settings that influence the way a
+// MerchantBehaviour behaves. It may be used to create NPC shops with fully
+// custom Trades.
+type MerchantBehaviourConfig struct {
+	// Name is the name displayed at the top of the trading window.
+	Name string
+	// Trades are the Trades offered by the Mob.
+	Trades []Trade
+	// Traded is called every time a customer uses one of the Trades of the
+	// Mob, after the customer received the output of the Trade. Traded may be
+	// nil. Note that a trade may still be reverted after Traded is called, if
+	// the item stack request that it was part of is rejected.
+	Traded func(m *Mob, customer world.Entity, t Trade)
+	// Economy is the economy.Economy that the Price of Trades is withdrawn
+	// from, such as the one returned by Server.Economy. If nil, Trades with a
+	// Price cannot be used.
+	Economy economy.Economy
+}
+
+// New creates a MerchantBehaviour using conf. It may be passed to
+// MobConfig.Behaviour.
+func (conf MerchantBehaviourConfig) New() *MerchantBehaviour {
+	return &MerchantBehaviour{conf: conf, name: conf.Name, trades: append([]Trade(nil), conf.Trades...)}
+}
+
+// MerchantBehaviour implements the behaviour of Mobs that trade with players.
+// Players interacting with the Mob open a trading window, in which they may
+// use the Trades that have been unlocked. Using a Trade gives the Mob
+// experience, unlocking new Trades as its tier increases.
+type MerchantBehaviour struct {
+	conf MerchantBehaviourConfig
+
+	mu         sync.Mutex
+	name       string
+	trades     []Trade
+	experience int
+	customer   world.Entity
+}
+
+// MerchantName returns the name displayed at the top of the trading window.
+func (b *MerchantBehaviour) MerchantName() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.name
+}
+
+// SetMerchantName changes the name displayed at the top of the trading window.
+func (b *MerchantBehaviour) SetMerchantName(name string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.name = name
+}
+
+// Trades returns all Trades of the Mob, including those that are not yet
+// unlocked.
+func (b *MerchantBehaviour) Trades() []Trade {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]Trade(nil), b.trades...)
+}
+
+// SetTrades replaces the Trades of the Mob with the Trades passed. A customer
+// currently trading with the Mob sees the new Trades the next time it opens
+// the trading window.
+func (b *MerchantBehaviour) SetTrades(trades []Trade) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trades = append([]Trade(nil), trades...)
+}
+
+// Restock resets the uses of all Trades of the Mob, so that exhausted Trades
+// may be used again.
+func (b *MerchantBehaviour) Restock() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for i := range b.trades {
+		b.trades[i].Uses = 0
+	}
+}
+
+// Tier returns the tier that the Mob has reached, based on its experience.
+func (b *MerchantBehaviour) Tier() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.tier()
+}
+
+// tier returns the tier that the Mob has reached. b.mu must be held when
+// calling tier.
+func (b *MerchantBehaviour) tier() int {
+	tier := 0
+	for i, exp := range merchantTierExperience {
+		if b.experience >= exp {
+			tier = i
+		}
+	}
+	return tier
+}
+
+// Experience returns the amount of experience that the Mob has gained by
+// trading.
+func (b *MerchantBehaviour) Experience() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.experience
+}
+
+// SetExperience changes the amount of experience of the Mob, which decides
+// its tier and thus the Trades that are unlocked.
+func (b *MerchantBehaviour) SetExperience(m *Mob, exp int) {
+	b.mu.Lock()
+	b.experience = exp
+	b.mu.Unlock()
+	m.updateState()
+}
+
+// Customer returns the entity currently trading with the Mob, or nil if no
+// entity is trading with it.
+func (b *MerchantBehaviour) Customer() world.Entity {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.customer
+}
+
+// StartTrading makes the entity passed the customer of the Mob. False is
+// returned if another entity is already trading with the Mob.
+func (b *MerchantBehaviour) StartTrading(m *Mob, customer world.Entity) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.customer != nil && b.customer != customer {
+		return false
+	}
+	b.customer = customer
+	return true
+}
+
+// StopTrading stops the Mob from trading with its customer.
+func (b *MerchantBehaviour) StopTrading(*Mob) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.customer = nil
+}
+
+// UseTrade uses the Trade with the index passed for the customer passed,
+// giving the Mob experience. The Trade is returned as it was before it was
+// used. False is returned if the customer is not trading with the Mob, if
+// the Trade is not unlocked or exhausted, or if the Price of the Trade could
+// not be withdrawn from the balance of the customer.
+func (b *MerchantBehaviour) UseTrade(m *Mob, customer world.Entity, index int) (Trade, bool) {
+	b.mu.Lock()
+	if b.customer != customer || index < 0 || index >= len(b.trades) {
+		b.mu.Unlock()
+		return Trade{}, false
+	}
+	t := b.trades[index]
+	if t.Exhausted() || t.Tier > b.tier() {
+		b.mu.Unlock()
+		return Trade{}, false
+	}
+	// The use is counted before the price is withdrawn, so that the Trade
+	// cannot be used more than its maximum uses while the Mob is unlocked.
+	b.trades[index].Uses++
+	b.experience += t.Experience
+	b.mu.Unlock()
+
+	if !b.charge(customer, t.Price) {
+		b.mu.Lock()
+		if b.trades[index].Uses > 0 {
+			b.trades[index].Uses--
+		}
+		b.experience -= t.Experience
+		b.mu.Unlock()
+		return Trade{}, false
+	}
+
+	if t.Experience > 0 {
+		m.updateState()
+	}
+	if b.conf.Traded != nil {
+		b.conf.Traded(m, customer, t)
+	}
+	return t, true
+}
+
+// RevertTrade reverts a use of the Trade with the index passed by the
+// customer passed, removing the experience it gave the Mob and depositing its
+// Price back to the balance of the customer.
+func (b *MerchantBehaviour) RevertTrade(m *Mob, customer world.Entity, index int) {
+	b.mu.Lock()
+	if index < 0 || index >= len(b.trades) || b.trades[index].Uses == 0 {
+		b.mu.Unlock()
+		return
+	}
+	t := b.trades[index]
+	b.trades[index].Uses--
+	b.experience -= t.Experience
+	b.mu.Unlock()
+
+	if t.Experience > 0 {
+		m.updateState()
+	}
+	if c, ok := customer.(interface{ UUID() uuid.UUID }); ok && t.Price > 0 && b.conf.Economy != nil {
+		_ = b.conf.Economy.Deposit(c.UUID(), t.Price)
+	}
+}
+
+// charge withdraws the price passed from the balance of the customer in the
+// Economy of the Mob. True is returned if the price was withdrawn or if it is
+// 0.
+func (b *MerchantBehaviour) charge(customer world.Entity, price int64) bool {
+	if price == 0 {
+		return true
+	}
+	c, ok := customer.(interface{ UUID() uuid.UUID })
+	if !ok || b.conf.Economy == nil {
+		return false
+	}
+	return b.conf.Economy.Withdraw(c.UUID(), price) == nil
+}
+
+// EncodeNBT encodes the experience of the Mob and the uses of its Trades to
+// the data passed. The Trades themselves are not encoded.
+func (b *MerchantBehaviour) EncodeNBT(_ *Mob, data map[string]any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	uses := make([]int32, 0, len(b.trades))
+	for _, t := range b.trades {
+		uses = append(uses, int32(t.Uses))
+	}
+	data["TradeExperience"], data["TradeUses"] = int32(b.experience), uses
+}
+
+// DecodeNBT decodes the experience of the Mob and the uses of its Trades from
+// the data passed.
+func (b *MerchantBehaviour) DecodeNBT(_ *Mob, data map[string]any) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.experience = int(nbtconv.Int32(data, "TradeExperience"))
+	uses, _ := data["TradeUses"].([]int32)
+	for i := 0; i < len(uses) && i < len(b.trades); i++ {
+		b.trades[i].Uses = int(uses[i])
+	}
+}
+
+// Tick stops the Mob from trading once its customer has moved away or left
+// the world of the Mob.
+func (b *MerchantBehaviour) Tick(m *Mob) {
+	customer := b.Customer()
+	if customer == nil {
+		return
+	}
+	if w, ok := world.OfEntity(customer); ok && w == m.World() && customer.Position().Sub(m.Position()).Len() <= 8 {
+		if l, ok := customer.(Living); !ok || !l.Dead() {
+			return
+		}
+	}
+	b.StopTrading(m)
+	if c, ok := customer.(interface{ CloseContainer() }); ok {
+		c.CloseContainer()
+	}
+}
+
+// Interact opens the trading window for the user if it is able to trade and
+// the Mob has Trades to offer. Items that may be used on entities, such as
+// name tags and leads, are used normally instead.
+func (b *MerchantBehaviour) Interact(m *Mob, user item.User, _ *item.UseContext) bool {
+	if held, _ := user.HeldItems(); !held.Empty() {
+		if _, ok := held.Item().(item.UsableOnEntity); ok {
+			return false
+		}
+	}
+	t, ok := user.(interface{ OpenTrading(m *Mob) })
+	if !ok || len(b.Trades()) == 0 {
+		return false
+	}
+	t.OpenTrading(m)
+	return true
+}
+
+// TradeGoal is a Goal that makes a Mob stand still and look at its customer
+// while it is trading. It only has effect on Mobs with a Merchant behaviour.
+type TradeGoal struct {
+	customer world.Entity
+}
+
+// NewTradeGoal creates a TradeGoal.
+func NewTradeGoal() *TradeGoal {
+	return &TradeGoal{}
+}
+
+// Controls returns GoalControlMove and GoalControlLook.
+func (g *TradeGoal) Controls() GoalControl {
+	return GoalControlMove | GoalControlLook
+}
+
+// CanStart returns true if the Mob is trading with a customer.
+func (g *TradeGoal) CanStart(m *Mob) bool {
+	if merchant, ok := m.Behaviour().(Merchant); ok {
+		g.customer = merchant.Customer()
+	}
+	return g.customer != nil
+}
+
+// CanContinue returns true as long as the Mob is trading with a customer.
+func (g *TradeGoal) CanContinue(m *Mob) bool {
+	return g.CanStart(m)
+}
+
+// Start stops the movement of the Mob.
+func (g *TradeGoal) Start(m *Mob) {
+	m.StopMoving()
+}
+
+// Stop forgets the customer of the Mob.
+func (g *TradeGoal) Stop(*Mob) {
+	g.customer = nil
+}
+
+// Tick makes the Mob look at its customer.
+func (g *TradeGoal) Tick(m *Mob) {
+	m.LookAt(EyePosition(g.customer))
+}
@@ -0,0 +1,158 @@
+package entity
+
+import "sync"
+
+// MetadataFlag is a flag of an entity that may be overridden using Metadata.
+type MetadataFlag struct {
+	metadataFlag
+}
+
+// OnFireFlag returns the MetadataFlag that makes an entity appear on fire.
+func OnFireFlag() MetadataFlag {
+	return MetadataFlag{0}
+}
+
+// SneakingFlag returns the MetadataFlag that makes an entity appear to be
+// sneaking.
+func SneakingFlag() MetadataFlag {
+	return MetadataFlag{1}
+}
+
+// SprintingFlag returns the MetadataFlag that makes an entity appear to be
+// sprinting.
+func SprintingFlag() MetadataFlag {
+	return MetadataFlag{2}
+}
+
+// InvisibleFlag returns the MetadataFlag that makes an entity invisible.
+func InvisibleFlag() MetadataFlag {
+	return MetadataFlag{3}
+}
+
+// ImmobileFlag returns the MetadataFlag that prevents the client from
+// animating the movement of an entity.
+func ImmobileFlag() MetadataFlag {
+	return MetadataFlag{4}
+}
+
+// MetadataFlags returns all possible MetadataFlags.
+func MetadataFlags() []MetadataFlag {
+	return []MetadataFlag{OnFireFlag(), SneakingFlag(), SprintingFlag(), InvisibleFlag(), ImmobileFlag()}
+}
+
+type metadataFlag uint8
+
+// Uint8 returns the MetadataFlag as a uint8.
+func (f metadataFlag) Uint8() uint8 {
+	return uint8(f)
+}
+
+// Metadata is a component that holds properties of an entity as shown to its
+// viewers, such as its scale and whether it appears to be on fire. Properties
+// set in the Metadata override those that follow from the state of the
+// entity, and every change is shown to the viewers of the entity immediately.
+// Metadata does not change the actual state of the entity: An entity of which
+// the OnFireFlag is set does not take fire damage.
+type Metadata struct {
+	update func()
+
+	mu       sync.Mutex
+	flags    map[MetadataFlag]bool
+	scale    float64
+	hasScale bool
+	width    float64
+	height   float64
+	hasSize  bool
+}
+
+// NewMetadata creates an empty Metadata. The update function passed is called
+// every time the Metadata changes and should show the state of the entity to
+// its viewers.
+func NewMetadata(update func()) *Metadata {
+	return &Metadata{update: update, flags: map[MetadataFlag]bool{}}
+}
+
+// SetFlag overrides the MetadataFlag passed, setting it to v regardless of the
+// state of the entity.
+func (m *Metadata) SetFlag(f MetadataFlag, v bool) {
+	m.mu.Lock()
+	m.flags[f] = v
+	m.mu.Unlock()
+	m.update()
+}
+
+// ResetFlag removes the override of the MetadataFlag passed, so that it
+// follows from the state of the entity again.
+func (m *Metadata) ResetFlag(f MetadataFlag) {
+	m.mu.Lock()
+	delete(m.flags, f)
+	m.mu.Unlock()
+	m.update()
+}
+
+// Flag returns the value that the MetadataFlag passed was overridden with.
+// False is returned if the flag is not overridden.
+func (m *Metadata) Flag(f MetadataFlag) (v bool, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok = m.flags[f]
+	return v, ok
+}
+
+// Flags returns all MetadataFlags that are overridden with the values they
+// were overridden with.
+func (m *Metadata) Flags() map[MetadataFlag]bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	flags := make(map[MetadataFlag]bool, len(m.flags))
+	for f, v := range m.flags {
+		flags[f] = v
+	}
+	return flags
+}
+
+// SetScale overrides the scale of the entity as shown to viewers. A scale of
+// 1 is the normal size of the entity.
+func (m *Metadata) SetScale(scale float64) {
+	m.mu.Lock()
+	m.scale, m.hasScale = scale, true
+	m.mu.Unlock()
+	m.update()
+}
+
+// Scale returns the scale that the entity is shown with. False is returned if
+// the scale is not overridden.
+func (m *Metadata) Scale() (float64, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.scale, m.hasScale
+}
+
+// SetSize overrides the width and height of the bounding box of the entity as
+// shown to viewers, which changes the area in which players are able to
+// interact with the entity. The bounding box used by the server is not
+// changed.
+func (m *Metadata) SetSize(width, height float64) {
+	m.mu.Lock()
+	m.width, m.height, m.hasSize = width, height, true
+	m.mu.Unlock()
+	m.update()
+}
+
+// Size returns the width and height of the bounding box of the entity as
+// shown to viewers. False is returned if the size is not overridden.
+func (m *Metadata) Size() (width, height float64, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.width, m.height, m.hasSize
+}
+
+// Reset removes all overrides, so that all properties follow from the state
+// of the entity again.
+func (m *Metadata) Reset() {
+	m.mu.Lock()
+	m.flags = map[MetadataFlag]bool{}
+	m.hasScale, m.hasSize = false, false
+	m.mu.Unlock()
+	m.update()
+}
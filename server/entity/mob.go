@@ -0,0 +1,1133 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity/attribute"
+	"github.com/df-mc/dragonfly/server/entity/effect"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/enchantment"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"sync"
+	"time"
+)
+
+// MobConfig holds settings that influence the way a Mob behaves. MobConfig.New
+// may be called to create a new Mob with this config.
+type MobConfig struct {
+	// MaxHealth is the maximum health of the Mob. The Mob spawns with this
+	// health. If left as 0, the maximum health is 20.
+	MaxHealth float64
+	// Speed is the movement speed of the Mob. The horizontal velocity of a
+	// moving Mob in blocks per tick is its speed multiplied by the speed
+	// modifier passed to Mob.MoveTo. If left as 0, the speed is 0.25.
+	Speed float64
+	// AttackDamage is the damage dealt by the Mob when it attacks another
+	// entity in melee.
+	AttackDamage float64
+	// KnockBackResistance is the fraction of knock back that the Mob resists,
+	// ranging from 0 to 1.
+	KnockBackResistance float64
+	// Drops is a function that returns the items dropped by the Mob passed
+	// when it dies. If nil, the Mob drops no items.
+	Drops func(m *Mob) []item.Stack
+	// Experience is the amount of experience dropped by the Mob when it dies.
+	Experience int
+	// Navigator holds the settings used by the Navigator of the Mob to find
+	// paths.
+	Navigator NavigatorConfig
+	// DisablePushing prevents the Mob from pushing and being pushed by other
+	// entities that it collides with.
+	DisablePushing bool
+	// Sinks makes the Mob sink in water instead of swimming up to stay afloat
+	// when it is not moving towards a target above it.
+	Sinks bool
+	// BreathesUnderwater makes the Mob able to breathe underwater, so that it
+	// does not run out of air and drown.
+	BreathesUnderwater bool
+	// Undead marks the Mob as undead, which makes it take additional damage
+	// from weapons enchanted with Smite.
+	Undead bool
+	// Behaviour implements behaviour specific to the type of the Mob, such as
+	// the ageing and breeding of animals. Behaviour may be nil.
+	Behaviour MobBehaviour
+}
+
+// MobBehaviour implements behaviour specific to a type of Mob that cannot be
+// expressed using Goals. A MobBehaviour may additionally implement
+// Interact(m *Mob, user item.User, ctx *item.UseContext) bool to handle
+// players using items on the Mob, Baby() bool to prevent a Mob from
+// dropping items and experience when it dies, and
+// Hurt(m *Mob, dmg float64, src world.DamageSource) bool to prevent the Mob
+// from being hurt by returning false. Behaviours that have state that should
+// be saved to disk with the Mob may implement
+// EncodeNBT(m *Mob, data map[string]any) and
+// DecodeNBT(m *Mob, data map[string]any).
+type MobBehaviour interface {
+	// Tick is called every tick that the Mob is alive, before its Goals are
+	// ticked.
+	Tick(m *Mob)
+}
+
+// New creates a new Mob using conf. The Mob has the world.EntityType passed
+// and spawns at the position passed. Goals may be added to the Mob through
+// Mob.Goals and Mob.Targets.
+func (conf MobConfig) New(t world.EntityType, pos mgl64.Vec3) *Mob {
+	if conf.MaxHealth == 0 {
+		conf.MaxHealth = 20
+	}
+	if conf.Speed == 0 {
+		conf.Speed = 0.25
+	}
+	m := &Mob{
+		conf:    conf,
+		t:       t,
+		pos:     pos,
+		health:  NewHealthManager(conf.MaxHealth, conf.MaxHealth),
+		effects: NewEffectManager(),
+		mc:      &MovementComputer{Gravity: 0.08, Drag: 0.02, LiquidPhysics: true},
+
+		breathing: true,
+		airSupply: maxMobAirSupply,
+	}
+	m.metadata = NewMetadata(m.updateState)
+	m.armour = inventory.NewArmour(func(int, item.Stack, item.Stack) {
+		for _, v := range m.World().Viewers(m.Position()) {
+			v.ViewEntityArmour(m)
+		}
+	})
+	m.attributes = attribute.NewMap(func(a attribute.Attribute, v float64) {
+		if a == attribute.MaxHealth {
+			m.health.SetMaxHealth(v)
+		}
+	})
+	m.attributes.SetBase(attribute.MaxHealth, conf.MaxHealth)
+	m.attributes.SetBase(attribute.MovementSpeed, conf.Speed)
+	m.attributes.SetBase(attribute.AttackDamage, conf.AttackDamage)
+	m.attributes.SetBase(attribute.KnockBackResistance, conf.KnockBackResistance)
+	m.nav = conf.Navigator.New(m)
+	return m
+}
+
+// Mob is a Living entity that is controlled by Goals. Every tick, the Goals
+// added to Mob.Targets first select the target of the Mob, after which the
+// Goals added to Mob.Goals move the Mob and perform actions such as attacking
+// its target.
+type Mob struct {
+	conf MobConfig
+	t    world.EntityType
+
+	mu        sync.Mutex
+	pos, vel  mgl64.Vec3
+	rot       cube.Rotation
+	name      string
+	mainHand  item.Stack
+	offHand   item.Stack
+	immunity  time.Time
+	fire      time.Duration
+	deathTime int
+	portal    portalTravel
+	teleports teleportState
+	invisible bool
+	fallDist  float64
+	breathing bool
+	airSupply int
+	// natural and category hold if the Mob was spawned naturally when it was
+	// saved and the world.SpawnCategory it was spawned with.
+	natural  bool
+	category world.SpawnCategory
+
+	target, owner, attacker world.Entity
+	leashHolder             world.Entity
+	savedLeash              *savedLeash
+	attackTime              time.Time
+
+	moving        bool
+	moveTarget    mgl64.Vec3
+	moveSpeed     float64
+	looking       bool
+	lookTarget    mgl64.Vec3
+	knockBackTime int
+	jump          bool
+
+	health     *HealthManager
+	attributes *attribute.Map
+	effects    *EffectManager
+	armour     *inventory.Armour
+	metadata   *Metadata
+	mc         *MovementComputer
+	nav        *Navigator
+
+	goals, targets GoalSelector
+}
+
+// Type returns the world.EntityType passed to MobConfig.New.
+func (m *Mob) Type() world.EntityType {
+	return m.t
+}
+
+// Goals returns the GoalSelector that runs the Goals of the Mob that move it
+// and make it perform actions.
+func (m *Mob) Goals() *GoalSelector {
+	return &m.goals
+}
+
+// Behaviour returns the MobBehaviour of the Mob as passed to MobConfig.New,
+// or nil if it has none.
+func (m *Mob) Behaviour() MobBehaviour {
+	return m.conf.Behaviour
+}
+
+// Interact handles a user using the item in its main hand on the Mob. If the
+// MobBehaviour of the Mob handles the interaction, true is returned and the
+// UseContext passed is updated with the result of the interaction.
+func (m *Mob) Interact(user item.User, ctx *item.UseContext) bool {
+	if h := m.LeashHolder(); h != nil && h == user {
+		// The user unleashes the Mob that was leashed to it.
+		m.Unleash()
+		return true
+	}
+	if i, ok := m.conf.Behaviour.(interface {
+		Interact(m *Mob, user item.User, ctx *item.UseContext) bool
+	}); ok && !m.Dead() {
+		return i.Interact(m, user, ctx)
+	}
+	return false
+}
+
+// Metadata returns the Metadata of the Mob, which may be used to change the
+// way the Mob is shown to viewers.
+func (m *Mob) Metadata() *Metadata {
+	return m.metadata
+}
+
+// Navigator returns the Navigator of the Mob, which may be used to move the
+// Mob along a path to a position.
+func (m *Mob) Navigator() *Navigator {
+	return m.nav
+}
+
+// Targets returns the GoalSelector that runs the Goals of the Mob that select
+// its target.
+func (m *Mob) Targets() *GoalSelector {
+	return &m.targets
+}
+
+// Position returns the current position of the Mob.
+func (m *Mob) Position() mgl64.Vec3 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.pos
+}
+
+// Velocity returns the current velocity of the Mob in blocks per tick.
+func (m *Mob) Velocity() mgl64.Vec3 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.vel
+}
+
+// SetVelocity sets the velocity of the Mob in blocks per tick.
+func (m *Mob) SetVelocity(v mgl64.Vec3) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.vel = v
+}
+
+// Rotation returns the rotation of the Mob.
+func (m *Mob) Rotation() cube.Rotation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.rot
+}
+
+// World returns the world of the Mob.
+func (m *Mob) World() *world.World {
+	w, _ := world.OfEntity(m)
+	return w
+}
+
+// EyeHeight returns the height of the eyes of the Mob, which is at 85% of the
+// height of its bounding box.
+func (m *Mob) EyeHeight() float64 {
+	return m.t.BBox(m).Height() * 0.85
+}
+
+// Teleport immediately moves the Mob to the position passed, stopping any
+// movement started using MoveTo. Teleport is equivalent to calling
+// TeleportWithCause with a CustomTeleportCause.
+func (m *Mob) Teleport(pos mgl64.Vec3) {
+	m.TeleportWithCause(nil, pos, CustomTeleportCause{})
+}
+
+// TeleportWithCause teleports the Mob to a position in the world.World
+// passed, or in its current world if w is nil, stopping any movement started
+// using MoveTo. world.Handler.HandleEntityTeleport is called with the
+// world.TeleportCause passed, and cancelling it prevents the teleport. The
+// cause also decides if the velocity of the Mob is kept and for how long
+// teleports with a cause of the same type are ignored afterwards.
+// TeleportWithCause returns true if the Mob was teleported.
+func (m *Mob) TeleportWithCause(w *world.World, pos mgl64.Vec3, cause world.TeleportCause) bool {
+	return m.teleports.teleport(m, w, pos, cause, func(pos mgl64.Vec3, keepVel bool) {
+		m.mu.Lock()
+		m.pos, m.moving, m.fallDist = pos, false, 0
+		if !keepVel {
+			m.vel = mgl64.Vec3{}
+		}
+		m.mu.Unlock()
+	})
+}
+
+// SetPosition moves the Mob to an absolute position and rotation, stopping
+// any movement started using MoveTo. If smooth is true, viewers interpolate
+// the position and rotation of the Mob towards the new values, rotating it in
+// the shortest direction, so that calling SetPosition every tick results in
+// smooth movement. Movements over large distances are always shown as a
+// teleport. If smooth is false, the Mob snaps to the new position and
+// rotation immediately.
+func (m *Mob) SetPosition(pos mgl64.Vec3, rot cube.Rotation, smooth bool) {
+	m.mu.Lock()
+	m.rot = wrapRotation(rot)
+	m.pos, m.vel, m.moving = pos, mgl64.Vec3{}, false
+	rot, onGround := m.rot, m.mc.OnGround()
+	m.mu.Unlock()
+
+	for _, v := range m.World().Viewers(pos) {
+		if smooth {
+			v.ViewEntityMovement(m, pos, rot.Yaw(), rot.Pitch(), onGround)
+		} else {
+			v.ViewEntityTeleport(m, pos)
+		}
+	}
+}
+
+// OnGround checks if the Mob is currently on the ground.
+func (m *Mob) OnGround() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mc.OnGround()
+}
+
+// Health returns the health of the Mob.
+func (m *Mob) Health() float64 {
+	return m.health.Health()
+}
+
+// MaxHealth returns the maximum health of the Mob.
+func (m *Mob) MaxHealth() float64 {
+	return m.health.MaxHealth()
+}
+
+// SetMaxHealth changes the base maximum health of the Mob. Modifiers of the
+// attribute.MaxHealth attribute, such as those of effects, are applied on top
+// of it.
+func (m *Mob) SetMaxHealth(v float64) {
+	m.attributes.SetBase(attribute.MaxHealth, v)
+}
+
+// Attributes returns the attribute.Map holding the attributes of the Mob, such
+// as its maximum health and movement speed.
+func (m *Mob) Attributes() *attribute.Map {
+	return m.attributes
+}
+
+// Dead checks if the Mob is dead.
+func (m *Mob) Dead() bool {
+	return m.Health() <= mgl64.Epsilon
+}
+
+// AttackImmune checks if the Mob was attacked recently and is therefore
+// immune to attacks.
+func (m *Mob) AttackImmune() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.immunity.After(time.Now())
+}
+
+// Hurt hurts the Mob for the damage passed. If the source of the damage is
+// another entity, that entity is remembered as the last attacker of the Mob.
+func (m *Mob) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
+	if m.Dead() {
+		return 0, false
+	}
+	if h, ok := m.conf.Behaviour.(interface {
+		Hurt(m *Mob, dmg float64, src world.DamageSource) bool
+	}); ok && !h.Hurt(m, dmg, src) {
+		return 0, false
+	}
+	if _, ok := m.Effect(effect.FireResistance{}); ok && src.Fire() {
+		return 0, false
+	}
+	dmg = math.Max(dmg, 0)
+	original := dmg
+	dmg -= m.armour.DamageReduction(dmg, src)
+	if res, ok := m.Effect(effect.Resistance{}); ok {
+		dmg *= effect.Resistance{}.Multiplier(src, res.Level())
+	}
+	m.health.AddHealth(-dmg)
+	if src.ReducedByArmour() {
+		m.damageArmour(int(math.Max(math.Floor(original/4), 1)))
+	}
+
+	m.mu.Lock()
+	m.immunity = time.Now().Add(time.Second / 2)
+	switch s := src.(type) {
+	case AttackDamageSource:
+		m.attacker, m.attackTime = s.Attacker, time.Now()
+	case ProjectileDamageSource:
+		m.attacker, m.attackTime = s.Owner, time.Now()
+	}
+	pos := m.pos
+	m.mu.Unlock()
+
+	w := m.World()
+	for _, v := range w.Viewers(pos) {
+		v.ViewEntityAction(m, HurtAction{})
+	}
+	if src.Fire() {
+		w.PlaySound(pos, sound.Burning{})
+	} else if _, ok := src.(DrowningDamageSource); ok {
+		w.PlaySound(pos, sound.Drowning{})
+	}
+	if m.Dead() {
+		m.kill()
+	}
+	return dmg, true
+}
+
+// kill shows the death animation of the Mob to viewers and drops its items and
+// experience.
+func (m *Mob) kill() {
+	m.goals.Stop(m)
+	m.targets.Stop(m)
+
+	w, pos := m.World(), m.Position()
+	for _, v := range w.Viewers(pos) {
+		v.ViewEntityAction(m, DeathAction{})
+	}
+	m.Unleash()
+	if b, ok := m.conf.Behaviour.(interface{ Baby() bool }); ok && b.Baby() {
+		// Babies don't drop any items or experience.
+		return
+	}
+	if m.conf.Drops != nil {
+		for _, it := range m.conf.Drops(m) {
+			w.AddEntity(NewItem(it, pos.Add(mgl64.Vec3{0, 0.5})))
+		}
+	}
+	for _, orb := range NewExperienceOrbs(pos, m.conf.Experience) {
+		w.AddEntity(orb)
+	}
+}
+
+// damageArmour deals the damage passed to every armour item worn by the Mob,
+// taking the Unbreaking enchantment into account.
+func (m *Mob) damageArmour(d int) {
+	for slot, it := range m.armour.Slots() {
+		if it.Empty() || it.MaxDurability() == -1 {
+			continue
+		}
+		dmg := d
+		if e, ok := it.Enchantment(enchantment.Unbreaking{}); ok {
+			dmg = (enchantment.Unbreaking{}).Reduce(it.Item(), e.Level(), dmg)
+		}
+		if it = it.Damage(dmg); it.Empty() {
+			m.World().PlaySound(m.Position(), sound.ItemBreak{})
+		}
+		_ = m.armour.Inventory().SetItem(slot, it)
+	}
+}
+
+// Undead checks if the Mob is undead, as set in its MobConfig.
+func (m *Mob) Undead() bool {
+	return m.conf.Undead
+}
+
+// Armour returns the armour inventory of the Mob. Viewers of the Mob are
+// updated every time the armour changes. Armour worn by the Mob reduces the
+// damage it takes.
+func (m *Mob) Armour() *inventory.Armour {
+	return m.armour
+}
+
+// Heal heals the Mob for the amount of health passed.
+func (m *Mob) Heal(health float64, _ world.HealingSource) {
+	if m.Dead() || health < 0 {
+		return
+	}
+	m.health.AddHealth(health)
+}
+
+// KnockBack knocks the Mob back with a force and height, away from the source
+// position passed. The velocity of the Mob is first reduced by the
+// KnockBackFriction of the world.Physics of its World. The Goals of the Mob are
+// unable to move it for a short duration after being knocked back.
+func (m *Mob) KnockBack(src mgl64.Vec3, force, height float64) {
+	friction := m.World().Physics().KnockBackFriction
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	vel := m.pos.Sub(src)
+	vel[1] = 0
+	if vel.Len() > 0 {
+		vel = vel.Normalize().Mul(force)
+	}
+	vel[1] = height
+	m.vel = m.vel.Mul(1 - friction).Add(vel.Mul(1 - m.attributes.Value(attribute.KnockBackResistance)))
+	m.knockBackTime = 10
+}
+
+// Explode hurts the Mob and knocks it back as the result of an explosion at
+// the position passed, with the impact depending on the distance of the Mob to
+// the explosion.
+func (m *Mob) Explode(explosionPos mgl64.Vec3, impact float64, conf block.ExplosionConfig) {
+	diff := m.Position().Sub(explosionPos)
+	m.Hurt(math.Floor((impact*impact+impact)*3.5*conf.Size+1), ExplosionDamageSource{})
+	m.KnockBack(explosionPos, impact, diff[1]/diff.Len()*impact)
+}
+
+// AddEffect adds an effect.Effect to the Mob. Viewers of the Mob are updated
+// so that the particles of the effect are shown.
+func (m *Mob) AddEffect(e effect.Effect) {
+	m.effects.Add(e, m)
+	m.updateState()
+}
+
+// RemoveEffect removes an effect from the Mob.
+func (m *Mob) RemoveEffect(e effect.Type) {
+	m.effects.Remove(e, m)
+	m.updateState()
+}
+
+// Effect returns the effect of the type passed and true if the Mob has it.
+func (m *Mob) Effect(e effect.Type) (effect.Effect, bool) {
+	return m.effects.Effect(e)
+}
+
+// Effects returns all effects currently applied to the Mob.
+func (m *Mob) Effects() []effect.Effect {
+	return m.effects.Effects()
+}
+
+// Speed returns the movement speed of the Mob, including any modifiers such
+// as those of the Speed and Slowness effects.
+func (m *Mob) Speed() float64 {
+	return m.attributes.Value(attribute.MovementSpeed)
+}
+
+// SetSpeed changes the base movement speed of the Mob.
+func (m *Mob) SetSpeed(v float64) {
+	m.attributes.SetBase(attribute.MovementSpeed, v)
+}
+
+// AttackDamage returns the damage dealt by the Mob when it attacks another
+// entity in melee, including the changes of the Strength and Weakness effects.
+func (m *Mob) AttackDamage() float64 {
+	return m.attributes.Value(attribute.AttackDamage)
+}
+
+// Invisible checks if the Mob is invisible, for example because of the
+// Invisibility effect.
+func (m *Mob) Invisible() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.invisible
+}
+
+// SetInvisible turns the Mob invisible.
+func (m *Mob) SetInvisible() {
+	m.setInvisible(true)
+}
+
+// SetVisible turns the Mob visible again. Nothing happens if the Mob still
+// has the Invisibility effect.
+func (m *Mob) SetVisible() {
+	if _, ok := m.Effect(effect.Invisibility{}); ok {
+		return
+	}
+	m.setInvisible(false)
+}
+
+// setInvisible changes the visibility of the Mob and updates its viewers if it
+// changed.
+func (m *Mob) setInvisible(v bool) {
+	m.mu.Lock()
+	changed := m.invisible != v
+	m.invisible = v
+	m.mu.Unlock()
+
+	if changed {
+		m.updateState()
+	}
+}
+
+// updateState updates the state of the Mob to all of its viewers.
+func (m *Mob) updateState() {
+	for _, v := range m.World().Viewers(m.Position()) {
+		v.ViewEntityState(m)
+	}
+}
+
+// HeldItems returns the items held by the Mob.
+func (m *Mob) HeldItems() (mainHand, offHand item.Stack) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mainHand, m.offHand
+}
+
+// SetHeldItems changes the items held by the Mob.
+func (m *Mob) SetHeldItems(mainHand, offHand item.Stack) {
+	m.mu.Lock()
+	m.mainHand, m.offHand = mainHand, offHand
+	pos := m.pos
+	m.mu.Unlock()
+
+	for _, v := range m.World().Viewers(pos) {
+		v.ViewEntityItems(m)
+	}
+}
+
+// OnFireDuration returns the duration that the Mob remains on fire for.
+func (m *Mob) OnFireDuration() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.fire
+}
+
+// SetOnFire sets the Mob on fire for the duration passed.
+func (m *Mob) SetOnFire(duration time.Duration) {
+	if duration < 0 {
+		duration = 0
+	}
+	m.mu.Lock()
+	before, after := m.fire > 0, duration > 0
+	m.fire = duration
+	pos := m.pos
+	m.mu.Unlock()
+
+	if before == after {
+		return
+	}
+	for _, v := range m.World().Viewers(pos) {
+		v.ViewEntityState(m)
+	}
+}
+
+// Extinguish extinguishes the Mob.
+func (m *Mob) Extinguish() {
+	m.SetOnFire(0)
+}
+
+// NameTag returns the name tag of the Mob. An empty string is returned if no
+// name tag was set.
+func (m *Mob) NameTag() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.name
+}
+
+// SetNameTag changes the name tag of the Mob. The name tag is removed if an
+// empty string is passed.
+func (m *Mob) SetNameTag(s string) {
+	m.mu.Lock()
+	m.name = s
+	pos := m.pos
+	m.mu.Unlock()
+
+	for _, v := range m.World().Viewers(pos) {
+		v.ViewEntityState(m)
+	}
+}
+
+// Target returns the entity that the Mob is currently targeting, or nil if it
+// has no target.
+func (m *Mob) Target() world.Entity {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.target
+}
+
+// SetTarget changes the target of the Mob. Nil may be passed to clear the
+// target.
+func (m *Mob) SetTarget(e world.Entity) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.target = e
+}
+
+// LastAttacker returns the entity that last attacked the Mob and the time at
+// which it did so. Nil is returned if the Mob was never attacked by an entity.
+func (m *Mob) LastAttacker() (world.Entity, time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.attacker, m.attackTime
+}
+
+// Owner returns the owner of the Mob, or nil if it doesn't have one.
+func (m *Mob) Owner() world.Entity {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.owner
+}
+
+// SetOwner changes the owner of the Mob. Nil may be passed to remove the
+// owner.
+func (m *Mob) SetOwner(e world.Entity) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.owner = e
+}
+
+// LeashHolder returns the entity that the Mob is leashed to, such as a player
+// or a LeashKnot. Nil is returned if the Mob is not leashed.
+func (m *Mob) LeashHolder() world.Entity {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.leashHolder
+}
+
+// Leash leashes the Mob to the holder passed. If the Mob was already leashed,
+// its leash is moved to the new holder. A leashed Mob is pulled towards its
+// holder and no longer despawns. False is returned if the Mob is dead.
+func (m *Mob) Leash(holder world.Entity) bool {
+	if m.Dead() || holder == world.Entity(m) {
+		return false
+	}
+	m.mu.Lock()
+	m.leashHolder, m.savedLeash = holder, nil
+	m.mu.Unlock()
+
+	m.World().Persist(m)
+	m.updateState()
+	return true
+}
+
+// Unleash breaks the leash of the Mob, dropping a lead at its position.
+// Unleash does nothing if the Mob is not leashed.
+func (m *Mob) Unleash() {
+	m.mu.Lock()
+	holder, pos := m.leashHolder, m.pos
+	m.leashHolder = nil
+	m.mu.Unlock()
+	if holder == nil {
+		return
+	}
+	m.World().AddEntity(NewItem(item.NewStack(item.Lead{}, 1), pos.Add(mgl64.Vec3{0, 0.5})))
+	m.updateState()
+}
+
+// tickLeash breaks the leash of the Mob if its holder is too far away and
+// otherwise moves the Mob towards its holder if the leash is stretched.
+func (m *Mob) tickLeash(w *world.World) {
+	holder := m.LeashHolder()
+	if holder == nil {
+		m.restoreLeash(w)
+		return
+	}
+	if holder.World() != w {
+		m.Unleash()
+		return
+	}
+	target := holder.Position()
+	if dist := target.Sub(m.Position()).Len(); dist > leashBreakDistance {
+		m.Unleash()
+	} else if dist > leashLength {
+		m.nav.clear()
+		m.moveTo(target, 1)
+	}
+}
+
+// MoveTo makes the Mob move in a straight line towards the position passed.
+// The speed modifier passed is multiplied with the speed of the Mob. The Mob
+// jumps when it runs into a block and stops moving once it reaches the
+// position. Navigator().NavigateTo may be used instead to make the Mob find
+// a path around obstacles.
+func (m *Mob) MoveTo(pos mgl64.Vec3, speed float64) {
+	m.nav.clear()
+	m.moveTo(pos, speed)
+}
+
+// moveTo makes the Mob move in a straight line towards the position passed
+// without clearing the path of its Navigator.
+func (m *Mob) moveTo(pos mgl64.Vec3, speed float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.moving, m.moveTarget, m.moveSpeed = true, pos, speed
+}
+
+// StopMoving stops any movement of the Mob started using MoveTo or its
+// Navigator.
+func (m *Mob) StopMoving() {
+	m.nav.clear()
+	m.stopMoving()
+}
+
+// stopMoving stops the movement of the Mob without clearing the path of its
+// Navigator.
+func (m *Mob) stopMoving() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.moving = false
+}
+
+// Moving checks if the Mob is currently moving towards a position passed to
+// MoveTo or along the path of its Navigator.
+func (m *Mob) Moving() bool {
+	m.mu.Lock()
+	moving := m.moving
+	m.mu.Unlock()
+	return moving || m.nav.Navigating()
+}
+
+// LookAt makes the Mob look at the position passed during the next tick. If
+// the Mob is moving, only its pitch is changed. LookAt must be called every
+// tick for the Mob to keep looking at a position.
+func (m *Mob) LookAt(pos mgl64.Vec3) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.looking, m.lookTarget = true, pos
+}
+
+// SwingArm makes the Mob swing its arm.
+func (m *Mob) SwingArm() {
+	for _, v := range m.World().Viewers(m.Position()) {
+		v.ViewEntityAction(m, SwingArmAction{})
+	}
+}
+
+// Tick ticks the Mob, running its Goals and moving it.
+func (m *Mob) Tick(w *world.World, current int64) {
+	if m.Dead() {
+		m.mu.Lock()
+		m.deathTime++
+		done := m.deathTime >= 20
+		m.mu.Unlock()
+		if done {
+			_ = m.Close()
+		}
+		return
+	}
+	m.mu.Lock()
+	y := m.pos[1]
+	m.mu.Unlock()
+	if y < float64(w.Range()[0]) && current%10 == 0 {
+		m.Hurt(4, VoidDamageSource{})
+		return
+	}
+	if d := m.OnFireDuration(); d > 0 {
+		m.SetOnFire(d - time.Second/20)
+		if current%20 == 0 {
+			m.Hurt(1, block.FireDamageSource{})
+		}
+	}
+	m.tickAirSupply(w)
+	m.effects.Tick(m)
+	if m.conf.Behaviour != nil {
+		m.conf.Behaviour.Tick(m)
+	}
+
+	m.targets.Tick(m)
+	m.goals.Tick(m)
+	m.nav.tick(w)
+	m.tickLeash(w)
+	m.tickMovement(w)
+	m.portal.tick(m, w, m.Position(), m.TeleportWithCause)
+}
+
+// EnterPortal marks the Mob as being inside a portal of a specific
+// Dimension. The Mob is transported to the destination of the portal during
+// its next tick.
+func (m *Mob) EnterPortal(dim world.Dimension) {
+	m.portal.enter(dim)
+}
+
+// tickMovement moves the Mob towards its move target, applying gravity and
+// block collisions, and rotates it towards the position it is looking at.
+func (m *Mob) tickMovement(w *world.World) {
+	if pos, ok := ridingPosition(m); ok {
+		// The Mob moves along with its vehicle, so viewers don't need to be updated.
+		m.mu.Lock()
+		m.pos, m.vel = pos, mgl64.Vec3{}
+		m.mu.Unlock()
+		return
+	}
+	var push mgl64.Vec3
+	if m.Pushable() {
+		push = pushVelocity(w, m, m.Position())
+	}
+	m.mu.Lock()
+	pos, vel, rot := m.pos, m.vel, m.rot
+	yaw, pitch := rot.Elem()
+
+	var wantedVel mgl64.Vec3
+	if m.moving {
+		diff := m.moveTarget.Sub(pos)
+		diff[1] = 0
+		speed := m.attributes.Value(attribute.MovementSpeed) * m.moveSpeed
+		if diff.Len() <= speed || diff.Len() < 0.1 {
+			m.moving = false
+		} else {
+			wantedVel = diff.Normalize().Mul(speed)
+			yaw = math.Atan2(-diff[0], diff[2]) * 180 / math.Pi
+			if !m.looking {
+				pitch = 0
+			}
+		}
+	}
+	if m.looking {
+		diff := m.lookTarget.Sub(pos.Add(mgl64.Vec3{0, m.t.BBox(m).Height() * 0.85}))
+		horizontal := math.Hypot(diff[0], diff[2])
+		if !m.moving {
+			yaw = math.Atan2(-diff[0], diff[2]) * 180 / math.Pi
+		}
+		pitch = -math.Atan2(diff[1], horizontal) * 180 / math.Pi
+	}
+	if m.knockBackTime > 0 {
+		m.knockBackTime--
+	} else {
+		if m.moving {
+			vel[0], vel[2] = wantedVel[0], wantedVel[2]
+			if m.jump && m.mc.OnGround() {
+				vel[1] = JumpVelocity(w, pos)
+			}
+		}
+		if l, ok := w.Liquid(cube.PosFromVec3(pos)); ok && (m.moving && m.moveTarget[1] >= pos[1]-0.5 || !m.moving && !m.conf.Sinks) {
+			if _, water := l.(block.Water); water {
+				// Swim up to stay afloat in water.
+				vel[1] = w.Physics().SwimVelocity
+			}
+		}
+	}
+	m.jump, m.looking = false, false
+	m.mu.Unlock()
+
+	mov := m.mc.TickMovement(m, pos, vel.Add(push), yaw, pitch)
+
+	m.mu.Lock()
+	m.pos, m.vel, m.rot = mov.Position(), mov.Velocity(), mov.Rotation()
+	if m.moving && wantedVel.Len() > 0 {
+		// The mob tried to move horizontally but was blocked by a block, so it tries to jump over it next tick.
+		m.jump = math.Hypot(mov.dpos[0], mov.dpos[2]) < wantedVel.Len()*0.25
+	}
+	m.mu.Unlock()
+
+	m.updateFallState(w, mov.dpos[1], mov.onGround)
+	mov.Send()
+	if mov.dpos.ApproxEqualThreshold(zeroVec3, epsilon) && rot != mov.Rotation() {
+		// Movement.Send only sends the movement if the position changed, so the rotation is sent separately.
+		for _, v := range w.Viewers(mov.Position()) {
+			v.ViewEntityMovement(m, mov.Position(), mov.Rotation().Yaw(), mov.Rotation().Pitch(), mov.onGround)
+		}
+	}
+}
+
+// maxMobAirSupply is the maximum air supply of a Mob in ticks.
+const maxMobAirSupply = 300
+
+// tickAirSupply consumes the air supply of the Mob while its eyes are in
+// water, hurting it when it runs out, and replenishes it otherwise.
+func (m *Mob) tickAirSupply(w *world.World) {
+	_, waterBreathing := m.Effect(effect.WaterBreathing{})
+	_, conduitPower := m.Effect(effect.ConduitPower{})
+	canBreathe := m.conf.BreathesUnderwater || waterBreathing || conduitPower || !EyesInWater(w, m)
+
+	m.mu.Lock()
+	air, breathing := m.airSupply, m.breathing
+	if !canBreathe {
+		m.airSupply--
+		m.breathing = false
+	} else if !m.breathing {
+		m.airSupply += 5
+		if m.airSupply >= maxMobAirSupply {
+			m.airSupply, m.breathing = maxMobAirSupply, true
+		}
+	}
+	drown := m.airSupply <= -20
+	if drown {
+		m.airSupply = 0
+	}
+	changed := m.airSupply != air || m.breathing != breathing
+	m.mu.Unlock()
+
+	if drown {
+		m.Hurt(2, DrowningDamageSource{})
+	}
+	if changed {
+		m.updateState()
+	}
+}
+
+// Breathing checks if the Mob is breathing, meaning its air supply is not
+// being consumed.
+func (m *Mob) Breathing() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.breathing
+}
+
+// AirSupply returns the remaining air supply of the Mob. The Mob starts
+// drowning when it runs out of air.
+func (m *Mob) AirSupply() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return time.Duration(m.airSupply) * time.Second / 20
+}
+
+// MaxAirSupply returns the maximum air supply of the Mob.
+func (m *Mob) MaxAirSupply() time.Duration {
+	return maxMobAirSupply * time.Second / 20
+}
+
+// Pushable checks if the Mob pushes and is pushed by other entities that it
+// collides with.
+func (m *Mob) Pushable() bool {
+	_, _, riding := Vehicle(m)
+	return !m.conf.DisablePushing && !m.Dead() && !riding
+}
+
+// FallDistance returns the distance in blocks that the Mob has fallen since it
+// last stood on the ground.
+func (m *Mob) FallDistance() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.fallDist
+}
+
+// ResetFallDistance resets the fall distance of the Mob, so that it does not
+// take fall damage when it lands.
+func (m *Mob) ResetFallDistance() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fallDist = 0
+}
+
+// updateFallState updates the fall distance of the Mob after it moved dy
+// blocks vertically, dealing fall damage if the Mob landed on the ground.
+func (m *Mob) updateFallState(w *world.World, dy float64, onGround bool) {
+	_, slowFalling := m.Effect(effect.SlowFalling{})
+	_, inLiquid := w.Liquid(cube.PosFromVec3(m.Position()))
+
+	m.mu.Lock()
+	dist := m.fallDist
+	switch {
+	case slowFalling || inLiquid:
+		m.fallDist = 0
+	case onGround:
+		m.fallDist = 0
+	case dy < dist:
+		m.fallDist -= dy
+	default:
+		m.fallDist = 0
+	}
+	m.mu.Unlock()
+
+	if onGround && dist > 0 && !slowFalling && !inLiquid {
+		if dmg := FallDamage(m, w, dist); dmg > 0 {
+			m.Hurt(dmg, FallDamageSource{})
+		}
+	}
+}
+
+// NaturalSpawnCategory returns the world.SpawnCategory that the Mob was
+// spawned naturally with at the time it was saved. It is used to keep
+// naturally spawned mobs despawning after they are loaded again.
+func (m *Mob) NaturalSpawnCategory() (world.SpawnCategory, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.category, m.natural
+}
+
+// Close closes the Mob and removes it from the world.
+func (m *Mob) Close() error {
+	m.World().RemoveEntity(m)
+	return nil
+}
+
+// encodeMobNBT encodes the state of the Mob passed, including the state of its
+// MobBehaviour if it implements EncodeNBT, to a map that can be encoded to NBT.
+func encodeMobNBT(m *Mob) map[string]any {
+	yaw, pitch := m.Rotation().Elem()
+	data := map[string]any{
+		"Pos":          nbtconv.Vec3ToFloat32Slice(m.Position()),
+		"Yaw":          float32(yaw),
+		"Pitch":        float32(pitch),
+		"Motion":       nbtconv.Vec3ToFloat32Slice(m.Velocity()),
+		"Health":       float32(m.Health()),
+		"Fire":         int16(m.OnFireDuration() / (time.Second / 20)),
+		"FallDistance": float32(m.FallDistance()),
+		"Air":          int16(m.AirSupply() / (time.Second / 20)),
+		"Persistent":   uint8(1),
+	}
+	if c, ok := m.World().SpawnCategory(m); ok {
+		data["Persistent"], data["SpawnCategory"] = uint8(0), uint8(c)
+	}
+	if name := m.NameTag(); name != "" {
+		data["CustomName"] = name
+	}
+	mainHand, offHand := m.HeldItems()
+	if !mainHand.Empty() {
+		data["Mainhand"] = nbtconv.WriteItem(mainHand, true)
+	}
+	if !offHand.Empty() {
+		data["Offhand"] = nbtconv.WriteItem(offHand, true)
+	}
+	if len(m.armour.Items()) > 0 {
+		armour := make([]map[string]any, 0, 4)
+		for _, it := range m.armour.Slots() {
+			if it.Empty() {
+				armour = append(armour, map[string]any{})
+				continue
+			}
+			armour = append(armour, nbtconv.WriteItem(it, true))
+		}
+		data["Armor"] = armour
+	}
+	encodeLeash(m, data)
+	if b, ok := m.conf.Behaviour.(interface {
+		EncodeNBT(m *Mob, data map[string]any)
+	}); ok {
+		b.EncodeNBT(m, data)
+	}
+	return data
+}
+
+// decodeMobNBT decodes the state of a Mob from the NBT data passed into the Mob
+// m, which was created at the position held by the data. The state of the
+// MobBehaviour of m is decoded if it implements DecodeNBT. m is returned.
+func decodeMobNBT(m *Mob, data map[string]any) *Mob {
+	if b, ok := m.conf.Behaviour.(interface {
+		DecodeNBT(m *Mob, data map[string]any)
+	}); ok {
+		// The behaviour is decoded first, as it may change the maximum health
+		// of the Mob, for example if the Mob was tamed.
+		b.DecodeNBT(m, data)
+	}
+	m.rot = cube.Rotation{float64(nbtconv.Float32(data, "Yaw")), float64(nbtconv.Float32(data, "Pitch"))}
+	m.vel = nbtconv.Vec3(data, "Motion")
+	if _, ok := data["Health"]; ok {
+		m.health.AddHealth(float64(nbtconv.Float32(data, "Health")) - m.health.Health())
+	}
+	m.fire = time.Duration(nbtconv.Int16(data, "Fire")) * time.Second / 20
+	m.fallDist = float64(nbtconv.Float32(data, "FallDistance"))
+	if _, ok := data["Air"]; ok {
+		m.airSupply = int(nbtconv.Int16(data, "Air"))
+		m.breathing = m.airSupply >= maxMobAirSupply
+	}
+	if _, ok := data["SpawnCategory"]; ok && !nbtconv.Bool(data, "Persistent") {
+		m.natural, m.category = true, world.SpawnCategory(nbtconv.Uint8(data, "SpawnCategory"))
+	}
+	m.name = nbtconv.String(data, "CustomName")
+	m.savedLeash = decodeLeash(data)
+	m.mainHand = nbtconv.MapItem(data, "Mainhand")
+	m.offHand = nbtconv.MapItem(data, "Offhand")
+	for slot, itemData := range nbtconv.Slice(data, "Armor") {
+		if d, ok := itemData.(map[string]any); ok && slot < 4 {
+			_ = m.armour.Inventory().SetItem(slot, nbtconv.Item(d, nil))
+		}
+	}
+	return m
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"github.com/df-mc/dragonfly/server/block"
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
@@ -8,14 +9,29 @@ import (
 )
 
 // MovementComputer is used to compute movement of an entity. When constructed, the Gravity of the entity
-// the movement is computed for must be passed.
+// the movement is computed for must be passed. The Gravity and Drag are scaled by the world.Physics of the
+// world that the entity is in.
 type MovementComputer struct {
 	Gravity, Drag     float64
 	DragBeforeGravity bool
+	// LiquidPhysics specifies if the liquid gravity and drag of the world.Physics of the world apply while the
+	// entity is inside a liquid. If false, the entity moves through liquids the same way as through air.
+	LiquidPhysics bool
 
 	onGround bool
 }
 
+// wrapRotation returns the rotation passed with its yaw wrapped to the range [-180, 180). Viewers interpolate
+// towards the wrapped yaw in the shortest direction, so rotating from a yaw of 170 to a yaw of -170 results in a
+// rotation of 20 degrees rather than 340, while the yaw of the entity never grows without bound.
+func wrapRotation(rot cube.Rotation) cube.Rotation {
+	yaw := math.Mod(rot.Yaw()+180, 360)
+	if yaw < 0 {
+		yaw += 360
+	}
+	return cube.Rotation{yaw - 180, rot.Pitch()}
+}
+
 // Movement represents the movement of a world.Entity as a result of a call to MovementComputer.TickMovement. The
 // resulting position and velocity can be obtained by calling Position and Velocity. These can be sent to viewers by
 // calling Send.
@@ -28,7 +44,8 @@ type Movement struct {
 }
 
 // Send sends the Movement to any viewers watching the entity at the time of the movement. If the position/velocity
-// changes were negligible, nothing is sent.
+// changes were negligible, nothing is sent. After sending, the blocks that the entity ended up inside of are
+// notified through CheckBlocksInside, which is why Send must be called without holding any locks of the entity.
 func (m *Movement) Send() {
 	posChanged := !m.dpos.ApproxEqualThreshold(zeroVec3, epsilon)
 	velChanged := !m.dvel.ApproxEqualThreshold(zeroVec3, epsilon)
@@ -41,6 +58,9 @@ func (m *Movement) Send() {
 			v.ViewEntityVelocity(m.e, m.vel)
 		}
 	}
+	if w, ok := world.OfEntity(m.e); ok {
+		CheckBlocksInside(w, m.e, m.e.Type().BBox(m.e).Translate(m.pos))
+	}
 }
 
 // Position returns the position as a result of the Movement as an mgl64.Vec3.
@@ -59,15 +79,18 @@ func (m *Movement) Rotation() cube.Rotation {
 }
 
 // TickMovement performs a movement tick on an entity. Velocity is applied and changed according to the values
-// of its Drag and Gravity.
+// of its Drag and Gravity and the world.Physics of the world the entity is in.
 // The new position of the entity after movement is returned.
 // The resulting Movement can be sent to viewers by calling Movement.Send.
 func (c *MovementComputer) TickMovement(e world.Entity, pos, vel mgl64.Vec3, yaw, pitch float64) *Movement {
 	w := e.World()
 	viewers := w.Viewers(pos)
+	gravity, drag, terminal := c.forces(w, pos)
 
 	velBefore := vel
-	vel = c.applyHorizontalForces(w, pos, c.applyVerticalForces(vel))
+	vel = c.applyHorizontalForces(w, pos, drag, c.applyVerticalForces(vel, gravity, drag, terminal))
+	vel = c.applyStickiness(e, w, pos, vel)
+	vel = c.applyBlockVelocity(e, w, pos, vel)
 	dPos, vel := c.checkCollision(e, pos, vel)
 
 	return &Movement{v: viewers, e: e,
@@ -87,21 +110,43 @@ var zeroVec3 mgl64.Vec3
 // epsilon is the epsilon used for thresholds for change used for change in position and velocity.
 const epsilon = 0.001
 
-// applyVerticalForces applies gravity and drag on the Y axis, based on the Gravity and Drag values set.
-func (c *MovementComputer) applyVerticalForces(vel mgl64.Vec3) mgl64.Vec3 {
+// forces returns the gravity and drag applied to an entity at the position passed and the terminal velocity it may
+// fall at, based on the Gravity and Drag values set and the world.Physics of the world. The liquid forces of the
+// world.Physics are only applied if LiquidPhysics is true.
+func (c *MovementComputer) forces(w *world.World, pos mgl64.Vec3) (gravity, drag, terminal float64) {
+	p := w.Physics()
+	gravity, drag = c.Gravity*p.Gravity, c.Drag*p.Drag
+	if !c.LiquidPhysics {
+		return gravity, drag, p.TerminalVelocity
+	}
+	if l, ok := w.Liquid(cube.PosFromVec3(pos)); ok {
+		gravity, drag = gravity*p.LiquidGravity, p.LiquidDrag
+		if _, lava := l.(block.Lava); lava {
+			drag = p.LavaDrag
+		}
+	}
+	return gravity, drag, p.TerminalVelocity
+}
+
+// applyVerticalForces applies gravity and drag on the Y axis and limits the falling speed to the terminal velocity
+// passed, unless it is 0.
+func (c *MovementComputer) applyVerticalForces(vel mgl64.Vec3, gravity, drag, terminal float64) mgl64.Vec3 {
 	if c.DragBeforeGravity {
-		vel[1] *= 1 - c.Drag
+		vel[1] *= 1 - drag
 	}
-	vel[1] -= c.Gravity
+	vel[1] -= gravity
 	if !c.DragBeforeGravity {
-		vel[1] *= 1 - c.Drag
+		vel[1] *= 1 - drag
+	}
+	if terminal > 0 && vel[1] < -terminal {
+		vel[1] = -terminal
 	}
 	return vel
 }
 
-// applyHorizontalForces applies friction to the velocity based on the Drag value, reducing it on the X and Z axes.
-func (c *MovementComputer) applyHorizontalForces(w *world.World, pos, vel mgl64.Vec3) mgl64.Vec3 {
-	friction := 1 - c.Drag
+// applyHorizontalForces applies friction to the velocity based on the drag passed, reducing it on the X and Z axes.
+func (c *MovementComputer) applyHorizontalForces(w *world.World, pos mgl64.Vec3, drag float64, vel mgl64.Vec3) mgl64.Vec3 {
+	friction := 1 - drag
 	if c.onGround {
 		if f, ok := w.Block(cube.PosFromVec3(pos).Side(cube.FaceDown)).(interface {
 			Friction() float64
@@ -116,6 +161,111 @@ func (c *MovementComputer) applyHorizontalForces(w *world.World, pos, vel mgl64.
 	return vel
 }
 
+// applyStickiness slows down the entity if it is moving on a block.Sticky, and limits its falling speed if it is
+// sliding down along the side of one.
+func (c *MovementComputer) applyStickiness(e world.Entity, w *world.World, pos, vel mgl64.Vec3) mgl64.Vec3 {
+	if c.onGround {
+		if s, ok := blockUnder(w, pos).(block.Sticky); ok {
+			vel[0] *= s.SpeedFactor()
+			vel[2] *= s.SpeedFactor()
+		}
+		return vel
+	}
+	if vel[1] >= 0 {
+		return vel
+	}
+	box := e.Type().BBox(e).Translate(pos).GrowVec3(mgl64.Vec3{0.01, 0, 0.01})
+	min, max := box.Min(), box.Max()
+	for x := int(math.Floor(min[0])); x <= int(math.Floor(max[0])); x++ {
+		for y := int(math.Floor(min[1])); y <= int(math.Floor(max[1])); y++ {
+			for z := int(math.Floor(min[2])); z <= int(math.Floor(max[2])); z++ {
+				if s, ok := w.Block(cube.Pos{x, y, z}).(block.Sticky); ok && vel[1] < -s.SlideVelocity() {
+					vel[1] = -s.SlideVelocity()
+				}
+			}
+		}
+	}
+	return vel
+}
+
+// applyBlockVelocity changes the velocity of the entity passed according to the block.EntityVelocityModifier blocks
+// that it is inside of, such as bubble columns.
+func (c *MovementComputer) applyBlockVelocity(e world.Entity, w *world.World, pos, vel mgl64.Vec3) mgl64.Vec3 {
+	blocksInside(w, e.Type().BBox(e).Translate(pos), func(bpos cube.Pos, b world.Block) {
+		if m, ok := b.(block.EntityVelocityModifier); ok {
+			vel = m.EntityVelocity(bpos, w, e, vel)
+		}
+	})
+	return vel
+}
+
+// CheckBlocksInside calls EntityInside on every block.EntityInsider, including liquids, that the bounding box passed
+// intersects with. It is called for entities moved by a MovementComputer when their Movement is sent, but may be
+// called by other entities that compute their movement differently, such as players.
+func CheckBlocksInside(w *world.World, e world.Entity, box cube.BBox) {
+	blocksInside(w, box, func(pos cube.Pos, b world.Block) {
+		if insider, ok := b.(block.EntityInsider); ok {
+			insider.EntityInside(pos, w, e)
+			if _, liquid := b.(world.Liquid); liquid {
+				return
+			}
+		}
+		if l, ok := w.Liquid(pos); ok {
+			if insider, ok := l.(block.EntityInsider); ok {
+				insider.EntityInside(pos, w, e)
+			}
+		}
+	})
+}
+
+// breathingDistanceBelowEyes is the lowest distance that the eyes of an entity can be in water while the entity is
+// still able to breathe.
+const breathingDistanceBelowEyes = 0.11111111
+
+// EyesInWater checks if the eyes of the entity passed are in water, meaning the entity is unable to breathe unless
+// it is able to breathe underwater.
+func EyesInWater(w *world.World, e world.Entity) bool {
+	pos := cube.PosFromVec3(EyePosition(e))
+	if l, ok := w.Liquid(pos); ok {
+		if _, ok := l.(block.Water); ok {
+			d := float64(l.SpreadDecay()) + 1
+			if l.LiquidFalling() {
+				d = 1
+			}
+			return e.Position().Y() < (pos.Side(cube.FaceUp).Vec3().Y())-(d/9-breathingDistanceBelowEyes)
+		}
+	}
+	return false
+}
+
+// blocksInside calls f for every block that the bounding box passed intersects with.
+func blocksInside(w *world.World, box cube.BBox, f func(pos cube.Pos, b world.Block)) {
+	box = box.Grow(-0.0001)
+	min, max := cube.PosFromVec3(box.Min()), cube.PosFromVec3(box.Max())
+	for y := min[1]; y <= max[1]; y++ {
+		for x := min[0]; x <= max[0]; x++ {
+			for z := min[2]; z <= max[2]; z++ {
+				pos := cube.Pos{x, y, z}
+				f(pos, w.Block(pos))
+			}
+		}
+	}
+}
+
+// blockUnder returns the block that an entity standing at the position passed is standing on.
+func blockUnder(w *world.World, pos mgl64.Vec3) world.Block {
+	return w.Block(cube.PosFromVec3(pos.Sub(mgl64.Vec3{0, 0.1})))
+}
+
+// JumpVelocity returns the velocity with which an entity standing at the position passed jumps. The velocity is
+// reduced if the entity is standing on a block.Sticky.
+func JumpVelocity(w *world.World, pos mgl64.Vec3) float64 {
+	if s, ok := blockUnder(w, pos).(block.Sticky); ok {
+		return w.Physics().JumpVelocity * s.JumpFactor()
+	}
+	return w.Physics().JumpVelocity
+}
+
 // checkCollision handles the collision of the entity with blocks, adapting the velocity of the entity if it
 // happens to collide with a block.
 // The final velocity and the Vec3 that the entity should move is returned.
@@ -157,11 +307,15 @@ func (c *MovementComputer) checkCollision(e world.Entity, pos, vel mgl64.Vec3) (
 	}
 	if !mgl64.FloatEqual(deltaY, vel[1]) {
 		// The entity either hit the ground or hit the ceiling.
+		bounce := 0.0
 		if vel[1] < 0 {
 			// The entity was going down, so we can assume it is now on the ground.
 			c.onGround = true
+			if b, ok := blockUnder(e.World(), pos.Add(mgl64.Vec3{0, deltaY})).(block.Bouncy); ok {
+				bounce = b.Bounce(e, vel[1])
+			}
 		}
-		vel[1] = 0
+		vel[1] = bounce
 	}
 	if !mgl64.FloatEqual(deltaZ, vel[2]) {
 		vel[2] = 0
@@ -193,3 +347,27 @@ func blockBBoxsAround(e world.Entity, box cube.BBox) []cube.BBox {
 	}
 	return blockBBoxs
 }
+
+// liquidFlow returns the normalised direction in which the world.Liquid at the position passed flows. The liquid
+// flows towards neighbouring blocks with a lower depth of the same liquid and towards air. A zero vector is returned
+// if the liquid does not flow, which is the case for liquid surrounded by source blocks.
+func liquidFlow(w *world.World, pos cube.Pos, l world.Liquid) mgl64.Vec3 {
+	var flow mgl64.Vec3
+	for _, f := range cube.HorizontalFaces() {
+		side := pos.Side(f)
+		diff := 0
+		if sl, ok := w.Liquid(side); ok {
+			if sl.LiquidType() != l.LiquidType() {
+				continue
+			}
+			diff = l.LiquidDepth() - sl.LiquidDepth()
+		} else if _, air := w.Block(side).(block.Air); air {
+			diff = l.LiquidDepth()
+		}
+		flow = flow.Add(cube.Pos{}.Side(f).Vec3().Mul(float64(diff)))
+	}
+	if flow.Len() == 0 {
+		return flow
+	}
+	return flow.Normalize()
+}
@@ -0,0 +1,556 @@
+package entity
+
+import (
+	"container/heap"
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"sync"
+)
+
+// NavigatorConfig holds settings that influence the paths found by a
+// Navigator. NavigatorConfig.FindPath may also be used directly to find paths
+// without moving an entity along them.
+type NavigatorConfig struct {
+	// JumpHeight is the maximum amount of blocks that a path may go up in a
+	// single step. If left as 0, the jump height is 1.
+	JumpHeight int
+	// MaxFallDistance is the maximum amount of blocks that a path may go down
+	// in a single step. If left as 0, the maximum fall distance is 3.
+	MaxFallDistance int
+	// AvoidWater specifies if paths should never pass through water. If
+	// false, paths may pass through water, in which case the entity swims.
+	AvoidWater bool
+	// OpenDoors specifies if paths may pass through closed wooden doors. A
+	// Navigator opens these doors when the entity walks through them.
+	OpenDoors bool
+	// MaxNodes is the maximum amount of positions evaluated while searching
+	// for a path. It limits the cost of searching for a path to a position
+	// that cannot be reached. If left as 0, the maximum is 1000.
+	MaxNodes int
+	// NodesPerTick is the maximum amount of positions evaluated by a
+	// Navigator every tick while searching for a path, so that the search for
+	// a long path is spread out over multiple ticks. If left as 0, the maximum
+	// is 200.
+	NodesPerTick int
+}
+
+// New creates a Navigator for the Mob passed using conf.
+func (conf NavigatorConfig) New(m *Mob) *Navigator {
+	return &Navigator{conf: conf.withDefaults(), m: m}
+}
+
+// withDefaults returns the NavigatorConfig with all fields left as 0 set to
+// their default values.
+func (conf NavigatorConfig) withDefaults() NavigatorConfig {
+	if conf.JumpHeight == 0 {
+		conf.JumpHeight = 1
+	}
+	if conf.MaxFallDistance == 0 {
+		conf.MaxFallDistance = 3
+	}
+	if conf.MaxNodes == 0 {
+		conf.MaxNodes = 1000
+	}
+	if conf.NodesPerTick == 0 {
+		conf.NodesPerTick = 200
+	}
+	return conf
+}
+
+// FindPath finds a path for an entity with the bounding box passed from start
+// to end using A*. The path returned holds the positions of the blocks that
+// the feet of the entity should pass through, excluding start and including
+// end. Paths respect the collision boxes of blocks, avoid lava, fire and
+// cacti, and take the jump height and maximum fall distance of conf into
+// account. Paths never pass through chunks that are not loaded, so FindPath
+// does not load or generate any chunks.
+// If no path to end could be found, FindPath returns the path to the
+// position closest to end that could be reached and false.
+func (conf NavigatorConfig) FindPath(w *world.World, box cube.BBox, start, end cube.Pos) ([]cube.Pos, bool) {
+	conf = conf.withDefaults()
+	f := newPathfinder(conf, w, box, start, end)
+	for {
+		if path, found, done := f.search(conf.MaxNodes); done {
+			return path, found
+		}
+	}
+}
+
+// pathNode is a single position evaluated by a pathfinder.
+type pathNode struct {
+	pos    cube.Pos
+	parent *pathNode
+	// g is the cost of the path from the start to the node, h is the estimated
+	// cost from the node to the end.
+	g, h   float64
+	index  int
+	closed bool
+}
+
+// pathfinder finds a path through a world.World using A*. The search may be
+// spread out over multiple calls to search.
+type pathfinder struct {
+	conf  NavigatorConfig
+	w     *world.World
+	box   cube.BBox
+	end   cube.Pos
+	nodes map[cube.Pos]*pathNode
+	open  pathHeap
+
+	closest   *pathNode
+	evaluated int
+	// chunks caches which chunks are loaded during a single call to search.
+	chunks map[world.ChunkPos]bool
+}
+
+// newPathfinder creates a pathfinder that searches a path from start to end.
+func newPathfinder(conf NavigatorConfig, w *world.World, box cube.BBox, start, end cube.Pos) *pathfinder {
+	first := &pathNode{pos: start, h: pathDistance(start, end)}
+	f := &pathfinder{conf: conf, w: w, box: box, end: end, nodes: map[cube.Pos]*pathNode{start: first}, closest: first}
+	heap.Push(&f.open, first)
+	return f
+}
+
+// search continues the search for a path, evaluating at most n positions. If
+// the search is done, the path found is returned together with true if it
+// leads to the end. Otherwise, the path to the position closest to the end is
+// returned. If the search is not yet done, done is false.
+func (f *pathfinder) search(n int) (path []cube.Pos, found, done bool) {
+	f.chunks = make(map[world.ChunkPos]bool)
+	for i := 0; i < n; i++ {
+		if f.open.Len() == 0 || f.evaluated >= f.conf.MaxNodes {
+			return f.closest.path(), false, true
+		}
+		f.evaluated++
+
+		node := heap.Pop(&f.open).(*pathNode)
+		node.closed = true
+		if node.pos == f.end {
+			return node.path(), true, true
+		}
+		if node.h < f.closest.h {
+			f.closest = node
+		}
+		for _, next := range f.neighbours(node.pos) {
+			g := node.g + pathDistance(node.pos, next) + f.penalty(next)
+			other, ok := f.nodes[next]
+			if !ok {
+				other = &pathNode{pos: next, parent: node, g: g, h: pathDistance(next, f.end)}
+				f.nodes[next] = other
+				heap.Push(&f.open, other)
+				continue
+			}
+			if other.closed || g >= other.g {
+				continue
+			}
+			other.parent, other.g = node, g
+			heap.Fix(&f.open, other.index)
+		}
+	}
+	return nil, false, false
+}
+
+// neighbours returns all positions that an entity at the position passed can
+// move to in a single step.
+func (f *pathfinder) neighbours(pos cube.Pos) []cube.Pos {
+	neighbours := make([]cube.Pos, 0, 10)
+	var open [4]bool
+	for i, face := range cube.HorizontalFaces() {
+		if next, ok := f.step(pos, pos.Side(face)); ok {
+			neighbours = append(neighbours, next)
+			open[i] = next[1] == pos[1]
+		}
+	}
+	// Diagonal steps are only allowed if both sides are open at the same
+	// height, so that the entity doesn't cut corners.
+	for _, d := range [4][2]int{{0, 1}, {0, 3}, {2, 1}, {2, 3}} {
+		if !open[d[0]] || !open[d[1]] {
+			continue
+		}
+		a, b := cube.HorizontalFaces()[d[0]], cube.HorizontalFaces()[d[1]]
+		if next, ok := f.step(pos, pos.Side(a).Side(b)); ok && next[1] == pos[1] {
+			neighbours = append(neighbours, next)
+		}
+	}
+	if f.swimmable(pos) {
+		// Entities in water may swim up and down.
+		if up := pos.Side(cube.FaceUp); f.passable(up) && (f.swimmable(up) || f.supported(up)) {
+			neighbours = append(neighbours, up)
+		}
+		if down := pos.Side(cube.FaceDown); f.passable(down) && f.swimmable(down) {
+			neighbours = append(neighbours, down)
+		}
+	}
+	return neighbours
+}
+
+// step finds the position in the column of next that an entity at pos could
+// move to, either by walking, jumping up or falling down.
+func (f *pathfinder) step(pos, next cube.Pos) (cube.Pos, bool) {
+	if f.passable(next) {
+		for y := 0; y <= f.conf.MaxFallDistance; y++ {
+			below := next.Sub(cube.Pos{0, y})
+			if !f.passable(below) {
+				break
+			}
+			if f.standable(below) {
+				return below, true
+			}
+		}
+		return cube.Pos{}, false
+	}
+	for y := 1; y <= f.conf.JumpHeight; y++ {
+		// The entity needs space above its head to jump up.
+		if !f.passable(pos.Add(cube.Pos{0, y})) {
+			break
+		}
+		if above := next.Add(cube.Pos{0, y}); f.passable(above) {
+			return above, f.standable(above)
+		}
+	}
+	return cube.Pos{}, false
+}
+
+// penalty returns the additional cost of moving to the position passed.
+func (f *pathfinder) penalty(pos cube.Pos) float64 {
+	if f.swimmable(pos) {
+		return 2
+	}
+	return 0
+}
+
+// standable checks if an entity can stand or swim at the position passed.
+func (f *pathfinder) standable(pos cube.Pos) bool {
+	return f.supported(pos) || f.swimmable(pos)
+}
+
+// supported checks if there is a block right below an entity with its feet at
+// the position passed.
+func (f *pathfinder) supported(pos cube.Pos) bool {
+	box := f.box.Translate(pos.Vec3Middle()).Grow(-0.01)
+	min, max := box.Min(), box.Max()
+	// Blocks up to half a block below the feet of the entity, or half a block
+	// above them, such as slabs and carpets, support the entity.
+	return f.collides(cube.Box(min[0], min[1]-0.5, min[2], max[0], min[1]+0.5, max[2]))
+}
+
+// passable checks if an entity with its feet at the position passed fits in
+// the space at that position without colliding with any blocks. Blocks lower
+// than half a block, such as slabs, are ignored.
+func (f *pathfinder) passable(pos cube.Pos) bool {
+	if pos[1] < f.w.Range()[0] || pos[1] > f.w.Range()[1] {
+		return false
+	}
+	box := f.box.Translate(pos.Vec3Middle()).Grow(-0.01)
+	min, max := box.Min(), box.Max()
+	box = cube.Box(min[0], min[1]+0.5, min[2], max[0], max[1], max[2])
+	if !f.loaded(box.Grow(0.5)) || f.collides(box) {
+		return false
+	}
+	return f.safe(box) && (!f.conf.AvoidWater || !f.water(pos))
+}
+
+// loaded checks if all chunks that the bounding box passed intersects with are
+// loaded. Positions in chunks that are not loaded are never part of a path, so
+// that searching for a path does not load chunks.
+func (f *pathfinder) loaded(box cube.BBox) bool {
+	min, max := box.Min(), box.Max()
+	for x := int(math.Floor(min[0])) >> 4; x <= int(math.Floor(max[0]))>>4; x++ {
+		for z := int(math.Floor(min[2])) >> 4; z <= int(math.Floor(max[2]))>>4; z++ {
+			pos := world.ChunkPos{int32(x), int32(z)}
+			loaded, ok := f.chunks[pos]
+			if !ok {
+				loaded = f.w.ChunkLoaded(pos)
+				f.chunks[pos] = loaded
+			}
+			if !loaded {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// swimmable checks if an entity with its feet at the position passed swims.
+func (f *pathfinder) swimmable(pos cube.Pos) bool {
+	return !f.conf.AvoidWater && f.water(pos)
+}
+
+// water checks if there is water at the position passed.
+func (f *pathfinder) water(pos cube.Pos) bool {
+	l, ok := f.w.Liquid(pos)
+	if !ok {
+		return false
+	}
+	_, water := l.(block.Water)
+	return water
+}
+
+// collides checks if the bounding box passed collides with any block.
+func (f *pathfinder) collides(box cube.BBox) bool {
+	collides := false
+	f.blocksWithin(box, func(pos cube.Pos, b world.Block) bool {
+		if door, ok := b.(block.WoodDoor); ok && f.conf.OpenDoors && !door.Open {
+			return true
+		}
+		for _, bb := range b.Model().BBox(pos, f.w) {
+			if bb.Translate(pos.Vec3()).IntersectsWith(box) {
+				collides = true
+				return false
+			}
+		}
+		return true
+	})
+	return collides
+}
+
+// safe checks if the bounding box passed does not intersect with any blocks
+// that damage entities, such as lava.
+func (f *pathfinder) safe(box cube.BBox) bool {
+	safe := true
+	f.blocksWithin(box.Grow(0.5), func(pos cube.Pos, b world.Block) bool {
+		switch b.(type) {
+		case block.Fire, block.Cactus:
+			safe = false
+		}
+		if l, ok := f.w.Liquid(pos); ok {
+			if _, lava := l.(block.Lava); lava {
+				safe = false
+			}
+		}
+		return safe
+	})
+	return safe
+}
+
+// blocksWithin calls the function passed for all blocks within the bounding
+// box passed until it returns false.
+func (f *pathfinder) blocksWithin(box cube.BBox, fn func(pos cube.Pos, b world.Block) bool) {
+	min, max := box.Min(), box.Max()
+	for x := int(math.Floor(min[0])); x <= int(math.Floor(max[0])); x++ {
+		for y := int(math.Floor(min[1])); y <= int(math.Floor(max[1])); y++ {
+			for z := int(math.Floor(min[2])); z <= int(math.Floor(max[2])); z++ {
+				pos := cube.Pos{x, y, z}
+				if !fn(pos, f.w.Block(pos)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// path returns the path from the start to the node, excluding the start.
+func (n *pathNode) path() []cube.Pos {
+	var path []cube.Pos
+	for ; n.parent != nil; n = n.parent {
+		path = append(path, n.pos)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// pathDistance returns the distance between two positions.
+func pathDistance(a, b cube.Pos) float64 {
+	return a.Vec3().Sub(b.Vec3()).Len()
+}
+
+// pathHeap implements heap.Interface for pathNodes, ordering them by their
+// estimated total cost.
+type pathHeap []*pathNode
+
+func (h pathHeap) Len() int           { return len(h) }
+func (h pathHeap) Less(i, j int) bool { return h[i].g+h[i].h < h[j].g+h[j].h }
+func (h pathHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+func (h *pathHeap) Push(x any) {
+	n := x.(*pathNode)
+	n.index = len(*h)
+	*h = append(*h, n)
+}
+func (h *pathHeap) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
+
+// Navigator moves a Mob along paths found using A*. Goals and plugins may use
+// Navigator.NavigateTo to send a Mob to a position, after which the Navigator
+// searches a path over the next ticks and moves the Mob from block to block
+// every tick until it arrives.
+type Navigator struct {
+	conf NavigatorConfig
+	m    *Mob
+
+	mu     sync.Mutex
+	search *pathfinder
+	// doors holds the positions of doors opened by the Navigator, which are
+	// closed again once the Mob has walked through them.
+	doors  []cube.Pos
+	path   []cube.Pos
+	index  int
+	target mgl64.Vec3
+	speed  float64
+	last   mgl64.Vec3
+	stuck  int
+}
+
+// NavigateTo starts searching a path from the current position of the Mob to
+// the position passed. The search is spread out over the next ticks, after
+// which the Mob is moved along the path using the speed modifier passed. If
+// the position cannot be reached, the Mob moves to the reachable position
+// closest to it, or in a straight line towards it if no path could be found
+// at all. False is returned if the Mob is not in a world.
+func (n *Navigator) NavigateTo(pos mgl64.Vec3, speed float64) bool {
+	w := n.m.World()
+	if w == nil {
+		return false
+	}
+	f := newPathfinder(n.conf, w, n.m.Type().BBox(n.m), cube.PosFromVec3(n.m.Position()), cube.PosFromVec3(pos))
+	n.mu.Lock()
+	n.search, n.path, n.index, n.target, n.speed, n.stuck = f, nil, 0, pos, speed, 0
+	n.mu.Unlock()
+	return true
+}
+
+// Stop stops the Navigator from moving the Mob along its path.
+func (n *Navigator) Stop() {
+	n.m.StopMoving()
+}
+
+// Navigating checks if the Navigator is currently searching a path or moving
+// the Mob along one.
+func (n *Navigator) Navigating() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.search != nil || n.path != nil
+}
+
+// Path returns the remaining positions of the path that the Navigator is
+// moving the Mob along.
+func (n *Navigator) Path() []cube.Pos {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.path == nil {
+		return nil
+	}
+	return append([]cube.Pos(nil), n.path[n.index:]...)
+}
+
+// clear clears the path of the Navigator.
+func (n *Navigator) clear() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.search, n.path = nil, nil
+}
+
+// tick continues the search for a path and moves the Mob towards the next
+// position on its path, opening doors on its way if needed and closing them
+// behind it. If the Mob gets stuck, a new path is searched.
+func (n *Navigator) tick(w *world.World) {
+	n.closeDoors(w)
+
+	n.mu.Lock()
+	if f := n.search; f != nil {
+		n.mu.Unlock()
+		path, _, done := f.search(n.conf.NodesPerTick)
+		if !done {
+			return
+		}
+		n.mu.Lock()
+		if n.search != f {
+			// The search was replaced or stopped while it was running.
+			n.mu.Unlock()
+			return
+		}
+		n.search = nil
+		if len(path) == 0 {
+			target, speed := n.target, n.speed
+			n.mu.Unlock()
+			n.m.moveTo(target, speed)
+			return
+		}
+		n.path, n.index, n.last = path, 0, n.m.Position()
+	}
+	if n.path == nil {
+		n.mu.Unlock()
+		return
+	}
+	pos := n.m.Position()
+	next := n.path[n.index]
+	if diff := next.Vec3Middle().Sub(pos); math.Hypot(diff[0], diff[2]) < 0.4 && math.Abs(diff[1]) < 1.5 {
+		if n.index++; n.index == len(n.path) {
+			n.path = nil
+			n.mu.Unlock()
+			n.m.stopMoving()
+			return
+		}
+		next = n.path[n.index]
+	}
+	if pos.Sub(n.last).Len() < 0.01 {
+		n.stuck++
+	} else {
+		n.stuck = 0
+	}
+	n.last = pos
+	stuck, target, speed := n.stuck >= 40, n.target, n.speed
+	n.mu.Unlock()
+
+	if stuck {
+		if !n.NavigateTo(target, speed) {
+			n.m.StopMoving()
+		}
+		return
+	}
+	if n.conf.OpenDoors {
+		for _, p := range [2]cube.Pos{next, next.Side(cube.FaceUp)} {
+			if door, ok := w.Block(p).(block.WoodDoor); ok && !door.Open {
+				door.Activate(p, cube.FaceUp, w, nil, nil)
+				n.mu.Lock()
+				n.doors = append(n.doors, p)
+				n.mu.Unlock()
+			}
+		}
+	}
+	n.m.moveTo(next.Vec3Middle(), speed)
+}
+
+// closeDoors closes the doors opened by the Navigator that the Mob is no
+// longer close to.
+func (n *Navigator) closeDoors(w *world.World) {
+	box := n.m.Type().BBox(n.m).Translate(n.m.Position()).Grow(1)
+
+	n.mu.Lock()
+	var closing []cube.Pos
+	remaining := n.doors[:0]
+	for _, p := range n.doors {
+		if box.IntersectsWith(cube.Box(0, 0, 0, 1, 1, 1).Translate(p.Vec3())) {
+			remaining = append(remaining, p)
+			continue
+		}
+		closing = append(closing, p)
+	}
+	n.doors = remaining
+	n.mu.Unlock()
+
+	for _, p := range closing {
+		if door, ok := w.Block(p).(block.WoodDoor); ok && door.Open {
+			door.Activate(p, cube.FaceUp, w, nil, nil)
+		}
+	}
+}
+
+// navigateTo moves the Mob passed along a path to the position passed, or in
+// a straight line towards it if no path could be found.
+func navigateTo(m *Mob, pos mgl64.Vec3, speed float64) {
+	if !m.nav.NavigateTo(pos, speed) {
+		m.MoveTo(pos, speed)
+	}
+}
@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/player/skin"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+	"sync"
+)
+
+// NPCConfig holds settings that influence the way an NPC behaves. NPCConfig.New
+// may be called to create a new NPC with this config.
+type NPCConfig struct {
+	// Name is the name shown above the head of the NPC.
+	Name string
+	// Skin is the skin of the NPC. The NPC is rendered as a player wearing
+	// this skin.
+	Skin skin.Skin
+	// Interact is called when a player interacts with the NPC, for example by
+	// right-clicking it. Interact may be nil.
+	Interact func(n *Mob, user item.User)
+	// Attack is called when an entity attacks the NPC. NPCs cannot be hurt,
+	// so attacking an NPC has no other effect. Attack may be nil.
+	Attack func(n *Mob, attacker world.Entity)
+}
+
+// New creates an NPC using conf at the position passed. The NPC is a Mob
+// without Goals that is rendered as a player. Goals may be added to the NPC
+// through Mob.Goals to make it move.
+func (conf NPCConfig) New(pos mgl64.Vec3) *Mob {
+	m := MobConfig{
+		KnockBackResistance: 1,
+		DisablePushing:      true,
+		Behaviour:           &NPCBehaviour{conf: conf, id: uuid.New(), skin: conf.Skin},
+	}.New(NPCType{}, pos)
+	m.name = conf.Name
+	return m
+}
+
+// NPCBehaviour implements the behaviour of NPCs. NPCs cannot be hurt and call
+// the functions in their NPCConfig when players click them.
+type NPCBehaviour struct {
+	conf NPCConfig
+	id   uuid.UUID
+
+	mu   sync.Mutex
+	skin skin.Skin
+}
+
+// UUID returns the UUID under which the NPC is added to the player list of
+// viewers.
+func (b *NPCBehaviour) UUID() uuid.UUID {
+	return b.id
+}
+
+// Skin returns the skin of the NPC.
+func (b *NPCBehaviour) Skin() skin.Skin {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.skin
+}
+
+// SetSkin changes the skin of the NPC and shows it to all viewers.
+func (b *NPCBehaviour) SetSkin(m *Mob, s skin.Skin) {
+	b.mu.Lock()
+	b.skin = s
+	b.mu.Unlock()
+
+	for _, v := range m.World().Viewers(m.Position()) {
+		v.ViewSkin(m)
+	}
+}
+
+// Tick ...
+func (b *NPCBehaviour) Tick(*Mob) {}
+
+// Interact calls the Interact function of the NPC, if it has one.
+func (b *NPCBehaviour) Interact(m *Mob, user item.User, _ *item.UseContext) bool {
+	if b.conf.Interact == nil {
+		return false
+	}
+	b.conf.Interact(m, user)
+	return true
+}
+
+// Hurt calls the Attack function of the NPC if it was attacked by an entity.
+// False is always returned, so the NPC is never hurt.
+func (b *NPCBehaviour) Hurt(m *Mob, _ float64, src world.DamageSource) bool {
+	if s, ok := src.(AttackDamageSource); ok && b.conf.Attack != nil {
+		b.conf.Attack(m, s.Attacker)
+	}
+	return false
+}
+
+// NPCType is a world.EntityType implementation for NPCs. NPCs are sent to
+// viewers as players so that they may have a skin.
+type NPCType struct{}
+
+func (NPCType) EncodeEntity() string        { return "dragonfly:npc" }
+func (NPCType) NetworkEncodeEntity() string { return "minecraft:player" }
+func (NPCType) NetworkOffset() float64      { return 1.62 }
+func (NPCType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.8, 0.3)
+}
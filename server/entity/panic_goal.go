@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// PanicGoal is a Goal that makes a Mob run around in panic after it was
+// attacked or while it is on fire.
+type PanicGoal struct {
+	speed   float64
+	handled time.Time
+}
+
+// NewPanicGoal creates a PanicGoal that makes a Mob run around with the speed
+// modifier passed.
+func NewPanicGoal(speed float64) *PanicGoal {
+	return &PanicGoal{speed: speed}
+}
+
+// Controls returns GoalControlMove.
+func (g *PanicGoal) Controls() GoalControl {
+	return GoalControlMove
+}
+
+// CanStart returns true if the Mob was attacked since the goal last started
+// or if it is on fire.
+func (g *PanicGoal) CanStart(m *Mob) bool {
+	_, at := m.LastAttacker()
+	return at.After(g.handled) || m.OnFireDuration() > 0
+}
+
+// CanContinue returns true until the Mob reaches the position it is running
+// to.
+func (g *PanicGoal) CanContinue(m *Mob) bool {
+	return m.Moving()
+}
+
+// Start makes the Mob run to a random position up to 5 blocks away.
+func (g *PanicGoal) Start(m *Mob) {
+	_, g.handled = m.LastAttacker()
+	navigateTo(m, m.Position().Add(mgl64.Vec3{rand.Float64()*10 - 5, 0, rand.Float64()*10 - 5}), g.speed)
+}
+
+// Stop stops the movement of the Mob.
+func (g *PanicGoal) Stop(m *Mob) {
+	m.StopMoving()
+}
+
+// Tick does nothing.
+func (g *PanicGoal) Tick(*Mob) {}
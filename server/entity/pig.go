@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// NewPig creates a pig at the position passed. Pigs may be bred using
+// carrots, potatoes and beetroots.
+func NewPig(pos mgl64.Vec3) *Mob {
+	m := MobConfig{
+		MaxHealth: 10,
+		Speed:     0.25,
+		Drops: func(m *Mob) []item.Stack {
+			return []item.Stack{item.NewStack(item.Porkchop{Cooked: m.OnFireDuration() > 0}, rand.Intn(3)+1)}
+		},
+		Experience: rand.Intn(3) + 1,
+		Behaviour: AnimalBehaviourConfig{
+			Food: pigFood,
+			Breed: func(a, _ *Mob) *Mob {
+				return NewPig(a.Position())
+			},
+		}.New(),
+	}.New(PigType{}, pos)
+	addAnimalGoals(m, pigFood)
+	return m
+}
+
+// pigFood checks if the item passed may be fed to pigs.
+func pigFood(it world.Item) bool {
+	switch it.(type) {
+	case block.Carrot, block.Potato, item.Beetroot:
+		return true
+	}
+	return false
+}
+
+// PigType is a world.EntityType implementation for pigs.
+type PigType struct{}
+
+func (PigType) EncodeEntity() string              { return "minecraft:pig" }
+func (PigType) Spawn(pos mgl64.Vec3) world.Entity { return NewPig(pos) }
+func (PigType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.9, 0.9)
+}
+
+func (PigType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMobNBT(NewPig(nbtconv.Vec3(data, "Pos")), data)
+}
+
+func (PigType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Mob))
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"sync"
+)
+
+// portalTravel tracks the portals that an entity other than a player is
+// inside of. Unlike players, these entities travel through portals as soon as
+// they enter them.
+type portalTravel struct {
+	mu sync.Mutex
+	// portal is the Dimension of the portal the entity was inside of since the
+	// last tick, or nil if it was not inside a portal.
+	portal world.Dimension
+	// cooldown is true after the entity travelled through a portal, until it
+	// leaves the portal it arrived in.
+	cooldown bool
+}
+
+// enter marks the entity as being inside a portal of the Dimension passed.
+func (t *portalTravel) enter(dim world.Dimension) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.portal = dim
+}
+
+// tick moves the entity passed, which is at the position passed, to the
+// destination of the portal it is inside of, using teleport to teleport it.
+// The entity does not travel if it is riding or being ridden by another
+// entity.
+func (t *portalTravel) tick(e world.Entity, w *world.World, pos mgl64.Vec3, teleport func(w *world.World, pos mgl64.Vec3, cause world.TeleportCause) bool) {
+	t.mu.Lock()
+	dim := t.portal
+	t.portal = nil
+	if dim == nil || t.cooldown {
+		t.cooldown = dim != nil
+		t.mu.Unlock()
+		return
+	}
+	t.cooldown = true
+	t.mu.Unlock()
+
+	if current, ok := world.OfEntity(e); !ok || current != w {
+		// The entity was closed or moved to another world during the tick.
+		return
+	}
+	if _, _, riding := Vehicle(e); riding || len(Passengers(e)) > 0 {
+		return
+	}
+	if dst, target, ok := block.PortalTarget(w, pos, dim); ok {
+		teleport(dst, target, block.PortalTeleportCause{Dimension: dim})
+	}
+}
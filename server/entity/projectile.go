@@ -8,6 +8,7 @@ import (
 	"github.com/df-mc/dragonfly/server/item/potion"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
+	"golang.org/x/exp/slices"
 	"math"
 	"math/rand"
 	"time"
@@ -25,7 +26,8 @@ type ProjectileBehaviourConfig struct {
 	// Damage specifies the base damage dealt by the Projectile. If set to a
 	// negative number, entities hit are not hurt at all and are not knocked
 	// back. The base damage is multiplied with the velocity of the projectile
-	// to calculate the final damage of the projectile.
+	// to calculate the final damage of the projectile, so that an arrow shot
+	// from a fully drawn bow deals 3 times its base damage.
 	Damage float64
 	// Potion is the potion effect that is applied to an entity when the
 	// projectile hits it.
@@ -74,6 +76,10 @@ type ProjectileBehaviourConfig struct {
 	// PickupItem is the item that is given to a player when it picks up this
 	// projectile. If left as an empty item.Stack, no item is given upon pickup.
 	PickupItem item.Stack
+	// PiercingLevel is the amount of entities that the projectile is able to
+	// pass through. Every entity pierced is hit by the projectile. The
+	// projectile stops at the first entity hit if PiercingLevel is 0.
+	PiercingLevel int
 }
 
 // New creates a new ProjectileBehaviour using conf. The owner passed may be nil
@@ -100,6 +106,8 @@ type ProjectileBehaviour struct {
 
 	collisionPos cube.Pos
 	collided     bool
+
+	pierced []world.Entity
 }
 
 // Owner returns the owner of the projectile.
@@ -168,6 +176,11 @@ func (lt *ProjectileBehaviour) Tick(e *Ent) *Movement {
 	case trace.EntityResult:
 		if l, ok := r.Entity().(Living); ok && lt.conf.Damage >= 0 {
 			lt.hitEntity(l, e, before, vel)
+			if lt.pierce(e, l, vel) {
+				return m
+			}
+		} else if c, ok := r.Entity().(*EndCrystal); ok {
+			c.Hurt(lt.conf.Damage, ProjectileDamageSource{Projectile: e, Owner: lt.owner})
 		}
 	case trace.BlockResult:
 		bpos := r.BlockPosition()
@@ -260,16 +273,21 @@ func (lt *ProjectileBehaviour) hitBlockSurviving(e *Ent, r trace.BlockResult, m
 	e.mu.Unlock()
 }
 
+// projectileSpeedScale converts the speed of a projectile to the speed that
+// vanilla bases projectile damage on. Bows launch arrows at 5 blocks per tick
+// when fully drawn, while the same arrow moves at 3 blocks per tick in vanilla.
+const projectileSpeedScale = 3.0 / 5.0
+
 // hitEntity is called when a projectile hits a Living. It deals damage to the
 // entity and knocks it back. Additionally, it applies any potion effects and
 // fire if applicable.
 func (lt *ProjectileBehaviour) hitEntity(l Living, e *Ent, origin, vel mgl64.Vec3) {
 	src := ProjectileDamageSource{Projectile: e, Owner: lt.owner}
-	dmg := math.Ceil(lt.conf.Damage * vel.Len())
+	dmg := math.Ceil(lt.conf.Damage * vel.Len() * projectileSpeedScale)
 	if lt.conf.Critical {
 		dmg += rand.Float64() * dmg / 2
 	}
-	if _, vulnerable := l.Hurt(lt.conf.Damage, src); vulnerable {
+	if _, vulnerable := l.Hurt(dmg, src); vulnerable {
 		l.KnockBack(origin, 0.45+lt.conf.KnockBackForceAddend, 0.3608+lt.conf.KnockBackHeightAddend)
 
 		for _, eff := range lt.conf.Potion.Effects() {
@@ -281,15 +299,30 @@ func (lt *ProjectileBehaviour) hitEntity(l Living, e *Ent, origin, vel mgl64.Vec
 	}
 }
 
+// pierce checks if the projectile is able to pierce through the entity passed,
+// after having hit it. If so, the entity is ignored by the projectile from then
+// on and the projectile continues moving with the velocity passed.
+func (lt *ProjectileBehaviour) pierce(e *Ent, hit world.Entity, vel mgl64.Vec3) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(lt.pierced) >= lt.conf.PiercingLevel {
+		return false
+	}
+	lt.pierced = append(lt.pierced, hit)
+	e.vel = vel
+	return true
+}
+
 // tickMovement ticks the movement of a projectile. It updates the position and
 // rotation of the projectile based on its velocity and updates the velocity
 // based on gravity and drag.
 func (lt *ProjectileBehaviour) tickMovement(e *Ent) (*Movement, trace.Result) {
 	w, pos, vel := e.World(), e.pos, e.vel
 	viewers := w.Viewers(pos)
+	gravity, drag, terminal := lt.mc.forces(w, pos)
 
 	velBefore := vel
-	vel = lt.mc.applyHorizontalForces(w, pos, lt.mc.applyVerticalForces(vel))
+	vel = lt.mc.applyHorizontalForces(w, pos, drag, lt.mc.applyVerticalForces(vel, gravity, drag, terminal))
 	rot := cube.Rotation{
 		mgl64.RadToDeg(math.Atan2(vel[0], vel[2])),
 		mgl64.RadToDeg(math.Atan2(vel[1], math.Hypot(vel[0], vel[2]))),
@@ -325,12 +358,13 @@ func (lt *ProjectileBehaviour) tickMovement(e *Ent) (*Movement, trace.Result) {
 }
 
 // ignores returns a function to ignore entities in trace.Perform that are
-// either a spectator, not living, the entity itself or its owner in the first
-// 5 ticks.
+// either a spectator, not living or an end crystal, the entity itself, its
+// owner in the first 5 ticks or an entity that the projectile pierced.
 func (lt *ProjectileBehaviour) ignores(e *Ent) func(other world.Entity) bool {
 	return func(other world.Entity) (ignored bool) {
 		g, ok := other.(interface{ GameMode() world.GameMode })
 		_, living := other.(Living)
-		return (ok && !g.GameMode().HasCollision()) || e == other || !living || (lt.age < 5 && lt.owner == other)
+		_, crystal := other.(*EndCrystal)
+		return (ok && !g.GameMode().HasCollision()) || e == other || (!living && !crystal) || (lt.age < 5 && lt.owner == other) || slices.IndexFunc(lt.pierced, func(p world.Entity) bool { return p == other }) != -1
 	}
 }
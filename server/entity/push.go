@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+)
+
+// Pushable is implemented by entities that push other entities apart when they collide with them. Only Pushable
+// entities of which Pushable returns true push and are pushed by other entities.
+type Pushable interface {
+	world.Entity
+	// Pushable checks if the entity currently pushes and is pushed by other entities. Entities that are exempt from
+	// collisions, such as players in spectator mode, return false.
+	Pushable() bool
+}
+
+// pushVelocity returns the horizontal velocity with which the entity passed at the position passed is pushed away
+// from the Pushable entities that it collides with. A zero vector is returned if entity pushing is disabled in the
+// world.Physics of the World.
+func pushVelocity(w *world.World, e world.Entity, pos mgl64.Vec3) mgl64.Vec3 {
+	strength := w.Physics().EntityPushing
+	if strength == 0 {
+		return mgl64.Vec3{}
+	}
+	var vel mgl64.Vec3
+	box := e.Type().BBox(e).Translate(pos)
+	// EntitiesWithin only checks the positions of entities, so the box is grown to find entities of which the bounding
+	// box intersects with that of the entity, but of which the position is outside of it.
+	for _, other := range w.EntitiesWithin(box.Grow(2), func(other world.Entity) bool { return other == e }) {
+		if p, ok := other.(Pushable); !ok || !p.Pushable() || !other.Type().BBox(other).Translate(other.Position()).IntersectsWith(box) {
+			continue
+		}
+		diff := pos.Sub(other.Position())
+		dist := math.Max(math.Abs(diff[0]), math.Abs(diff[2]))
+		if dist < 0.01 {
+			// The entities are at practically the same position, so there is no direction to push them apart in.
+			continue
+		}
+		dist = math.Sqrt(dist)
+		f := math.Min(1/dist, 1) / dist * strength
+		vel[0] += diff[0] * f
+		vel[2] += diff[2] * f
+	}
+	return vel
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// RangedAttackGoal is a Goal that makes a Mob approach its target until it is
+// within a specific radius, after which it attacks the target from a distance
+// at a fixed interval.
+type RangedAttackGoal struct {
+	speed    float64
+	interval int
+	radius   float64
+	attack   func(m *Mob, target world.Entity)
+	cooldown int
+	repath   int
+}
+
+// NewRangedAttackGoal creates a RangedAttackGoal that makes a Mob approach its
+// target with the speed modifier passed. Once within the radius passed, attack
+// is called every interval ticks to attack the target, for example by
+// shooting a projectile at it.
+func NewRangedAttackGoal(speed float64, interval int, radius float64, attack func(m *Mob, target world.Entity)) *RangedAttackGoal {
+	return &RangedAttackGoal{speed: speed, interval: interval, radius: radius, attack: attack}
+}
+
+// Controls returns GoalControlMove and GoalControlLook.
+func (g *RangedAttackGoal) Controls() GoalControl {
+	return GoalControlMove | GoalControlLook
+}
+
+// CanStart returns true if the Mob has a target that can be attacked.
+func (g *RangedAttackGoal) CanStart(m *Mob) bool {
+	return validTarget(m, m.Target())
+}
+
+// CanContinue returns true as long as the target of the Mob can be attacked.
+func (g *RangedAttackGoal) CanContinue(m *Mob) bool {
+	return validTarget(m, m.Target())
+}
+
+// Start resets the attack interval.
+func (g *RangedAttackGoal) Start(*Mob) {
+	g.cooldown, g.repath = g.interval, 0
+}
+
+// Stop stops the movement of the Mob.
+func (g *RangedAttackGoal) Stop(m *Mob) {
+	m.StopMoving()
+}
+
+// Tick moves the Mob towards its target until it is within the radius of the
+// goal and attacks the target once the interval has passed.
+func (g *RangedAttackGoal) Tick(m *Mob) {
+	target := m.Target()
+	if target == nil {
+		return
+	}
+	m.LookAt(EyePosition(target))
+
+	inRange := target.Position().Sub(m.Position()).Len() <= g.radius
+	if !inRange {
+		if g.repath--; g.repath <= 0 {
+			g.repath = 10
+			navigateTo(m, target.Position(), g.speed)
+		}
+	} else if m.Moving() {
+		m.StopMoving()
+	}
+	if g.cooldown > 0 {
+		g.cooldown--
+		return
+	}
+	if inRange {
+		g.cooldown = g.interval
+		g.attack(m, target)
+	}
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/enchantment"
 	"github.com/df-mc/dragonfly/server/item/potion"
@@ -15,18 +16,29 @@ var DefaultRegistry = conf.New([]world.EntityType{
 	AreaEffectCloudType{},
 	ArrowType{},
 	BottleOfEnchantingType{},
+	CatType{},
+	ChickenType{},
+	CowType{},
 	EggType{},
+	EndCrystalType{},
 	EnderPearlType{},
 	ExperienceOrbType{},
 	FallingBlockType{},
 	FireworkType{},
 	ItemType{},
+	LeashKnotType{},
 	LightningType{},
 	LingeringPotionType{},
+	PigType{},
+	SheepType{},
+	SkeletonType{},
 	SnowballType{},
 	SplashPotionType{},
 	TNTType{},
 	TextType{},
+	VillagerType{},
+	WolfType{},
+	ZombieType{},
 })
 
 var conf = world.EntityRegistryConfig{
@@ -68,6 +80,9 @@ var conf = world.EntityRegistryConfig{
 		e.vel = vel
 		return e
 	},
+	EndCrystal: func(pos mgl64.Vec3, showBase bool) world.Entity {
+		return NewEndCrystal(pos, showBase)
+	},
 	Firework: func(pos mgl64.Vec3, yaw, pitch float64, attached bool, firework world.Item, owner world.Entity) world.Entity {
 		f := NewFirework(pos, yaw, pitch, firework.(item.Firework))
 		f.owner = owner
@@ -92,4 +107,32 @@ var conf = world.EntityRegistryConfig{
 	Lightning: func(pos mgl64.Vec3) world.Entity {
 		return NewLightning(pos)
 	},
+	LeashKnot: func(pos cube.Pos) world.Entity {
+		return NewLeashKnot(pos)
+	},
+}
+
+func init() {
+	for _, t := range []world.EntityType{CatType{}, ChickenType{}, CowType{}, PigType{}, SheepType{}, SkeletonType{}, VillagerType{}, WolfType{}, ZombieType{}} {
+		world.RegisterItem(item.SpawnEgg{Type: t})
+	}
+}
+
+// RegisterSpawnEggs registers an item.SpawnEgg for every world.SpawnableEntityType in the world.EntityRegistry passed
+// that is not part of DefaultRegistry and that does not have a spawn egg registered yet. Spawn eggs with a name
+// unknown to the server, such as those of custom entities, are registered under a new runtime ID.
+func RegisterSpawnEggs(reg world.EntityRegistry) {
+	for _, t := range reg.Types() {
+		if _, ok := t.(world.SpawnableEntityType); !ok {
+			continue
+		}
+		if _, ok := DefaultRegistry.Lookup(t.EncodeEntity()); ok {
+			continue
+		}
+		egg := item.SpawnEgg{Type: t}
+		if _, ok := world.ItemByName(egg.EncodeItem()); ok {
+			continue
+		}
+		world.RegisterItem(egg, world.NewRuntimeID())
+	}
 }
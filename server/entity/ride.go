@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Mount makes the passenger ride the vehicle, sitting at an offset relative to the position of the vehicle. If the
+// passenger was already riding an entity, it is dismounted from it first. A vehicle may have multiple passengers,
+// of which the first one is the driver, and a vehicle may itself ride another entity, so that entities may be
+// stacked. Mount returns false if the entities are not in the same world or if the vehicle is (indirectly) riding
+// the passenger. The ride is dropped automatically when either of the entities is removed from its world.
+func Mount(passenger, vehicle world.Entity, offset mgl64.Vec3) bool {
+	return passenger.World().Mount(passenger, vehicle, offset)
+}
+
+// Dismount makes the passenger stop riding its vehicle. Dismount does nothing if the passenger is not riding an
+// entity.
+func Dismount(passenger world.Entity) {
+	passenger.World().Dismount(passenger)
+}
+
+// Eject dismounts all passengers of the vehicle passed.
+func Eject(vehicle world.Entity) {
+	vehicle.World().Eject(vehicle)
+}
+
+// Vehicle returns the entity that the passenger passed is riding and the offset of its seat. False is returned if
+// the passenger is not riding any entity.
+func Vehicle(passenger world.Entity) (vehicle world.Entity, offset mgl64.Vec3, ok bool) {
+	return passenger.World().Vehicle(passenger)
+}
+
+// Passengers returns the passengers riding the vehicle passed. The first passenger, if any, is the driver.
+func Passengers(vehicle world.Entity) []world.Entity {
+	return vehicle.World().Passengers(vehicle)
+}
+
+// ridingPosition returns the position that the passenger passed should be at while riding its vehicle. False is
+// returned if the passenger is not riding an entity.
+func ridingPosition(passenger world.Entity) (mgl64.Vec3, bool) {
+	vehicle, offset, ok := Vehicle(passenger)
+	if !ok {
+		return mgl64.Vec3{}, false
+	}
+	return vehicle.Position().Add(offset), true
+}
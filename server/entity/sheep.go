@@ -0,0 +1,178 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"sync"
+)
+
+// NewSheep creates a sheep with a random natural wool colour at the position
+// passed.
+func NewSheep(pos mgl64.Vec3) *Mob {
+	return NewSheepWithColour(pos, randomSheepColour())
+}
+
+// NewSheepWithColour creates a sheep with the wool colour passed at the
+// position passed. Sheep may be bred using wheat and sheared using shears,
+// after which they regrow their wool by eating grass.
+func NewSheepWithColour(pos mgl64.Vec3, colour item.Colour) *Mob {
+	b := &SheepBehaviour{colour: colour}
+	b.AnimalBehaviour = AnimalBehaviourConfig{
+		Food: wheatFood,
+		Breed: func(a, partner *Mob) *Mob {
+			colour := a.Behaviour().(*SheepBehaviour).Colour()
+			if rand.Intn(2) == 0 {
+				colour = partner.Behaviour().(*SheepBehaviour).Colour()
+			}
+			return NewSheepWithColour(a.Position(), colour)
+		},
+	}.New()
+
+	m := MobConfig{
+		MaxHealth: 8,
+		Speed:     0.23,
+		Drops: func(m *Mob) []item.Stack {
+			drops := []item.Stack{item.NewStack(item.Mutton{Cooked: m.OnFireDuration() > 0}, rand.Intn(2)+1)}
+			if !b.Sheared() {
+				drops = append(drops, item.NewStack(block.Wool{Colour: b.Colour()}, 1))
+			}
+			return drops
+		},
+		Experience: rand.Intn(3) + 1,
+		Behaviour:  b,
+	}.New(SheepType{}, pos)
+	addAnimalGoals(m, wheatFood)
+	m.Goals().Add(5, NewEatGrassGoal(b.eatGrass))
+	return m
+}
+
+// SheepBehaviour implements the behaviour of sheep, which may be sheared and
+// regrow their wool by eating grass, in addition to the behaviour of other
+// animals.
+type SheepBehaviour struct {
+	*AnimalBehaviour
+
+	mu      sync.Mutex
+	colour  item.Colour
+	sheared bool
+}
+
+// Colour returns the colour of the wool of the sheep.
+func (s *SheepBehaviour) Colour() item.Colour {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.colour
+}
+
+// Sheared checks if the sheep has been sheared and has not yet regrown its
+// wool.
+func (s *SheepBehaviour) Sheared() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sheared
+}
+
+// EncodeNBT encodes the colour of the sheep and whether it is sheared to the
+// data passed, in addition to the state of its AnimalBehaviour.
+func (s *SheepBehaviour) EncodeNBT(m *Mob, data map[string]any) {
+	s.AnimalBehaviour.EncodeNBT(m, data)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data["Color"], data["Sheared"] = s.colour.Uint8(), boolByte(s.sheared)
+}
+
+// DecodeNBT decodes the colour of the sheep and whether it is sheared from the
+// data passed, in addition to the state of its AnimalBehaviour.
+func (s *SheepBehaviour) DecodeNBT(m *Mob, data map[string]any) {
+	s.AnimalBehaviour.DecodeNBT(m, data)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c := int(nbtconv.Uint8(data, "Color")); c < len(item.Colours()) {
+		s.colour = item.Colours()[c]
+	}
+	s.sheared = nbtconv.Bool(data, "Sheared")
+}
+
+// Interact shears the sheep if the user holds shears, or feeds the sheep
+// otherwise.
+func (s *SheepBehaviour) Interact(m *Mob, user item.User, ctx *item.UseContext) bool {
+	held, _ := user.HeldItems()
+	if _, ok := held.Item().(item.Shears); !ok {
+		return s.AnimalBehaviour.Interact(m, user, ctx)
+	}
+	s.mu.Lock()
+	if s.sheared || s.Baby() {
+		s.mu.Unlock()
+		return false
+	}
+	s.sheared = true
+	colour := s.colour
+	s.mu.Unlock()
+
+	w, pos := m.World(), m.Position()
+	w.AddEntity(NewItem(item.NewStack(block.Wool{Colour: colour}, rand.Intn(3)+1), pos.Add(mgl64.Vec3{0, 1})))
+	for _, v := range w.Viewers(pos) {
+		v.ViewEntityState(m)
+	}
+	ctx.DamageItem(1)
+	return true
+}
+
+// eatGrass regrows the wool of the sheep after it has eaten grass. Baby sheep
+// grow up a minute faster.
+func (s *SheepBehaviour) eatGrass(m *Mob) {
+	s.mu.Lock()
+	sheared := s.sheared
+	s.sheared = false
+	s.mu.Unlock()
+
+	if age := s.Age(); age < -1200 {
+		s.SetAge(age + 1200)
+	} else if age < 0 {
+		s.SetAge(0)
+	}
+	if sheared {
+		for _, v := range m.World().Viewers(m.Position()) {
+			v.ViewEntityState(m)
+		}
+	}
+}
+
+// randomSheepColour returns a random natural colour for the wool of a sheep.
+func randomSheepColour() item.Colour {
+	switch n := rand.Intn(1000); {
+	case n < 50:
+		return item.ColourBlack()
+	case n < 100:
+		return item.ColourGrey()
+	case n < 150:
+		return item.ColourLightGrey()
+	case n < 180:
+		return item.ColourBrown()
+	case n < 182:
+		return item.ColourPink()
+	}
+	return item.ColourWhite()
+}
+
+// SheepType is a world.EntityType implementation for sheep.
+type SheepType struct{}
+
+func (SheepType) EncodeEntity() string              { return "minecraft:sheep" }
+func (SheepType) Spawn(pos mgl64.Vec3) world.Entity { return NewSheep(pos) }
+func (SheepType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.9, 1.3)
+}
+
+func (SheepType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMobNBT(NewSheep(nbtconv.Vec3(data, "Pos")), data)
+}
+
+func (SheepType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Mob))
+}
@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+)
+
+// NewSkeleton creates a skeleton holding a bow at the position passed. The
+// skeleton shoots arrows at players within 16 blocks and any entity that hurts
+// it, and wanders around otherwise.
+func NewSkeleton(pos mgl64.Vec3) *Mob {
+	m := MobConfig{
+		MaxHealth: 20,
+		Speed:     0.25,
+		Drops: func(*Mob) []item.Stack {
+			var drops []item.Stack
+			if n := rand.Intn(3); n > 0 {
+				drops = append(drops, item.NewStack(item.Bone{}, n))
+			}
+			if n := rand.Intn(3); n > 0 {
+				drops = append(drops, item.NewStack(item.Arrow{}, n))
+			}
+			return drops
+		},
+		Experience:         5,
+		Sinks:              true,
+		BreathesUnderwater: true,
+		Undead:             true,
+	}.New(SkeletonType{}, pos)
+	m.SetHeldItems(item.NewStack(item.Bow{}, 1), item.Stack{})
+
+	m.Goals().Add(4, NewRangedAttackGoal(1, 40, 15, skeletonShoot))
+	m.Goals().Add(5, NewWanderGoal(1))
+	m.Goals().Add(6, NewLookAtPlayerGoal(8))
+
+	m.Targets().Add(1, NewHurtByTargetGoal())
+	m.Targets().Add(2, NewNearestTargetGoal(16, isPlayer))
+	return m
+}
+
+// skeletonShoot shoots an arrow from the eyes of a skeleton at the target
+// passed, aiming slightly above the target to make up for gravity.
+func skeletonShoot(m *Mob, target world.Entity) {
+	w := m.World()
+	if w == nil {
+		return
+	}
+	eye := EyePosition(m)
+	diff := target.Position().Add(mgl64.Vec3{0, target.Type().BBox(target).Height() / 3}).Sub(eye)
+	horizontal := math.Sqrt(diff[0]*diff[0] + diff[2]*diff[2])
+	diff[1] += horizontal * 0.2 * w.Physics().Gravity
+	if diff.Len() == 0 {
+		return
+	}
+	rot := m.Rotation()
+	arrow := NewArrowWithDamage(eye, rot.Yaw(), rot.Pitch(), 2, m)
+	arrow.SetVelocity(diff.Normalize().Mul(1.6))
+
+	w.PlaySound(eye, sound.BowShoot{})
+	w.AddEntity(arrow)
+}
+
+// SkeletonType is a world.EntityType implementation for skeletons.
+type SkeletonType struct{}
+
+func (SkeletonType) EncodeEntity() string              { return "minecraft:skeleton" }
+func (SkeletonType) Spawn(pos mgl64.Vec3) world.Entity { return NewSkeleton(pos) }
+func (SkeletonType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.99, 0.3)
+}
+
+func (SkeletonType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMobNBT(NewSkeleton(nbtconv.Vec3(data, "Pos")), data)
+}
+
+func (SkeletonType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Mob))
+}
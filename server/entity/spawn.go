@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/biome"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// DefaultSpawns returns the spawn list of a world.Biome with the mobs
+// implemented in this package. It may be passed to world.MobSpawning to
+// spawn zombies and skeletons in the dark and cows, pigs, sheep and chickens
+// on grass, similarly to vanilla.
+func DefaultSpawns(b world.Biome) []world.SpawnEntry {
+	switch b.(type) {
+	case biome.NetherWastes, biome.CrimsonForest, biome.WarpedForest, biome.SoulSandValley, biome.BasaltDeltas,
+		biome.End, biome.MushroomFields, biome.MushroomFieldShore, biome.DeepDark:
+		return nil
+	case biome.Ocean, biome.DeepOcean, biome.ColdOcean, biome.DeepColdOcean, biome.FrozenOcean,
+		biome.DeepFrozenOcean, biome.LegacyFrozenOcean, biome.LukewarmOcean, biome.DeepLukewarmOcean,
+		biome.WarmOcean, biome.DeepWarmOcean, biome.River, biome.FrozenRiver, biome.Beach, biome.SnowyBeach,
+		biome.StonyShore, biome.Desert, biome.DesertHills, biome.DesertLakes:
+		return monsterSpawns
+	}
+	return append(monsterSpawns[:len(monsterSpawns):len(monsterSpawns)], creatureSpawns...)
+}
+
+var (
+	// monsterSpawns holds the spawn entries of monsters that spawn in most
+	// biomes of the overworld.
+	monsterSpawns = []world.SpawnEntry{
+		{Category: world.SpawnCategoryMonster, Weight: 95, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewZombie)},
+		{Category: world.SpawnCategoryMonster, Weight: 100, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewSkeleton)},
+	}
+	// creatureSpawns holds the spawn entries of animals that spawn in biomes
+	// of the overworld with grass.
+	creatureSpawns = []world.SpawnEntry{
+		{Category: world.SpawnCategoryCreature, Weight: 12, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewSheep)},
+		{Category: world.SpawnCategoryCreature, Weight: 10, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewPig)},
+		{Category: world.SpawnCategoryCreature, Weight: 10, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewChicken)},
+		{Category: world.SpawnCategoryCreature, Weight: 8, MinGroup: 4, MaxGroup: 4, New: spawnMob(NewCow)},
+	}
+)
+
+// spawnMob converts a function creating a Mob to a function that may be used
+// in a world.SpawnEntry.
+func spawnMob(f func(pos mgl64.Vec3) *Mob) func(pos mgl64.Vec3) world.Entity {
+	return func(pos mgl64.Vec3) world.Entity {
+		return f(pos)
+	}
+}
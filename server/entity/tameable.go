@@ -0,0 +1,296 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/google/uuid"
+	"math/rand"
+	"sync"
+)
+
+// Tameable is implemented by the MobBehaviour of Mobs that may be tamed by a
+// player, after which they follow their owner and may be told to sit.
+// TameableBehaviour implements Tameable.
+type Tameable interface {
+	MobBehaviour
+	// Tamed checks if the Mob has been tamed.
+	Tamed() bool
+	// OwnerUUID returns the UUID of the owner of the Mob. False is returned if
+	// the Mob has not been tamed.
+	OwnerUUID() (uuid.UUID, bool)
+	// Tame tames the Mob, making the owner passed its owner. False is returned
+	// if the owner passed does not have a UUID.
+	Tame(m *Mob, owner world.Entity) bool
+	// Sitting checks if the Mob is sitting.
+	Sitting() bool
+	// SetSitting makes the Mob sit down or stand up.
+	SetSitting(m *Mob, sitting bool)
+}
+
+// TameableBehaviourConfig holds settings that influence the way a
+// TameableBehaviour behaves.
+type TameableBehaviourConfig struct {
+	// Animal holds the settings of the AnimalBehaviour of the Mob. Tamed Mobs
+	// may be bred by their owner using the food specified.
+	Animal AnimalBehaviourConfig
+	// TameItem checks if the item passed may be used to tame the Mob.
+	TameItem func(it world.Item) bool
+	// TameChance is the chance, ranging from 0 to 1, that feeding a TameItem
+	// to the Mob tames it. If left as 0, the chance is 1/3.
+	TameChance float64
+	// Tamed is called when the Mob is tamed. It may be used to change the
+	// attributes of the Mob, such as its maximum health. Tamed may be nil.
+	Tamed func(m *Mob)
+}
+
+// New creates a TameableBehaviour using conf. It may be passed to
+// MobConfig.Behaviour.
+func (conf TameableBehaviourConfig) New() *TameableBehaviour {
+	if conf.TameChance == 0 {
+		conf.TameChance = 1.0 / 3
+	}
+	return &TameableBehaviour{AnimalBehaviour: conf.Animal.New(), conf: conf}
+}
+
+// TameableBehaviour implements the behaviour of animals that may be tamed,
+// such as wolves and cats. In addition to the behaviour of an
+// AnimalBehaviour, the Mob may be tamed by feeding it, after which its owner
+// may make it sit by interacting with it. The owner is stored using its UUID,
+// so that the Mob finds its owner again when it leaves and rejoins.
+type TameableBehaviour struct {
+	*AnimalBehaviour
+	conf TameableBehaviourConfig
+
+	mu      sync.Mutex
+	owner   uuid.UUID
+	tamed   bool
+	sitting bool
+	ticks   int
+}
+
+// Tamed checks if the Mob has been tamed.
+func (t *TameableBehaviour) Tamed() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tamed
+}
+
+// OwnerUUID returns the UUID of the owner of the Mob. False is returned if the
+// Mob has not been tamed.
+func (t *TameableBehaviour) OwnerUUID() (uuid.UUID, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.owner, t.tamed
+}
+
+// SetOwnerUUID tames the Mob with the UUID of its owner, without the owner
+// having to be online. It may be used to restore a Mob tamed earlier. The Mob
+// finds its owner once an entity with the UUID is in the same world.
+func (t *TameableBehaviour) SetOwnerUUID(id uuid.UUID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.owner, t.tamed = id, true
+}
+
+// Tame tames the Mob, making the owner passed its owner. False is returned if
+// the owner passed does not have a UUID.
+func (t *TameableBehaviour) Tame(m *Mob, owner world.Entity) bool {
+	u, ok := owner.(interface{ UUID() uuid.UUID })
+	if !ok {
+		return false
+	}
+	t.SetOwnerUUID(u.UUID())
+	m.SetOwner(owner)
+	m.SetTarget(nil)
+	m.World().Persist(m)
+	if t.conf.Tamed != nil {
+		t.conf.Tamed(m)
+	}
+	m.updateState()
+	return true
+}
+
+// Sitting checks if the Mob is sitting.
+func (t *TameableBehaviour) Sitting() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.sitting
+}
+
+// SetSitting makes the Mob sit down or stand up. A sitting Mob does not move
+// and does not follow its owner.
+func (t *TameableBehaviour) SetSitting(m *Mob, sitting bool) {
+	t.mu.Lock()
+	t.sitting = sitting
+	t.mu.Unlock()
+	m.updateState()
+}
+
+// EncodeNBT encodes the owner of the Mob and whether it is sitting to the data
+// passed, in addition to the state of its AnimalBehaviour.
+func (t *TameableBehaviour) EncodeNBT(m *Mob, data map[string]any) {
+	t.AnimalBehaviour.EncodeNBT(m, data)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.tamed {
+		data["OwnerUUID"] = t.owner.String()
+	}
+	data["Sitting"] = boolByte(t.sitting)
+}
+
+// DecodeNBT decodes the owner of the Mob and whether it is sitting from the
+// data passed, in addition to the state of its AnimalBehaviour.
+func (t *TameableBehaviour) DecodeNBT(m *Mob, data map[string]any) {
+	t.AnimalBehaviour.DecodeNBT(m, data)
+	t.mu.Lock()
+	t.sitting = nbtconv.Bool(data, "Sitting")
+	t.mu.Unlock()
+	if id, err := uuid.Parse(nbtconv.String(data, "OwnerUUID")); err == nil {
+		t.SetOwnerUUID(id)
+		if t.conf.Tamed != nil {
+			t.conf.Tamed(m)
+		}
+	}
+}
+
+// Tick ages the Mob and, if it is tamed, looks for its owner once a second if
+// it is not in the same world as the Mob.
+func (t *TameableBehaviour) Tick(m *Mob) {
+	t.AnimalBehaviour.Tick(m)
+
+	t.mu.Lock()
+	t.ticks++
+	id, check := t.owner, t.tamed && t.ticks%20 == 0
+	t.mu.Unlock()
+	if !check {
+		return
+	}
+	w, current := m.World(), m.Owner()
+	if current != nil {
+		if ow, ok := world.OfEntity(current); ok && ow == w {
+			return
+		}
+	}
+	owner, _ := w.EntityByUUID(id)
+	if owner != current {
+		m.SetOwner(owner)
+		m.updateState()
+	}
+}
+
+// Interact tries to tame the Mob if it is wild and the user holds an item
+// used to tame it. If the Mob is tamed and the user is its owner, the Mob is
+// either fed, making it fall in love, or is made to sit down or stand up.
+func (t *TameableBehaviour) Interact(m *Mob, user item.User, ctx *item.UseContext) bool {
+	held, _ := user.HeldItems()
+	if !t.Tamed() {
+		if held.Empty() || t.conf.TameItem == nil || !t.conf.TameItem(held.Item()) {
+			return false
+		}
+		ctx.CountSub = 1
+		if rand.Float64() < t.conf.TameChance && t.Tame(m, user) {
+			t.SetSitting(m, true)
+			t.viewAction(m, TameSucceedAction{})
+			return true
+		}
+		t.viewAction(m, TameFailAction{})
+		return true
+	}
+	if id, _ := t.OwnerUUID(); !ownedBy(user, id) {
+		return false
+	}
+	if t.AnimalBehaviour.Interact(m, user, ctx) {
+		return true
+	}
+	if _, ok := held.Item().(item.UsableOnEntity); ok {
+		// Items such as leads may still be used on the Mob.
+		return false
+	}
+	t.SetSitting(m, !t.Sitting())
+	m.StopMoving()
+	return true
+}
+
+// viewAction shows the world.EntityAction passed to all viewers of the Mob.
+func (t *TameableBehaviour) viewAction(m *Mob, a world.EntityAction) {
+	for _, v := range m.World().Viewers(m.Position()) {
+		v.ViewEntityAction(m, a)
+	}
+}
+
+// tameableBehaviour returns the TameableBehaviour itself. It allows behaviours
+// that embed a TameableBehaviour to be used as tameable animals.
+func (t *TameableBehaviour) tameableBehaviour() *TameableBehaviour {
+	return t
+}
+
+// tameableOf returns the TameableBehaviour of the Mob passed. False is
+// returned if the Mob cannot be tamed.
+func tameableOf(m *Mob) (*TameableBehaviour, bool) {
+	t, ok := m.Behaviour().(interface{ tameableBehaviour() *TameableBehaviour })
+	if !ok {
+		return nil, false
+	}
+	return t.tameableBehaviour(), true
+}
+
+// ownedBy checks if the entity passed has the UUID passed.
+func ownedBy(e world.Entity, id uuid.UUID) bool {
+	u, ok := e.(interface{ UUID() uuid.UUID })
+	return ok && u.UUID() == id
+}
+
+// breedTamed makes the child passed owned by the owner of the parent passed,
+// if the parent is tamed. The child is returned.
+func breedTamed(parent, child *Mob) *Mob {
+	p, ok := tameableOf(parent)
+	if !ok {
+		return child
+	}
+	if id, tamed := p.OwnerUUID(); tamed {
+		c, _ := tameableOf(child)
+		c.SetOwnerUUID(id)
+		child.SetOwner(parent.Owner())
+		if c.conf.Tamed != nil {
+			c.conf.Tamed(child)
+		}
+	}
+	return child
+}
+
+// SitGoal is a Goal that makes a tamed Mob stay in place while it is sitting.
+// It only has effect on Mobs with a TameableBehaviour.
+type SitGoal struct{}
+
+// NewSitGoal creates a SitGoal.
+func NewSitGoal() *SitGoal {
+	return &SitGoal{}
+}
+
+// Controls returns GoalControlMove.
+func (g *SitGoal) Controls() GoalControl {
+	return GoalControlMove
+}
+
+// CanStart returns true if the Mob is sitting.
+func (g *SitGoal) CanStart(m *Mob) bool {
+	t, ok := tameableOf(m)
+	return ok && t.Sitting()
+}
+
+// CanContinue returns true as long as the Mob is sitting.
+func (g *SitGoal) CanContinue(m *Mob) bool {
+	return g.CanStart(m)
+}
+
+// Start stops the movement of the Mob.
+func (g *SitGoal) Start(m *Mob) {
+	m.StopMoving()
+}
+
+// Stop does nothing.
+func (g *SitGoal) Stop(*Mob) {}
+
+// Tick does nothing.
+func (g *SitGoal) Tick(*Mob) {}
@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"math/rand"
+	"time"
+)
+
+// HurtByTargetGoal is a target Goal that makes a Mob target the last entity
+// that attacked it.
+type HurtByTargetGoal struct {
+	handled time.Time
+}
+
+// NewHurtByTargetGoal creates a HurtByTargetGoal.
+func NewHurtByTargetGoal() *HurtByTargetGoal {
+	return &HurtByTargetGoal{}
+}
+
+// Controls returns GoalControlTarget.
+func (g *HurtByTargetGoal) Controls() GoalControl {
+	return GoalControlTarget
+}
+
+// CanStart returns true if the Mob was attacked by a valid target since the
+// goal was last started.
+func (g *HurtByTargetGoal) CanStart(m *Mob) bool {
+	attacker, at := m.LastAttacker()
+	return at.After(g.handled) && validTarget(m, attacker)
+}
+
+// CanContinue returns true as long as the target of the Mob remains valid.
+func (g *HurtByTargetGoal) CanContinue(m *Mob) bool {
+	return validTarget(m, m.Target())
+}
+
+// Start sets the target of the Mob to its last attacker.
+func (g *HurtByTargetGoal) Start(m *Mob) {
+	attacker, at := m.LastAttacker()
+	g.handled = at
+	m.SetTarget(attacker)
+}
+
+// Stop clears the target of the Mob.
+func (g *HurtByTargetGoal) Stop(m *Mob) {
+	m.SetTarget(nil)
+}
+
+// Tick does nothing.
+func (g *HurtByTargetGoal) Tick(*Mob) {}
+
+// NearestTargetGoal is a target Goal that makes a Mob target the nearest
+// entity that matches a filter.
+type NearestTargetGoal struct {
+	distance float64
+	filter   func(e world.Entity) bool
+}
+
+// NewNearestTargetGoal creates a NearestTargetGoal that makes a Mob target the
+// nearest entity within the distance passed for which filter returns true.
+func NewNearestTargetGoal(distance float64, filter func(e world.Entity) bool) *NearestTargetGoal {
+	return &NearestTargetGoal{distance: distance, filter: filter}
+}
+
+// Controls returns GoalControlTarget.
+func (g *NearestTargetGoal) Controls() GoalControl {
+	return GoalControlTarget
+}
+
+// CanStart randomly returns true if a matching entity is within the distance
+// of the Mob.
+func (g *NearestTargetGoal) CanStart(m *Mob) bool {
+	if rand.Intn(10) != 0 {
+		return false
+	}
+	if e := nearestEntity(m, g.distance, g.filter); e != nil {
+		m.SetTarget(e)
+		return true
+	}
+	return false
+}
+
+// CanContinue returns true as long as the target is valid and within the
+// distance of the Mob.
+func (g *NearestTargetGoal) CanContinue(m *Mob) bool {
+	target := m.Target()
+	return validTarget(m, target) && target.Position().Sub(m.Position()).Len() <= g.distance
+}
+
+// Start does nothing. The target is set by CanStart.
+func (g *NearestTargetGoal) Start(*Mob) {}
+
+// Stop clears the target of the Mob.
+func (g *NearestTargetGoal) Stop(m *Mob) {
+	m.SetTarget(nil)
+}
+
+// Tick does nothing.
+func (g *NearestTargetGoal) Tick(*Mob) {}
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"reflect"
+	"sync"
+	"time"
+)
+
+type (
+	// CustomTeleportCause is used for teleports that are not caused by
+	// anything in the game itself, for example when a minigame teleports
+	// players to an arena.
+	CustomTeleportCause struct {
+		// KeepMotion specifies if the velocity of the entity should be kept
+		// when it is teleported.
+		KeepMotion bool
+	}
+
+	// CommandTeleportCause is used for teleports caused by a command being
+	// executed.
+	CommandTeleportCause struct{}
+
+	// EnderPearlTeleportCause is used for teleports caused by an ender pearl
+	// thrown by the entity landing.
+	EnderPearlTeleportCause struct {
+		// Pearl is the ender pearl entity that caused the teleport.
+		Pearl world.Entity
+	}
+)
+
+func (c CustomTeleportCause) ConservesMotion() bool     { return c.KeepMotion }
+func (CustomTeleportCause) Cooldown() time.Duration     { return 0 }
+func (CommandTeleportCause) ConservesMotion() bool      { return false }
+func (CommandTeleportCause) Cooldown() time.Duration    { return 0 }
+func (EnderPearlTeleportCause) ConservesMotion() bool   { return false }
+func (EnderPearlTeleportCause) Cooldown() time.Duration { return 0 }
+
+// teleportState teleports entities other than players with a
+// world.TeleportCause, keeping track of the cooldowns of the causes that the
+// entity was teleported with.
+type teleportState struct {
+	mu        sync.Mutex
+	cooldowns map[reflect.Type]time.Time
+}
+
+// teleport teleports the entity passed to a position in the world.World
+// passed, or in its current world if w is nil. A position outside the
+// world.Border of the world is first clamped to the edge of the border.
+// HandleEntityTeleport of the current world of the entity is called, and
+// cancelling it prevents the teleport. move is called to change the position
+// of the entity, keeping its velocity if keepVel is true. teleport returns
+// true if the entity was teleported.
+func (t *teleportState) teleport(e world.Entity, w *world.World, pos mgl64.Vec3, cause world.TeleportCause, move func(pos mgl64.Vec3, keepVel bool)) bool {
+	current, ok := world.OfEntity(e)
+	if !ok {
+		return false
+	}
+	if w == nil {
+		w = current
+	}
+	pos = w.Border().Clamp(pos)
+	t.mu.Lock()
+	until, ok := t.cooldowns[reflect.TypeOf(cause)]
+	t.mu.Unlock()
+	if ok && time.Now().Before(until) {
+		return false
+	}
+	ctx := event.C()
+	if current.Handler().HandleEntityTeleport(ctx, e, w, pos, cause); ctx.Cancelled() {
+		return false
+	}
+	if d := cause.Cooldown(); d > 0 {
+		t.mu.Lock()
+		if t.cooldowns == nil {
+			t.cooldowns = make(map[reflect.Type]time.Time)
+		}
+		t.cooldowns[reflect.TypeOf(cause)] = time.Now().Add(d)
+		t.mu.Unlock()
+	}
+
+	if w != current {
+		// Entities can only ride entities in the same world, so the entity
+		// leaves its vehicle and passengers behind.
+		Dismount(e)
+		Eject(e)
+		move(pos, cause.ConservesMotion())
+		w.AddEntity(e)
+		return true
+	}
+	move(pos, cause.ConservesMotion())
+	for _, v := range w.Viewers(pos) {
+		v.ViewEntityTeleport(e, pos)
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// TemptGoal is a Goal that makes a Mob follow players that hold an item that
+// tempts it, such as wheat for cows.
+type TemptGoal struct {
+	speed  float64
+	tempts func(it world.Item) bool
+	player world.Entity
+	repath int
+}
+
+// NewTemptGoal creates a TemptGoal that makes a Mob follow players holding an
+// item for which tempts returns true with the speed modifier passed.
+func NewTemptGoal(speed float64, tempts func(it world.Item) bool) *TemptGoal {
+	return &TemptGoal{speed: speed, tempts: tempts}
+}
+
+// Controls returns GoalControlMove and GoalControlLook.
+func (g *TemptGoal) Controls() GoalControl {
+	return GoalControlMove | GoalControlLook
+}
+
+// CanStart returns true if a player holding a tempting item is within 10
+// blocks of the Mob.
+func (g *TemptGoal) CanStart(m *Mob) bool {
+	g.player = nearestEntity(m, 10, func(e world.Entity) bool {
+		return isPlayer(e) && g.holdsTempting(e)
+	})
+	return g.player != nil
+}
+
+// CanContinue returns true as long as the player is within 10 blocks and
+// still holds a tempting item.
+func (g *TemptGoal) CanContinue(m *Mob) bool {
+	return validTarget(m, g.player) && g.player.Position().Sub(m.Position()).Len() <= 10 && g.holdsTempting(g.player)
+}
+
+// Start starts following the player.
+func (g *TemptGoal) Start(*Mob) {
+	g.repath = 0
+}
+
+// Stop forgets the player and stops the movement of the Mob.
+func (g *TemptGoal) Stop(m *Mob) {
+	g.player = nil
+	m.StopMoving()
+}
+
+// Tick looks at the player and moves towards it until the Mob is within 2.5
+// blocks.
+func (g *TemptGoal) Tick(m *Mob) {
+	m.LookAt(EyePosition(g.player))
+	if g.player.Position().Sub(m.Position()).Len() < 2.5 {
+		m.StopMoving()
+		return
+	}
+	if g.repath--; g.repath <= 0 {
+		g.repath = 10
+		navigateTo(m, g.player.Position(), g.speed)
+	}
+}
+
+// holdsTempting checks if the entity passed holds an item that tempts the
+// Mob.
+func (g *TemptGoal) holdsTempting(e world.Entity) bool {
+	h, ok := e.(interface {
+		HeldItems() (mainHand, offHand item.Stack)
+	})
+	if !ok {
+		return false
+	}
+	main, off := h.HeldItems()
+	return (!main.Empty() && g.tempts(main.Item())) || (!off.Empty() && g.tempts(off.Item()))
+}
@@ -0,0 +1,333 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"golang.org/x/exp/slices"
+	"strings"
+	"sync"
+)
+
+const (
+	// villagerWorkstationRange is the horizontal distance in blocks within
+	// which a villager looks for a workstation to claim.
+	villagerWorkstationRange = 8
+	// villagerRestocksPerDay is the amount of times a villager may restock its
+	// trades every day.
+	villagerRestocksPerDay = 2
+)
+
+// NewVillager creates a villager with the profession passed at the position
+// passed. Villagers trade with players using the default Trades of their
+// profession and restock at their workstation up to twice a day. Unemployed
+// villagers take the profession of the first workstation they find.
+func NewVillager(pos mgl64.Vec3, profession VillagerProfession) *Mob {
+	b := &VillagerBehaviour{profession: profession}
+	b.MerchantBehaviour = MerchantBehaviourConfig{
+		Name:   villagerName(profession),
+		Trades: profession.Trades(),
+	}.New()
+
+	m := MobConfig{
+		MaxHealth: 20,
+		Speed:     0.5,
+		Behaviour: b,
+	}.New(VillagerType{}, pos)
+
+	m.Goals().Add(0, NewPanicGoal(0.6))
+	m.Goals().Add(1, NewTradeGoal())
+	m.Goals().Add(2, NewWorkGoal(0.5))
+	m.Goals().Add(3, NewWanderGoal(0.5))
+	m.Goals().Add(4, NewLookAtPlayerGoal(8))
+	return m
+}
+
+// VillagerBehaviour implements the behaviour of villagers. In addition to the
+// behaviour of other merchants, villagers have a profession and claim a
+// workstation, at which they restock their Trades.
+type VillagerBehaviour struct {
+	*MerchantBehaviour
+
+	mu             sync.Mutex
+	profession     VillagerProfession
+	workstation    cube.Pos
+	hasWorkstation bool
+	restockDay     int
+	restocks       int
+	ticks          int
+	// scanPos and scanVersions hold the position that the villager last
+	// looked for a workstation from without finding one, and the versions of
+	// the chunks it looked in, so that the search is not repeated if nothing
+	// changed.
+	scanPos      cube.Pos
+	scanVersions []uint64
+}
+
+// Profession returns the profession of the villager.
+func (v *VillagerBehaviour) Profession() VillagerProfession {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.profession
+}
+
+// SetProfession changes the profession of the villager, replacing its Trades
+// with the default Trades of the new profession. A workstation claimed for the
+// old profession is released.
+func (v *VillagerBehaviour) SetProfession(m *Mob, p VillagerProfession) {
+	v.mu.Lock()
+	v.profession, v.hasWorkstation = p, false
+	v.mu.Unlock()
+
+	v.SetMerchantName(villagerName(p))
+	v.SetTrades(p.Trades())
+	m.updateState()
+}
+
+// Variant returns the profession of the villager as an int, which decides the
+// clothes of the villager.
+func (v *VillagerBehaviour) Variant() int {
+	return int(v.Profession().Uint8())
+}
+
+// Workstation returns the position of the workstation claimed by the
+// villager. False is returned if the villager has not claimed a workstation.
+func (v *VillagerBehaviour) Workstation() (cube.Pos, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.workstation, v.hasWorkstation
+}
+
+// EncodeNBT encodes the profession of the villager and the amount of times it
+// restocked today to the data passed, in addition to the state of its
+// MerchantBehaviour.
+func (v *VillagerBehaviour) EncodeNBT(m *Mob, data map[string]any) {
+	v.mu.Lock()
+	data["Profession"] = v.profession.Uint8()
+	data["RestockDay"], data["Restocks"] = int32(v.restockDay), int32(v.restocks)
+	v.mu.Unlock()
+	v.MerchantBehaviour.EncodeNBT(m, data)
+}
+
+// DecodeNBT decodes the profession of the villager and the amount of times it
+// restocked today from the data passed, in addition to the state of its
+// MerchantBehaviour.
+func (v *VillagerBehaviour) DecodeNBT(m *Mob, data map[string]any) {
+	if p := int(nbtconv.Uint8(data, "Profession")); p < len(VillagerProfessions()) {
+		// The profession is set first, as it replaces the Trades of which the
+		// uses are decoded by the MerchantBehaviour.
+		v.SetProfession(m, VillagerProfessions()[p])
+	}
+	v.mu.Lock()
+	v.restockDay, v.restocks = int(nbtconv.Int32(data, "RestockDay")), int(nbtconv.Int32(data, "Restocks"))
+	v.mu.Unlock()
+	v.MerchantBehaviour.DecodeNBT(m, data)
+}
+
+// Tick stops the villager from trading once its customer moves away and looks
+// for a workstation to claim every five seconds.
+func (v *VillagerBehaviour) Tick(m *Mob) {
+	v.MerchantBehaviour.Tick(m)
+
+	v.mu.Lock()
+	v.ticks++
+	check := v.ticks%100 == 0
+	v.mu.Unlock()
+	if check {
+		v.updateWorkstation(m)
+	}
+}
+
+// updateWorkstation releases the workstation of the villager if it was broken
+// and looks for a new workstation if the villager has none.
+func (v *VillagerBehaviour) updateWorkstation(m *Mob) {
+	w, p := m.World(), v.Profession()
+	if p == NitwitProfession() {
+		return
+	}
+	if pos, ok := v.Workstation(); ok {
+		if p.Workstation(w.Block(pos)) {
+			return
+		}
+		v.mu.Lock()
+		v.hasWorkstation = false
+		v.mu.Unlock()
+	}
+
+	base := cube.PosFromVec3(m.Position())
+	versions := workstationChunkVersions(w, base)
+	v.mu.Lock()
+	unchanged := v.scanVersions != nil && v.scanPos == base && slices.Equal(v.scanVersions, versions)
+	v.mu.Unlock()
+	if unchanged {
+		// The villager already looked for a workstation here and none of the
+		// blocks around it changed since.
+		return
+	}
+	defer func() {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		if v.hasWorkstation {
+			v.scanVersions = nil
+			return
+		}
+		v.scanPos, v.scanVersions = base, versions
+	}()
+
+	for x := -villagerWorkstationRange; x <= villagerWorkstationRange; x++ {
+		for z := -villagerWorkstationRange; z <= villagerWorkstationRange; z++ {
+			for y := -2; y <= 2; y++ {
+				pos := base.Add(cube.Pos{x, y, z})
+				b := w.Block(pos)
+				if p == UnemployedProfession() {
+					if profession, ok := professionOfWorkstation(b); ok {
+						v.SetProfession(m, profession)
+						p = profession
+					}
+				}
+				if p.Workstation(b) {
+					v.mu.Lock()
+					v.workstation, v.hasWorkstation = pos, true
+					v.mu.Unlock()
+					return
+				}
+			}
+		}
+	}
+}
+
+// workstationChunkVersions returns the versions of the chunks that a villager
+// at the position passed looks for a workstation in.
+func workstationChunkVersions(w *world.World, base cube.Pos) []uint64 {
+	var versions []uint64
+	for x := (base[0] - villagerWorkstationRange) >> 4; x <= (base[0]+villagerWorkstationRange)>>4; x++ {
+		for z := (base[2] - villagerWorkstationRange) >> 4; z <= (base[2]+villagerWorkstationRange)>>4; z++ {
+			versions = append(versions, w.ChunkVersion(world.ChunkPos{int32(x), int32(z)}))
+		}
+	}
+	return versions
+}
+
+// canRestock checks if the villager has used any of its Trades and has not yet
+// restocked the maximum amount of times on the day passed.
+func (v *VillagerBehaviour) canRestock(day int) bool {
+	v.mu.Lock()
+	restocked := v.restockDay == day && v.restocks >= villagerRestocksPerDay
+	v.mu.Unlock()
+	if restocked {
+		return false
+	}
+	for _, t := range v.Trades() {
+		if t.Uses > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// restock restocks the Trades of the villager, counting the restock towards
+// the day passed.
+func (v *VillagerBehaviour) restock(day int) {
+	v.mu.Lock()
+	if v.restockDay != day {
+		v.restockDay, v.restocks = day, 0
+	}
+	v.restocks++
+	v.mu.Unlock()
+	v.Restock()
+}
+
+// villagerName returns the name shown at the top of the trading window of a
+// villager with the profession passed.
+func villagerName(p VillagerProfession) string {
+	s := p.String()
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// WorkGoal is a Goal that makes a villager walk to its workstation during the
+// day to restock its Trades once some of them have been used. It only has
+// effect on Mobs with a VillagerBehaviour.
+type WorkGoal struct {
+	speed  float64
+	pos    cube.Pos
+	repath int
+}
+
+// NewWorkGoal creates a WorkGoal that makes a villager walk to its
+// workstation with the speed modifier passed.
+func NewWorkGoal(speed float64) *WorkGoal {
+	return &WorkGoal{speed: speed}
+}
+
+// Controls returns GoalControlMove.
+func (g *WorkGoal) Controls() GoalControl {
+	return GoalControlMove
+}
+
+// CanStart returns true if it is day, the villager has a workstation and
+// needs to restock.
+func (g *WorkGoal) CanStart(m *Mob) bool {
+	v, ok := m.Behaviour().(*VillagerBehaviour)
+	if !ok {
+		return false
+	}
+	t := m.World().Time()
+	if t%24000 >= 12000 || !v.canRestock(t/24000) {
+		return false
+	}
+	g.pos, ok = v.Workstation()
+	return ok
+}
+
+// CanContinue returns true as long as the villager has not reached its
+// workstation.
+func (g *WorkGoal) CanContinue(m *Mob) bool {
+	v := m.Behaviour().(*VillagerBehaviour)
+	pos, ok := v.Workstation()
+	return ok && pos == g.pos && v.canRestock(m.World().Time()/24000)
+}
+
+// Start starts walking to the workstation.
+func (g *WorkGoal) Start(*Mob) {
+	g.repath = 0
+}
+
+// Stop stops the movement of the villager.
+func (g *WorkGoal) Stop(m *Mob) {
+	m.StopMoving()
+}
+
+// Tick walks the villager to its workstation and restocks its Trades once it
+// is within two blocks of it.
+func (g *WorkGoal) Tick(m *Mob) {
+	target := g.pos.Vec3Middle()
+	if target.Sub(m.Position()).Len() <= 2 {
+		m.LookAt(target)
+		m.Behaviour().(*VillagerBehaviour).restock(m.World().Time() / 24000)
+		return
+	}
+	if g.repath--; g.repath <= 0 {
+		g.repath = 20
+		navigateTo(m, target, g.speed)
+	}
+}
+
+// VillagerType is a world.EntityType implementation for villagers.
+type VillagerType struct{}
+
+func (VillagerType) EncodeEntity() string { return "minecraft:villager_v2" }
+func (VillagerType) Spawn(pos mgl64.Vec3) world.Entity {
+	return NewVillager(pos, UnemployedProfession())
+}
+func (VillagerType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.95, 0.3)
+}
+
+func (VillagerType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMobNBT(NewVillager(nbtconv.Vec3(data, "Pos"), UnemployedProfession()), data)
+}
+
+func (VillagerType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Mob))
+}
@@ -0,0 +1,339 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// VillagerProfession is the profession of a villager. The profession decides
+// the Trades that a villager offers and the workstation at which it restocks.
+type VillagerProfession struct {
+	villagerProfession
+}
+
+// UnemployedProfession returns the profession of villagers that have not yet
+// claimed a workstation. Unemployed villagers do not trade and take the
+// profession of the first workstation they find.
+func UnemployedProfession() VillagerProfession {
+	return VillagerProfession{0}
+}
+
+// FarmerProfession returns the farmer profession, which uses a composter.
+func FarmerProfession() VillagerProfession {
+	return VillagerProfession{1}
+}
+
+// FishermanProfession returns the fisherman profession, which uses a barrel.
+func FishermanProfession() VillagerProfession {
+	return VillagerProfession{2}
+}
+
+// ShepherdProfession returns the shepherd profession, which uses a loom.
+func ShepherdProfession() VillagerProfession {
+	return VillagerProfession{3}
+}
+
+// FletcherProfession returns the fletcher profession, which uses a fletching
+// table.
+func FletcherProfession() VillagerProfession {
+	return VillagerProfession{4}
+}
+
+// LibrarianProfession returns the librarian profession. Lecterns, the
+// workstation of librarians, are not implemented, so librarians never restock
+// by themselves.
+func LibrarianProfession() VillagerProfession {
+	return VillagerProfession{5}
+}
+
+// CartographerProfession returns the cartographer profession. Cartography
+// tables, the workstation of cartographers, are not implemented, so
+// cartographers never restock by themselves.
+func CartographerProfession() VillagerProfession {
+	return VillagerProfession{6}
+}
+
+// ClericProfession returns the cleric profession. Brewing stands, the
+// workstation of clerics, are not implemented, so clerics never restock by
+// themselves.
+func ClericProfession() VillagerProfession {
+	return VillagerProfession{7}
+}
+
+// ArmourerProfession returns the armourer profession, which uses a blast
+// furnace.
+func ArmourerProfession() VillagerProfession {
+	return VillagerProfession{8}
+}
+
+// WeaponsmithProfession returns the weaponsmith profession, which uses a
+// grindstone.
+func WeaponsmithProfession() VillagerProfession {
+	return VillagerProfession{9}
+}
+
+// ToolsmithProfession returns the toolsmith profession, which uses a smithing
+// table.
+func ToolsmithProfession() VillagerProfession {
+	return VillagerProfession{10}
+}
+
+// ButcherProfession returns the butcher profession, which uses a smoker.
+func ButcherProfession() VillagerProfession {
+	return VillagerProfession{11}
+}
+
+// LeatherworkerProfession returns the leatherworker profession, which uses a
+// cauldron.
+func LeatherworkerProfession() VillagerProfession {
+	return VillagerProfession{12}
+}
+
+// MasonProfession returns the mason profession, which uses a stonecutter.
+func MasonProfession() VillagerProfession {
+	return VillagerProfession{13}
+}
+
+// NitwitProfession returns the nitwit profession. Nitwits never trade and
+// never claim a workstation.
+func NitwitProfession() VillagerProfession {
+	return VillagerProfession{14}
+}
+
+// VillagerProfessions returns a list of all existing villager professions.
+func VillagerProfessions() []VillagerProfession {
+	return []VillagerProfession{
+		UnemployedProfession(), FarmerProfession(), FishermanProfession(), ShepherdProfession(), FletcherProfession(),
+		LibrarianProfession(), CartographerProfession(), ClericProfession(), ArmourerProfession(), WeaponsmithProfession(),
+		ToolsmithProfession(), ButcherProfession(), LeatherworkerProfession(), MasonProfession(), NitwitProfession(),
+	}
+}
+
+// villagerProfession is the underlying value of a VillagerProfession struct.
+type villagerProfession uint8
+
+// Uint8 returns the profession as a uint8.
+func (p villagerProfession) Uint8() uint8 {
+	return uint8(p)
+}
+
+// String returns the profession as a string.
+func (p villagerProfession) String() string {
+	switch p {
+	case 1:
+		return "farmer"
+	case 2:
+		return "fisherman"
+	case 3:
+		return "shepherd"
+	case 4:
+		return "fletcher"
+	case 5:
+		return "librarian"
+	case 6:
+		return "cartographer"
+	case 7:
+		return "cleric"
+	case 8:
+		return "armourer"
+	case 9:
+		return "weaponsmith"
+	case 10:
+		return "toolsmith"
+	case 11:
+		return "butcher"
+	case 12:
+		return "leatherworker"
+	case 13:
+		return "mason"
+	case 14:
+		return "nitwit"
+	}
+	return "unemployed"
+}
+
+// Workstation checks if the block passed is the workstation of the
+// profession.
+func (p villagerProfession) Workstation(b world.Block) bool {
+	switch b.(type) {
+	case block.Composter:
+		return p == 1
+	case block.Barrel:
+		return p == 2
+	case block.Loom:
+		return p == 3
+	case block.FletchingTable:
+		return p == 4
+	case block.BlastFurnace:
+		return p == 8
+	case block.Grindstone:
+		return p == 9
+	case block.SmithingTable:
+		return p == 10
+	case block.Smoker:
+		return p == 11
+	case block.Cauldron:
+		return p == 12
+	case block.Stonecutter:
+		return p == 13
+	}
+	return false
+}
+
+// professionOfWorkstation returns the profession that uses the block passed
+// as its workstation. False is returned if the block is not a workstation.
+func professionOfWorkstation(b world.Block) (VillagerProfession, bool) {
+	for _, p := range VillagerProfessions() {
+		if p.Workstation(b) {
+			return p, true
+		}
+	}
+	return VillagerProfession{}, false
+}
+
+// Trades returns the default Trades offered by villagers with the profession.
+func (p villagerProfession) Trades() []Trade {
+	emerald := item.Emerald{}
+	switch p {
+	case 1:
+		return []Trade{
+			buyTrade(item.NewStack(item.Wheat{}, 20), 1, 0, 2),
+			buyTrade(item.NewStack(block.Potato{}, 26), 1, 0, 2),
+			sellTrade(1, item.NewStack(item.Bread{}, 6), 1, 5),
+			buyTrade(item.NewStack(block.Pumpkin{}, 6), 1, 1, 10),
+			sellTrade(1, item.NewStack(item.Apple{}, 4), 2, 10),
+			buyTrade(item.NewStack(block.Melon{}, 4), 1, 2, 20),
+			sellTrade(3, item.NewStack(item.Cookie{}, 18), 3, 15),
+			sellTrade(3, item.NewStack(item.GoldenCarrot{}, 3), 4, 30),
+			sellTrade(4, item.NewStack(item.GlisteringMelonSlice{}, 3), 4, 30),
+		}
+	case 2:
+		return []Trade{
+			buyTrade(item.NewStack(item.Coal{}, 10), 1, 0, 2),
+			{Input: item.NewStack(item.Cod{}, 6), SecondInput: item.NewStack(emerald, 1), Output: item.NewStack(item.Cod{Cooked: true}, 6), MaxUses: 16, Experience: 1},
+			buyTrade(item.NewStack(item.Cod{}, 15), 1, 1, 10),
+			{Input: item.NewStack(item.Salmon{}, 6), SecondInput: item.NewStack(emerald, 1), Output: item.NewStack(item.Salmon{Cooked: true}, 6), MaxUses: 16, Tier: 1, Experience: 5},
+			buyTrade(item.NewStack(item.Salmon{}, 13), 1, 2, 20),
+			buyTrade(item.NewStack(item.TropicalFish{}, 6), 1, 3, 30),
+			buyTrade(item.NewStack(item.Pufferfish{}, 4), 1, 4, 30),
+		}
+	case 3:
+		return []Trade{
+			buyTrade(item.NewStack(block.Wool{Colour: item.ColourWhite()}, 18), 1, 0, 2),
+			sellTrade(2, item.NewStack(item.Shears{}, 1), 0, 1),
+			buyTrade(item.NewStack(item.Dye{Colour: item.ColourWhite()}, 12), 1, 1, 10),
+			sellTrade(1, item.NewStack(block.Wool{Colour: item.ColourWhite()}, 1), 1, 5),
+			buyTrade(item.NewStack(item.Dye{Colour: item.ColourYellow()}, 12), 1, 2, 20),
+			sellTrade(1, item.NewStack(block.Wool{Colour: item.ColourLightBlue()}, 1), 3, 15),
+			sellTrade(2, item.NewStack(block.Wool{Colour: item.ColourRed()}, 1), 4, 30),
+		}
+	case 4:
+		return []Trade{
+			buyTrade(item.NewStack(item.Stick{}, 32), 1, 0, 2),
+			sellTrade(1, item.NewStack(item.Arrow{}, 16), 0, 1),
+			buyTrade(item.NewStack(item.Flint{}, 26), 1, 1, 10),
+			sellTrade(2, item.NewStack(item.Bow{}, 1), 1, 5),
+			buyTrade(item.NewStack(item.Feather{}, 24), 1, 3, 30),
+		}
+	case 5:
+		return []Trade{
+			buyTrade(item.NewStack(item.Paper{}, 24), 1, 0, 2),
+			sellTrade(9, item.NewStack(block.Bookshelf{}, 1), 0, 1),
+			buyTrade(item.NewStack(item.Book{}, 4), 1, 1, 10),
+			sellTrade(1, item.NewStack(block.Glass{}, 4), 2, 10),
+			sellTrade(5, item.NewStack(item.Clock{}, 1), 3, 15),
+			sellTrade(5, item.NewStack(item.Compass{}, 1), 3, 15),
+		}
+	case 6:
+		return []Trade{
+			buyTrade(item.NewStack(item.Paper{}, 24), 1, 0, 2),
+			buyTrade(item.NewStack(item.Compass{}, 1), 1, 2, 20),
+			sellTrade(7, item.NewStack(item.Compass{}, 1), 3, 15),
+		}
+	case 7:
+		return []Trade{
+			buyTrade(item.NewStack(item.RottenFlesh{}, 32), 1, 0, 2),
+			sellTrade(1, item.NewStack(item.LapisLazuli{}, 1), 1, 5),
+			buyTrade(item.NewStack(item.GoldIngot{}, 3), 1, 1, 10),
+			buyTrade(item.NewStack(item.RabbitFoot{}, 2), 1, 2, 20),
+			sellTrade(4, item.NewStack(block.Glowstone{}, 1), 2, 10),
+			buyTrade(item.NewStack(item.Scute{}, 4), 1, 3, 30),
+			sellTrade(5, item.NewStack(item.EnderPearl{}, 1), 3, 15),
+			sellTrade(3, item.NewStack(item.BottleOfEnchanting{}, 1), 4, 30),
+		}
+	case 8:
+		return []Trade{
+			buyTrade(item.NewStack(item.Coal{}, 15), 1, 0, 2),
+			sellTrade(5, item.NewStack(item.Helmet{Tier: item.ArmourTierIron{}}, 1), 0, 1),
+			buyTrade(item.NewStack(item.IronIngot{}, 4), 1, 1, 10),
+			sellTrade(9, item.NewStack(item.Chestplate{Tier: item.ArmourTierIron{}}, 1), 1, 5),
+			buyTrade(item.NewStack(item.Diamond{}, 1), 1, 2, 20),
+			sellTrade(13, item.NewStack(item.Boots{Tier: item.ArmourTierDiamond{}}, 1), 3, 15),
+			sellTrade(21, item.NewStack(item.Chestplate{Tier: item.ArmourTierDiamond{}}, 1), 4, 30),
+		}
+	case 9:
+		return []Trade{
+			buyTrade(item.NewStack(item.Coal{}, 15), 1, 0, 2),
+			sellTrade(3, item.NewStack(item.Axe{Tier: item.ToolTierIron}, 1), 0, 1),
+			buyTrade(item.NewStack(item.IronIngot{}, 4), 1, 1, 10),
+			sellTrade(7, item.NewStack(item.Sword{Tier: item.ToolTierIron}, 1), 1, 5),
+			buyTrade(item.NewStack(item.Flint{}, 24), 1, 2, 20),
+			buyTrade(item.NewStack(item.Diamond{}, 1), 1, 3, 30),
+			sellTrade(17, item.NewStack(item.Axe{Tier: item.ToolTierDiamond}, 1), 4, 30),
+		}
+	case 10:
+		return []Trade{
+			buyTrade(item.NewStack(item.Coal{}, 15), 1, 0, 2),
+			sellTrade(1, item.NewStack(item.Pickaxe{Tier: item.ToolTierStone}, 1), 0, 1),
+			buyTrade(item.NewStack(item.IronIngot{}, 4), 1, 1, 10),
+			sellTrade(1, item.NewStack(item.Shovel{Tier: item.ToolTierStone}, 1), 1, 5),
+			buyTrade(item.NewStack(item.Flint{}, 30), 1, 2, 20),
+			buyTrade(item.NewStack(item.Diamond{}, 1), 1, 3, 30),
+			sellTrade(13, item.NewStack(item.Pickaxe{Tier: item.ToolTierDiamond}, 1), 4, 30),
+		}
+	case 11:
+		return []Trade{
+			buyTrade(item.NewStack(item.Chicken{}, 14), 1, 0, 2),
+			buyTrade(item.NewStack(item.Porkchop{}, 7), 1, 0, 2),
+			buyTrade(item.NewStack(item.Coal{}, 15), 1, 1, 10),
+			sellTrade(1, item.NewStack(item.Porkchop{Cooked: true}, 5), 1, 5),
+			buyTrade(item.NewStack(item.Mutton{}, 7), 1, 2, 20),
+			buyTrade(item.NewStack(item.Beef{}, 10), 1, 2, 20),
+			buyTrade(item.NewStack(item.DriedKelp{}, 10), 1, 4, 30),
+		}
+	case 12:
+		return []Trade{
+			buyTrade(item.NewStack(item.Leather{}, 6), 1, 0, 2),
+			sellTrade(3, item.NewStack(item.Leggings{Tier: item.ArmourTierLeather{}}, 1), 0, 1),
+			buyTrade(item.NewStack(item.Flint{}, 26), 1, 1, 10),
+			sellTrade(7, item.NewStack(item.Chestplate{Tier: item.ArmourTierLeather{}}, 1), 1, 5),
+			buyTrade(item.NewStack(item.RabbitHide{}, 9), 1, 2, 20),
+			buyTrade(item.NewStack(item.Scute{}, 4), 1, 3, 30),
+			sellTrade(5, item.NewStack(item.Helmet{Tier: item.ArmourTierLeather{}}, 1), 4, 30),
+		}
+	case 13:
+		return []Trade{
+			buyTrade(item.NewStack(item.ClayBall{}, 10), 1, 0, 2),
+			sellTrade(1, item.NewStack(item.Brick{}, 10), 0, 1),
+			buyTrade(item.NewStack(block.Stone{}, 20), 1, 1, 10),
+			sellTrade(1, item.NewStack(block.Stone{Smooth: true}, 4), 1, 5),
+			buyTrade(item.NewStack(item.NetherQuartz{}, 12), 1, 3, 30),
+			sellTrade(1, item.NewStack(block.Terracotta{}, 1), 3, 15),
+			sellTrade(1, item.NewStack(block.Quartz{}, 1), 4, 30),
+		}
+	}
+	return nil
+}
+
+// buyTrade returns a Trade in which a villager buys the item passed for an
+// amount of emeralds.
+func buyTrade(input item.Stack, emeralds, tier, experience int) Trade {
+	return Trade{Input: input, Output: item.NewStack(item.Emerald{}, emeralds), MaxUses: 16, Tier: tier, Experience: experience}
+}
+
+// sellTrade returns a Trade in which a villager sells the item passed for an
+// amount of emeralds.
+func sellTrade(emeralds int, output item.Stack, tier, experience int) Trade {
+	return Trade{Input: item.NewStack(item.Emerald{}, emeralds), Output: output, MaxUses: 12, Tier: tier, Experience: experience}
+}
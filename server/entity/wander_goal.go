@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// WanderGoal is a Goal that makes a Mob walk to random positions around it
+// every now and then.
+type WanderGoal struct {
+	speed float64
+}
+
+// NewWanderGoal creates a WanderGoal that makes a Mob wander around with the
+// speed modifier passed.
+func NewWanderGoal(speed float64) *WanderGoal {
+	return &WanderGoal{speed: speed}
+}
+
+// Controls returns GoalControlMove.
+func (g *WanderGoal) Controls() GoalControl {
+	return GoalControlMove
+}
+
+// CanStart randomly returns true roughly once every 6 seconds.
+func (g *WanderGoal) CanStart(*Mob) bool {
+	return rand.Intn(120) == 0
+}
+
+// CanContinue returns true as long as the Mob has not yet reached the position
+// it is wandering to.
+func (g *WanderGoal) CanContinue(m *Mob) bool {
+	return m.Moving()
+}
+
+// Start makes the Mob move to a random position up to 10 blocks away from it.
+func (g *WanderGoal) Start(m *Mob) {
+	navigateTo(m, m.Position().Add(mgl64.Vec3{rand.Float64()*20 - 10, 0, rand.Float64()*20 - 10}), g.speed)
+}
+
+// Stop stops the movement of the Mob.
+func (g *WanderGoal) Stop(m *Mob) {
+	m.StopMoving()
+}
+
+// Tick does nothing. The Mob moves to its target position by itself.
+func (g *WanderGoal) Tick(*Mob) {}
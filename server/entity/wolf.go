@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// NewWolf creates a wild wolf at the position passed. Wolves may be tamed
+// using bones, after which they follow their owner, may be told to sit and
+// may be bred using meat. Wolves attack any entity that hurts them.
+func NewWolf(pos mgl64.Vec3) *Mob {
+	m := MobConfig{
+		MaxHealth:    8,
+		Speed:        0.3,
+		AttackDamage: 4,
+		Experience:   rand.Intn(3) + 1,
+		Behaviour: TameableBehaviourConfig{
+			Animal: AnimalBehaviourConfig{
+				Food: wolfFood,
+				Breed: func(a, _ *Mob) *Mob {
+					return breedTamed(a, NewWolf(a.Position()))
+				},
+			},
+			TameItem: func(it world.Item) bool {
+				_, ok := it.(item.Bone)
+				return ok
+			},
+			Tamed: func(m *Mob) {
+				m.SetMaxHealth(20)
+				m.Heal(20, FoodHealingSource{})
+			},
+		}.New(),
+	}.New(WolfType{}, pos)
+
+	m.Goals().Add(1, NewSitGoal())
+	m.Goals().Add(2, NewBreedGoal(1))
+	m.Goals().Add(3, NewMeleeAttackGoal(1))
+	m.Goals().Add(4, NewFollowOwnerGoal(1, 10, 2))
+	m.Goals().Add(5, NewFollowParentGoal(1.1))
+	m.Goals().Add(6, NewWanderGoal(1))
+	m.Goals().Add(7, NewLookAtPlayerGoal(8))
+
+	m.Targets().Add(1, NewHurtByTargetGoal())
+	return m
+}
+
+// wolfFood checks if the item passed is meat that may be fed to wolves.
+func wolfFood(it world.Item) bool {
+	switch it.(type) {
+	case item.Beef, item.Chicken, item.Mutton, item.Porkchop, item.Rabbit, item.RottenFlesh:
+		return true
+	}
+	return false
+}
+
+// WolfType is a world.EntityType implementation for wolves.
+type WolfType struct{}
+
+func (WolfType) EncodeEntity() string              { return "minecraft:wolf" }
+func (WolfType) Spawn(pos mgl64.Vec3) world.Entity { return NewWolf(pos) }
+func (WolfType) BBox(e world.Entity) cube.BBox {
+	return animalBBox(e, 0.6, 0.85)
+}
+
+func (WolfType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMobNBT(NewWolf(nbtconv.Vec3(data, "Pos")), data)
+}
+
+func (WolfType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Mob))
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// NewZombie creates a zombie at the position passed. The zombie attacks
+// players within 35 blocks and any entity that hurts it, and wanders around
+// otherwise.
+func NewZombie(pos mgl64.Vec3) *Mob {
+	m := MobConfig{
+		MaxHealth:    20,
+		Speed:        0.23,
+		AttackDamage: 3,
+		Drops: func(*Mob) []item.Stack {
+			if n := rand.Intn(3); n > 0 {
+				return []item.Stack{item.NewStack(item.RottenFlesh{}, n)}
+			}
+			return nil
+		},
+		Experience:         5,
+		Sinks:              true,
+		BreathesUnderwater: true,
+		Undead:             true,
+	}.New(ZombieType{}, pos)
+
+	m.Goals().Add(2, NewMeleeAttackGoal(1))
+	m.Goals().Add(7, NewWanderGoal(0.8))
+	m.Goals().Add(8, NewLookAtPlayerGoal(8))
+
+	m.Targets().Add(1, NewHurtByTargetGoal())
+	m.Targets().Add(2, NewNearestTargetGoal(35, isPlayer))
+	return m
+}
+
+// ZombieType is a world.EntityType implementation for zombies.
+type ZombieType struct{}
+
+func (ZombieType) EncodeEntity() string              { return "minecraft:zombie" }
+func (ZombieType) Spawn(pos mgl64.Vec3) world.Entity { return NewZombie(pos) }
+func (ZombieType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.95, 0.3)
+}
+
+func (ZombieType) DecodeNBT(data map[string]any) world.Entity {
+	return decodeMobNBT(NewZombie(nbtconv.Vec3(data, "Pos")), data)
+}
+
+func (ZombieType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Mob))
+}
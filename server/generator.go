@@ -0,0 +1,160 @@
+package server
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/biome"
+	"github.com/df-mc/dragonfly/server/world/generator"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// GeneratorPreset creates a world.Generator for a world.Dimension. settings is the generator settings string set in
+// the configuration of the server, which may be empty. The format of the string is decided by the GeneratorPreset.
+type GeneratorPreset func(dim world.Dimension, settings string) (world.Generator, error)
+
+// generatorPresets holds all GeneratorPresets that may be selected in the configuration of the server, indexed by
+// their name.
+var generatorPresets = map[string]GeneratorPreset{
+	"default":   defaultGenerator,
+	"flat":      flatGenerator,
+	"void":      voidGenerator,
+	"terrain":   terrainGenerator(false),
+	"amplified": terrainGenerator(true),
+}
+
+// RegisterGeneratorPreset registers a GeneratorPreset under the name passed, so that it may be selected as generator
+// in the configuration of the server. Registering a GeneratorPreset with the name of an existing one replaces it.
+// RegisterGeneratorPreset must be called before UserConfig.Config is called.
+func RegisterGeneratorPreset(name string, preset GeneratorPreset) {
+	generatorPresets[strings.ToLower(name)] = preset
+}
+
+// presetGenerator returns a function that returns the world.Generator of the GeneratorPreset with the name passed for
+// the overworld. The Nether and End always use their standard generator, as presets such as 'flat' describe overworld
+// terrain only. An error is returned if no GeneratorPreset with the name exists or if creating its generator failed.
+func presetGenerator(name, settings string) (func(dim world.Dimension) world.Generator, error) {
+	if name == "" {
+		name = "default"
+	}
+	preset, ok := generatorPresets[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown generator preset %q", name)
+	}
+	g, err := preset(world.Overworld, settings)
+	if err != nil {
+		return nil, fmt.Errorf("create overworld generator of preset %q: %w", name, err)
+	}
+	return func(dim world.Dimension) world.Generator {
+		if dim == world.Overworld {
+			return g
+		}
+		return loadGenerator(dim)
+	}, nil
+}
+
+// defaultGenerator returns the standard generator of a world.Dimension, as returned by loadGenerator. The settings
+// are ignored.
+func defaultGenerator(dim world.Dimension, _ string) (world.Generator, error) {
+	return loadGenerator(dim), nil
+}
+
+// voidGenerator returns a generator.Flat without any layers. The settings are ignored.
+func voidGenerator(dim world.Dimension, _ string) (world.Generator, error) {
+	return generator.NewFlat(dimensionBiome(dim), nil), nil
+}
+
+// flatGenerator returns a generator.Flat with the layers described by the settings passed. The settings hold the
+// item names of the layers from the bottom up, separated by commas, with an optional amount of layers followed by '*'
+// in front of each name. The layers may be followed by a semicolon and the name of the biome, for example:
+// 'minecraft:bedrock,2*minecraft:dirt,minecraft:grass;plains'. The standard generator of the world.Dimension is
+// returned if the settings are empty.
+func flatGenerator(dim world.Dimension, settings string) (world.Generator, error) {
+	if settings == "" {
+		return loadGenerator(dim), nil
+	}
+	layerSettings, biomeName, hasBiome := strings.Cut(settings, ";")
+	b := dimensionBiome(dim)
+	if hasBiome {
+		var ok bool
+		if b, ok = world.BiomeByName(strings.TrimPrefix(strings.TrimSpace(biomeName), "minecraft:")); !ok {
+			return nil, fmt.Errorf("unknown biome %q", biomeName)
+		}
+	}
+	var layers []world.Block
+	height := dim.Range().Height()
+	for _, layer := range strings.Split(layerSettings, ",") {
+		layer = strings.TrimSpace(layer)
+		if layer == "" {
+			continue
+		}
+		n := 1
+		if count, name, ok := strings.Cut(layer, "*"); ok {
+			var err error
+			if n, err = strconv.Atoi(count); err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid layer count %q", count)
+			}
+			layer = name
+		}
+		it, ok := world.ItemByName(layer, 0)
+		if !ok {
+			return nil, fmt.Errorf("unknown block %q", layer)
+		}
+		bl, ok := it.(world.Block)
+		if !ok {
+			return nil, fmt.Errorf("%q is not a block", layer)
+		}
+		if n > height-len(layers) {
+			return nil, fmt.Errorf("layers exceed the height of %v blocks of the dimension", height)
+		}
+		for i := 0; i < n; i++ {
+			layers = append(layers, bl)
+		}
+	}
+	// generator.Flat places the last layer at the bottom, so the layers are reversed.
+	for i, j := 0, len(layers)-1; i < j; i, j = i+1, j-1 {
+		layers[i], layers[j] = layers[j], layers[i]
+	}
+	return generator.NewFlat(b, layers), nil
+}
+
+// terrainGenerator returns a GeneratorPreset that creates a generator.Terrain. The settings hold the seed of the
+// terrain, which is either a number or any other string that is hashed to produce a seed. An empty string results in
+// a seed of 0.
+func terrainGenerator(amplified bool) GeneratorPreset {
+	return func(dim world.Dimension, settings string) (world.Generator, error) {
+		var seed int64
+		if settings != "" {
+			var err error
+			if seed, err = strconv.ParseInt(settings, 10, 64); err != nil {
+				h := fnv.New64a()
+				_, _ = h.Write([]byte(settings))
+				seed = int64(h.Sum64())
+			}
+		}
+		conf := generator.TerrainConfig{Seed: seed, Biome: dimensionBiome(dim), Amplified: amplified}
+		switch dim {
+		case world.Nether:
+			conf.Surface, conf.Filler, conf.Base, conf.Water = block.Netherrack{}, block.Netherrack{}, block.Netherrack{}, block.Lava{Still: true, Depth: 8}
+		case world.End:
+			conf.Surface, conf.Filler, conf.Base = block.EndStone{}, block.EndStone{}, block.EndStone{}
+		default:
+			conf.Surface, conf.Filler, conf.Base, conf.Water = block.Grass{}, block.Dirt{}, block.Stone{}, block.Water{Still: true, Depth: 8}
+			conf.Caves, conf.Rivers = true, true
+		}
+		return generator.NewTerrain(conf), nil
+	}
+}
+
+// dimensionBiome returns the world.Biome used by the standard generator of the world.Dimension passed.
+func dimensionBiome(dim world.Dimension) world.Biome {
+	switch dim {
+	case world.Nether:
+		return biome.NetherWastes{}
+	case world.End:
+		return biome.End{}
+	}
+	return biome.Plains{}
+}
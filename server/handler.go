@@ -0,0 +1,39 @@
+package server
+
+// Handler handles events that occur during the lifecycle of a Server. Embedders may implement Handler to run code
+// at specific phases, such as initialising a database once the Server is ready or draining traffic when the
+// Server starts shutting down. Handler implementations may embed NopHandler to implement only the methods they need.
+// A Handler may be set using Config.Handler to also receive events that occur while the Server is created, or using
+// Server.Handle afterwards.
+type Handler interface {
+	// HandleReady handles the Server being created. It is called once, right before Config.New returns, so only a
+	// Handler set in Config.Handler receives it. The overworld, nether and end of the Server are already loaded
+	// when HandleReady is called, so it may be used to, for example, attach world handlers or initialise a
+	// database. Blocks, items, entity types and generators are used when the worlds are created, so they must be
+	// registered or set in the Config before Config.New is called rather than in HandleReady.
+	HandleReady(srv *Server)
+	// HandleListen handles the Server starting to listen for connections. It is called when Server.Listen is
+	// called, before any of the listeners of the Server are started.
+	HandleListen(srv *Server)
+	// HandleFirstTick handles the first tick of the overworld after the Server started listening.
+	HandleFirstTick(srv *Server)
+	// HandleShutdown handles the Server starting to shut down. It is called before any players are disconnected,
+	// so that they may, for example, be transferred to a different server.
+	HandleShutdown(srv *Server)
+	// HandleShutdownComplete handles the Server being completely shut down. All players are disconnected and
+	// all worlds and listeners are closed when it is called.
+	HandleShutdownComplete(srv *Server)
+}
+
+// NopHandler implements the Handler interface but does not execute any code when an event is called. The default
+// Handler of a Server is NopHandler.
+type NopHandler struct{}
+
+// Compile time check to make sure NopHandler implements Handler.
+var _ Handler = (*NopHandler)(nil)
+
+func (NopHandler) HandleReady(*Server)            {}
+func (NopHandler) HandleListen(*Server)           {}
+func (NopHandler) HandleFirstTick(*Server)        {}
+func (NopHandler) HandleShutdown(*Server)         {}
+func (NopHandler) HandleShutdownComplete(*Server) {}
@@ -0,0 +1,130 @@
+package bitset
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"math/bits"
+)
+
+// Set is a fixed size set of non-negative integers, stored as a bitmap. A Set may be used in place of a map[int]bool
+// or map[cube.Pos]struct{} where the range of values is known up front, avoiding an allocation per value added.
+type Set []uint64
+
+// New returns a Set that is able to hold the values [0, n).
+func New(n int) Set {
+	return make(Set, (n+63)/64)
+}
+
+// Cap returns the amount of values that the Set is able to hold.
+func (s Set) Cap() int {
+	return len(s) * 64
+}
+
+// Grow returns a Set that is able to hold the values [0, n) and holds the same values as the Set. If the Set is
+// already able to hold n values, the Set itself is returned.
+func (s Set) Grow(n int) Set {
+	words := (n + 63) / 64
+	if words <= len(s) {
+		return s
+	}
+	grown := make(Set, words)
+	copy(grown, s)
+	return grown
+}
+
+// Add adds a value to the Set. Add returns true if the value was not yet present in the Set. Add panics if the value
+// is out of the range of the Set.
+func (s Set) Add(i int) bool {
+	word, bit := i>>6, uint64(1)<<(i&63)
+	if s[word]&bit != 0 {
+		return false
+	}
+	s[word] |= bit
+	return true
+}
+
+// Remove removes a value from the Set.
+func (s Set) Remove(i int) {
+	s[i>>6] &^= uint64(1) << (i & 63)
+}
+
+// Contains checks if a value is present in the Set.
+func (s Set) Contains(i int) bool {
+	return s[i>>6]&(uint64(1)<<(i&63)) != 0
+}
+
+// Len returns the amount of values present in the Set.
+func (s Set) Len() (n int) {
+	for _, word := range s {
+		n += bits.OnesCount64(word)
+	}
+	return n
+}
+
+// Clear removes all values from the Set.
+func (s Set) Clear() {
+	for i := range s {
+		s[i] = 0
+	}
+}
+
+// Volume is a set of block positions within a cuboid area. It is used by algorithms such as flood fills to keep track
+// of positions that were already visited, without allocating for every position.
+type Volume struct {
+	min        cube.Pos
+	dx, dy, dz int
+	s          Set
+}
+
+// NewVolume returns a Volume that is able to hold all positions between min and max, inclusive.
+func NewVolume(min, max cube.Pos) *Volume {
+	dx, dy, dz := max[0]-min[0]+1, max[1]-min[1]+1, max[2]-min[2]+1
+	return &Volume{min: min, dx: dx, dy: dy, dz: dz, s: New(dx * dy * dz)}
+}
+
+// NewVolumeAround returns a Volume that is able to hold all positions within a specific horizontal and vertical
+// radius around a centre position.
+func NewVolumeAround(centre cube.Pos, horizontal, vertical int) *Volume {
+	return NewVolume(centre.Sub(cube.Pos{horizontal, vertical, horizontal}), centre.Add(cube.Pos{horizontal, vertical, horizontal}))
+}
+
+// Within checks if the position passed lies within the area of the Volume.
+func (v *Volume) Within(pos cube.Pos) bool {
+	x, y, z := pos[0]-v.min[0], pos[1]-v.min[1], pos[2]-v.min[2]
+	return x >= 0 && y >= 0 && z >= 0 && x < v.dx && y < v.dy && z < v.dz
+}
+
+// Add adds a position to the Volume. Add returns true if the position was within the area of the Volume and was not
+// yet present in it.
+func (v *Volume) Add(pos cube.Pos) bool {
+	if !v.Within(pos) {
+		return false
+	}
+	return v.s.Add(v.index(pos))
+}
+
+// Contains checks if a position is present in the Volume.
+func (v *Volume) Contains(pos cube.Pos) bool {
+	return v.Within(pos) && v.s.Contains(v.index(pos))
+}
+
+// Len returns the amount of positions present in the Volume.
+func (v *Volume) Len() int {
+	return v.s.Len()
+}
+
+// Reset removes all positions from the Volume and moves it so that its area starts at the minimum position passed.
+// The size of the Volume remains the same.
+func (v *Volume) Reset(min cube.Pos) {
+	v.min = min
+	v.s.Clear()
+}
+
+// Min returns the minimum position of the area of the Volume.
+func (v *Volume) Min() cube.Pos {
+	return v.min
+}
+
+// index returns the index in the Set of a position within the Volume.
+func (v *Volume) index(pos cube.Pos) int {
+	return ((pos[1]-v.min[1])*v.dz+(pos[2]-v.min[2]))*v.dx + (pos[0] - v.min[0])
+}
@@ -0,0 +1,116 @@
+package bitset
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"testing"
+)
+
+// TestSet checks that values added to a Set are present in it until they are removed or the Set is cleared.
+func TestSet(t *testing.T) {
+	s := New(200)
+	if s.Cap() < 200 {
+		t.Fatalf("expected a capacity of at least 200, got %v", s.Cap())
+	}
+	for i := 0; i < 200; i += 3 {
+		if !s.Add(i) {
+			t.Fatalf("value %v was already present before it was added", i)
+		}
+		if s.Add(i) {
+			t.Fatalf("value %v was added twice", i)
+		}
+	}
+	for i := 0; i < 200; i++ {
+		if s.Contains(i) != (i%3 == 0) {
+			t.Fatalf("value %v: expected present to be %v", i, i%3 == 0)
+		}
+	}
+	if s.Len() != 67 {
+		t.Fatalf("expected 67 values, got %v", s.Len())
+	}
+	s.Remove(3)
+	if s.Contains(3) || s.Len() != 66 {
+		t.Fatalf("value 3 was not removed")
+	}
+	s.Clear()
+	if s.Len() != 0 {
+		t.Fatalf("expected no values after clearing, got %v", s.Len())
+	}
+}
+
+// TestSetGrow checks that a grown Set is able to hold more values and keeps the values of the original Set.
+func TestSetGrow(t *testing.T) {
+	s := New(64)
+	s.Add(5)
+	s.Add(63)
+	if grown := s.Grow(10); len(grown) != len(s) {
+		t.Fatalf("set grown to a smaller size changed its size from %v to %v", len(s), len(grown))
+	}
+	s = s.Grow(1000)
+	if s.Cap() < 1000 {
+		t.Fatalf("expected a capacity of at least 1000 after growing, got %v", s.Cap())
+	}
+	if !s.Contains(5) || !s.Contains(63) || s.Len() != 2 {
+		t.Fatalf("values were lost when growing the set")
+	}
+	if !s.Add(999) || !s.Contains(999) {
+		t.Fatalf("value 999 could not be added after growing the set")
+	}
+}
+
+// TestVolume checks that positions added to a Volume are present in it and that positions outside of its area are
+// never added.
+func TestVolume(t *testing.T) {
+	v := NewVolumeAround(cube.Pos{10, 64, -10}, 2, 1)
+	if !v.Add(cube.Pos{12, 65, -8}) || !v.Contains(cube.Pos{12, 65, -8}) {
+		t.Fatalf("position in the corner of the volume could not be added")
+	}
+	if v.Add(cube.Pos{13, 65, -8}) || v.Contains(cube.Pos{13, 65, -8}) {
+		t.Fatalf("position outside of the volume was added")
+	}
+	if v.Contains(cube.Pos{12, 64, -8}) || v.Len() != 1 {
+		t.Fatalf("expected only a single position in the volume")
+	}
+	v.Reset(cube.Pos{0, 0, 0})
+	if v.Len() != 0 || v.Min() != (cube.Pos{0, 0, 0}) || !v.Add(cube.Pos{4, 2, 4}) {
+		t.Fatalf("volume was not reset correctly")
+	}
+}
+
+// BenchmarkSetAdd benchmarks adding values to a Set.
+func BenchmarkSetAdd(b *testing.B) {
+	s := New(4096)
+	for i := 0; i < b.N; i++ {
+		s.Add(i & 4095)
+	}
+}
+
+// BenchmarkSetContains benchmarks checking if values are present in a half-filled Set.
+func BenchmarkSetContains(b *testing.B) {
+	s := New(4096)
+	for i := 0; i < 4096; i += 2 {
+		s.Add(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Contains(i & 4095)
+	}
+}
+
+// BenchmarkMapAdd benchmarks adding values to a map[int]struct{}, which a Set may be used in place of, for
+// comparison with BenchmarkSetAdd.
+func BenchmarkMapAdd(b *testing.B) {
+	m := make(map[int]struct{}, 4096)
+	for i := 0; i < b.N; i++ {
+		m[i&4095] = struct{}{}
+	}
+}
+
+// BenchmarkVolumeAdd benchmarks adding positions to a Volume of the size used by sponges to search for water.
+func BenchmarkVolumeAdd(b *testing.B) {
+	v := NewVolumeAround(cube.Pos{}, 8, 8)
+	for i := 0; i < b.N; i++ {
+		if !v.Add(cube.Pos{i%17 - 8, (i/17)%17 - 8, (i/289)%17 - 8}) && i%4913 == 4912 {
+			v.Reset(v.Min())
+		}
+	}
+}
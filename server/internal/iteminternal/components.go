@@ -81,6 +81,13 @@ func Components(it world.CustomItem) map[string]any {
 	if x, ok := it.(item.HandEquipped); ok {
 		builder.AddProperty("hand_equipped", x.HandEquipped())
 	}
+	if x, ok := it.(item.Weapon); ok {
+		builder.AddProperty("damage", int32(x.AttackDamage()))
+	}
+	if x, ok := it.(item.Tool); ok && x.ToolType() == item.TypeSword {
+		// Like vanilla swords, custom swords should not be able to break blocks in creative mode.
+		builder.AddProperty("can_destroy_in_creative", false)
+	}
 	itemScale := calculateItemScale(it)
 	builder.AddComponent("minecraft:render_offsets", map[string]any{
 		"main_hand": map[string]any{
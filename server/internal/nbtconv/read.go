@@ -168,6 +168,7 @@ func Item(data map[string]any, s *item.Stack) item.Stack {
 
 	readAnvilCost(tag, s)
 	readDamage(tag, s, disk)
+	readUnbreakable(tag, s)
 	readDisplay(tag, s)
 	readDragonflyData(tag, s)
 	readEnchantments(tag, s)
@@ -212,6 +213,14 @@ func readDamage(m map[string]any, s *item.Stack, disk bool) {
 	*s = s.Damage(int(Int32(m, "Damage")))
 }
 
+// readUnbreakable reads if an item.Stack is unbreakable from the Unbreakable tag of the NBT passed. It must be called
+// after readDamage, as unbreakable stacks cannot be damaged.
+func readUnbreakable(m map[string]any, s *item.Stack) {
+	if Bool(m, "Unbreakable") {
+		*s = s.AsUnbreakable()
+	}
+}
+
 // readAnvilCost ...
 func readAnvilCost(m map[string]any, s *item.Stack) {
 	*s = s.WithAnvilCost(int(Int32(m, "RepairCost")))
@@ -269,7 +278,9 @@ func readDragonflyData(m map[string]any, s *item.Stack) {
 		}
 		var values []mapValue
 		if err := gob.NewDecoder(bytes.NewBuffer(d)).Decode(&values); err != nil {
-			panic("error decoding item user data: " + err.Error())
+			// The data could not be decoded, either because it was sent by a client or because a value of a type
+			// that was not registered using gob.Register was stored. Ignore the data instead of failing entirely.
+			return
 		}
 		for _, val := range values {
 			*s = s.WithValue(val.K, val.V)
@@ -19,6 +19,7 @@ func WriteItem(s item.Stack, disk bool) map[string]any {
 	}
 	writeAnvilCost(tag, s)
 	writeDamage(tag, s, disk)
+	writeUnbreakable(tag, s)
 	writeDisplay(tag, s)
 	writeDragonflyData(tag, s)
 	writeEnchantments(tag, s)
@@ -140,6 +141,13 @@ func writeDamage(m map[string]any, s item.Stack, disk bool) {
 	}
 }
 
+// writeUnbreakable writes if an item.Stack is unbreakable to a map for NBT encoding.
+func writeUnbreakable(m map[string]any, s item.Stack) {
+	if s.Unbreakable() {
+		m["Unbreakable"] = uint8(1)
+	}
+}
+
 // writeAnvilCost ...
 func writeAnvilCost(m map[string]any, s item.Stack) {
 	if cost := s.AnvilCost(); cost > 0 {
@@ -2,6 +2,7 @@ package item
 
 import (
 	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/event"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/df-mc/dragonfly/server/world/particle"
 	"github.com/go-gl/mathgl/mgl64"
@@ -17,8 +18,17 @@ type BoneMealAffected interface {
 }
 
 // UseOnBlock ...
-func (b BoneMeal) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
-	if bm, ok := w.Block(pos).(BoneMealAffected); ok && bm.BoneMeal(pos, w) {
+func (BoneMeal) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	b := w.Block(pos)
+	bm, ok := b.(BoneMealAffected)
+	if !ok {
+		return false
+	}
+	evt := event.C()
+	if w.Handler().HandleBoneMeal(evt, pos, b); evt.Cancelled() {
+		return false
+	}
+	if bm.BoneMeal(pos, w) {
 		ctx.CountSub = 1
 		w.AddParticle(pos.Vec3(), particle.BoneMeal{})
 		return true
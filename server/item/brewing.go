@@ -0,0 +1,201 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/item/potion"
+	"github.com/df-mc/dragonfly/server/world"
+	"golang.org/x/exp/slices"
+	"sync"
+)
+
+// PotionMix is a brewing recipe that turns a potion of one type into a potion of another type by brewing it with a
+// reagent in a brewing stand. The container of the potion is kept, so a PotionMix applies to potions, splash
+// potions and lingering potions alike.
+type PotionMix struct {
+	// Input is the type of potion that is brewed.
+	Input potion.Potion
+	// Reagent is the item that must be put in the ingredient slot of the brewing stand to brew the potion.
+	Reagent world.Item
+	// Output is the type of potion produced by the brew.
+	Output potion.Potion
+}
+
+// PotionContainerChange is a brewing recipe that changes the container of a potion by brewing it with a reagent in a
+// brewing stand, such as turning a potion into a splash potion. The type of the potion is kept.
+type PotionContainerChange struct {
+	// Input is the potion container that is brewed, such as Potion{}.
+	Input world.Item
+	// Reagent is the item that must be put in the ingredient slot of the brewing stand to brew the potion.
+	Reagent world.Item
+	// Output is the potion container produced by the brew, such as SplashPotion{}.
+	Output world.Item
+}
+
+var (
+	// brewingMu protects potionMixes and potionContainerChanges.
+	brewingMu sync.RWMutex
+	// potionMixes holds the PotionMixes registered using RegisterPotionMix.
+	potionMixes []PotionMix
+	// potionContainerChanges holds the PotionContainerChanges registered using RegisterPotionContainerChange.
+	potionContainerChanges []PotionContainerChange
+)
+
+// RegisterPotionMix registers a PotionMix so that it may be brewed in brewing stands. Players receive the mix the
+// next time recipes are sent to them.
+func RegisterPotionMix(mix PotionMix) {
+	brewingMu.Lock()
+	defer brewingMu.Unlock()
+	potionMixes = append(potionMixes, mix)
+}
+
+// RegisterPotionContainerChange registers a PotionContainerChange so that it may be brewed in brewing stands.
+// Players receive the recipe the next time recipes are sent to them.
+func RegisterPotionContainerChange(change PotionContainerChange) {
+	brewingMu.Lock()
+	defer brewingMu.Unlock()
+	potionContainerChanges = append(potionContainerChanges, change)
+}
+
+// PotionMixes returns all PotionMixes registered using RegisterPotionMix.
+func PotionMixes() []PotionMix {
+	brewingMu.RLock()
+	defer brewingMu.RUnlock()
+	return slices.Clone(potionMixes)
+}
+
+// PotionContainerChanges returns all PotionContainerChanges registered using RegisterPotionContainerChange.
+func PotionContainerChanges() []PotionContainerChange {
+	brewingMu.RLock()
+	defer brewingMu.RUnlock()
+	return slices.Clone(potionContainerChanges)
+}
+
+// Brew returns the potion produced by brewing the input potion passed with the reagent passed in a brewing stand.
+// False is returned if no PotionMix or PotionContainerChange exists for the two.
+func Brew(input, reagent Stack) (Stack, bool) {
+	if input.Empty() || reagent.Empty() {
+		return Stack{}, false
+	}
+	reagentKey := keyOf(reagent.Item())
+
+	brewingMu.RLock()
+	defer brewingMu.RUnlock()
+	for _, mix := range potionMixes {
+		if keyOf(mix.Reagent) != reagentKey {
+			continue
+		}
+		switch p := input.Item().(type) {
+		case Potion:
+			if p.Type == mix.Input {
+				return NewStack(Potion{Type: mix.Output}, 1), true
+			}
+		case SplashPotion:
+			if p.Type == mix.Input {
+				return NewStack(SplashPotion{Type: mix.Output}, 1), true
+			}
+		case LingeringPotion:
+			if p.Type == mix.Input {
+				return NewStack(LingeringPotion{Type: mix.Output}, 1), true
+			}
+		}
+	}
+	inputName, meta := input.Item().EncodeItem()
+	for _, change := range potionContainerChanges {
+		if keyOf(change.Reagent) != reagentKey || keyOf(change.Input).name != inputName {
+			continue
+		}
+		// The meta of potions is their type, which remains the same regardless of the container.
+		name, _ := change.Output.EncodeItem()
+		if it, ok := world.ItemByName(name, meta); ok {
+			return NewStack(it, 1), true
+		}
+	}
+	return Stack{}, false
+}
+
+// BrewingReagent checks if the item passed is the reagent of any PotionMix or PotionContainerChange registered, which
+// means it may be put in the ingredient slot of a brewing stand.
+func BrewingReagent(i world.Item) bool {
+	key := keyOf(i)
+
+	brewingMu.RLock()
+	defer brewingMu.RUnlock()
+	for _, mix := range potionMixes {
+		if keyOf(mix.Reagent) == key {
+			return true
+		}
+	}
+	for _, change := range potionContainerChanges {
+		if keyOf(change.Reagent) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// keyOf returns the itemKey of the item passed.
+func keyOf(i world.Item) itemKey {
+	name, meta := i.EncodeItem()
+	return itemKey{name: name, meta: meta}
+}
+
+// registerVanillaPotionMixes registers the vanilla PotionMixes and PotionContainerChanges. Mixes with reagents that
+// are blocks, such as nether wart, are registered by the block package.
+func registerVanillaPotionMixes() {
+	for _, reagent := range []world.Item{Sugar{}, RabbitFoot{}, GlisteringMelonSlice{}, SpiderEye{}, GhastTear{}, MagmaCream{}, BlazePowder{}} {
+		RegisterPotionMix(PotionMix{Input: potion.Water(), Reagent: reagent, Output: potion.Mundane()})
+	}
+	mixes := []PotionMix{
+		{potion.Water(), GlowstoneDust{}, potion.Thick()},
+		{potion.Water(), FermentedSpiderEye{}, potion.Weakness()},
+
+		{potion.Awkward(), GoldenCarrot{}, potion.NightVision()},
+		{potion.NightVision(), FermentedSpiderEye{}, potion.Invisibility()},
+		{potion.LongNightVision(), FermentedSpiderEye{}, potion.LongInvisibility()},
+
+		{potion.Awkward(), RabbitFoot{}, potion.Leaping()},
+		{potion.Leaping(), GlowstoneDust{}, potion.StrongLeaping()},
+		{potion.Leaping(), FermentedSpiderEye{}, potion.Slowness()},
+		{potion.LongLeaping(), FermentedSpiderEye{}, potion.LongSlowness()},
+
+		{potion.Awkward(), MagmaCream{}, potion.FireResistance()},
+		{potion.FireResistance(), FermentedSpiderEye{}, potion.Slowness()},
+		{potion.LongFireResistance(), FermentedSpiderEye{}, potion.LongSlowness()},
+
+		{potion.Awkward(), Sugar{}, potion.Swiftness()},
+		{potion.Swiftness(), GlowstoneDust{}, potion.StrongSwiftness()},
+		{potion.Swiftness(), FermentedSpiderEye{}, potion.Slowness()},
+		{potion.LongSwiftness(), FermentedSpiderEye{}, potion.LongSlowness()},
+		{potion.StrongSwiftness(), FermentedSpiderEye{}, potion.StrongSlowness()},
+		{potion.Slowness(), GlowstoneDust{}, potion.StrongSlowness()},
+
+		{potion.Awkward(), Pufferfish{}, potion.WaterBreathing()},
+
+		{potion.Awkward(), GlisteringMelonSlice{}, potion.Healing()},
+		{potion.Healing(), GlowstoneDust{}, potion.StrongHealing()},
+		{potion.Healing(), FermentedSpiderEye{}, potion.Harming()},
+		{potion.StrongHealing(), FermentedSpiderEye{}, potion.StrongHarming()},
+		{potion.Harming(), GlowstoneDust{}, potion.StrongHarming()},
+
+		{potion.Awkward(), SpiderEye{}, potion.Poison()},
+		{potion.Poison(), GlowstoneDust{}, potion.StrongPoison()},
+		{potion.Poison(), FermentedSpiderEye{}, potion.Harming()},
+		{potion.LongPoison(), FermentedSpiderEye{}, potion.Harming()},
+		{potion.StrongPoison(), FermentedSpiderEye{}, potion.StrongHarming()},
+
+		{potion.Awkward(), GhastTear{}, potion.Regeneration()},
+		{potion.Regeneration(), GlowstoneDust{}, potion.StrongRegeneration()},
+
+		{potion.Awkward(), BlazePowder{}, potion.Strength()},
+		{potion.Strength(), GlowstoneDust{}, potion.StrongStrength()},
+
+		{potion.Awkward(), TurtleShell{}, potion.TurtleMaster()},
+		{potion.TurtleMaster(), GlowstoneDust{}, potion.StrongTurtleMaster()},
+
+		{potion.Awkward(), PhantomMembrane{}, potion.SlowFalling()},
+	}
+	for _, mix := range mixes {
+		RegisterPotionMix(mix)
+	}
+	RegisterPotionContainerChange(PotionContainerChange{Input: Potion{}, Reagent: Gunpowder{}, Output: SplashPotion{}})
+	RegisterPotionContainerChange(PotionContainerChange{Input: SplashPotion{}, Reagent: DragonBreath{}, Output: LingeringPotion{}})
+}
@@ -98,22 +98,33 @@ func (b Bucket) FuelInfo() FuelInfo {
 	return FuelInfo{}
 }
 
+// bucketUser is a User that is able to prevent buckets from being filled or emptied, such as a player.
+type bucketUser interface {
+	// CanFillBucket checks if the user may fill a bucket with the liquid at the position passed.
+	CanFillBucket(pos cube.Pos, liquid world.Liquid) bool
+	// CanEmptyBucket checks if the user may empty a bucket, placing the liquid passed at the position passed.
+	CanEmptyBucket(pos cube.Pos, liquid world.Liquid) bool
+}
+
 // UseOnBlock handles the bucket filling and emptying logic.
-func (b Bucket) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+func (b Bucket) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user User, ctx *UseContext) bool {
 	if b.Content.milk {
 		return false
 	}
 	if b.Empty() {
-		return b.fillFrom(pos, w, ctx)
+		return b.fillFrom(pos, w, user, ctx)
 	}
 	liq := b.Content.liquid.WithDepth(8, false)
-	if bl := w.Block(pos); canDisplace(bl, liq) || replaceableWith(bl, liq) {
-		w.SetLiquid(pos, liq)
-	} else if bl := w.Block(pos.Side(face)); canDisplace(bl, liq) || replaceableWith(bl, liq) {
-		w.SetLiquid(pos.Side(face), liq)
-	} else {
+	if bl := w.Block(pos); !canDisplace(bl, liq) && !replaceableWith(bl, liq) {
+		pos = pos.Side(face)
+		if bl := w.Block(pos); !canDisplace(bl, liq) && !replaceableWith(bl, liq) {
+			return false
+		}
+	}
+	if u, ok := user.(bucketUser); ok && !u.CanEmptyBucket(pos, liq) {
 		return false
 	}
+	w.SetLiquid(pos, liq)
 
 	w.PlaySound(pos.Vec3Centre(), sound.BucketEmpty{Liquid: b.Content.liquid})
 	ctx.NewItem = NewStack(Bucket{}, 1)
@@ -124,7 +135,7 @@ func (b Bucket) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.
 
 // fillFrom fills a bucket from the liquid at the position passed in the world. If there is no liquid or if
 // the liquid is no source, fillFrom returns false.
-func (b Bucket) fillFrom(pos cube.Pos, w *world.World, ctx *UseContext) bool {
+func (b Bucket) fillFrom(pos cube.Pos, w *world.World, user User, ctx *UseContext) bool {
 	liquid, ok := w.Liquid(pos)
 	if !ok {
 		return false
@@ -133,6 +144,9 @@ func (b Bucket) fillFrom(pos cube.Pos, w *world.World, ctx *UseContext) bool {
 		// Only allow picking up liquid source blocks.
 		return false
 	}
+	if u, ok := user.(bucketUser); ok && !u.CanFillBucket(pos, liquid) {
+		return false
+	}
 	w.SetLiquid(pos, nil)
 	w.PlaySound(pos.Vec3Centre(), sound.BucketFill{Liquid: liquid})
 
@@ -0,0 +1,88 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// ChorusFruit is a food item obtained from chorus plants that randomly teleports the entity that eats it.
+type ChorusFruit struct{}
+
+// ChorusFruitTeleportCause is used for teleports caused by eating chorus fruit.
+type ChorusFruitTeleportCause struct{}
+
+func (ChorusFruitTeleportCause) ConservesMotion() bool   { return false }
+func (ChorusFruitTeleportCause) Cooldown() time.Duration { return time.Second }
+
+// teleporter represents a Consumer that may be teleported by eating chorus fruit.
+type teleporter interface {
+	TeleportWithCause(w *world.World, pos mgl64.Vec3, cause world.TeleportCause) bool
+}
+
+// AlwaysConsumable ...
+func (ChorusFruit) AlwaysConsumable() bool {
+	return true
+}
+
+// Cooldown ...
+func (ChorusFruit) Cooldown() time.Duration {
+	return time.Second
+}
+
+// ConsumeDuration ...
+func (ChorusFruit) ConsumeDuration() time.Duration {
+	return DefaultConsumeDuration
+}
+
+// Consume ...
+func (ChorusFruit) Consume(w *world.World, c Consumer) Stack {
+	c.Saturate(4, 2.4)
+	t, ok := c.(teleporter)
+	if !ok {
+		return Stack{}
+	}
+	origin := c.Position()
+	// Up to 16 attempts are made to find a position within 8 blocks that the consumer can safely stand at.
+	for i := 0; i < 16; i++ {
+		pos, ok := chorusFruitTarget(w, origin)
+		if ok && t.TeleportWithCause(w, pos, ChorusFruitTeleportCause{}) {
+			w.PlaySound(origin, sound.Teleport{})
+			w.PlaySound(pos, sound.Teleport{})
+			break
+		}
+	}
+	return Stack{}
+}
+
+// chorusFruitTarget picks a random position within 8 blocks from the origin passed and moves it down to the first
+// block that may be stood on. False is returned if no such block was found or if the position is obstructed.
+func chorusFruitTarget(w *world.World, origin mgl64.Vec3) (mgl64.Vec3, bool) {
+	r := w.Range()
+	pos := cube.PosFromVec3(origin.Add(mgl64.Vec3{rand.Float64()*16 - 8, float64(rand.Intn(16) - 8), rand.Float64()*16 - 8}))
+	if pos[1] > r[1]-1 {
+		pos[1] = r[1] - 1
+	} else if pos[1] < r[0]+1 {
+		pos[1] = r[0] + 1
+	}
+
+	for !w.Block(pos.Side(cube.FaceDown)).Model().FaceSolid(pos.Side(cube.FaceDown), cube.FaceUp, w) {
+		if pos = pos.Side(cube.FaceDown); pos[1] <= r[0] {
+			return mgl64.Vec3{}, false
+		}
+	}
+	for _, p := range []cube.Pos{pos, pos.Side(cube.FaceUp)} {
+		if _, liquid := w.Liquid(p); liquid || len(w.Block(p).Model().BBox(p, w)) != 0 {
+			return mgl64.Vec3{}, false
+		}
+	}
+	return pos.Vec3Middle(), true
+}
+
+// EncodeItem ...
+func (ChorusFruit) EncodeItem() (name string, meta int16) {
+	return "minecraft:chorus_fruit", 0
+}
@@ -9,22 +9,51 @@ import (
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/sandertv/gophertunnel/minecraft/nbt"
+	"golang.org/x/exp/slices"
+	"sync"
 )
 
 // Items returns a list with all items that have been registered as a creative item. These items will
 // be accessible by players in-game who have creative mode enabled.
 func Items() []item.Stack {
-	return creativeItemStacks
+	creativeMu.RLock()
+	defer creativeMu.RUnlock()
+	return slices.Clone(creativeItemStacks)
 }
 
-// RegisterItem registers an item as a creative item, exposing it in the creative inventory.
+// RegisterItem registers an item as a creative item, exposing it in the creative inventory. Custom items are
+// shown in the group of the category.Category returned by their Category method. Players that already joined
+// only see items registered after they joined once creative items are sent to them again, for example using
+// player.Player.SendCreativeItems.
 func RegisterItem(item item.Stack) {
+	creativeMu.Lock()
+	defer creativeMu.Unlock()
 	creativeItemStacks = append(creativeItemStacks, item)
 }
 
+// UnregisterItem removes all creative items of the same type as the item passed from the creative inventory,
+// hiding them from players. Creative items are only removed if they have the same enchantments, lore and
+// custom name as the item passed. UnregisterItem returns false if no creative items were removed. Like
+// RegisterItem, the change only applies to players that already joined once creative items are sent to them
+// again.
+func UnregisterItem(it item.Stack) bool {
+	creativeMu.Lock()
+	defer creativeMu.Unlock()
+	n := len(creativeItemStacks)
+	for i := 0; i < len(creativeItemStacks); i++ {
+		if !it.Empty() && it.Comparable(creativeItemStacks[i]) {
+			creativeItemStacks = slices.Delete(creativeItemStacks, i, i+1)
+			i--
+		}
+	}
+	return len(creativeItemStacks) != n
+}
+
 var (
 	//go:embed creative_items.nbt
 	creativeItemData []byte
+	// creativeMu guards creativeItemStacks, so that creative items may be changed while the server is running.
+	creativeMu sync.RWMutex
 	// creativeItemStacks holds a list of all item stacks that were registered to the creative inventory using
 	// RegisterItem.
 	creativeItemStacks []item.Stack
@@ -0,0 +1,168 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item/potion"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"time"
+)
+
+const (
+	// crossbowChargeDuration is the time it takes to load a crossbow without the quick charge enchantment.
+	crossbowChargeDuration = time.Millisecond * 1250
+	// crossbowArrowSpeed is the speed of arrows shot from a crossbow.
+	crossbowArrowSpeed = 5.25
+)
+
+// Crossbow is a ranged weapon similar to a bow that fires arrows. Unlike a bow, a crossbow is loaded by charging
+// it, after which it stays loaded until it is used again to shoot.
+type Crossbow struct {
+	// Item is the item that the crossbow is loaded with. The crossbow is not loaded if Item is empty.
+	Item Stack
+}
+
+// MaxCount always returns 1.
+func (Crossbow) MaxCount() int {
+	return 1
+}
+
+// DurabilityInfo ...
+func (Crossbow) DurabilityInfo() DurabilityInfo {
+	return DurabilityInfo{
+		MaxDurability: 464,
+		BrokenItem:    simpleItem(Stack{}),
+	}
+}
+
+// FuelInfo ...
+func (Crossbow) FuelInfo() FuelInfo {
+	return newFuelInfo(time.Second * 15)
+}
+
+// Release loads the crossbow if it was charged for long enough and is not already loaded.
+func (c Crossbow) Release(releaser Releaser, duration time.Duration, ctx *UseContext) {
+	if !c.Item.Empty() {
+		return
+	}
+	held, left := releaser.HeldItems()
+	chargeDuration := crossbowChargeDuration
+	for _, enchant := range held.Enchantments() {
+		if q, ok := enchant.Type().(interface{ ChargeReduction(int) time.Duration }); ok {
+			chargeDuration -= q.ChargeReduction(enchant.Level())
+		}
+	}
+	if duration < chargeDuration {
+		return
+	}
+
+	creative := releaser.GameMode().CreativeInventory()
+	arrow, ok := ctx.FirstFunc(func(stack Stack) bool {
+		_, ok := stack.Item().(Arrow)
+		return ok
+	})
+	if !ok && !creative {
+		// No arrows in inventory and not in creative mode.
+		return
+	}
+	if !ok {
+		arrow = NewStack(Arrow{}, 1)
+	}
+	arrow = arrow.Grow(1 - arrow.Count())
+	if !creative {
+		ctx.Consume(arrow)
+	}
+
+	releaser.SetHeldItems(held.withItem(Crossbow{Item: arrow}), left)
+	releaser.PlaySound(sound.CrossbowLoad{})
+}
+
+// ReleaseCharge shoots the item that the crossbow is loaded with. Crossbows with the multishot enchantment shoot
+// three arrows, of which only the middle one may be picked up.
+func (c Crossbow) ReleaseCharge(releaser Releaser, ctx *UseContext) bool {
+	if c.Item.Empty() {
+		return false
+	}
+	held, left := releaser.HeldItems()
+	projectiles, piercingLevel := 1, 0
+	for _, enchant := range held.Enchantments() {
+		if m, ok := enchant.Type().(interface{ Projectiles() int }); ok {
+			projectiles = m.Projectiles()
+		}
+		if p, ok := enchant.Type().(interface{ PiercingLevel(int) int }); ok {
+			piercingLevel = p.PiercingLevel(enchant.Level())
+		}
+	}
+	var tip potion.Potion
+	if arrow, ok := c.Item.Item().(Arrow); ok {
+		tip = arrow.Tip
+	}
+
+	creative := releaser.GameMode().CreativeInventory()
+	create := releaser.World().EntityRegistry().Config().Arrow
+	for i := 0; i < projectiles; i++ {
+		// Every projectile after the first is shot at an angle to alternating sides of the first.
+		offset := float64((i+1)/2) * 10
+		if i%2 == 1 {
+			offset = -offset
+		}
+		rot := releaser.Rotation().Add(cube.Rotation{offset})
+		rYaw, rPitch := rot.Elem()
+		yaw, pitch := -rYaw, -rPitch
+		if rYaw > 180 {
+			yaw = 360 - rYaw
+		}
+		// Only the first arrow may be picked up, as the others were not consumed.
+		projectile := create(eyePosition(releaser), rot.Vec3().Mul(crossbowArrowSpeed), yaw, pitch, 2.0, releaser, false, i != 0, i == 0 && !creative, 0, tip)
+		if p, ok := projectile.(interface{ SetPiercingLevel(level int) }); ok {
+			p.SetPiercingLevel(piercingLevel)
+		}
+		releaser.World().AddEntity(projectile)
+	}
+
+	ctx.DamageItem(projectiles)
+	releaser.SetHeldItems(held.withItem(Crossbow{}), left)
+	releaser.PlaySound(sound.CrossbowShoot{})
+	return true
+}
+
+// EnchantmentValue ...
+func (Crossbow) EnchantmentValue() int {
+	return 1
+}
+
+// Requirements returns the required items to release this item.
+func (Crossbow) Requirements() []Stack {
+	return []Stack{NewStack(Arrow{}, 1)}
+}
+
+// DecodeNBT ...
+func (c Crossbow) DecodeNBT(data map[string]any) any {
+	c.Item = Stack{}
+	if charged, ok := data["chargedItem"].(map[string]any); ok {
+		name, _ := charged["Name"].(string)
+		meta, _ := charged["Damage"].(int16)
+		if it, ok := world.ItemByName(name, meta); ok {
+			c.Item = NewStack(it, 1)
+		}
+	}
+	return c
+}
+
+// EncodeNBT ...
+func (c Crossbow) EncodeNBT() map[string]any {
+	if c.Item.Empty() {
+		return nil
+	}
+	name, meta := c.Item.Item().EncodeItem()
+	return map[string]any{"chargedItem": map[string]any{
+		"Name":   name,
+		"Damage": meta,
+		"Count":  byte(1),
+	}}
+}
+
+// EncodeItem ...
+func (Crossbow) EncodeItem() (name string, meta int16) {
+	return "minecraft:crossbow", 0
+}
@@ -0,0 +1,46 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"time"
+)
+
+// QuickCharge is an enchantment for crossbows that decreases the time it takes to load them.
+type QuickCharge struct{}
+
+// Name ...
+func (QuickCharge) Name() string {
+	return "Quick Charge"
+}
+
+// MaxLevel ...
+func (QuickCharge) MaxLevel() int {
+	return 3
+}
+
+// Cost ...
+func (QuickCharge) Cost(level int) (int, int) {
+	return 12 + (level-1)*20, 50
+}
+
+// Rarity ...
+func (QuickCharge) Rarity() item.EnchantmentRarity {
+	return item.EnchantmentRarityUncommon
+}
+
+// ChargeReduction returns the duration that the loading time of a crossbow is reduced by for the level passed.
+func (QuickCharge) ChargeReduction(level int) time.Duration {
+	return time.Millisecond * 250 * time.Duration(level)
+}
+
+// CompatibleWithEnchantment ...
+func (QuickCharge) CompatibleWithEnchantment(item.EnchantmentType) bool {
+	return true
+}
+
+// CompatibleWithItem ...
+func (QuickCharge) CompatibleWithItem(i world.Item) bool {
+	_, ok := i.(item.Crossbow)
+	return ok
+}
@@ -13,7 +13,7 @@ func init() {
 	item.RegisterEnchantment(7, DepthStrider{})
 	item.RegisterEnchantment(8, AquaAffinity{})
 	item.RegisterEnchantment(9, Sharpness{})
-	// TODO: (10) Smite. (Requires undead mobs)
+	item.RegisterEnchantment(10, Smite{})
 	// TODO: (11) Bane of Arthropods. (Requires arthropod mobs)
 	item.RegisterEnchantment(12, KnockBack{})
 	item.RegisterEnchantment(13, FireAspect{})
@@ -36,9 +36,9 @@ func init() {
 	// TODO: (30) Riptide.
 	// TODO: (31) Loyalty.
 	// TODO: (32) Channeling.
-	// TODO: (33) Multishot.
-	// TODO: (34) Piercing.
-	// TODO: (35) Quick Charge.
+	item.RegisterEnchantment(33, Multishot{})
+	item.RegisterEnchantment(34, Piercing{})
+	item.RegisterEnchantment(35, QuickCharge{})
 	item.RegisterEnchantment(36, SoulSpeed{})
 	item.RegisterEnchantment(37, SwiftSneak{})
 }
@@ -35,8 +35,9 @@ func (Sharpness) Addend(level int) float64 {
 }
 
 // CompatibleWithEnchantment ...
-func (Sharpness) CompatibleWithEnchantment(item.EnchantmentType) bool {
-	return true
+func (Sharpness) CompatibleWithEnchantment(t item.EnchantmentType) bool {
+	_, smite := t.(Smite)
+	return !smite
 }
 
 // CompatibleWithItem ...
@@ -0,0 +1,36 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// EndCrystal is an item that may be placed on obsidian or bedrock to create an end crystal, which explodes when it
+// is damaged.
+type EndCrystal struct{}
+
+// UseOnBlock ...
+func (EndCrystal) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	if name, _ := w.Block(pos).EncodeBlock(); name != "minecraft:obsidian" && name != "minecraft:bedrock" {
+		return false
+	}
+	above := pos.Side(cube.FaceUp)
+	if w.Block(above) != air() || w.Block(above.Side(cube.FaceUp)) != air() {
+		return false
+	}
+	box := cube.Box(0, 0, 0, 1, 2, 1).Translate(above.Vec3())
+	if len(w.EntitiesWithin(box, nil)) != 0 {
+		return false
+	}
+	create := w.EntityRegistry().Config().EndCrystal
+	w.AddEntity(create(above.Vec3Middle(), false))
+
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// EncodeItem ...
+func (EndCrystal) EncodeItem() (name string, meta int16) {
+	return "minecraft:end_crystal", 0
+}
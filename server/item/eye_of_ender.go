@@ -0,0 +1,9 @@
+package item
+
+// EyeOfEnder is an item used to locate strongholds and to activate end portals when placed in an end portal frame.
+type EyeOfEnder struct{}
+
+// EncodeItem ...
+func (EyeOfEnder) EncodeItem() (name string, meta int16) {
+	return "minecraft:ender_eye", 0
+}
@@ -64,9 +64,11 @@ func (f Firework) EncodeNBT() map[string]any {
 func (f Firework) DecodeNBT(data map[string]any) any {
 	if fireworks, ok := data["Fireworks"].(map[string]any); ok {
 		if explosions, ok := fireworks["Explosions"].([]any); ok {
-			f.Explosions = make([]FireworkExplosion, len(explosions))
-			for i, explosion := range f.Explosions {
-				f.Explosions[i] = explosion.DecodeNBT(explosions[i].(map[string]any)).(FireworkExplosion)
+			f.Explosions = make([]FireworkExplosion, 0, len(explosions))
+			for _, e := range explosions {
+				if m, ok := e.(map[string]any); ok {
+					f.Explosions = append(f.Explosions, FireworkExplosion{}.DecodeNBT(m).(FireworkExplosion))
+				}
 			}
 		}
 		if durationTicks, ok := fireworks["Flight"].(uint8); ok {
@@ -76,6 +78,11 @@ func (f Firework) DecodeNBT(data map[string]any) any {
 	return f
 }
 
+// OffHand ...
+func (Firework) OffHand() bool {
+	return true
+}
+
 // RandomisedDuration returns the randomised flight duration of the firework.
 func (f Firework) RandomisedDuration() time.Duration {
 	return f.Duration + time.Duration(rand.Intn(int(time.Millisecond*600)))
@@ -33,19 +33,31 @@ func (f FireworkExplosion) EncodeNBT() map[string]any {
 
 // DecodeNBT ...
 func (f FireworkExplosion) DecodeNBT(data map[string]any) any {
-	f.Shape = FireworkShapes()[data["FireworkType"].(uint8)]
-	f.Twinkle = data["FireworkFlicker"].(uint8) == 1
-	f.Trail = data["FireworkTrail"].(uint8) == 1
-
-	colours := data["FireworkColor"]
-	if diskColour, ok := colours.([1]uint8); ok {
-		f.Colour = invertColourID(int16(diskColour[0]))
-	} else if networkColours, ok := colours.([]any); ok {
-		f.Colour = invertColourID(int16(networkColours[0].(uint8)))
+	if t, ok := data["FireworkType"].(uint8); ok && int(t) < len(FireworkShapes()) {
+		f.Shape = FireworkShapes()[t]
 	}
+	f.Twinkle = data["FireworkFlicker"] == uint8(1)
+	f.Trail = data["FireworkTrail"] == uint8(1)
 
-	if fades, ok := data["FireworkFade"].([1]uint8); ok {
-		f.Fade, f.Fades = invertColourID(int16(fades[0])), true
+	if c, ok := fireworkColour(data["FireworkColor"]); ok {
+		f.Colour = c
 	}
+	f.Fade, f.Fades = fireworkColour(data["FireworkFade"])
 	return f
 }
+
+// fireworkColour reads the first colour from a firework colour (or fade) array. Colours read from disk are stored
+// in byte arrays, while those sent by the client are decoded as slices. If no colour is present, false is returned.
+func fireworkColour(v any) (Colour, bool) {
+	switch colours := v.(type) {
+	case [1]uint8:
+		return invertColourID(int16(colours[0])), true
+	case []any:
+		if len(colours) > 0 {
+			if c, ok := colours[0].(uint8); ok {
+				return invertColourID(int16(c)), true
+			}
+		}
+	}
+	return Colour{}, false
+}
@@ -19,6 +19,8 @@ type Inventory struct {
 	mu    sync.RWMutex
 	h     Handler
 	slots []item.Stack
+	// locked holds the slots locked using LockSlot.
+	locked map[int]struct{}
 
 	f      func(slot int, before, after item.Stack)
 	canAdd func(s item.Stack, slot int) bool
@@ -76,6 +78,56 @@ func (inv *Inventory) SetItem(slot int, item item.Stack) error {
 	return nil
 }
 
+// LockSlot locks the slot passed, preventing players from moving the item in the slot, placing an item in it or
+// dropping it. LockSlot may be used to lock equipment in place, for example on kit servers. The item in a locked
+// slot may still be changed using SetItem, but items added using AddItem are never put in it. LockSlot returns an
+// error if the slot passed is out of range.
+func (inv *Inventory) LockSlot(slot int) error {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+
+	inv.check()
+	if !inv.validSlot(slot) {
+		return ErrSlotOutOfRange
+	}
+	if inv.locked == nil {
+		inv.locked = make(map[int]struct{})
+	}
+	inv.locked[slot] = struct{}{}
+	return nil
+}
+
+// UnlockSlot unlocks a slot previously locked using LockSlot. UnlockSlot returns an error if the slot passed is out
+// of range.
+func (inv *Inventory) UnlockSlot(slot int) error {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+
+	inv.check()
+	if !inv.validSlot(slot) {
+		return ErrSlotOutOfRange
+	}
+	delete(inv.locked, slot)
+	return nil
+}
+
+// SlotLocked checks if the slot passed was locked using LockSlot.
+func (inv *Inventory) SlotLocked(slot int) bool {
+	inv.mu.RLock()
+	defer inv.mu.RUnlock()
+	_, ok := inv.locked[slot]
+	return ok
+}
+
+// Accepts checks if the item.Stack passed may be put in the slot passed. Some inventories, such as the armour
+// inventory, only accept specific items in some of their slots. Setting an item that is not accepted in a slot
+// leaves the slot unchanged.
+func (inv *Inventory) Accepts(slot int, it item.Stack) bool {
+	inv.mu.RLock()
+	defer inv.mu.RUnlock()
+	return inv.canAdd(it, slot)
+}
+
 // Slots returns the all slots in the inventory as a slice. The index in the slice is the slot of the inventory that a
 // specific item.Stack is in. Note that this item.Stack might be empty.
 func (inv *Inventory) Slots() []item.Stack {
@@ -148,7 +200,7 @@ func (inv *Inventory) Swap(slotA, slotB int) error {
 // the inventory to make sure no existing stacks of the same type exist. If these stacks do exist, the item
 // added is first added on top of those stacks to make sure they are fully filled.
 // If no existing stacks with leftover space are left, empty slots will be filled up with the remainder of the
-// item added.
+// item added. Slots locked using LockSlot are skipped.
 // If the item could not be fully added to the inventory, an error is returned along with the count that was
 // added to the inventory.
 func (inv *Inventory) AddItem(it item.Stack) (n int, err error) {
@@ -162,6 +214,9 @@ func (inv *Inventory) AddItem(it item.Stack) (n int, err error) {
 
 	inv.check()
 	for slot, invIt := range inv.slots {
+		if _, locked := inv.locked[slot]; locked {
+			continue
+		}
 		if invIt.Empty() {
 			// This slot was empty, and we should first try to add the item stack to existing stacks.
 			emptySlots = append(emptySlots, slot)
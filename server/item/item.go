@@ -157,6 +157,15 @@ type Releasable interface {
 	Requirements() []Stack
 }
 
+// Chargeable represents a Releasable item that is charged by using it for a duration, after which the charge is
+// kept until the item is used again, such as a crossbow.
+type Chargeable interface {
+	Releasable
+	// ReleaseCharge releases the charge of the item. False is returned if the item was not charged, in which case
+	// it starts charging.
+	ReleaseCharge(releaser Releaser, ctx *UseContext) bool
+}
+
 // User represents an entity that is able to use an item in the world, typically entities such as players,
 // which interact with the world using an item.
 type User interface {
@@ -0,0 +1,31 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// Lead is an item used to leash mobs to a player or to a fence. A Lead is dropped again when the leash breaks.
+type Lead struct{}
+
+// UseOnEntity leashes the entity passed to the user, provided the entity can be leashed and is not leashed yet.
+func (Lead) UseOnEntity(e world.Entity, _ *world.World, user User, ctx *UseContext) bool {
+	l, ok := e.(leashable)
+	if !ok || l.LeashHolder() != nil || !l.Leash(user) {
+		return false
+	}
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// leashable represents an entity that may be leashed using a Lead.
+type leashable interface {
+	// LeashHolder returns the entity that the entity is leashed to, or nil if it is not leashed.
+	LeashHolder() world.Entity
+	// Leash leashes the entity to the holder passed, returning false if the entity could not be leashed.
+	Leash(holder world.Entity) bool
+}
+
+// EncodeItem ...
+func (Lead) EncodeItem() (name string, meta int16) {
+	return "minecraft:lead", 0
+}
@@ -0,0 +1,29 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// NameTag is an item used to give a custom name to a mob. A NameTag must be renamed in an anvil before it can be
+// used. Mobs that were given a name using a NameTag no longer despawn.
+type NameTag struct{}
+
+// UseOnEntity changes the name tag of the entity passed to the custom name of the NameTag held by the user.
+func (NameTag) UseOnEntity(e world.Entity, w *world.World, user User, ctx *UseContext) bool {
+	held, _ := user.HeldItems()
+	n, ok := e.(interface{ SetNameTag(s string) })
+	if _, player := e.(interface{ XUID() string }); !ok || player || held.CustomName() == "" {
+		// Players cannot be given a name using a name tag.
+		return false
+	}
+	n.SetNameTag(held.CustomName())
+	w.Persist(e)
+
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// EncodeItem ...
+func (NameTag) EncodeItem() (name string, meta int16) {
+	return "minecraft:name_tag", 0
+}
@@ -3,6 +3,11 @@ package item
 // NautilusShell is an item that is used for crafting conduits.
 type NautilusShell struct{}
 
+// OffHand ...
+func (NautilusShell) OffHand() bool {
+	return true
+}
+
 // EncodeItem ...
 func (NautilusShell) EncodeItem() (name string, meta int16) {
 	return "minecraft:nautilus_shell", 0
@@ -1,9 +1,12 @@
 package recipe
 
 import (
+	"fmt"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/tag"
 	"math"
+	"strings"
 )
 
 // inputItems is a type representing a list of input items, with helper functions to convert them to
@@ -75,3 +78,20 @@ func (d outputItems) Stacks() ([]item.Stack, bool) {
 	}
 	return s, true
 }
+
+// NewItemTag returns an item.Stack that may be used as recipe input to accept any item of the item tag with the name
+// passed, such as "minecraft:planks". The item of the stack returned is the first item of the tag that is registered.
+// NewItemTag panics if the tag does not exist or none of its items are registered.
+func NewItemTag(name string, count int) item.Stack {
+	name = strings.TrimPrefix(name, "#")
+	names, ok := tag.Items(name)
+	if !ok {
+		panic(fmt.Sprintf("item tag %v does not exist", name))
+	}
+	for _, n := range names {
+		if it, ok := world.ItemByName(n, 0); ok {
+			return item.NewStack(it, count).WithValue("tag", name)
+		}
+	}
+	panic(fmt.Sprintf("item tag %v has no registered items", name))
+}
@@ -1,7 +1,11 @@
 package recipe
 
 import (
+	"fmt"
 	"github.com/df-mc/dragonfly/server/item"
+	"reflect"
+	"strconv"
+	"strings"
 )
 
 // Recipe is implemented by all recipe types.
@@ -76,6 +80,41 @@ func (r Shaped) Shape() Shape {
 	return r.shape
 }
 
+// Equal checks if the recipes passed are equal, meaning they are of the same type, are crafted on the same block
+// and have the same input and output.
+func Equal(a, b Recipe) bool {
+	return Key(a) == Key(b)
+}
+
+// Key returns a string that identifies the recipe passed by its type, the block it is crafted on and its input and
+// output. Recipes that are Equal have the same key. Unlike the index of a recipe in Recipes, the key of a recipe does
+// not depend on the order in which recipes are registered, so it may be used to save recipes.
+func Key(r Recipe) string {
+	var b strings.Builder
+	b.WriteString(reflect.TypeOf(r).String())
+	b.WriteString("|" + r.Block() + "|" + strconv.Itoa(int(r.Priority())))
+	if shaped, ok := r.(Shaped); ok {
+		b.WriteString("|" + strconv.Itoa(shaped.Shape().Width()) + "x" + strconv.Itoa(shaped.Shape().Height()))
+	}
+	for _, stacks := range [][]item.Stack{r.Input(), r.Output()} {
+		b.WriteByte('|')
+		for i, s := range stacks {
+			if i != 0 {
+				b.WriteByte(',')
+			}
+			if s.Empty() {
+				continue
+			}
+			name, meta := s.Item().EncodeItem()
+			b.WriteString(name + ":" + strconv.Itoa(int(meta)) + "*" + strconv.Itoa(s.Count()))
+			if t, ok := s.Value("tag"); ok {
+				b.WriteString("#" + fmt.Sprint(t))
+			}
+		}
+	}
+	return b.String()
+}
+
 // recipe implements the Recipe interface. Structs in this package may embed it to gets its functionality
 // out of the box.
 type recipe struct {
@@ -2,17 +2,40 @@ package recipe
 
 import (
 	"golang.org/x/exp/slices"
+	"sync"
 )
 
-// recipes is a list of each recipe.
-var recipes []Recipe
+var (
+	// recipesMu protects recipes.
+	recipesMu sync.RWMutex
+	// recipes is a list of each recipe.
+	recipes []Recipe
+)
 
 // Recipes returns each recipe in a slice.
 func Recipes() []Recipe {
+	recipesMu.RLock()
+	defer recipesMu.RUnlock()
 	return slices.Clone(recipes)
 }
 
-// Register registers a new recipe.
+// Register registers a new recipe. Register may be called while the server is running, in which case players
+// receive the recipe the next time recipes are sent to them, for example using session.Session.SendRecipes.
 func Register(recipe Recipe) {
+	recipesMu.Lock()
+	defer recipesMu.Unlock()
 	recipes = append(recipes, recipe)
 }
+
+// Unregister removes a recipe registered using Register. The first registered recipe that is Equal to the recipe
+// passed is removed. Unregister returns false if no such recipe was registered.
+func Unregister(recipe Recipe) bool {
+	recipesMu.Lock()
+	defer recipesMu.Unlock()
+	i := slices.IndexFunc(recipes, func(r Recipe) bool { return Equal(r, recipe) })
+	if i == -1 {
+		return false
+	}
+	recipes = slices.Delete(recipes, i, i+1)
+	return true
+}
@@ -40,6 +40,7 @@ func init() {
 	world.RegisterItem(Compass{})
 	world.RegisterItem(Cookie{})
 	world.RegisterItem(CopperIngot{})
+	world.RegisterItem(Crossbow{})
 	world.RegisterItem(Diamond{})
 	world.RegisterItem(DiscFragment{})
 	world.RegisterItem(DragonBreath{})
@@ -50,7 +51,9 @@ func init() {
 	world.RegisterItem(Emerald{})
 	world.RegisterItem(EnchantedApple{})
 	world.RegisterItem(EnchantedBook{})
+	world.RegisterItem(EndCrystal{})
 	world.RegisterItem(EnderPearl{})
+	world.RegisterItem(EyeOfEnder{})
 	world.RegisterItem(Feather{})
 	world.RegisterItem(FermentedSpiderEye{})
 	world.RegisterItem(FireCharge{})
@@ -73,10 +76,12 @@ func init() {
 	world.RegisterItem(IronIngot{})
 	world.RegisterItem(IronNugget{})
 	world.RegisterItem(LapisLazuli{})
+	world.RegisterItem(Lead{})
 	world.RegisterItem(Leather{})
 	world.RegisterItem(MagmaCream{})
 	world.RegisterItem(MelonSlice{})
 	world.RegisterItem(MushroomStew{})
+	world.RegisterItem(NameTag{})
 	world.RegisterItem(Mutton{Cooked: true})
 	world.RegisterItem(Mutton{})
 	world.RegisterItem(NautilusShell{})
@@ -88,6 +93,7 @@ func init() {
 	world.RegisterItem(Paper{})
 	world.RegisterItem(PhantomMembrane{})
 	world.RegisterItem(PoisonousPotato{})
+	world.RegisterItem(ChorusFruit{})
 	world.RegisterItem(PoppedChorusFruit{})
 	world.RegisterItem(Porkchop{Cooked: true})
 	world.RegisterItem(Porkchop{})
@@ -109,13 +115,16 @@ func init() {
 	world.RegisterItem(Salmon{})
 	world.RegisterItem(Scute{})
 	world.RegisterItem(Shears{})
+	world.RegisterItem(Shield{})
 	world.RegisterItem(ShulkerShell{})
 	world.RegisterItem(Slimeball{})
 	world.RegisterItem(Snowball{})
 	world.RegisterItem(SpiderEye{})
 	world.RegisterItem(Spyglass{})
 	world.RegisterItem(Stick{})
+	world.RegisterItem(String{})
 	world.RegisterItem(Sugar{})
+	world.RegisterItem(Totem{})
 	world.RegisterItem(TropicalFish{})
 	world.RegisterItem(TurtleShell{})
 	world.RegisterItem(WarpedFungusOnAStick{})
@@ -158,4 +167,5 @@ func init() {
 	for _, stew := range StewTypes() {
 		world.RegisterItem(SuspiciousStew{Type: stew})
 	}
+	registerVanillaPotionMixes()
 }
@@ -1,6 +1,8 @@
 package item
 
 import (
+	"github.com/df-mc/dragonfly/server/world"
+	"sync"
 	"time"
 )
 
@@ -75,3 +77,75 @@ func (f FuelInfo) WithResidue(residue Stack) FuelInfo {
 func newFuelInfo(duration time.Duration) FuelInfo {
 	return FuelInfo{Duration: duration}
 }
+
+// itemKey identifies a type of item by its name and metadata value.
+type itemKey struct {
+	name string
+	meta int16
+}
+
+var (
+	// smeltingMu protects smelts and fuels.
+	smeltingMu sync.RWMutex
+	// smelts holds the SmeltInfo registered using RegisterSmelt.
+	smelts = map[itemKey]SmeltInfo{}
+	// fuels holds the FuelInfo registered using RegisterFuel.
+	fuels = map[itemKey]FuelInfo{}
+)
+
+// RegisterSmelt registers the SmeltInfo passed for the item passed, so that it may be smelted in smelters. The
+// SmeltInfo registered takes precedence over the SmeltInfo returned by items that implement Smeltable, which
+// allows changing the smelting recipes of existing items as well as adding recipes for custom items.
+func RegisterSmelt(i world.Item, info SmeltInfo) {
+	name, meta := i.EncodeItem()
+	smeltingMu.Lock()
+	defer smeltingMu.Unlock()
+	smelts[itemKey{name: name, meta: meta}] = info
+}
+
+// RegisterFuel registers the FuelInfo passed for the item passed, so that it may be used as fuel in smelters. The
+// FuelInfo registered takes precedence over the FuelInfo returned by items that implement Fuel.
+func RegisterFuel(i world.Item, info FuelInfo) {
+	name, meta := i.EncodeItem()
+	smeltingMu.Lock()
+	defer smeltingMu.Unlock()
+	fuels[itemKey{name: name, meta: meta}] = info
+}
+
+// SmeltInfoOf returns the SmeltInfo of the item passed, either registered using RegisterSmelt or returned by the
+// item if it implements Smeltable. False is returned if the item cannot be smelted.
+func SmeltInfoOf(i world.Item) (SmeltInfo, bool) {
+	if i == nil {
+		return SmeltInfo{}, false
+	}
+	name, meta := i.EncodeItem()
+	smeltingMu.RLock()
+	info, ok := smelts[itemKey{name: name, meta: meta}]
+	smeltingMu.RUnlock()
+	if ok {
+		return info, true
+	}
+	if s, ok := i.(Smeltable); ok {
+		return s.SmeltInfo(), true
+	}
+	return SmeltInfo{}, false
+}
+
+// FuelInfoOf returns the FuelInfo of the item passed, either registered using RegisterFuel or returned by the item
+// if it implements Fuel. False is returned if the item cannot be used as fuel.
+func FuelInfoOf(i world.Item) (FuelInfo, bool) {
+	if i == nil {
+		return FuelInfo{}, false
+	}
+	name, meta := i.EncodeItem()
+	smeltingMu.RLock()
+	info, ok := fuels[itemKey{name: name, meta: meta}]
+	smeltingMu.RUnlock()
+	if ok {
+		return info, true
+	}
+	if f, ok := i.(Fuel); ok {
+		return f.FuelInfo(), true
+	}
+	return FuelInfo{}, false
+}
@@ -0,0 +1,35 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"strings"
+)
+
+// SpawnEgg is an item that spawns an entity when used on a block. Spawn eggs are registered for all entity types in
+// the entity package that have a spawn egg, and for spawnable custom entity types when the server is created.
+type SpawnEgg struct {
+	// Type is the type of the entity spawned by the SpawnEgg. If it does not implement world.SpawnableEntityType,
+	// no entity is spawned.
+	Type world.EntityType
+}
+
+// UseOnBlock ...
+func (s SpawnEgg) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	t, ok := s.Type.(world.SpawnableEntityType)
+	if !ok {
+		return false
+	}
+	w.AddEntity(t.Spawn(pos.Side(face).Vec3Middle()))
+
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// EncodeItem ...
+func (s SpawnEgg) EncodeItem() (name string, meta int16) {
+	// Entities that were reworked, such as villagers (minecraft:villager_v2), use the spawn egg of the entity they
+	// replaced.
+	return strings.TrimSuffix(s.Type.EncodeEntity(), "_v2") + "_spawn_egg", 0
+}
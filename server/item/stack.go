@@ -21,7 +21,8 @@ type Stack struct {
 	customName string
 	lore       []string
 
-	damage int
+	damage      int
+	unbreakable bool
 
 	anvilCost int
 
@@ -100,6 +101,10 @@ func (s Stack) Damage(d int) Stack {
 		// Not a durable item.
 		return s
 	}
+	if s.unbreakable && d > 0 {
+		// Unbreakable items can still be repaired, but never lose durability.
+		return s
+	}
 	durability := s.Durability()
 	info := durable.DurabilityInfo()
 	if durability-d <= 0 {
@@ -207,7 +212,8 @@ func (s Stack) Lore() []string {
 // WithValue may be called with a nil value, in which case the value at the key will be cleared.
 //
 // WithValue stores Values by encoding them using the encoding/gob package. Users of WithValue must ensure
-// that their value is valid for encoding with this package.
+// that their value is valid for encoding with this package. Values of types other than the basic types, such
+// as structs, must be registered using gob.Register before they are stored.
 func (s Stack) WithValue(key string, val any) Stack {
 	s.data = copyMap(s.data)
 	if val != nil {
@@ -225,6 +231,13 @@ func (s Stack) Value(key string) (val any, ok bool) {
 	return val, ok
 }
 
+// StackValue returns the value of type T set to the Stack passed at the key passed using Stack.WithValue. If no
+// value is set at the key, or if the value is not of type T, the zero value of T is returned and ok is false.
+func StackValue[T any](s Stack, key string) (val T, ok bool) {
+	val, ok = s.data[key].(T)
+	return val, ok
+}
+
 // WithEnchantments returns the current stack with the passed enchantments. If an enchantment is not compatible
 // with the item stack, it will not be applied.
 func (s Stack) WithEnchantments(enchants ...Enchantment) Stack {
@@ -295,6 +308,28 @@ func (s Stack) WithAnvilCost(anvilCost int) Stack {
 	return s
 }
 
+// Unbreakable checks if the Stack is unbreakable. Unbreakable stacks never lose durability, but may still be
+// repaired.
+func (s Stack) Unbreakable() bool {
+	return s.unbreakable
+}
+
+// AsUnbreakable returns the current Stack as an unbreakable Stack that never loses durability. AsUnbreakable has no
+// effect if the item of the Stack is not Durable.
+func (s Stack) AsUnbreakable() Stack {
+	if _, ok := s.Item().(Durable); ok {
+		s.unbreakable = true
+	}
+	return s
+}
+
+// AsBreakable returns the current Stack as a Stack that loses durability when it is used, undoing a call to
+// AsUnbreakable.
+func (s Stack) AsBreakable() Stack {
+	s.unbreakable = false
+	return s
+}
+
 // AddStack adds another stack to the stack and returns both stacks. The first stack returned will have as
 // many items in it as possible to fit in the stack, according to a max count of either 64 or otherwise as
 // returned by Item.MaxCount(). The second stack will have the leftover items: It may be empty if the count of
@@ -335,7 +370,7 @@ func (s Stack) Comparable(s2 Stack) bool {
 
 	name, meta := s.Item().EncodeItem()
 	name2, meta2 := s2.Item().EncodeItem()
-	if name != name2 || meta != meta2 || s.anvilCost != s2.anvilCost || s.customName != s2.customName {
+	if name != name2 || meta != meta2 || s.anvilCost != s2.anvilCost || s.customName != s2.customName || s.unbreakable != s2.unbreakable {
 		return false
 	}
 	for !slices.Equal(s.lore, s2.lore) {
@@ -373,6 +408,13 @@ func (s Stack) Values() map[string]any {
 	return copyMap(s.data)
 }
 
+// withItem returns the stack with its item replaced by the item passed. All other properties of the stack, such as
+// its enchantments and damage, are kept.
+func (s Stack) withItem(t world.Item) Stack {
+	s.item, s.id = t, newID()
+	return s
+}
+
 // stackID is a counter for unique stack IDs.
 var stackID = new(int32)
 
@@ -0,0 +1,20 @@
+package item
+
+// Totem is an item that prevents the death of the entity holding it in either hand. The totem is consumed when it
+// saves the entity, which then receives a number of effects to help it recover.
+type Totem struct{}
+
+// MaxCount ...
+func (Totem) MaxCount() int {
+	return 1
+}
+
+// OffHand ...
+func (Totem) OffHand() bool {
+	return true
+}
+
+// EncodeItem ...
+func (Totem) EncodeItem() (name string, meta int16) {
+	return "minecraft:totem_of_undying", 0
+}
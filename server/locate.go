@@ -0,0 +1,101 @@
+package server
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/cmd"
+	"github.com/df-mc/dragonfly/server/world"
+	"math"
+	"sort"
+)
+
+// locateRadius is the radius in chunks searched by the /locate command.
+const locateRadius = 100
+
+// registerLocateCommand registers the /locate command, unless a command with the same name was already registered.
+func (srv *Server) registerLocateCommand() {
+	if _, ok := cmd.ByAlias("locate"); ok {
+		return
+	}
+	cmd.Register(cmd.New("locate", "Displays the coordinates of the closest biome or structure of a given type.", nil, locateBiome{}, locateStructure{}))
+}
+
+// locateBiome implements the /locate biome command, which finds the closest column with a specific biome using
+// World.LocateBiome.
+type locateBiome struct {
+	Biome cmd.SubCommand `cmd:"biome"`
+	Name  biomeName      `cmd:"biome"`
+}
+
+// Allow only allows operators to locate biomes.
+func (locateBiome) Allow(src cmd.Source) bool {
+	return operator(src)
+}
+
+// Run ...
+func (l locateBiome) Run(src cmd.Source, o *cmd.Output) {
+	b, ok := world.BiomeByName(string(l.Name))
+	if !ok {
+		o.Errorf("Unknown biome %v.", l.Name)
+		return
+	}
+	origin := cube.PosFromVec3(src.Position())
+	pos, ok := src.World().LocateBiome(b, origin, locateRadius)
+	if !ok {
+		o.Errorf("Could not find a %v biome within a reasonable distance.", l.Name)
+		return
+	}
+	o.Printf("The nearest %v is at %v (%v blocks away).", l.Name, pos, horizontalDistance(origin, pos))
+}
+
+// locateStructure implements the /locate structure command, which finds the closest structure with a specific
+// name using World.LocateStructure.
+type locateStructure struct {
+	Structure cmd.SubCommand `cmd:"structure"`
+	Name      string         `cmd:"structure"`
+}
+
+// Allow only allows operators to locate structures.
+func (locateStructure) Allow(src cmd.Source) bool {
+	return operator(src)
+}
+
+// Run ...
+func (l locateStructure) Run(src cmd.Source, o *cmd.Output) {
+	origin := cube.PosFromVec3(src.Position())
+	pos, ok := src.World().LocateStructure(l.Name, origin, locateRadius)
+	if !ok {
+		o.Errorf("Could not find a %v structure within a reasonable distance.", l.Name)
+		return
+	}
+	o.Printf("The nearest %v is at %v (%v blocks away).", l.Name, pos, horizontalDistance(origin, pos))
+}
+
+// horizontalDistance returns the distance between two positions on the X and Z axes, rounded to whole blocks.
+func horizontalDistance(a, b cube.Pos) int {
+	dx, dz := float64(a[0]-b[0]), float64(a[2]-b[2])
+	return int(math.Round(math.Sqrt(dx*dx + dz*dz)))
+}
+
+// biomeName is a cmd.Enum holding the names of all registered biomes.
+type biomeName string
+
+// Type ...
+func (biomeName) Type() string {
+	return "Biome"
+}
+
+// Options ...
+func (biomeName) Options(cmd.Source) []string {
+	names := make([]string, 0, len(world.Biomes()))
+	for _, b := range world.Biomes() {
+		names = append(names, b.String())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// operator checks if the cmd.Source passed is an operator.
+func operator(src cmd.Source) bool {
+	op, ok := src.(interface{ Operator() bool })
+	return ok && op.Operator()
+}
@@ -3,6 +3,7 @@ package player
 import (
 	"github.com/df-mc/dragonfly/server/entity/effect"
 	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/recipe"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/google/uuid"
@@ -54,6 +55,8 @@ type Data struct {
 	FireTicks int64
 	// FallDistance is the distance the player has currently been falling. This is used to calculate fall damage.
 	FallDistance float64
+	// UnlockedRecipes contains the recipes that the player has unlocked.
+	UnlockedRecipes []recipe.Recipe
 	// World is the world the player was last in.
 	World *world.World
 }
@@ -0,0 +1,77 @@
+// Package economy implements a lightweight currency system keyed by player UUIDs. An Economy may be passed to the
+// server.Config so that built-in systems that charge currency, such as custom trades, may use it. External backends,
+// like a database shared between servers, may be used by implementing the Economy interface.
+package economy
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+)
+
+var (
+	// ErrInsufficientFunds is returned by Economy.Withdraw if the balance of an account is lower than the amount that
+	// was attempted to be withdrawn.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	// ErrNegativeAmount is returned when a negative amount is passed to Economy.Deposit or Economy.Withdraw.
+	ErrNegativeAmount = errors.New("amount must not be negative")
+)
+
+// Economy manages the balances of accounts, each of which is identified by a UUID. Amounts are expressed in the
+// smallest unit of the currency, so that no precision is lost when adding or subtracting from balances.
+// Methods on an Economy must be safe to call from multiple goroutines simultaneously.
+type Economy interface {
+	// Balance returns the current balance of the account with the UUID passed. Accounts that have never had money
+	// deposited have a balance of 0.
+	Balance(id uuid.UUID) (int64, error)
+	// Deposit adds amount to the balance of the account with the UUID passed. ErrNegativeAmount is returned if amount
+	// is negative.
+	Deposit(id uuid.UUID, amount int64) error
+	// Withdraw subtracts amount from the balance of the account with the UUID passed. If the balance is lower than
+	// amount, ErrInsufficientFunds is returned and the balance is left unchanged. ErrNegativeAmount is returned if
+	// amount is negative.
+	Withdraw(id uuid.UUID, amount int64) error
+	// Closer is called when the server is closed, so that any balances held may be persisted.
+	io.Closer
+}
+
+// Transfer moves amount from the account with UUID from to the account with UUID to. If the withdrawal from the first
+// account fails, the balance of neither account is changed. If the deposit fails, the amount is returned to the first
+// account.
+func Transfer(e Economy, from, to uuid.UUID, amount int64) error {
+	if err := e.Withdraw(from, amount); err != nil {
+		return err
+	}
+	if err := e.Deposit(to, amount); err != nil {
+		if rerr := e.Deposit(from, amount); rerr != nil {
+			return fmt.Errorf("deposit: %w (refund failed: %v)", err, rerr)
+		}
+		return fmt.Errorf("deposit: %w", err)
+	}
+	return nil
+}
+
+// Compile time check to make sure NopEconomy implements Economy.
+var _ Economy = NopEconomy{}
+
+// NopEconomy is an Economy that holds no balances. Every account has a balance of 0 and withdrawals of any non-zero
+// amount fail with ErrInsufficientFunds. Deposits are accepted but discarded.
+type NopEconomy struct{}
+
+func (NopEconomy) Balance(uuid.UUID) (int64, error) { return 0, nil }
+func (NopEconomy) Deposit(_ uuid.UUID, amount int64) error {
+	if amount < 0 {
+		return ErrNegativeAmount
+	}
+	return nil
+}
+func (NopEconomy) Withdraw(_ uuid.UUID, amount int64) error {
+	if amount < 0 {
+		return ErrNegativeAmount
+	} else if amount > 0 {
+		return ErrInsufficientFunds
+	}
+	return nil
+}
+func (NopEconomy) Close() error { return nil }
@@ -0,0 +1,56 @@
+package economy
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"os"
+	"path/filepath"
+)
+
+// File is an Economy that keeps balances in memory and writes them to a JSON file when Save or Close is called. It
+// is suitable for single servers that don't share balances with other servers.
+type File struct {
+	*Memory
+	path string
+}
+
+// NewFile opens the JSON file at the path passed and loads all balances from it. If the file does not exist, an empty
+// economy is returned and the file is created once the balances are first saved.
+func NewFile(path string) (*File, error) {
+	f := &File{Memory: NewMemory(nil), path: path}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return f, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read economy file: %w", err)
+	}
+	var balances map[uuid.UUID]int64
+	if err := json.Unmarshal(data, &balances); err != nil {
+		return nil, fmt.Errorf("decode economy file: %w", err)
+	}
+	f.Memory = NewMemory(balances)
+	return f, nil
+}
+
+// Save writes all balances to the file of the File economy. The balances are first written to a temporary file
+// which then replaces the old file, so that balances are not lost if writing is interrupted.
+func (f *File) Save() error {
+	data, err := json.Marshal(f.Balances())
+	if err != nil {
+		return fmt.Errorf("encode economy file: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0777); err != nil {
+		return fmt.Errorf("create economy directory: %w", err)
+	}
+	tmp := f.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("write economy file: %w", err)
+	}
+	return os.Rename(tmp, f.path)
+}
+
+// Close saves the balances of the File economy.
+func (f *File) Close() error {
+	return f.Save()
+}
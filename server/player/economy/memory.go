@@ -0,0 +1,69 @@
+package economy
+
+import (
+	"github.com/google/uuid"
+	"golang.org/x/exp/maps"
+	"sync"
+)
+
+// Memory is an Economy that keeps all balances in memory. Balances are lost when the program ends. Memory may be
+// embedded by implementations that load and store balances elsewhere, like File. The zero value of Memory is ready
+// for use.
+type Memory struct {
+	mu       sync.Mutex
+	balances map[uuid.UUID]int64
+}
+
+// NewMemory returns a Memory economy with the starting balances passed. The map may be nil.
+func NewMemory(balances map[uuid.UUID]int64) *Memory {
+	return &Memory{balances: maps.Clone(balances)}
+}
+
+// Balance ...
+func (m *Memory) Balance(id uuid.UUID) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.balances[id], nil
+}
+
+// Deposit ...
+func (m *Memory) Deposit(id uuid.UUID, amount int64) error {
+	if amount < 0 {
+		return ErrNegativeAmount
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.balances == nil {
+		m.balances = make(map[uuid.UUID]int64)
+	}
+	m.balances[id] += amount
+	return nil
+}
+
+// Withdraw ...
+func (m *Memory) Withdraw(id uuid.UUID, amount int64) error {
+	if amount < 0 {
+		return ErrNegativeAmount
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.balances[id] < amount {
+		return ErrInsufficientFunds
+	}
+	if amount != 0 {
+		m.balances[id] -= amount
+	}
+	return nil
+}
+
+// Balances returns a copy of all balances held by the Memory economy.
+func (m *Memory) Balances() map[uuid.UUID]int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return maps.Clone(m.balances)
+}
+
+// Close ...
+func (m *Memory) Close() error {
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/event"
 	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/item/recipe"
 	"github.com/df-mc/dragonfly/server/player/skin"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
@@ -23,6 +25,10 @@ type Handler interface {
 	HandleJump()
 	// HandleTeleport handles the teleportation of a player. ctx.Cancel() may be called to cancel it.
 	HandleTeleport(ctx *event.Context, pos mgl64.Vec3)
+	// HandleTeleportCause handles the teleportation of a player with the world.TeleportCause passed, such as a
+	// portal or an ender pearl. It is called for every teleport after HandleTeleport, unless that cancelled the
+	// teleport. ctx.Cancel() may be called to cancel it.
+	HandleTeleportCause(ctx *event.Context, pos mgl64.Vec3, cause world.TeleportCause)
 	// HandleChangeWorld handles when the player is added to a new world. before may be nil.
 	HandleChangeWorld(before, after *world.World)
 	// HandleToggleSprint handles when the player starts or stops sprinting.
@@ -31,6 +37,10 @@ type Handler interface {
 	// HandleToggleSneak handles when the player starts or stops sneaking.
 	// After is true if the player is sneaking after toggling (changing their sneaking state).
 	HandleToggleSneak(ctx *event.Context, after bool)
+	// HandleToggleGlide handles when the player starts or stops gliding with an elytra. ctx.Cancel() may be called
+	// to prevent the player from starting to glide. Stopping to glide cannot be cancelled.
+	// After is true if the player is gliding after toggling (changing their gliding state).
+	HandleToggleGlide(ctx *event.Context, after bool)
 	// HandleChat handles a message sent in the chat by a player. ctx.Cancel() may be called to cancel the
 	// message being sent in chat.
 	// The message may be changed by assigning to *message.
@@ -46,8 +56,30 @@ type Handler interface {
 	// damage being dealt to the player.
 	// The damage dealt to the player may be changed by assigning to *damage.
 	HandleHurt(ctx *event.Context, damage *float64, attackImmunity *time.Duration, src world.DamageSource)
-	// HandleDeath handles the player dying to a particular damage cause.
+	// HandleFall handles the player landing on the ground after falling the distance passed in blocks.
+	// ctx.Cancel() may be called to cancel the fall damage dealt to the player. The damage dealt may be changed by
+	// assigning to *damage. HandleFall is called even if the fall does not deal damage.
+	HandleFall(ctx *event.Context, distance float64, damage *float64)
+	// HandleLethalDamage handles the player taking damage from the world.DamageSource passed that would make it
+	// die, before a totem of undying is used. ctx.Cancel() may be called to prevent the player from dying, in which
+	// case it is left with 1 health.
+	HandleLethalDamage(ctx *event.Context, src world.DamageSource)
+	// HandleTotemUse handles the player using a totem of undying to avoid dying to the world.DamageSource passed.
+	// ctx.Cancel() may be called to prevent the totem from being used, in which case the player dies.
+	HandleTotemUse(ctx *event.Context, src world.DamageSource)
+	// HandleDeath handles the player dying to a particular damage cause. *keepInv is initially set to the
+	// KeepInventory setting of the world.World that the player died in. HandleLethalDamage may be used to prevent
+	// the player from dying.
 	HandleDeath(src world.DamageSource, keepInv *bool)
+	// HandleDeathMessage handles the death message of the player being broadcast in the global chat after it died.
+	// It is only called if death messages are enabled in the world.Config of the world the player died in. The
+	// message is derived from the damage source using entity.DeathMessage and may be changed by assigning to
+	// *message. ctx.Cancel() may be called to prevent the message from being broadcast.
+	HandleDeathMessage(ctx *event.Context, message *string)
+	// HandleDeathDrops handles the items of the player being dropped at the position passed after it died without
+	// keeping its inventory. The items dropped may be changed by assigning to *drops. ctx.Cancel() may be called to
+	// prevent the items from being dropped, for example to store them in a block.Grave instead.
+	HandleDeathDrops(ctx *event.Context, pos mgl64.Vec3, drops *[]item.Stack)
 	// HandleRespawn handles the respawning of the player in the world. The spawn position passed may be
 	// changed by assigning to *pos. The world.World in which the Player is respawned may be modifying by assigning to
 	// *w. This world may be the world the Player died in, but it might also point to a different world (the overworld)
@@ -69,6 +101,15 @@ type Handler interface {
 	// HandleBlockPick handles the player picking a specific block at a position in its world. ctx.Cancel()
 	// may be called to cancel the block being picked.
 	HandleBlockPick(ctx *event.Context, pos cube.Pos, b world.Block)
+	// HandleBucketFill handles the player filling a bucket with the liquid at a position in its world.
+	// ctx.Cancel() may be called to cancel the bucket being filled.
+	HandleBucketFill(ctx *event.Context, pos cube.Pos, liquid world.Liquid)
+	// HandleBucketEmpty handles the player emptying a bucket, placing the liquid passed at a position in its
+	// world. ctx.Cancel() may be called to cancel the bucket being emptied.
+	HandleBucketEmpty(ctx *event.Context, pos cube.Pos, liquid world.Liquid)
+	// HandleHeldItemSwap handles the player swapping the items held in its main hand and off-hand. ctx.Cancel() may
+	// be called to cancel the swap, for example to bind an ability to swapping hands instead.
+	HandleHeldItemSwap(ctx *event.Context, mainHand, offHand item.Stack)
 	// HandleItemUse handles the player using an item in the air. It is called for each item, although most
 	// will not actually do anything. Items such as snowballs may be thrown if HandleItemUse does not cancel
 	// the context using ctx.Cancel(). It is not called if the player is holding no item.
@@ -107,7 +148,25 @@ type Handler interface {
 	HandlePunchAir(ctx *event.Context)
 	// HandleSignEdit handles the player editing a sign. It is called for every keystroke while editing a sign and
 	// has both the old text passed and the text after the edit. This typically only has a change of one character.
+	// The new text has already been validated and had control characters removed. ctx.Cancel() may be called to
+	// prevent the edit, for example to filter profanity.
 	HandleSignEdit(ctx *event.Context, oldText, newText string)
+	// HandleBookEdit handles the player editing the pages of a writable book in the inventory slot passed. The
+	// old pages are passed together with the pages after the edit, which have already been validated and may be
+	// modified.
+	HandleBookEdit(ctx *event.Context, slot int, oldPages []string, newPages *[]string)
+	// HandleBookSign handles the player signing a writable book in the inventory slot passed, turning it into a
+	// written book. The title has already been validated and may be modified.
+	HandleBookSign(ctx *event.Context, slot int, title *string, pages []string)
+	// HandleCraft handles the player crafting an item using the recipe passed, after the items in its crafting grid
+	// were validated to form the recipe. The items that the player obtains are passed and may be changed, for example
+	// to give custom results. ctx.Cancel() may be called to prevent the player from crafting the recipe, for example
+	// if the recipe is locked for the player.
+	HandleCraft(ctx *event.Context, r recipe.Recipe, output *[]item.Stack)
+	// HandleCraftPreview handles the items in the crafting grid of the player forming the recipe passed. The items
+	// passed are shown to the player as the result of the recipe before it crafts it, and may be changed to match
+	// the changes made in HandleCraft. ctx.Cancel() may be called to show no result at all.
+	HandleCraftPreview(ctx *event.Context, r recipe.Recipe, output *[]item.Stack)
 	// HandleItemDamage handles the event wherein the item either held by the player or as armour takes
 	// damage through usage.
 	// The type of the item may be checked to determine whether it was armour or a tool used. The damage to
@@ -120,14 +179,28 @@ type Handler interface {
 	// ctx.Cancel() may be called to prevent the player from dropping the entity.Item passed on the ground.
 	// e.Item() may be called to obtain the item stack dropped.
 	HandleItemDrop(ctx *event.Context, e *entity.Item)
+	// HandleContainerOpen handles the player opening a block container, such as a chest or a crafting table, at
+	// the position passed. The inventory of the container is passed if it holds items, such as for chests and
+	// furnaces, and is nil otherwise. ctx.Cancel() may be called to prevent the player from opening the container.
+	HandleContainerOpen(ctx *event.Context, pos cube.Pos, b world.Block, inv *inventory.Inventory)
+	// HandleContainerClose handles the player closing the block container at the position passed that it
+	// previously opened. The block and inventory passed are the same as those passed to HandleContainerOpen,
+	// unless the container was changed or broken while it was open.
+	HandleContainerClose(pos cube.Pos, b world.Block, inv *inventory.Inventory)
 	// HandleTransfer handles a player being transferred to another server. ctx.Cancel() may be called to
 	// cancel the transfer.
 	HandleTransfer(ctx *event.Context, addr *net.UDPAddr)
 	// HandleCommandExecution handles the command execution of a player, who wrote a command in the chat.
 	// ctx.Cancel() may be called to cancel the command execution.
 	HandleCommandExecution(ctx *event.Context, command cmd.Command, args []string)
+	// HandleReport handles the player reporting another player, target, through a call to Player.Report, such as
+	// by the /report command that players may run. The reason
+	// passed is the reason given for the report, and messages holds the most recent chat messages of the target, with
+	// the oldest message first, so that they may be reviewed.
+	HandleReport(target *Player, reason string, messages []string)
 	// HandleQuit handles the closing of a player. It is always called when the player is disconnected,
-	// regardless of the reason.
+	// regardless of the reason. The reason why the player was disconnected may be obtained using
+	// Player.DisconnectReason.
 	HandleQuit()
 }
 
@@ -139,34 +212,51 @@ type NopHandler struct{}
 // Compile time check to make sure NopHandler implements Handler.
 var _ Handler = NopHandler{}
 
-func (NopHandler) HandleItemDrop(*event.Context, *entity.Item)                                {}
-func (NopHandler) HandleMove(*event.Context, mgl64.Vec3, float64, float64)                    {}
-func (NopHandler) HandleJump()                                                                {}
-func (NopHandler) HandleTeleport(*event.Context, mgl64.Vec3)                                  {}
-func (NopHandler) HandleChangeWorld(*world.World, *world.World)                               {}
-func (NopHandler) HandleToggleSprint(*event.Context, bool)                                    {}
-func (NopHandler) HandleToggleSneak(*event.Context, bool)                                     {}
-func (NopHandler) HandleCommandExecution(*event.Context, cmd.Command, []string)               {}
-func (NopHandler) HandleTransfer(*event.Context, *net.UDPAddr)                                {}
-func (NopHandler) HandleChat(*event.Context, *string)                                         {}
-func (NopHandler) HandleSkinChange(*event.Context, *skin.Skin)                                {}
-func (NopHandler) HandleStartBreak(*event.Context, cube.Pos)                                  {}
-func (NopHandler) HandleBlockBreak(*event.Context, cube.Pos, *[]item.Stack, *int)             {}
-func (NopHandler) HandleBlockPlace(*event.Context, cube.Pos, world.Block)                     {}
-func (NopHandler) HandleBlockPick(*event.Context, cube.Pos, world.Block)                      {}
-func (NopHandler) HandleSignEdit(*event.Context, string, string)                              {}
-func (NopHandler) HandleItemPickup(*event.Context, item.Stack)                                {}
-func (NopHandler) HandleItemUse(*event.Context)                                               {}
-func (NopHandler) HandleItemUseOnBlock(*event.Context, cube.Pos, cube.Face, mgl64.Vec3)       {}
-func (NopHandler) HandleItemUseOnEntity(*event.Context, world.Entity)                         {}
-func (NopHandler) HandleItemConsume(*event.Context, item.Stack)                               {}
-func (NopHandler) HandleItemDamage(*event.Context, item.Stack, int)                           {}
-func (NopHandler) HandleAttackEntity(*event.Context, world.Entity, *float64, *float64, *bool) {}
-func (NopHandler) HandleExperienceGain(*event.Context, *int)                                  {}
-func (NopHandler) HandlePunchAir(*event.Context)                                              {}
-func (NopHandler) HandleHurt(*event.Context, *float64, *time.Duration, world.DamageSource)    {}
-func (NopHandler) HandleHeal(*event.Context, *float64, world.HealingSource)                   {}
-func (NopHandler) HandleFoodLoss(*event.Context, int, *int)                                   {}
-func (NopHandler) HandleDeath(world.DamageSource, *bool)                                      {}
-func (NopHandler) HandleRespawn(*mgl64.Vec3, **world.World)                                   {}
-func (NopHandler) HandleQuit()                                                                {}
+func (NopHandler) HandleItemDrop(*event.Context, *entity.Item)                                     {}
+func (NopHandler) HandleMove(*event.Context, mgl64.Vec3, float64, float64)                         {}
+func (NopHandler) HandleJump()                                                                     {}
+func (NopHandler) HandleTeleport(*event.Context, mgl64.Vec3)                                       {}
+func (NopHandler) HandleTeleportCause(*event.Context, mgl64.Vec3, world.TeleportCause)             {}
+func (NopHandler) HandleChangeWorld(*world.World, *world.World)                                    {}
+func (NopHandler) HandleToggleSprint(*event.Context, bool)                                         {}
+func (NopHandler) HandleToggleSneak(*event.Context, bool)                                          {}
+func (NopHandler) HandleToggleGlide(*event.Context, bool)                                          {}
+func (NopHandler) HandleCommandExecution(*event.Context, cmd.Command, []string)                    {}
+func (NopHandler) HandleTransfer(*event.Context, *net.UDPAddr)                                     {}
+func (NopHandler) HandleContainerOpen(*event.Context, cube.Pos, world.Block, *inventory.Inventory) {}
+func (NopHandler) HandleContainerClose(cube.Pos, world.Block, *inventory.Inventory)                {}
+func (NopHandler) HandleChat(*event.Context, *string)                                              {}
+func (NopHandler) HandleSkinChange(*event.Context, *skin.Skin)                                     {}
+func (NopHandler) HandleStartBreak(*event.Context, cube.Pos)                                       {}
+func (NopHandler) HandleBlockBreak(*event.Context, cube.Pos, *[]item.Stack, *int)                  {}
+func (NopHandler) HandleBlockPlace(*event.Context, cube.Pos, world.Block)                          {}
+func (NopHandler) HandleBlockPick(*event.Context, cube.Pos, world.Block)                           {}
+func (NopHandler) HandleBucketFill(*event.Context, cube.Pos, world.Liquid)                         {}
+func (NopHandler) HandleBucketEmpty(*event.Context, cube.Pos, world.Liquid)                        {}
+func (NopHandler) HandleSignEdit(*event.Context, string, string)                                   {}
+func (NopHandler) HandleBookEdit(*event.Context, int, []string, *[]string)                         {}
+func (NopHandler) HandleBookSign(*event.Context, int, *string, []string)                           {}
+func (NopHandler) HandleItemPickup(*event.Context, item.Stack)                                     {}
+func (NopHandler) HandleHeldItemSwap(*event.Context, item.Stack, item.Stack)                       {}
+func (NopHandler) HandleItemUse(*event.Context)                                                    {}
+func (NopHandler) HandleItemUseOnBlock(*event.Context, cube.Pos, cube.Face, mgl64.Vec3)            {}
+func (NopHandler) HandleItemUseOnEntity(*event.Context, world.Entity)                              {}
+func (NopHandler) HandleItemConsume(*event.Context, item.Stack)                                    {}
+func (NopHandler) HandleCraft(*event.Context, recipe.Recipe, *[]item.Stack)                        {}
+func (NopHandler) HandleCraftPreview(*event.Context, recipe.Recipe, *[]item.Stack)                 {}
+func (NopHandler) HandleItemDamage(*event.Context, item.Stack, int)                                {}
+func (NopHandler) HandleAttackEntity(*event.Context, world.Entity, *float64, *float64, *bool)      {}
+func (NopHandler) HandleExperienceGain(*event.Context, *int)                                       {}
+func (NopHandler) HandlePunchAir(*event.Context)                                                   {}
+func (NopHandler) HandleHurt(*event.Context, *float64, *time.Duration, world.DamageSource)         {}
+func (NopHandler) HandleHeal(*event.Context, *float64, world.HealingSource)                        {}
+func (NopHandler) HandleFoodLoss(*event.Context, int, *int)                                        {}
+func (NopHandler) HandleFall(*event.Context, float64, *float64)                                    {}
+func (NopHandler) HandleLethalDamage(*event.Context, world.DamageSource)                           {}
+func (NopHandler) HandleTotemUse(*event.Context, world.DamageSource)                               {}
+func (NopHandler) HandleDeath(world.DamageSource, *bool)                                           {}
+func (NopHandler) HandleDeathMessage(*event.Context, *string)                                      {}
+func (NopHandler) HandleDeathDrops(*event.Context, mgl64.Vec3, *[]item.Stack)                      {}
+func (NopHandler) HandleRespawn(*mgl64.Vec3, **world.World)                                        {}
+func (NopHandler) HandleReport(*Player, string, []string)                                          {}
+func (NopHandler) HandleQuit()                                                                     {}
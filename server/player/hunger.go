@@ -158,3 +158,6 @@ type StarvationDamageSource struct{}
 func (StarvationDamageSource) ReducedByArmour() bool     { return false }
 func (StarvationDamageSource) ReducedByResistance() bool { return false }
 func (StarvationDamageSource) Fire() bool                { return false }
+func (StarvationDamageSource) DeathMessage(name string) string {
+	return name + " starved to death"
+}
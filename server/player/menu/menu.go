@@ -0,0 +1,111 @@
+package menu
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/item"
+	"strings"
+)
+
+// Menu represents a virtual container that may be sent to a Submitter, such as a player. Unlike the containers of
+// blocks, a Menu does not exist in the world and the items in it cannot be taken out or moved around. Instead,
+// clicking one of the slots of the Menu calls the Submittable of the Menu, which makes Menus suitable for
+// interfaces such as shops and kit selectors.
+type Menu struct {
+	typ         Type
+	name        string
+	submittable Submittable
+	items       map[int]item.Stack
+}
+
+// New creates a new Menu of the Type passed, using the Submittable passed to handle the slots of the Menu
+// being clicked. The name passed is shown at the top of the Menu and is formatted following the rules of
+// fmt.Sprintln.
+func New(t Type, submittable Submittable, name ...any) Menu {
+	return Menu{typ: t, name: strings.TrimSuffix(fmt.Sprintln(name...), "\n"), submittable: submittable}
+}
+
+// WithItem creates a copy of the Menu and puts the item.Stack passed in the slot passed, after which the new
+// Menu is returned. WithItem panics if the slot is not within the size of the Type of the Menu.
+func (m Menu) WithItem(slot int, it item.Stack) Menu {
+	if slot < 0 || slot >= m.typ.Size() {
+		panic(fmt.Sprintf("slot %v is out of range for menu of size %v", slot, m.typ.Size()))
+	}
+	items := make(map[int]item.Stack, len(m.items)+1)
+	for k, v := range m.items {
+		items[k] = v
+	}
+	items[slot] = it
+	m.items = items
+	return m
+}
+
+// WithItems creates a copy of the Menu and puts the item.Stacks passed in the slots of the Menu, starting at the
+// first slot, after which the new Menu is returned. Items that do not fit in the Menu are ignored.
+func (m Menu) WithItems(items ...item.Stack) Menu {
+	for slot, it := range items {
+		if slot >= m.typ.Size() {
+			break
+		}
+		m = m.WithItem(slot, it)
+	}
+	return m
+}
+
+// Type returns the Type of the Menu as passed to New.
+func (m Menu) Type() Type {
+	return m.typ
+}
+
+// Name returns the formatted name passed to the Menu upon construction using New.
+func (m Menu) Name() string {
+	return m.name
+}
+
+// Items returns all items in the Menu. The length of the slice returned is always equal to the size of the Type
+// of the Menu, with empty slots holding an empty item.Stack.
+func (m Menu) Items() []item.Stack {
+	items := make([]item.Stack, m.typ.Size())
+	for slot, it := range m.items {
+		items[slot] = it
+	}
+	return items
+}
+
+// Submit submits a click by the Submitter passed on the slot passed, holding the item.Stack passed, to the
+// Submittable of the Menu.
+func (m Menu) Submit(submitter Submitter, slot int, it item.Stack) {
+	if m.submittable != nil {
+		m.submittable.Submit(submitter, slot, it)
+	}
+}
+
+// Close notifies the Submittable of the Menu that the Menu was closed by the Submitter passed, if it implements
+// the Closer interface.
+func (m Menu) Close(submitter Submitter) {
+	if closer, ok := m.submittable.(Closer); ok {
+		closer.Close(submitter)
+	}
+}
+
+// Submittable is a type that handles the slots of a Menu being clicked.
+type Submittable interface {
+	// Submit is called when the Submitter passed clicks the slot passed of a Menu. The item.Stack passed is the
+	// item in that slot, which is empty if the slot holds no item. Submit may send another Menu to the
+	// Submitter, for example to move to a different page.
+	Submit(submitter Submitter, slot int, it item.Stack)
+}
+
+// Closer may be implemented by a Submittable to be notified when a Menu is closed, either by the Submitter
+// closing it or by another container being opened.
+type Closer interface {
+	// Close is called when the Submitter passed closes the Menu.
+	Close(submitter Submitter)
+}
+
+// Submitter is an entity that is able to open a Menu and click its slots. Generally, this is a player.
+type Submitter interface {
+	// SendMenu sends a Menu to the Submitter, closing any container it had opened before.
+	SendMenu(m Menu)
+	// CloseContainer closes the container that the Submitter currently has opened, including a Menu.
+	CloseContainer()
+}
@@ -0,0 +1,41 @@
+package menu
+
+// Type is the type of container that a Menu is shown as. The Type of a Menu determines its layout and the
+// amount of slots it has.
+type Type struct {
+	menuType
+}
+
+// Chest returns the Type of a Menu shown as a chest, which has 27 slots laid out in three rows of nine.
+func Chest() Type {
+	return Type{0}
+}
+
+// Hopper returns the Type of a Menu shown as a hopper, which has 5 slots laid out in a single row.
+func Hopper() Type {
+	return Type{1}
+}
+
+// Dispenser returns the Type of a Menu shown as a dispenser, which has 9 slots laid out in a three by three
+// grid.
+func Dispenser() Type {
+	return Type{2}
+}
+
+type menuType uint8
+
+// Uint8 returns the Type as a uint8.
+func (t menuType) Uint8() uint8 {
+	return uint8(t)
+}
+
+// Size returns the amount of slots of a Menu of the Type.
+func (t menuType) Size() int {
+	switch t {
+	case 1:
+		return 5
+	case 2:
+		return 9
+	}
+	return 27
+}
@@ -2,12 +2,16 @@ package player
 
 import (
 	"fmt"
+	"image/color"
 	"math"
 	"math/rand"
 	"net"
+	"reflect"
 	"strings"
 	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/df-mc/atomic"
 	"github.com/df-mc/dragonfly/server/block"
@@ -15,15 +19,18 @@ import (
 	"github.com/df-mc/dragonfly/server/block/model"
 	"github.com/df-mc/dragonfly/server/cmd"
 	"github.com/df-mc/dragonfly/server/entity"
+	"github.com/df-mc/dragonfly/server/entity/attribute"
 	"github.com/df-mc/dragonfly/server/entity/effect"
 	"github.com/df-mc/dragonfly/server/event"
 	"github.com/df-mc/dragonfly/server/internal/sliceutil"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/enchantment"
 	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/item/recipe"
 	"github.com/df-mc/dragonfly/server/player/bossbar"
 	"github.com/df-mc/dragonfly/server/player/chat"
 	"github.com/df-mc/dragonfly/server/player/form"
+	"github.com/df-mc/dragonfly/server/player/menu"
 	"github.com/df-mc/dragonfly/server/player/scoreboard"
 	"github.com/df-mc/dragonfly/server/player/skin"
 	"github.com/df-mc/dragonfly/server/player/title"
@@ -34,6 +41,7 @@ import (
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/google/uuid"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"golang.org/x/text/language"
 )
 
@@ -49,6 +57,7 @@ type Player struct {
 	scoreTag                            atomic.Value[string]
 	yaw, pitch, absorptionHealth, scale atomic.Float64
 	once                                sync.Once
+	disconnectReason                    atomic.Uint32
 
 	gameMode atomic.Value[world.GameMode]
 
@@ -56,15 +65,22 @@ type Player struct {
 	// s holds the session of the player. This field should not be used directly, but instead,
 	// Player.session() should be called.
 	s atomic.Value[*session.Session]
-	// h holds the current Handler of the player. It may be changed at any time by calling the Handle method.
+	// h holds the current Handler of the player, wrapped by all functions passed to WrapHandler. It may be
+	// changed at any time by calling the Handle method.
 	h atomic.Value[Handler]
+	// handlerMu guards base and wrappers. base is the Handler last passed to Handle, and wrappers holds the
+	// functions passed to WrapHandler.
+	handlerMu sync.Mutex
+	base      Handler
+	wrappers  []func(Handler) Handler
 
 	inv, offHand, enderChest *inventory.Inventory
 	armour                   *inventory.Armour
 	heldSlot                 *atomic.Uint32
 
 	sneaking, sprinting, swimming, gliding, flying,
-	invisible, immobile, onGround, usingItem atomic.Bool
+	invisible, immobile, onGround, usingItem, blocking atomic.Bool
+	operator   atomic.Bool
 	usingSince atomic.Int64
 
 	glideTicks   atomic.Int64
@@ -77,23 +93,49 @@ type Player struct {
 
 	cooldownMu sync.Mutex
 	cooldowns  map[string]time.Time
+	// teleportCooldowns holds the times until which teleports with a specific type of world.TeleportCause are
+	// ignored.
+	teleportCooldowns map[reflect.Type]time.Time
+
+	chatMu sync.Mutex
+	// recentMessages holds the last messages written in the chat by the player, with the oldest message first.
+	recentMessages []string
 	// lastTickedWorld holds the world that the player was in, in the last tick.
 	lastTickedWorld *world.World
 
-	speed      atomic.Float64
 	health     *entity.HealthManager
+	attributes *attribute.Map
 	experience *entity.ExperienceManager
 	effects    *entity.EffectManager
+	metadata   *entity.Metadata
 
 	lastXPPickup atomic.Value[time.Time]
 	immunity     atomic.Value[time.Time]
 
+	// actionBar holds the text persistently displayed in the action bar of the player. It is shown again after
+	// actionBarPause once other text is displayed in its place.
+	actionBar      atomic.Value[string]
+	actionBarPause atomic.Value[time.Time]
+
 	deathMu        sync.Mutex
 	deathPos       *mgl64.Vec3
 	deathDimension world.Dimension
 
 	enchantSeed atomic.Int64
 
+	recipeMu sync.Mutex
+	// recipes holds the recipes unlocked by the player, indexed by their recipe.Key.
+	recipes map[string]recipe.Recipe
+
+	portalMu sync.Mutex
+	// portal is the Dimension of the portal the player was inside of since the last tick, or nil if it was not
+	// inside a portal.
+	portal world.Dimension
+	// portalTicks is the amount of consecutive ticks the player has spent inside a portal.
+	portalTicks int64
+	// portalCooldown is true after the player travelled through a portal, until it leaves the portal it arrived in.
+	portalCooldown bool
+
 	mc *entity.MovementComputer
 
 	collidedVertically, collidedHorizontally atomic.Bool
@@ -128,9 +170,9 @@ func New(name string, skin skin.Skin, pos mgl64.Vec3) *Player {
 		effects:           entity.NewEffectManager(),
 		gameMode:          *atomic.NewValue[world.GameMode](world.GameModeSurvival),
 		h:                 *atomic.NewValue[Handler](NopHandler{}),
+		base:              NopHandler{},
 		name:              name,
 		skin:              *atomic.NewValue(skin),
-		speed:             *atomic.NewFloat64(0.1),
 		nameTag:           *atomic.NewValue(name),
 		heldSlot:          atomic.NewUint32(0),
 		locale:            language.BritishEnglish,
@@ -142,8 +184,11 @@ func New(name string, skin skin.Skin, pos mgl64.Vec3) *Player {
 		immunity:          *atomic.NewValue(time.Now()),
 		pos:               *atomic.NewValue(pos),
 		cooldowns:         make(map[string]time.Time),
+		teleportCooldowns: make(map[reflect.Type]time.Time),
 		mc:                &entity.MovementComputer{Gravity: 0.08, Drag: 0.02, DragBeforeGravity: true},
 	}
+	p.attributes = attribute.NewMap(p.attributeChanged)
+	p.metadata = entity.NewMetadata(p.updateState)
 	return p
 }
 
@@ -234,14 +279,19 @@ func (p *Player) Skin() skin.Skin {
 	return p.skin.Load()
 }
 
-// SetSkin changes the skin of the player. This skin will be visible to other players that the player
-// is shown to.
+// SetSkin changes the skin of the player and broadcasts it, so that it is visible to the player itself and to other
+// players that the player is shown to. The skin is not changed if it is not valid (see skin.Skin.Validate), or if
+// the change is cancelled by the Handler of the player, for example because the skin is inappropriate.
 func (p *Player) SetSkin(skin skin.Skin) {
 	if p.Dead() {
 		return
 	}
+	if err := skin.Validate(); err != nil {
+		p.session().ViewSkin(p)
+		return
+	}
 	ctx := event.C()
-	if p.Handler().HandleSkinChange(ctx, &skin); ctx.Cancelled() {
+	if p.handler().HandleSkinChange(ctx, &skin); ctx.Cancelled() {
 		p.session().ViewSkin(p)
 		return
 	}
@@ -263,7 +313,32 @@ func (p *Player) Handle(h Handler) {
 	if h == nil {
 		h = NopHandler{}
 	}
-	p.h.Store(h)
+	p.handlerMu.Lock()
+	defer p.handlerMu.Unlock()
+	p.base = h
+	p.h.Store(p.wrap(h))
+}
+
+// WrapHandler adds a function that wraps the Handler of the player. The function is applied to the current
+// Handler and to every Handler passed to Handle afterwards, so that the wrapping Handler is not lost when the
+// Handler of the player is changed. WrapHandler may be used to observe the events of a player regardless of the
+// Handler set.
+func (p *Player) WrapHandler(wrap func(h Handler) Handler) {
+	p.handlerMu.Lock()
+	defer p.handlerMu.Unlock()
+	p.wrappers = append(p.wrappers, wrap)
+	p.h.Store(p.wrap(p.base))
+}
+
+// wrap applies all functions passed to WrapHandler to the Handler passed. p.handlerMu must be held when calling
+// wrap.
+func (p *Player) wrap(h Handler) Handler {
+	for _, wrap := range p.wrappers {
+		if h = wrap(h); h == nil {
+			h = NopHandler{}
+		}
+	}
+	return h
 }
 
 // Message sends a formatted message to the player. The message is formatted following the rules of
@@ -282,18 +357,66 @@ func (p *Player) Messagef(f string, a ...any) {
 // overwrites/is overwritten by the name of the item equipped.
 // The popup is formatted following the rules of fmt.Sprintln without a newline at the end.
 func (p *Player) SendPopup(a ...any) {
+	p.pauseActionBar(actionBarMessageDuration)
 	p.session().SendPopup(format(a))
 }
 
 // SendTip sends a tip to the player. The tip is shown in the middle of the screen of the player.
 // The tip is formatted following the rules of fmt.Sprintln without a newline at the end.
 func (p *Player) SendTip(a ...any) {
+	p.pauseActionBar(actionBarMessageDuration)
 	p.session().SendTip(format(a))
 }
 
+// SendActionBarMessage sends a formatted message to the action bar of the player. The message is shown above the
+// hotbar of the player, in the same place as a popup, and fades out after the duration set by the last title sent.
+// The message is formatted following the rules of fmt.Sprintln without a newline at the end.
+func (p *Player) SendActionBarMessage(a ...any) {
+	p.pauseActionBar(actionBarMessageDuration)
+	p.session().SendActionBarMessage(format(a))
+}
+
+// SetActionBar sets text that is persistently displayed in the action bar of the player, until RemoveActionBar is
+// called. Unlike SendActionBarMessage, the text does not fade out. If a popup, tip, action bar message or title with
+// action text is sent to the player, the text is shown again once that message has disappeared.
+// The text is formatted following the rules of fmt.Sprintln without a newline at the end.
+func (p *Player) SetActionBar(a ...any) {
+	p.actionBar.Store(format(a))
+	p.actionBarPause.Store(time.Time{})
+	p.session().SendActionBarMessage(p.actionBar.Load())
+}
+
+// RemoveActionBar removes the text persistently displayed in the action bar of the player, as set using
+// SetActionBar. The text will fade out shortly after. Nothing happens if no such text was set.
+func (p *Player) RemoveActionBar() {
+	p.actionBar.Store("")
+}
+
+// actionBarMessageDuration is the duration that a popup, tip or action bar message is assumed to be visible for, before
+// the persistent action bar of a player is shown again.
+const actionBarMessageDuration = time.Second * 2
+
+// pauseActionBar stops the persistent action bar of the player from being resent for the duration passed, so that
+// other text displayed in the action bar is not overwritten.
+func (p *Player) pauseActionBar(d time.Duration) {
+	if until := time.Now().Add(d); until.After(p.actionBarPause.Load()) {
+		p.actionBarPause.Store(until)
+	}
+}
+
+// tickActionBar resends the persistent action bar of the player every second, so that it does not fade out.
+func (p *Player) tickActionBar(current int64) {
+	text := p.actionBar.Load()
+	if text == "" || current%20 != 0 || time.Now().Before(p.actionBarPause.Load()) {
+		return
+	}
+	p.session().SendActionBarMessage(text)
+}
+
 // SendJukeboxPopup sends a formatted jukebox popup to the player. This popup is shown above the hotbar of the player.
 // The popup is close to the position of an action bar message and the text has no background.
 func (p *Player) SendJukeboxPopup(a ...any) {
+	p.pauseActionBar(actionBarMessageDuration)
 	p.session().SendJukeboxPopup(format(a))
 }
 
@@ -326,13 +449,15 @@ func (p *Player) SendTitle(t title.Title) {
 		}
 	}
 	if t.ActionText() != "" {
+		p.pauseActionBar(t.FadeInDuration() + t.Duration() + t.FadeOutDuration())
 		p.session().SendActionBarMessage(t.ActionText())
 	}
 }
 
 // SendScoreboard sends a scoreboard to the player. The scoreboard will be present indefinitely until removed
 // by the caller.
-// SendScoreboard may be called at any time to change the scoreboard of the player.
+// SendScoreboard may be called at any time to change the scoreboard of the player. Only the lines that changed
+// are updated, so that lines that stay the same do not flicker.
 func (p *Player) SendScoreboard(scoreboard *scoreboard.Scoreboard) {
 	p.session().SendScoreboard(scoreboard)
 }
@@ -343,6 +468,19 @@ func (p *Player) RemoveScoreboard() {
 	p.session().RemoveScoreboard()
 }
 
+// SendObjective sends an objective to the player, which is displayed in the display slot passed. The objective will be
+// present indefinitely until removed by the caller. Only scores of entities visible to the player are displayed.
+// SendObjective may be called at any time to update the scores or change the objective in the display slot.
+func (p *Player) SendObjective(objective *scoreboard.Objective, slot scoreboard.DisplaySlot) {
+	p.session().SendObjective(objective, slot)
+}
+
+// RemoveObjective removes the objective currently displayed in the display slot passed. Nothing happens if the player
+// has no objective in that display slot.
+func (p *Player) RemoveObjective(slot scoreboard.DisplaySlot) {
+	p.session().RemoveObjective(slot)
+}
+
 // SendBossBar sends a boss bar to the player, so that it will be shown indefinitely at the top of the
 // player's screen.
 // The boss bar may be removed by calling Player.RemoveBossBar().
@@ -361,12 +499,41 @@ func (p *Player) RemoveBossBar() {
 func (p *Player) Chat(msg ...any) {
 	message := format(msg)
 	ctx := event.C()
-	if p.Handler().HandleChat(ctx, &message); ctx.Cancelled() {
+	if p.handler().HandleChat(ctx, &message); ctx.Cancelled() {
 		return
 	}
+	p.chatMu.Lock()
+	if len(p.recentMessages) == maxRecentMessages {
+		p.recentMessages = append(p.recentMessages[:0], p.recentMessages[1:]...)
+	}
+	p.recentMessages = append(p.recentMessages, message)
+	p.chatMu.Unlock()
+
 	_, _ = fmt.Fprintf(chat.Global, "<%v> %v\n", p.name, message)
 }
 
+// maxRecentMessages is the maximum amount of chat messages kept for each player, to be included as evidence in
+// reports against the player.
+const maxRecentMessages = 16
+
+// RecentMessages returns the most recent messages that the player wrote in the chat using Chat, with the oldest
+// message first. Messages cancelled in HandleChat are not included.
+func (p *Player) RecentMessages() []string {
+	p.chatMu.Lock()
+	defer p.chatMu.Unlock()
+	return slices.Clone(p.recentMessages)
+}
+
+// Report files a report from the player against the target passed, for the reason passed. The recent chat messages
+// of the target are flagged and passed along to the HandleReport method of the player's Handler, so that the report
+// may be forwarded to a moderation pipeline. Report does nothing if the player tries to report itself.
+func (p *Player) Report(target *Player, reason string) {
+	if target == nil || target == p {
+		return
+	}
+	p.handler().HandleReport(target, reason, target.RecentMessages())
+}
+
 // ExecuteCommand executes a command passed as the player. If the command could not be found, or if the usage
 // was incorrect, an error message is sent to the player. This message should start with a "/" for the command to be
 // recognised.
@@ -383,7 +550,7 @@ func (p *Player) ExecuteCommand(commandLine string) {
 		return
 	}
 	ctx := event.C()
-	if p.Handler().HandleCommandExecution(ctx, command, args[1:]); ctx.Cancelled() {
+	if p.handler().HandleCommandExecution(ctx, command, args[1:]); ctx.Cancelled() {
 		return
 	}
 	command.Execute(strings.Join(args[1:], " "), p)
@@ -398,7 +565,7 @@ func (p *Player) Transfer(address string) error {
 	}
 
 	ctx := event.C()
-	if p.Handler().HandleTransfer(ctx, addr); ctx.Cancelled() {
+	if p.handler().HandleTransfer(ctx, addr); ctx.Cancelled() {
 		return nil
 	}
 	p.session().Transfer(addr.IP, addr.Port)
@@ -419,6 +586,17 @@ func (p *Player) SendForm(f form.Form) {
 	p.session().SendForm(f)
 }
 
+// SendMenu sends a menu.Menu to the player, closing any container that the player had opened before. The
+// Submittable of the menu is called whenever the player clicks one of its slots. Items in a menu cannot be taken
+// out of it by the player.
+func (p *Player) SendMenu(m menu.Menu) {
+	if p.session() == session.Nop {
+		return
+	}
+	p.CloseContainer()
+	p.session().SendMenu(m)
+}
+
 // ShowCoordinates enables the vanilla coordinates for the player.
 func (p *Player) ShowCoordinates() {
 	p.session().EnableCoordinates(true)
@@ -463,17 +641,42 @@ func (p *Player) ScoreTag() string {
 	return p.scoreTag.Load()
 }
 
-// SetSpeed sets the speed of the player. The value passed is the blocks/tick speed that the player will then
-// obtain.
+// SetSpeed sets the base speed of the player. The value passed is the blocks/tick speed that the player will
+// then obtain, before modifiers such as those of sprinting and the Speed effect are applied.
 func (p *Player) SetSpeed(speed float64) {
-	p.speed.Store(speed)
-	p.session().SendSpeed(speed)
+	p.attributes.SetBase(attribute.MovementSpeed, speed)
 }
 
 // Speed returns the speed of the player, returning a value that indicates the blocks/tick speed. The default
-// speed of a player is 0.1.
+// speed of a player is 0.1, which is increased by 30% while sprinting.
 func (p *Player) Speed() float64 {
-	return p.speed.Load()
+	return p.attributes.Value(attribute.MovementSpeed)
+}
+
+// Attributes returns the attribute.Map holding the attributes of the player, such as its maximum health and
+// movement speed. Changes made to the attributes are sent to the player.
+func (p *Player) Attributes() *attribute.Map {
+	return p.attributes
+}
+
+// Metadata returns the entity.Metadata of the player, which may be used to change the way the player is shown to
+// itself and its viewers.
+func (p *Player) Metadata() *entity.Metadata {
+	return p.metadata
+}
+
+// sprintingModifier is the attribute.Modifier applied to the movement speed of a player while it is sprinting.
+var sprintingModifier = attribute.Modifier{Name: "sprinting", Operation: attribute.OperationMultiply, Amount: 0.3}
+
+// attributeChanged is called when the value of one of the attributes of the player changes. It applies the
+// change and sends it to the player.
+func (p *Player) attributeChanged(a attribute.Attribute, v float64) {
+	if a == attribute.MaxHealth {
+		p.health.SetMaxHealth(v)
+		p.session().SendHealth(p.health)
+		return
+	}
+	p.session().SendAttribute(a, v)
 }
 
 // Health returns the current health of the player. It will always be lower than Player.MaxHealth().
@@ -487,12 +690,11 @@ func (p *Player) MaxHealth() float64 {
 	return p.health.MaxHealth()
 }
 
-// SetMaxHealth sets the maximum health of the player. If the current health of the player is higher than the
-// new maximum health, the health is set to the new maximum.
-// SetMaxHealth panics if the max health passed is 0 or lower.
+// SetMaxHealth sets the base maximum health of the player. If the current health of the player is higher than
+// the new maximum health, the health is set to the new maximum. Modifiers of the attribute.MaxHealth attribute,
+// such as that of the HealthBoost effect, are applied on top of the value passed.
 func (p *Player) SetMaxHealth(health float64) {
-	p.health.SetMaxHealth(health)
-	p.session().SendHealth(p.health)
+	p.attributes.SetBase(attribute.MaxHealth, health)
 }
 
 // addHealth adds health to the player's current health.
@@ -511,7 +713,7 @@ func (p *Player) Heal(health float64, source world.HealingSource) {
 		return
 	}
 	ctx := event.C()
-	if p.Handler().HandleHeal(ctx, &health, source); ctx.Cancelled() {
+	if p.handler().HandleHeal(ctx, &health, source); ctx.Cancelled() {
 		return
 	}
 	p.addHealth(health)
@@ -520,7 +722,10 @@ func (p *Player) Heal(health float64, source world.HealingSource) {
 // updateFallState is called to update the entities falling state.
 func (p *Player) updateFallState(distanceThisTick float64) {
 	fallDistance := p.fallDistance.Load()
-	if p.OnGround() {
+	if _, slowFalling := p.Effect(effect.SlowFalling{}); slowFalling {
+		// Slow falling prevents the fall distance from building up, so that the player never takes fall damage.
+		p.ResetFallDistance()
+	} else if p.OnGround() {
 		if fallDistance > 0 {
 			p.fall(fallDistance)
 			p.ResetFallDistance()
@@ -534,26 +739,12 @@ func (p *Player) updateFallState(distanceThisTick float64) {
 
 // fall is called when a falling entity hits the ground.
 func (p *Player) fall(distance float64) {
-	var (
-		w   = p.World()
-		pos = cube.PosFromVec3(p.Position())
-		b   = w.Block(pos)
-	)
-	if len(b.Model().BBox(pos, w)) == 0 {
-		pos = pos.Sub(cube.Pos{0, 1})
-		b = w.Block(pos)
-	}
-	if h, ok := b.(block.EntityLander); ok {
-		h.EntityLand(pos, w, p, &distance)
-	}
-	dmg := distance - 3
-	if boost, ok := p.Effect(effect.JumpBoost{}); ok {
-		dmg -= float64(boost.Level())
-	}
-	if dmg < 0.5 {
+	dmg := entity.FallDamage(p, p.World(), distance)
+	ctx := event.C()
+	if p.handler().HandleFall(ctx, distance, &dmg); ctx.Cancelled() || dmg <= 0 {
 		return
 	}
-	p.Hurt(math.Ceil(dmg), entity.FallDamageSource{})
+	p.Hurt(dmg, entity.FallDamageSource{})
 }
 
 // Hurt hurts the player for a given amount of damage. The source passed
@@ -570,12 +761,15 @@ func (p *Player) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
 	}
 	immunity := time.Second / 2
 	ctx := event.C()
-	if p.Handler().HandleHurt(ctx, &dmg, &immunity, src); ctx.Cancelled() {
+	if p.handler().HandleHurt(ctx, &dmg, &immunity, src); ctx.Cancelled() {
 		return 0, false
 	}
 	if dmg < 0 {
 		return 0, true
 	}
+	if p.blockedByShield(dmg, src) {
+		return 0, false
+	}
 
 	totalDamage := p.FinalDamageFrom(dmg, src)
 	damageLeft := totalDamage
@@ -613,11 +807,103 @@ func (p *Player) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
 
 	p.immunity.Store(time.Now().Add(immunity))
 	if p.Dead() {
-		p.kill(src)
+		ctx := event.C()
+		if p.handler().HandleLethalDamage(ctx, src); ctx.Cancelled() {
+			p.addHealth(1 - p.Health())
+		} else if !p.useTotem(src) {
+			p.kill(src)
+		}
 	}
 	return totalDamage, true
 }
 
+// shieldCooldown is the duration that a shield is unable to block for after being hit by an axe.
+const shieldCooldown = time.Second * 5
+
+// blockedByShield checks if the player blocks the damage from the world.DamageSource passed with a shield. Only attacks
+// and projectiles coming from in front of the player are blocked. The shield is damaged by the blocked damage and
+// attackers are knocked back. If the attacker holds an axe, the shield is disabled for a short duration.
+func (p *Player) blockedByShield(dmg float64, src world.DamageSource) bool {
+	if !p.Blocking() {
+		return false
+	}
+	var origin world.Entity
+	switch s := src.(type) {
+	case entity.AttackDamageSource:
+		origin = s.Attacker
+	case entity.ProjectileDamageSource:
+		origin = s.Projectile
+	default:
+		return false
+	}
+	dir, facing := origin.Position().Sub(p.Position()), p.Rotation().Vec3()
+	dir[1], facing[1] = 0, 0
+	if dir.Dot(facing) <= 0 {
+		// The damage did not come from in front of the player.
+		return false
+	}
+
+	if dmg >= 3 {
+		mainHand, offHand := p.HeldItems()
+		if _, ok := mainHand.Item().(item.Shield); ok {
+			mainHand = p.damageItem(mainHand, 1+int(math.Floor(dmg)))
+		} else {
+			offHand = p.damageItem(offHand, 1+int(math.Floor(dmg)))
+		}
+		p.SetHeldItems(mainHand, offHand)
+	}
+	p.World().PlaySound(p.Position(), sound.ShieldBlock{})
+
+	if s, ok := src.(entity.AttackDamageSource); ok {
+		if l, ok := s.Attacker.(entity.Living); ok {
+			l.KnockBack(p.Position(), 0.45, 0.3608)
+		}
+		if c, ok := s.Attacker.(item.Carrier); ok {
+			held, _ := c.HeldItems()
+			if _, ok := held.Item().(item.Axe); ok {
+				p.SetCooldown(item.Shield{}, shieldCooldown)
+				p.updateBlocking()
+			}
+		}
+	}
+	return true
+}
+
+// useTotem uses a totem of undying held by the player to prevent it from dying to the world.DamageSource passed. The
+// player is left with one health and receives effects to help it recover. useTotem returns false if the player does
+// not hold a totem, if the source of the damage is the void or if the Handler of the player cancels the use of the
+// totem.
+func (p *Player) useTotem(src world.DamageSource) bool {
+	if _, ok := src.(entity.VoidDamageSource); ok {
+		return false
+	}
+	mainHand, offHand := p.HeldItems()
+	if _, ok := mainHand.Item().(item.Totem); ok {
+		mainHand = mainHand.Grow(-1)
+	} else if _, ok := offHand.Item().(item.Totem); ok {
+		offHand = offHand.Grow(-1)
+	} else {
+		return false
+	}
+	ctx := event.C()
+	if p.handler().HandleTotemUse(ctx, src); ctx.Cancelled() {
+		return false
+	}
+	p.SetHeldItems(mainHand, offHand)
+
+	p.addHealth(1 - p.Health())
+	for _, e := range p.Effects() {
+		p.RemoveEffect(e.Type())
+	}
+	p.AddEffect(effect.New(effect.Regeneration{}, 2, time.Second*40))
+	p.AddEffect(effect.New(effect.Absorption{}, 2, time.Second*5))
+	p.AddEffect(effect.New(effect.FireResistance{}, 1, time.Second*40))
+	for _, viewer := range p.viewers() {
+		viewer.ViewEntityAction(p, entity.TotemUseAction{})
+	}
+	return true
+}
+
 // applyThorns applies thorns damage to the attacking entity if the world.DamageSource is either damage.AttackDamageSource or
 // damage.ProjectileDamageSource.
 func (p *Player) applyThorns(src world.DamageSource) {
@@ -733,22 +1019,25 @@ func (p *Player) KnockBack(src mgl64.Vec3, force, height float64) {
 }
 
 // knockBack is an unexported function that is used to knock the player back. This function does not check if the player
-// can take damage or not.
+// can take damage or not. The velocity of the player is reduced by the world.Physics KnockBackFriction first.
 func (p *Player) knockBack(src mgl64.Vec3, force, height float64) {
 	velocity := p.Position().Sub(src)
 	velocity[1] = 0
 
-	velocity = velocity.Normalize().Mul(force)
+	if velocity.Len() > 0 {
+		velocity = velocity.Normalize().Mul(force)
+	}
 	velocity[1] = height
 
-	var resistance float64
+	resistance := p.attributes.Value(attribute.KnockBackResistance)
 	for _, i := range p.armour.Items() {
 		if a, ok := i.Item().(item.Armour); ok {
 			resistance += a.KnockBackResistance()
 		}
 	}
 
-	p.SetVelocity(velocity.Mul(1 - resistance))
+	friction := p.World().Physics().KnockBackFriction
+	p.SetVelocity(p.Velocity().Mul(1 - friction).Add(velocity.Mul(1 - math.Min(resistance, 1))))
 }
 
 // AttackImmune checks if the player is currently immune to entity attacks, meaning it was recently attacked.
@@ -846,7 +1135,7 @@ func (p *Player) Exhaust(points float64) {
 		p.hunger.SetFood(before)
 
 		ctx := event.C()
-		if p.Handler().HandleFoodLoss(ctx, before, &after); ctx.Cancelled() {
+		if p.handler().HandleFoodLoss(ctx, before, &after); ctx.Cancelled() {
 			return
 		}
 		p.hunger.SetFood(after)
@@ -864,6 +1153,13 @@ func (p *Player) Dead() bool {
 	return p.Health() <= mgl64.Epsilon
 }
 
+// Pushable checks if the player pushes other entities that it collides with. Players in a game mode without collision,
+// such as spectator mode, do not push other entities.
+func (p *Player) Pushable() bool {
+	_, _, riding := entity.Vehicle(p)
+	return !p.Dead() && p.GameMode().HasCollision() && !riding
+}
+
 // DeathPosition returns the last position the player was at when they died. If the player has never died, the third
 // return value will be false.
 func (p *Player) DeathPosition() (mgl64.Vec3, world.Dimension, bool) {
@@ -883,8 +1179,15 @@ func (p *Player) kill(src world.DamageSource) {
 
 	p.addHealth(-p.MaxHealth())
 
-	keepInv := false
-	p.Handler().HandleDeath(src, &keepInv)
+	keepInv := p.World().KeepInventory()
+	p.handler().HandleDeath(src, &keepInv)
+
+	if p.World().DeathMessages() {
+		ctx, msg := event.C(), entity.DeathMessage(p.Name(), src)
+		if p.handler().HandleDeathMessage(ctx, &msg); !ctx.Cancelled() && msg != "" {
+			_, _ = fmt.Fprintln(chat.Global, msg)
+		}
+	}
 	p.StopSneaking()
 	p.StopSprinting()
 
@@ -927,16 +1230,36 @@ func (p *Player) dropContents() {
 	p.session().SendExperience(p.experience)
 
 	p.session().EmptyUIInventory()
-	for _, it := range append(p.inv.Clear(), append(p.armour.Clear(), p.offHand.Clear()...)...) {
-		if _, ok := it.Enchantment(enchantment.CurseOfVanishing{}); ok {
-			continue
+	drops := make([]item.Stack, 0, p.inv.Size())
+	for _, it := range append(clearUnlocked(p.inv), append(clearUnlocked(p.armour.Inventory()), clearUnlocked(p.offHand)...)...) {
+		if _, ok := it.Enchantment(enchantment.CurseOfVanishing{}); !ok {
+			drops = append(drops, it)
 		}
+	}
+	ctx := event.C()
+	if p.handler().HandleDeathDrops(ctx, pos, &drops); ctx.Cancelled() {
+		return
+	}
+	for _, it := range drops {
 		ent := entity.NewItem(it, pos)
 		ent.SetVelocity(mgl64.Vec3{rand.Float64()*0.2 - 0.1, 0.2, rand.Float64()*0.2 - 0.1})
 		w.AddEntity(ent)
 	}
 }
 
+// clearUnlocked clears all slots of the inventory passed that were not locked using inventory.Inventory.LockSlot and
+// returns the items that were in them. Items in locked slots are kept, so that they are not dropped on death.
+func clearUnlocked(inv *inventory.Inventory) []item.Stack {
+	items := make([]item.Stack, 0, inv.Size())
+	for slot, it := range inv.Slots() {
+		if !it.Empty() && !inv.SlotLocked(slot) {
+			items = append(items, it)
+			_ = inv.SetItem(slot, item.Stack{})
+		}
+	}
+	return items
+}
+
 // Respawn spawns the player after it dies, so that its health is replenished and it is spawned in the world
 // again. Nothing will happen if the player does not have a session connected to it.
 func (p *Player) Respawn() {
@@ -955,10 +1278,11 @@ func (p *Player) Respawn() {
 	w = w.PortalDestination(w.Dimension())
 	pos := w.PlayerSpawn(p.UUID()).Vec3Middle()
 
-	p.Handler().HandleRespawn(&pos, &w)
+	p.handler().HandleRespawn(&pos, &w)
 
-	w.AddEntity(p)
-	p.Teleport(pos)
+	// The player is always moved to the respawn position, regardless of teleport cooldowns or a Handler cancelling
+	// teleports, as it would otherwise remain at the position it died at.
+	p.teleportToWorld(w, pos)
 	p.session().SendRespawn(pos)
 
 	p.SetVisible()
@@ -972,14 +1296,14 @@ func (p *Player) StartSprinting() {
 		return
 	}
 	ctx := event.C()
-	if p.Handler().HandleToggleSprint(ctx, true); ctx.Cancelled() {
+	if p.handler().HandleToggleSprint(ctx, true); ctx.Cancelled() {
 		return
 	}
 	if !p.sprinting.CAS(false, true) {
 		return
 	}
 	p.StopSneaking()
-	p.SetSpeed(p.Speed() * 1.3)
+	p.attributes.AddModifier(attribute.MovementSpeed, sprintingModifier)
 
 	p.updateState()
 }
@@ -992,13 +1316,13 @@ func (p *Player) Sprinting() bool {
 // StopSprinting makes a player stop sprinting, setting back the speed of the player to its original value.
 func (p *Player) StopSprinting() {
 	ctx := event.C()
-	if p.Handler().HandleToggleSprint(ctx, false); ctx.Cancelled() {
+	if p.handler().HandleToggleSprint(ctx, false); ctx.Cancelled() {
 		return
 	}
 	if !p.sprinting.CAS(true, false) {
 		return
 	}
-	p.SetSpeed(p.Speed() / 1.3)
+	p.attributes.RemoveModifier(attribute.MovementSpeed, sprintingModifier.Name)
 
 	p.updateState()
 }
@@ -1008,7 +1332,7 @@ func (p *Player) StopSprinting() {
 // If the player is sprinting while StartSneaking is called, the sprinting is stopped.
 func (p *Player) StartSneaking() {
 	ctx := event.C()
-	if p.Handler().HandleToggleSneak(ctx, true); ctx.Cancelled() {
+	if p.handler().HandleToggleSneak(ctx, true); ctx.Cancelled() {
 		return
 	}
 	if !p.sneaking.CAS(false, true) {
@@ -1025,11 +1349,30 @@ func (p *Player) Sneaking() bool {
 	return p.sneaking.Load()
 }
 
+// Blocking checks if the player is currently blocking with a shield. A player blocks while sneaking and holding a
+// shield in either hand, unless the shield was disabled by an axe.
+func (p *Player) Blocking() bool {
+	return p.blocking.Load()
+}
+
+// updateBlocking updates the blocking state of the player and sends it to viewers if it changed.
+func (p *Player) updateBlocking() {
+	mainHand, offHand := p.HeldItems()
+	_, shieldMainHand := mainHand.Item().(item.Shield)
+	_, shieldOffHand := offHand.Item().(item.Shield)
+
+	blocking := p.Sneaking() && (shieldMainHand || shieldOffHand) && !p.HasCooldown(item.Shield{}) && !p.UsingItem()
+	if p.blocking.Load() != blocking {
+		p.blocking.Store(blocking)
+		p.updateState()
+	}
+}
+
 // StopSneaking makes a player stop sneaking if it currently is. If the player is not sneaking, StopSneaking
 // will not do anything.
 func (p *Player) StopSneaking() {
 	ctx := event.C()
-	if p.Handler().HandleToggleSneak(ctx, false); ctx.Cancelled() {
+	if p.handler().HandleToggleSneak(ctx, false); ctx.Cancelled() {
 		return
 	}
 	if !p.sneaking.CAS(true, false) {
@@ -1061,13 +1404,20 @@ func (p *Player) StopSwimming() {
 	p.updateState()
 }
 
-// StartGliding makes the player start gliding if it is not currently doing so.
+// StartGliding makes the player start gliding if it is not currently doing so. The player must be wearing an elytra
+// that is not about to break, and may not be on the ground, flying or in water.
 func (p *Player) StartGliding() {
-	if !p.gliding.CAS(false, true) {
+	if !p.canGlide() {
+		// The client may have started gliding client-side already, so resend its state to stop it.
+		p.session().ViewEntityState(p)
 		return
 	}
-	chest := p.Armour().Chestplate()
-	if _, ok := chest.Item().(item.Elytra); !ok || chest.Durability() < 2 {
+	ctx := event.C()
+	if p.handler().HandleToggleGlide(ctx, true); ctx.Cancelled() {
+		p.session().ViewEntityState(p)
+		return
+	}
+	if !p.gliding.CAS(false, true) {
 		return
 	}
 	p.updateState()
@@ -1084,9 +1434,21 @@ func (p *Player) StopGliding() {
 		return
 	}
 	p.glideTicks.Store(0)
+	p.handler().HandleToggleGlide(event.C(), false)
 	p.updateState()
 }
 
+// canGlide checks if the player is currently able to glide. This is the case if it is wearing an elytra that is not
+// about to break and is airborne, outside of water and not flying.
+func (p *Player) canGlide() bool {
+	chest := p.Armour().Chestplate()
+	if _, ok := chest.Item().(item.Elytra); !ok || chest.Durability() < 2 {
+		return false
+	}
+	_, inLiquid := p.World().Liquid(cube.PosFromVec3(p.Position()))
+	return !p.OnGround() && !p.Flying() && !inLiquid
+}
+
 // StartFlying makes the player start flying if they aren't already. It requires the player to be in a gamemode which
 // allows flying.
 func (p *Player) StartFlying() {
@@ -1116,9 +1478,9 @@ func (p *Player) Jump() {
 		return
 	}
 
-	p.Handler().HandleJump()
+	p.handler().HandleJump()
 	if p.OnGround() {
-		jumpVel := 0.42
+		jumpVel := entity.JumpVelocity(p.World(), p.Position())
 		if e, ok := p.Effect(effect.JumpBoost{}); ok {
 			jumpVel = float64(e.Level()) / 10
 		}
@@ -1180,6 +1542,17 @@ func (p *Player) Immobile() bool {
 	return p.immobile.Load()
 }
 
+// SetOperator changes if the Player is an operator. Operators are able to edit blocks within the spawn protection
+// radius of a world, which other players cannot.
+func (p *Player) SetOperator(op bool) {
+	p.operator.Store(op)
+}
+
+// Operator checks if the Player is an operator, as set using SetOperator.
+func (p *Player) Operator() bool {
+	return p.operator.Load()
+}
+
 // FireProof checks if the Player is currently fireproof. True is returned if the player has a FireResistance effect or
 // if it is in creative mode.
 func (p *Player) FireProof() bool {
@@ -1238,6 +1611,18 @@ func (p *Player) SetHeldItems(mainHand, offHand item.Stack) {
 	_ = p.offHand.SetItem(0, offHand)
 }
 
+// SwapHeldItems swaps the items held in the main hand and the off-hand of the player. False is returned if the swap
+// was cancelled by the Handler of the player, in which case the held items are left unchanged.
+func (p *Player) SwapHeldItems() bool {
+	mainHand, offHand := p.HeldItems()
+	ctx := event.C()
+	if p.handler().HandleHeldItemSwap(ctx, mainHand, offHand); ctx.Cancelled() {
+		return false
+	}
+	p.SetHeldItems(offHand, mainHand)
+	return true
+}
+
 // EnderChestInventory returns the player's ender chest inventory. Its accessed by the player when opening
 // ender chests anywhere.
 func (p *Player) EnderChestInventory() *inventory.Inventory {
@@ -1291,7 +1676,10 @@ func (p *Player) HasCooldown(item world.Item) bool {
 	return true
 }
 
-// SetCooldown sets a cooldown for an item. If the world.Item passed is nil, nothing happens.
+// SetCooldown sets a cooldown for an item. While the cooldown is active, the item cannot be used and the client
+// shows the remaining cooldown over it. Items sharing the same type, such as all ender pearls, share a cooldown,
+// allowing servers to enforce cooldowns of custom abilities bound to items. If the world.Item passed is nil,
+// nothing happens.
 func (p *Player) SetCooldown(item world.Item, cooldown time.Duration) {
 	if item == nil {
 		return
@@ -1304,6 +1692,14 @@ func (p *Player) SetCooldown(item world.Item, cooldown time.Duration) {
 	p.session().ViewItemCooldown(item, cooldown)
 }
 
+// applyCooldown sets the cooldown of the item passed if it implements item.Cooldown. It is called after the item was
+// used or consumed successfully.
+func (p *Player) applyCooldown(it world.Item) {
+	if cd, ok := it.(item.Cooldown); ok {
+		p.SetCooldown(it, cd.Cooldown())
+	}
+}
+
 // UseItem uses the item currently held in the player's main hand in the air. Generally, nothing happens,
 // unless the held item implements the item.Usable interface, in which case it will be activated.
 // This generally happens for items such as throwable items like snowballs.
@@ -1316,16 +1712,20 @@ func (p *Player) UseItem() {
 	if p.HasCooldown(i.Item()) {
 		return
 	}
-	if p.Handler().HandleItemUse(ctx); ctx.Cancelled() {
+	if p.handler().HandleItemUse(ctx); ctx.Cancelled() {
 		return
 	}
 	i, left = p.HeldItems()
 	it := i.Item()
 
-	if cd, ok := it.(item.Cooldown); ok {
-		p.SetCooldown(it, cd.Cooldown())
+	if c, ok := it.(item.Chargeable); ok {
+		// Chargeable items that are charged are released when used, instead of starting to charge again.
+		useCtx := p.useContext()
+		if c.ReleaseCharge(p, useCtx) {
+			p.handleUseContext(useCtx)
+			return
+		}
 	}
-
 	if _, ok := it.(item.Releasable); ok {
 		if !p.canRelease() {
 			return
@@ -1346,6 +1746,7 @@ func (p *Player) UseItem() {
 		p.SwingArm()
 		p.SetHeldItems(p.subtractItem(p.damageItem(i, useCtx.Damage), useCtx.CountSub), left)
 		p.addNewItem(useCtx)
+		p.applyCooldown(it)
 	case item.Consumable:
 		if c, ok := usable.(interface{ CanConsume() bool }); ok && !c.CanConsume() {
 			p.ReleaseItem()
@@ -1372,7 +1773,7 @@ func (p *Player) UseItem() {
 		}
 
 		ctx = event.C()
-		if p.Handler().HandleItemConsume(ctx, i); ctx.Cancelled() {
+		if p.handler().HandleItemConsume(ctx, i); ctx.Cancelled() {
 			// Consuming was cancelled, but the client will continue consuming the next item.
 			p.usingSince.Store(time.Now().UnixNano())
 			return
@@ -1382,6 +1783,7 @@ func (p *Player) UseItem() {
 		useCtx := p.useContext()
 		useCtx.NewItem = usable.Consume(w, p)
 		p.addNewItem(useCtx)
+		p.applyCooldown(it)
 		w.PlaySound(p.Position().Add(mgl64.Vec3{0, 1.5}), sound.Burp{})
 	}
 }
@@ -1413,13 +1815,14 @@ func (p *Player) canRelease() bool {
 	if p.GameMode().CreativeInventory() {
 		return true
 	}
+	_, offHand := p.HeldItems()
 	for _, req := range releasable.Requirements() {
-		_, found := p.Inventory().FirstFunc(func(stack item.Stack) bool {
+		matches := func(stack item.Stack) bool {
 			name, _ := stack.Item().EncodeItem()
 			otherName, _ := req.Item().EncodeItem()
 			return name == otherName
-		})
-		if !found {
+		}
+		if _, found := p.Inventory().FirstFunc(matches); !found && (offHand.Empty() || !matches(offHand)) {
 			return false
 		}
 	}
@@ -1433,6 +1836,17 @@ func (p *Player) handleUseContext(ctx *item.UseContext) {
 	p.SetHeldItems(p.subtractItem(p.damageItem(i, ctx.Damage), ctx.CountSub), left)
 	p.addNewItem(ctx)
 	for _, it := range ctx.ConsumedItems {
+		// Items held in the off-hand, such as arrows, are consumed before those in the rest of the inventory.
+		if offHand, _ := p.offHand.Item(0); !offHand.Empty() && offHand.Comparable(it) {
+			n := it.Count()
+			if offHand.Count() < n {
+				n = offHand.Count()
+			}
+			_ = p.offHand.SetItem(0, offHand.Grow(-n))
+			if it = it.Grow(-n); it.Empty() {
+				continue
+			}
+		}
 		_ = p.Inventory().RemoveItem(it)
 	}
 }
@@ -1455,14 +1869,14 @@ func (p *Player) UsingItem() bool {
 // UseItemOnBlock does nothing if the block at the cube.Pos passed is of the type block.Air.
 func (p *Player) UseItemOnBlock(pos cube.Pos, face cube.Face, clickPos mgl64.Vec3) {
 	w := p.World()
-	if _, ok := w.Block(pos).(block.Air); ok || !p.canReach(pos.Vec3Centre()) {
+	if _, ok := w.Block(pos).(block.Air); ok || !p.canReach(pos.Vec3Centre()) || !p.canEdit(pos) {
 		// The client used its item on a block that does not exist server-side or one it couldn't reach. Stop trying
 		// to use the item immediately.
 		p.resendBlocks(pos, w, face)
 		return
 	}
 	ctx := event.C()
-	if p.Handler().HandleItemUseOnBlock(ctx, pos, face, clickPos); ctx.Cancelled() {
+	if p.handler().HandleItemUseOnBlock(ctx, pos, face, clickPos); ctx.Cancelled() {
 		p.resendBlocks(pos, w, face)
 		return
 	}
@@ -1521,15 +1935,21 @@ func (p *Player) UseItemOnEntity(e world.Entity) bool {
 		return false
 	}
 	ctx := event.C()
-	if p.Handler().HandleItemUseOnEntity(ctx, e); ctx.Cancelled() {
+	if p.handler().HandleItemUseOnEntity(ctx, e); ctx.Cancelled() {
 		return false
 	}
 	i, left := p.HeldItems()
+	useCtx := p.useContext()
+	if in, ok := e.(entity.Interactable); ok && in.Interact(p, useCtx) {
+		p.SwingArm()
+		p.SetHeldItems(p.subtractItem(p.damageItem(i, useCtx.Damage), useCtx.CountSub), left)
+		p.addNewItem(useCtx)
+		return true
+	}
 	usable, ok := i.Item().(item.UsableOnEntity)
 	if !ok {
 		return true
 	}
-	useCtx := p.useContext()
 	if !usable.UseOnEntity(e, e.World(), p, useCtx) {
 		return true
 	}
@@ -1549,19 +1969,23 @@ func (p *Player) AttackEntity(e world.Entity) bool {
 		return false
 	}
 	var (
-		force, height  = 0.45, 0.3608
+		force, height  = p.World().Physics().KnockBackForce, p.World().Physics().KnockBackHeight
 		_, slowFalling = p.Effect(effect.SlowFalling{})
 		_, blind       = p.Effect(effect.Blindness{})
 		critical       = !p.Sprinting() && !p.Flying() && p.FallDistance() > 0 && !slowFalling && !blind
 	)
 
 	ctx := event.C()
-	if p.Handler().HandleAttackEntity(ctx, e, &force, &height, &critical); ctx.Cancelled() {
+	if p.handler().HandleAttackEntity(ctx, e, &force, &height, &critical); ctx.Cancelled() {
 		return false
 	}
 	p.SwingArm()
 
 	i, _ := p.HeldItems()
+	if c, ok := e.(*entity.EndCrystal); ok {
+		c.Hurt(i.AttackDamage(), entity.AttackDamageSource{Attacker: p})
+		return true
+	}
 	living, ok := e.(entity.Living)
 	if !ok {
 		return false
@@ -1570,16 +1994,18 @@ func (p *Player) AttackEntity(e world.Entity) bool {
 		return true
 	}
 
-	dmg := i.AttackDamage()
-	if strength, ok := p.Effect(effect.Strength{}); ok {
-		dmg += dmg * effect.Strength{}.Multiplier(strength.Level())
-	}
-	if weakness, ok := p.Effect(effect.Weakness{}); ok {
-		dmg -= dmg * effect.Weakness{}.Multiplier(weakness.Level())
-	}
+	// The damage of a Stack includes the damage dealt by hand, which is replaced by the attack damage
+	// attribute of the player. The modifiers of the attribute, such as those of the Strength and Weakness
+	// effects, are applied to the damage of the held item too.
+	dmg := p.attributes.ValueWithBase(attribute.AttackDamage, p.attributes.Base(attribute.AttackDamage)+i.AttackDamage()-1)
 	if s, ok := i.Enchantment(enchantment.Sharpness{}); ok {
 		dmg += (enchantment.Sharpness{}).Addend(s.Level())
 	}
+	if s, ok := i.Enchantment(enchantment.Smite{}); ok {
+		if u, ok := living.(entity.Undead); ok && u.Undead() {
+			dmg += (enchantment.Smite{}).Addend(s.Level())
+		}
+	}
 	if critical {
 		dmg *= 1.5
 	}
@@ -1626,7 +2052,7 @@ func (p *Player) AttackEntity(e world.Entity) bool {
 func (p *Player) StartBreaking(pos cube.Pos, face cube.Face) {
 	p.AbortBreaking()
 	w := p.World()
-	if _, air := w.Block(pos).(block.Air); air || !p.canReach(pos.Vec3Centre()) {
+	if _, air := w.Block(pos).(block.Air); air || !p.canReach(pos.Vec3Centre()) || !p.canEdit(pos) {
 		// The block was either out of range or air, so it can't be broken by the player.
 		return
 	}
@@ -1647,7 +2073,7 @@ func (p *Player) StartBreaking(pos cube.Pos, face cube.Face) {
 	p.breakingPos.Store(pos)
 
 	ctx := event.C()
-	if p.Handler().HandleStartBreak(ctx, pos); ctx.Cancelled() {
+	if p.handler().HandleStartBreak(ctx, pos); ctx.Cancelled() {
 		return
 	}
 	if punchable, ok := w.Block(pos).(block.Punchable); ok {
@@ -1761,11 +2187,45 @@ func (p *Player) PlaceBlock(pos cube.Pos, b world.Block, ctx *item.UseContext) {
 	}
 }
 
+// CanFillBucket checks if the player is able to fill a bucket with the liquid at the position passed. It is
+// called by buckets before they are filled, and returns false if the position cannot be edited by the player or
+// if the Handler of the player cancelled the filling.
+func (p *Player) CanFillBucket(pos cube.Pos, liquid world.Liquid) bool {
+	return p.canUseBucket(pos, func(ctx *event.Context) {
+		p.handler().HandleBucketFill(ctx, pos, liquid)
+	})
+}
+
+// CanEmptyBucket checks if the player is able to empty a bucket, placing the liquid passed at the position passed.
+// It is called by buckets before they are emptied, and returns false if the position cannot be edited by the
+// player or if the Handler of the player cancelled the emptying.
+func (p *Player) CanEmptyBucket(pos cube.Pos, liquid world.Liquid) bool {
+	return p.canUseBucket(pos, func(ctx *event.Context) {
+		p.handler().HandleBucketEmpty(ctx, pos, liquid)
+	})
+}
+
+// canUseBucket checks if the player is able to use a bucket at the position passed, calling the handler function
+// passed to allow the use to be cancelled. The liquid at the position is resent to the player if it cannot.
+func (p *Player) canUseBucket(pos cube.Pos, handle func(ctx *event.Context)) bool {
+	w := p.World()
+	if !p.canReach(pos.Vec3Centre()) || !p.canEdit(pos) || !p.GameMode().AllowsEditing() {
+		p.resendBlocks(pos, w, cube.Faces()...)
+		return false
+	}
+	ctx := event.C()
+	if handle(ctx); ctx.Cancelled() {
+		p.resendBlocks(pos, w, cube.Faces()...)
+		return false
+	}
+	return true
+}
+
 // placeBlock makes the player place the block passed at the position passed, granted it is within the range
 // of the player. A bool is returned indicating if a block was placed successfully.
 func (p *Player) placeBlock(pos cube.Pos, b world.Block, ignoreBBox bool) bool {
 	w := p.World()
-	if !p.canReach(pos.Vec3Centre()) || !p.GameMode().AllowsEditing() {
+	if !p.canReach(pos.Vec3Centre()) || !p.canEdit(pos) || !p.GameMode().AllowsEditing() {
 		p.resendBlocks(pos, w, cube.Faces()...)
 		return false
 	}
@@ -1775,7 +2235,7 @@ func (p *Player) placeBlock(pos cube.Pos, b world.Block, ignoreBBox bool) bool {
 	}
 
 	ctx := event.C()
-	if p.Handler().HandleBlockPlace(ctx, pos, b); ctx.Cancelled() {
+	if p.handler().HandleBlockPlace(ctx, pos, b); ctx.Cancelled() {
 		p.resendBlocks(pos, w, cube.Faces()...)
 		return false
 	}
@@ -1817,7 +2277,7 @@ func (p *Player) BreakBlock(pos cube.Pos) {
 		// Don't do anything if the position broken is already air.
 		return
 	}
-	if !p.canReach(pos.Vec3Centre()) || !p.GameMode().AllowsEditing() {
+	if !p.canReach(pos.Vec3Centre()) || !p.canEdit(pos) || !p.GameMode().AllowsEditing() {
 		p.resendBlocks(pos, w)
 		return
 	}
@@ -1825,6 +2285,10 @@ func (p *Player) BreakBlock(pos cube.Pos) {
 		p.resendBlocks(pos, w)
 		return
 	}
+	if r, ok := b.(block.BreakRestricted); ok && !r.CanBreak(p) {
+		p.resendBlocks(pos, w)
+		return
+	}
 	held, _ := p.HeldItems()
 	drops := p.drops(held, b)
 
@@ -1834,7 +2298,7 @@ func (p *Player) BreakBlock(pos cube.Pos) {
 	}
 
 	ctx := event.C()
-	if p.Handler().HandleBlockBreak(ctx, pos, &drops, &xp); ctx.Cancelled() {
+	if p.handler().HandleBlockBreak(ctx, pos, &drops, &xp); ctx.Cancelled() {
 		p.resendBlocks(pos, w)
 		return
 	}
@@ -1876,7 +2340,14 @@ func (p *Player) drops(held item.Stack, b world.Block) []item.Stack {
 		t = item.ToolNone{}
 	}
 	var drops []item.Stack
-	if container, ok := b.(block.Container); ok {
+	if s, ok := b.(block.ShulkerBox); ok {
+		// Shulker boxes keep their contents when broken, so the contents are not dropped separately. Shulker boxes
+		// holding items are dropped even in creative mode so that the items are not lost.
+		if !p.GameMode().CreativeInventory() || !s.Inventory().Empty() {
+			drops = s.BreakInfo().Drops(t, held.Enchantments())
+		}
+		s.Inventory().Clear()
+	} else if container, ok := b.(block.Container); ok {
 		// If the block is a container, it should drop its inventory contents regardless whether the
 		// player is in creative mode or not.
 		drops = container.Inventory().Items()
@@ -1921,7 +2392,7 @@ func (p *Player) PickBlock(pos cube.Pos) {
 	}
 
 	ctx := event.C()
-	if p.Handler().HandleBlockPick(ctx, pos, b); ctx.Cancelled() {
+	if p.handler().HandleBlockPick(ctx, pos, b); ctx.Cancelled() {
 		return
 	}
 	_, offhand := p.HeldItems()
@@ -1950,13 +2421,104 @@ func (p *Player) PickBlock(pos cube.Pos) {
 }
 
 // Teleport teleports the player to a target position in the world. Unlike Move, it immediately changes the
-// position of the player, rather than showing an animation.
+// position of the player, rather than showing an animation. Teleport is equivalent to calling TeleportWithCause
+// with an entity.CustomTeleportCause.
 func (p *Player) Teleport(pos mgl64.Vec3) {
+	p.TeleportWithCause(nil, pos, entity.CustomTeleportCause{})
+}
+
+// TeleportToWorld teleports the player to a target position in the world.World passed. If the player is already in
+// that world, TeleportToWorld behaves the same as Teleport. Otherwise, the player is removed from its current world
+// and added to the new one at the target position. If the world.Dimension of the new world differs from the current
+// one, the client is shown the dimension change screen while the chunks of the new world are sent.
+// TeleportToWorld is equivalent to calling TeleportWithCause with an entity.CustomTeleportCause.
+func (p *Player) TeleportToWorld(w *world.World, pos mgl64.Vec3) {
+	if w == nil {
+		return
+	}
+	p.TeleportWithCause(w, pos, entity.CustomTeleportCause{})
+}
+
+// TeleportWithCause teleports the player to a target position in the world.World passed, or in its current world if
+// w is nil. HandleTeleport and HandleTeleportCause are called, and cancelling either prevents the teleport.
+// The cause also decides if the velocity of the player is kept and for how long teleports with a cause of the same
+// type are ignored afterwards. A position outside the world.Border of the World is clamped to the edge of the border
+// before the handler is called.
+// TeleportWithCause returns true if the player was teleported.
+func (p *Player) TeleportWithCause(w *world.World, pos mgl64.Vec3, cause world.TeleportCause) bool {
+	current := p.World()
+	if w == nil {
+		w = current
+	}
+	if p.teleportCooldown(cause) {
+		return false
+	}
+	pos = w.Border().Clamp(pos)
 	ctx := event.C()
-	if p.Handler().HandleTeleport(ctx, pos); ctx.Cancelled() {
+	if p.handler().HandleTeleport(ctx, pos); ctx.Cancelled() {
+		return false
+	}
+	if p.handler().HandleTeleportCause(ctx, pos, cause); ctx.Cancelled() {
+		return false
+	}
+	if d := cause.Cooldown(); d > 0 {
+		p.cooldownMu.Lock()
+		p.teleportCooldowns[reflect.TypeOf(cause)] = time.Now().Add(d)
+		p.cooldownMu.Unlock()
+	}
+
+	vel := p.Velocity()
+	p.teleportToWorld(w, pos)
+	if cause.ConservesMotion() {
+		p.SetVelocity(vel)
+	}
+	return true
+}
+
+// teleportToWorld teleports the player to a target position in the world passed, moving it to that world if it is
+// not already in it. Like teleport, it does not call the Handler of the player.
+func (p *Player) teleportToWorld(w *world.World, pos mgl64.Vec3) {
+	if w == p.World() {
+		p.teleport(pos)
 		return
 	}
-	p.teleport(pos)
+	// Entities can only ride entities in the same world, so the player leaves its vehicle and passengers behind.
+	entity.Dismount(p)
+	entity.Eject(p)
+
+	// The position is changed before the player is added to the new world, so that the session sends the
+	// dimension change and chunks around the new position rather than the old one.
+	p.pos.Store(pos)
+	p.vel.Store(mgl64.Vec3{})
+	p.ResetFallDistance()
+	w.AddEntity(p)
+}
+
+// MoveTo moves the player to an absolute position and rotation in its current world. If smooth is true, the
+// movement is shown to viewers as with Move, so that they interpolate the position and rotation of the player
+// towards the new values. The yaw is rotated in the shortest direction, so that a change from 350 to 10 degrees
+// rotates the player 20 degrees rather than 340. If smooth is false, the player is teleported to the position
+// as with Teleport and snaps to the new rotation immediately. Movements over large distances are always shown as
+// a teleport.
+func (p *Player) MoveTo(pos mgl64.Vec3, yaw, pitch float64, smooth bool) {
+	currentYaw, currentPitch := p.Rotation().Elem()
+	deltaYaw := math.Mod(math.Mod(yaw-currentYaw, 360)+540, 360) - 180
+	if !smooth {
+		// The rotation is changed first, so that the teleport sent to viewers includes the new rotation.
+		p.Move(mgl64.Vec3{}, deltaYaw, pitch-currentPitch)
+		p.Teleport(pos)
+		return
+	}
+	p.Move(pos.Sub(p.Position()), deltaYaw, pitch-currentPitch)
+}
+
+// teleportCooldown checks if teleports with a world.TeleportCause of the same type as the one passed currently have
+// a cooldown.
+func (p *Player) teleportCooldown(cause world.TeleportCause) bool {
+	p.cooldownMu.Lock()
+	defer p.cooldownMu.Unlock()
+	t, ok := p.teleportCooldowns[reflect.TypeOf(cause)]
+	return ok && time.Now().Before(t)
 }
 
 // teleport teleports the player to a target position in the world. It does not call the Handler of the
@@ -1970,6 +2532,10 @@ func (p *Player) teleport(pos mgl64.Vec3) {
 	p.ResetFallDistance()
 }
 
+// maxGlideSpeed is the maximum distance in blocks that a gliding player may move in a single movement for its
+// velocity to still be updated.
+const maxGlideSpeed = 6
+
 // Move moves the player from one position to another in the world, by adding the delta passed to the current
 // position of the player.
 // Move also rotates the player, adding deltaYaw and deltaPitch to the respective values.
@@ -1991,8 +2557,16 @@ func (p *Player) Move(deltaPos mgl64.Vec3, deltaYaw, deltaPitch float64) {
 		yaw, pitch            = p.Rotation().Elem()
 		res, resYaw, resPitch = pos.Add(deltaPos), yaw + deltaYaw, pitch + deltaPitch
 	)
+	if b := w.Border(); b.Distance(res) < 0 && b.Distance(res) < b.Distance(pos) {
+		// The player tried to move past the border, or further away from it while already outside. The rotation
+		// is still updated, but the player is moved back to its old position.
+		if p.session() != session.Nop {
+			p.teleport(pos)
+		}
+		deltaPos, res = mgl64.Vec3{}, pos
+	}
 	ctx := event.C()
-	if p.Handler().HandleMove(ctx, res, resYaw, resPitch); ctx.Cancelled() {
+	if p.handler().HandleMove(ctx, res, resYaw, resPitch); ctx.Cancelled() {
 		if p.session() != session.Nop && pos.ApproxEqual(p.Position()) {
 			// The position of the player was changed and the event cancelled. This means we still need to notify the
 			// player of this movement change.
@@ -2007,8 +2581,9 @@ func (p *Player) Move(deltaPos mgl64.Vec3, deltaYaw, deltaPitch float64) {
 	p.pos.Store(res)
 	p.yaw.Store(resYaw)
 	p.pitch.Store(resPitch)
-	if deltaPos.Len() <= 3 {
-		// Only update velocity if the player is not moving too fast to prevent potential OOMs.
+	if deltaPos.Len() <= 3 || (p.Gliding() && deltaPos.Len() <= maxGlideSpeed) {
+		// Only update velocity if the player is not moving too fast to prevent potential OOMs. Gliding players,
+		// especially those boosted by fireworks, are allowed to move faster than others.
 		p.vel.Store(deltaPos)
 		p.checkBlockCollisions(deltaPos, w)
 	}
@@ -2091,7 +2666,7 @@ func (p *Player) Collect(s item.Stack) int {
 		return 0
 	}
 	ctx := event.C()
-	if p.Handler().HandleItemPickup(ctx, s); ctx.Cancelled() {
+	if p.handler().HandleItemPickup(ctx, s); ctx.Cancelled() {
 		return 0
 	}
 	n, _ := p.Inventory().AddItem(s)
@@ -2116,7 +2691,7 @@ func (p *Player) ResetEnchantmentSeed() {
 // AddExperience adds experience to the player.
 func (p *Player) AddExperience(amount int) int {
 	ctx := event.C()
-	if p.Handler().HandleExperienceGain(ctx, &amount); ctx.Cancelled() {
+	if p.handler().HandleExperienceGain(ctx, &amount); ctx.Cancelled() {
 		return 0
 	}
 	before := p.experience.Level()
@@ -2231,7 +2806,7 @@ func (p *Player) Drop(s item.Stack) int {
 	e.SetPickupDelay(time.Second * 2)
 
 	ctx := event.C()
-	if p.Handler().HandleItemDrop(ctx, e); ctx.Cancelled() {
+	if p.handler().HandleItemDrop(ctx, e); ctx.Cancelled() {
 		return 0
 	}
 	p.World().AddEntity(e)
@@ -2239,12 +2814,66 @@ func (p *Player) Drop(s item.Stack) int {
 }
 
 // OpenBlockContainer opens a block container, such as a chest, at the position passed. If no container was
-// present at that location, OpenBlockContainer does nothing.
-// OpenBlockContainer will also do nothing if the player has no session connected to it.
+// present at that location, OpenBlockContainer does nothing. Any container that the player had open before
+// is closed first.
+// OpenBlockContainer will also do nothing if the player has no session connected to it or if the opening is
+// cancelled by the Handler of the player.
 func (p *Player) OpenBlockContainer(pos cube.Pos) {
-	if p.session() != session.Nop {
-		p.session().OpenBlockContainer(pos)
+	if p.session() == session.Nop {
+		return
 	}
+	if opened, ok := p.session().OpenedContainer(); ok && opened == pos {
+		return
+	}
+	b := p.World().Block(pos)
+	ctx := event.C()
+	if p.handler().HandleContainerOpen(ctx, pos, b, p.containerInventory(b)); ctx.Cancelled() {
+		return
+	}
+	p.CloseContainer()
+	p.session().OpenBlockContainer(pos)
+}
+
+// OpenTrading opens the trading window of the entity.Mob passed, which must have an entity.Merchant behaviour,
+// such as a villager. Any container that the player had open before is closed first. OpenTrading does nothing if
+// the player has no session connected to it or if another player is already trading with the Mob.
+func (p *Player) OpenTrading(m *entity.Mob) {
+	if p.session() == session.Nop {
+		return
+	}
+	p.CloseContainer()
+	p.session().OpenTrading(m)
+}
+
+// CloseContainer closes the block container or trading window that the player currently has open, such as a chest.
+// CloseContainer does nothing if the player has no container open.
+func (p *Player) CloseContainer() {
+	pos, ok := p.session().OpenedContainer()
+	if !ok {
+		// The player might still have a trading window open, which is not a block container.
+		p.session().CloseContainer()
+		return
+	}
+	b := p.World().Block(pos)
+	p.handler().HandleContainerClose(pos, b, p.containerInventory(b))
+	p.session().CloseContainer()
+}
+
+// containerInventory returns the inventory of the block container passed as shown to the player. Nil is returned
+// if the block does not hold items.
+func (p *Player) containerInventory(b world.Block) *inventory.Inventory {
+	switch c := b.(type) {
+	case block.Chest:
+		if double, ok := c.PairedInventory(); ok {
+			return double
+		}
+		return c.Inventory()
+	case block.Container:
+		return c.Inventory()
+	case block.EnderChest:
+		return p.EnderChestInventory()
+	}
+	return nil
 }
 
 // HideEntity hides a world.Entity from the Player so that it can under no circumstance see it. Hidden entities can be
@@ -2274,13 +2903,52 @@ func (p *Player) Latency() time.Duration {
 	return p.session().Latency()
 }
 
+// Corrections returns the amount of times that the state of the client of the player was corrected after the server
+// rejected an action of which the client predicted the outcome, such as breaking a block. If the Player does not have
+// a session associated with it, Corrections returns zero values.
+func (p *Player) Corrections() session.Corrections {
+	if p.session() == session.Nop {
+		return session.Corrections{}
+	}
+	return p.session().Corrections()
+}
+
+// ChunkRadius returns the radius in chunks around the player that chunks are sent to the player in. If the Player
+// does not have a session associated with it, ChunkRadius returns 0.
+func (p *Player) ChunkRadius() int {
+	if p.session() == session.Nop {
+		return 0
+	}
+	return p.session().ChunkRadius()
+}
+
+// SetMaxChunkRadius changes the maximum view distance in chunks of the player. If the view distance set in the
+// settings of the player is larger, chunks are only sent within the radius passed. The radius passed overrides
+// the maximum chunk radius set in the server configuration for this player only.
+func (p *Player) SetMaxChunkRadius(r int) {
+	if p.session() == session.Nop {
+		return
+	}
+	p.session().SetMaxChunkRadius(r)
+}
+
+// SetChunksPerTick changes the maximum amount of chunks sent to the player every tick. Chunks closest to the
+// player and in the direction that the player is facing are sent first. Lowering this value smooths out the
+// bandwidth used when the player has to load many chunks at once, for example after a teleport.
+func (p *Player) SetChunksPerTick(n int) {
+	if p.session() == session.Nop {
+		return
+	}
+	p.session().SetChunksPerTick(n)
+}
+
 // Tick ticks the entity, performing actions such as checking if the player is still breaking a block.
 func (p *Player) Tick(w *world.World, current int64) {
 	if p.Dead() {
 		return
 	}
 	if p.lastTickedWorld != w {
-		p.Handler().HandleChangeWorld(p.lastTickedWorld, w)
+		p.handler().HandleChangeWorld(p.lastTickedWorld, w)
 	}
 	p.lastTickedWorld = w
 	if _, ok := w.Liquid(cube.PosFromVec3(p.Position())); !ok {
@@ -2290,8 +2958,11 @@ func (p *Player) Tick(w *world.World, current int64) {
 		}
 	}
 
-	if _, ok := p.Armour().Chestplate().Item().(item.Elytra); ok && p.Gliding() {
-		if t := p.glideTicks.Inc(); t%20 == 0 {
+	if p.Gliding() {
+		if !p.canGlide() {
+			// The player landed, took off its elytra or started flying, so it can no longer glide.
+			p.StopGliding()
+		} else if t := p.glideTicks.Inc(); t%20 == 0 && p.GameMode().AllowsTakingDamage() {
 			d := p.damageItem(p.Armour().Chestplate(), 1)
 			p.armour.SetChestplate(d)
 			if d.Durability() < 2 {
@@ -2300,8 +2971,12 @@ func (p *Player) Tick(w *world.World, current int64) {
 		}
 	}
 
+	p.updateBlocking()
+	p.tickActionBar(current)
 	p.checkBlockCollisions(p.vel.Load(), w)
 	p.onGround.Store(p.checkOnGround(w))
+	p.tickPortal(w)
+	p.tickBorder(w, current)
 
 	p.effects.Tick(p)
 
@@ -2340,6 +3015,11 @@ func (p *Player) Tick(w *world.World, current int64) {
 			delete(p.cooldowns, it)
 		}
 	}
+	for cause, ti := range p.teleportCooldowns {
+		if time.Now().After(ti) {
+			delete(p.teleportCooldowns, cause)
+		}
+	}
 	p.cooldownMu.Unlock()
 
 	if p.session() == session.Nop && !p.Immobile() {
@@ -2353,6 +3033,87 @@ func (p *Player) Tick(w *world.World, current int64) {
 	}
 }
 
+// EnterPortal marks the player as being inside a portal of a specific Dimension. If the player remains inside
+// portals long enough, it is transported to the destination of the portal. For nether portals, this takes four
+// seconds in survival and adventure mode, whereas end portals and any portal in creative mode transport the player
+// immediately.
+func (p *Player) EnterPortal(dim world.Dimension) {
+	p.portalMu.Lock()
+	defer p.portalMu.Unlock()
+	p.portal = dim
+}
+
+// tickPortal ticks the time the player spent inside a portal, transporting it to the portal's destination once it
+// has been inside the portal for long enough.
+func (p *Player) tickPortal(w *world.World) {
+	p.portalMu.Lock()
+	dim := p.portal
+	p.portal = nil
+	if dim == nil {
+		p.portalTicks, p.portalCooldown = 0, false
+		p.portalMu.Unlock()
+		return
+	}
+	if p.portalCooldown {
+		p.portalMu.Unlock()
+		return
+	}
+	p.portalTicks++
+	delay := int64(80)
+	if dim == world.End || !p.GameMode().AllowsTakingDamage() {
+		delay = 1
+	}
+	if p.portalTicks < delay {
+		p.portalMu.Unlock()
+		return
+	}
+	p.portalTicks, p.portalCooldown = 0, true
+	p.portalMu.Unlock()
+
+	if dst, pos, ok := block.PortalTarget(w, p.Position(), dim); ok {
+		p.TeleportWithCause(dst, pos, block.PortalTeleportCause{Dimension: dim})
+	}
+}
+
+// borderRenderDistance is the distance in blocks from the world border within which the border is shown to a player.
+const borderRenderDistance = 8
+
+// tickBorder shows the world.Border of the World to the player when it is close to it and hurts the player if it is
+// outside of it, for example after the border shrunk.
+func (p *Player) tickBorder(w *world.World, current int64) {
+	b := w.Border()
+	pos := p.Position()
+	d := b.Distance(pos)
+	if d < 0 && current%20 == 0 && p.GameMode().AllowsTakingDamage() {
+		p.Hurt(math.Max(1, -d*0.2), entity.BorderDamageSource{})
+	}
+	if d > borderRenderDistance || current%10 != 0 {
+		return
+	}
+	// There is no packet to show a border to Bedrock Edition clients, so the part of the border close to the player
+	// is drawn using particles instead.
+	lo, hi := b.Centre.Sub(mgl64.Vec2{b.Radius, b.Radius}), b.Centre.Add(mgl64.Vec2{b.Radius, b.Radius})
+	dust := particle.Dust{Colour: color.RGBA{R: 0x20, G: 0xa0, B: 0xff, A: 0xff}}
+	for axis := 0; axis < 2; axis++ {
+		// Along the X axis, the edges are at a fixed X coordinate with their positions spread out over the Z
+		// axis, and the other way around for the Z axis.
+		i, j := axis*2, 2-axis*2
+		for _, edge := range []float64{lo[axis], hi[axis]} {
+			if math.Abs(pos[i]-edge) > borderRenderDistance {
+				continue
+			}
+			from, to := math.Max(math.Floor(pos[j])-4, lo[1-axis]), math.Min(math.Floor(pos[j])+4, hi[1-axis])
+			for h := math.Floor(pos[1]) - 1; h <= math.Floor(pos[1])+3; h++ {
+				for v := from; v <= to; v++ {
+					at := mgl64.Vec3{0, h + 0.5}
+					at[i], at[j] = edge, v+0.5
+					p.ShowParticle(at, dust)
+				}
+			}
+		}
+	}
+}
+
 // tickAirSupply tick's the player's air supply, consuming it when underwater, and replenishing it when out of water.
 func (p *Player) tickAirSupply(w *world.World) {
 	if !p.canBreathe(w) {
@@ -2387,7 +3148,8 @@ func (p *Player) tickFood(w *world.World) {
 		p.hunger.foodTick = 0
 	}
 
-	if p.hunger.foodTick%10 == 0 && (p.hunger.canQuicklyRegenerate() || w.Difficulty().FoodRegenerates()) {
+	regenerates := w.NaturalRegeneration()
+	if p.hunger.foodTick%10 == 0 && (regenerates && p.hunger.canQuicklyRegenerate() || w.Difficulty().FoodRegenerates()) {
 		if w.Difficulty().FoodRegenerates() {
 			p.AddFood(1)
 		}
@@ -2396,7 +3158,7 @@ func (p *Player) tickFood(w *world.World) {
 		}
 	}
 	if p.hunger.foodTick == 0 {
-		if p.hunger.canRegenerate() {
+		if regenerates && p.hunger.canRegenerate() {
 			p.regenerate(true)
 		} else if p.hunger.starving() {
 			p.starve(w)
@@ -2459,23 +3221,9 @@ func (p *Player) canBreathe(w *world.World) bool {
 	return !canTakeDamage || waterBreathing || conduitPower || (!p.insideOfWater(w) && !p.insideOfSolid(w))
 }
 
-// breathingDistanceBelowEyes is the lowest distance the player can be in water and still be able to breathe based on
-// the player's eye height.
-const breathingDistanceBelowEyes = 0.11111111
-
 // insideOfWater returns true if the player is currently underwater.
 func (p *Player) insideOfWater(w *world.World) bool {
-	pos := cube.PosFromVec3(entity.EyePosition(p))
-	if l, ok := w.Liquid(pos); ok {
-		if _, ok := l.(block.Water); ok {
-			d := float64(l.SpreadDecay()) + 1
-			if l.LiquidFalling() {
-				d = 1
-			}
-			return p.Position().Y() < (pos.Side(cube.FaceUp).Vec3().Y())-(d/9-breathingDistanceBelowEyes)
-		}
-	}
-	return false
+	return entity.EyesInWater(w, p)
 }
 
 // insideOfSolid returns true if the player is inside a solid block.
@@ -2506,7 +3254,7 @@ func (p *Player) checkBlockCollisions(vel mgl64.Vec3, w *world.World) {
 	entityBBox := p.Type().BBox(p).Translate(p.Position())
 	deltaX, deltaY, deltaZ := vel[0], vel[1], vel[2]
 
-	p.checkEntityInsiders(w, entityBBox)
+	entity.CheckBlocksInside(w, p, entityBBox)
 
 	grown := entityBBox.Extend(vel).Grow(0.25)
 	min, max := grown.Min(), grown.Max()
@@ -2555,33 +3303,6 @@ func (p *Player) checkBlockCollisions(vel mgl64.Vec3, w *world.World) {
 	p.collidedVertically.Store(!mgl64.FloatEqual(deltaY, vel[1]))
 }
 
-// checkEntityInsiders checks if the player is colliding with any EntityInsider blocks.
-func (p *Player) checkEntityInsiders(w *world.World, entityBBox cube.BBox) {
-	box := entityBBox.Grow(-0.0001)
-	min, max := cube.PosFromVec3(box.Min()), cube.PosFromVec3(box.Max())
-
-	for y := min[1]; y <= max[1]; y++ {
-		for x := min[0]; x <= max[0]; x++ {
-			for z := min[2]; z <= max[2]; z++ {
-				blockPos := cube.Pos{x, y, z}
-				b := w.Block(blockPos)
-				if collide, ok := b.(block.EntityInsider); ok {
-					collide.EntityInside(blockPos, w, p)
-					if _, liquid := b.(world.Liquid); liquid {
-						continue
-					}
-				}
-
-				if l, ok := w.Liquid(blockPos); ok {
-					if collide, ok := l.(block.EntityInsider); ok {
-						collide.EntityInside(blockPos, w, p)
-					}
-				}
-			}
-		}
-	}
-}
-
 // checkOnGround checks if the player is currently considered to be on the ground.
 func (p *Player) checkOnGround(w *world.World) bool {
 	box := p.Type().BBox(p).Translate(p.Position())
@@ -2640,6 +3361,48 @@ func (p *Player) PlaySound(sound world.Sound) {
 	p.session().PlaySound(sound)
 }
 
+// StopSound stops a sound with the name passed from playing to the Player, such as "record.cat" or a sound defined
+// in a custom resource pack.
+func (p *Player) StopSound(name string) {
+	p.session().StopSound(name)
+}
+
+// StopAllSounds stops all sounds currently playing to the Player, including music started using PlayMusic.
+func (p *Player) StopAllSounds() {
+	p.session().StopAllSounds()
+}
+
+// PlayMusic starts playing the sound.Music passed to the Player, stopping the track currently playing and clearing
+// the music queue. Only the Player can hear the music, which is played at the position of its eyes.
+func (p *Player) PlayMusic(m sound.Music) {
+	p.session().PlayMusic(m)
+}
+
+// QueueMusic adds the sound.Music passed to the music queue of the Player. Queued tracks are played after each
+// other, using the Duration of each track to determine when it ends. If no music is playing, the track starts
+// playing immediately.
+func (p *Player) QueueMusic(m sound.Music) {
+	p.session().QueueMusic(m)
+}
+
+// SkipMusic stops the track currently playing to the Player and starts the next track in the queue, if any.
+func (p *Player) SkipMusic() {
+	p.session().SkipMusic()
+}
+
+// StopMusic stops the music playing to the Player and clears the music queue. The track is cut off immediately:
+// The client does not support changing the volume of a sound after it has started playing, so it cannot be faded
+// out.
+func (p *Player) StopMusic() {
+	p.session().StopMusic()
+}
+
+// Music returns the sound.Music currently playing to the Player and the tracks queued after it. False is returned if
+// no music is playing.
+func (p *Player) Music() (current sound.Music, queue []sound.Music, ok bool) {
+	return p.session().Music()
+}
+
 // ShowParticle shows a particle that only this Player can see. Unlike World.AddParticle, it is not broadcast
 // to players around it.
 func (p *Player) ShowParticle(pos mgl64.Vec3, particle world.Particle) {
@@ -2647,7 +3410,8 @@ func (p *Player) ShowParticle(pos mgl64.Vec3, particle world.Particle) {
 }
 
 // EditSign edits the sign at the cube.Pos passed and writes the text passed to a sign at that position. If no sign is
-// present or if the Player cannot edit it, an error is returned
+// present, if the Player cannot edit it or if the text is not valid, an error is returned. Control and invisible
+// formatting characters are removed from the text before it is passed to the Handler.
 func (p *Player) EditSign(pos cube.Pos, text string) error {
 	w := p.World()
 	sign, ok := w.Block(pos).(block.Sign)
@@ -2657,9 +3421,13 @@ func (p *Player) EditSign(pos cube.Pos, text string) error {
 	if !sign.EditableBy(p) {
 		return fmt.Errorf("edit sign: sign text was already finalized")
 	}
+	if err := validText(text, maxSignLength); err != nil {
+		return fmt.Errorf("edit sign: %w", err)
+	}
+	text = sanitiseText(text)
 
 	ctx := event.C()
-	if p.Handler().HandleSignEdit(ctx, sign.Text, text); ctx.Cancelled() {
+	if p.handler().HandleSignEdit(ctx, sign.Text, text); ctx.Cancelled() {
 		return nil
 	}
 	sign.Text = text
@@ -2667,6 +3435,196 @@ func (p *Player) EditSign(pos cube.Pos, text string) error {
 	return nil
 }
 
+// EditBook changes the pages of the writable book in the inventory slot passed to the pages passed. An error is
+// returned if the slot does not hold a writable book or if the pages are not valid. Control and invisible formatting
+// characters are removed from the pages before they are passed to the Handler.
+func (p *Player) EditBook(slot int, pages []string) error {
+	it, err := p.inv.Item(slot)
+	if err != nil {
+		return fmt.Errorf("edit book: %w", err)
+	}
+	book, ok := it.Item().(item.BookAndQuill)
+	if !ok {
+		return fmt.Errorf("edit book: slot %v does not hold a writable book", slot)
+	}
+	if len(pages) > maxBookPages {
+		return fmt.Errorf("edit book: book cannot have more than %v pages", maxBookPages)
+	}
+	pages = slices.Clone(pages)
+	for i, page := range pages {
+		if err := validText(page, maxPageLength); err != nil {
+			return fmt.Errorf("edit book: page %v: %w", i, err)
+		}
+		pages[i] = sanitiseText(page)
+	}
+
+	ctx := event.C()
+	if p.handler().HandleBookEdit(ctx, slot, slices.Clone(book.Pages), &pages); ctx.Cancelled() {
+		// The client already changed the book on its side, so we need to send the original book back.
+		_ = p.inv.SetItem(slot, it)
+		return nil
+	}
+	book.Pages = pages
+	_ = p.inv.SetItem(slot, bookStack(it, book))
+	return nil
+}
+
+// SignBook signs the writable book in the inventory slot passed, turning it into a written book with the title
+// passed and the Player as its author. An error is returned if the slot does not hold a writable book or if the
+// title is not valid.
+func (p *Player) SignBook(slot int, title string) error {
+	it, err := p.inv.Item(slot)
+	if err != nil {
+		return fmt.Errorf("sign book: %w", err)
+	}
+	book, ok := it.Item().(item.BookAndQuill)
+	if !ok {
+		return fmt.Errorf("sign book: slot %v does not hold a writable book", slot)
+	}
+	if err := validText(title, maxTitleLength*utf8.UTFMax); err != nil {
+		return fmt.Errorf("sign book: title: %w", err)
+	}
+	title = strings.TrimSpace(sanitiseText(strings.ReplaceAll(title, "\n", " ")))
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		return fmt.Errorf("sign book: title cannot be longer than %v characters", maxTitleLength)
+	}
+
+	ctx := event.C()
+	if p.handler().HandleBookSign(ctx, slot, &title, slices.Clone(book.Pages)); ctx.Cancelled() {
+		_ = p.inv.SetItem(slot, it)
+		return nil
+	}
+	_ = p.inv.SetItem(slot, bookStack(it, item.WrittenBook{Title: title, Author: p.Name(), Pages: book.Pages, Generation: item.OriginalGeneration()}))
+	return nil
+}
+
+// CraftingResult returns the items that the player obtains when crafting using the recipe.Recipe passed. The Handler
+// of the player may change these items or prevent the player from crafting the recipe, in which case false is
+// returned.
+func (p *Player) CraftingResult(r recipe.Recipe) ([]item.Stack, bool) {
+	output := slices.Clone(r.Output())
+	ctx := event.C()
+	if p.handler().HandleCraft(ctx, r, &output); ctx.Cancelled() {
+		return nil, false
+	}
+	return output, true
+}
+
+// CraftingPreview returns the items shown to the player as the result of the recipe.Recipe passed when the items in
+// its crafting grid form the recipe. The Handler of the player may change these items or hide the result, in which
+// case false is returned.
+func (p *Player) CraftingPreview(r recipe.Recipe) ([]item.Stack, bool) {
+	output := slices.Clone(r.Output())
+	ctx := event.C()
+	if p.handler().HandleCraftPreview(ctx, r, &output); ctx.Cancelled() {
+		return nil, false
+	}
+	return output, true
+}
+
+// UnlockRecipes unlocks the recipes passed for the player. If the world of the player requires recipes to be
+// unlocked, as set using world.World.SetRecipesUnlock, the player is only able to craft recipes it has unlocked.
+// Recipes that the player already unlocked are ignored.
+func (p *Player) UnlockRecipes(recipes ...recipe.Recipe) {
+	p.recipeMu.Lock()
+	if p.recipes == nil {
+		p.recipes = make(map[string]recipe.Recipe, len(recipes))
+	}
+	for _, r := range recipes {
+		p.recipes[recipe.Key(r)] = r
+	}
+	p.recipeMu.Unlock()
+	p.session().SendRecipes()
+}
+
+// LockRecipes locks the recipes passed for the player, so that the player is no longer able to craft them if the
+// world of the player requires recipes to be unlocked.
+func (p *Player) LockRecipes(recipes ...recipe.Recipe) {
+	p.recipeMu.Lock()
+	for _, r := range recipes {
+		delete(p.recipes, recipe.Key(r))
+	}
+	p.recipeMu.Unlock()
+	p.session().SendRecipes()
+}
+
+// SendCreativeItems sends the items registered to the creative inventory to the player again. It should be called
+// after changing the creative items using creative.RegisterItem or creative.UnregisterItem for the change to
+// become visible to players that already joined.
+func (p *Player) SendCreativeItems() {
+	p.session().SendCreativeItems()
+}
+
+// RecipeUnlocked checks if the player has unlocked the recipe passed.
+func (p *Player) RecipeUnlocked(r recipe.Recipe) bool {
+	p.recipeMu.Lock()
+	defer p.recipeMu.Unlock()
+	_, ok := p.recipes[recipe.Key(r)]
+	return ok
+}
+
+// UnlockedRecipes returns all recipes that the player has unlocked.
+func (p *Player) UnlockedRecipes() []recipe.Recipe {
+	p.recipeMu.Lock()
+	defer p.recipeMu.Unlock()
+	return maps.Values(p.recipes)
+}
+
+const (
+	// maxSignLength is the maximum length in bytes of the text on a sign.
+	maxSignLength = 256
+	// maxBookPages is the maximum amount of pages that a writable book can have.
+	maxBookPages = 50
+	// maxPageLength is the maximum length in bytes of a single page of a writable book.
+	maxPageLength = 256
+	// maxTitleLength is the maximum length in characters of the title of a written book.
+	maxTitleLength = 16
+)
+
+// validText checks if the text passed is valid UTF-8 and at most n bytes long. Text that exceeds these limits could
+// only have been sent by a modified client and would otherwise end up in the NBT of blocks and items.
+func validText(text string, n int) error {
+	if len(text) > n {
+		return fmt.Errorf("text cannot be longer than %v bytes", n)
+	}
+	if !utf8.ValidString(text) {
+		return fmt.Errorf("text is not valid UTF-8")
+	}
+	return nil
+}
+
+// sanitiseText removes control characters other than newlines and invisible formatting characters, such as zero
+// width spaces, from the text passed. These characters may otherwise be used to bypass chat filters.
+func sanitiseText(text string) string {
+	return strings.Map(func(r rune) rune {
+		if r != '\n' && (unicode.IsControl(r) || unicode.Is(unicode.Cf, r)) {
+			return -1
+		}
+		return r
+	}, text)
+}
+
+// bookStack returns a copy of the item.Stack passed with its item replaced by the book passed, keeping its custom
+// name, lore and values.
+func bookStack(s item.Stack, book world.Item) item.Stack {
+	n := item.NewStack(book, s.Count()).WithCustomName(s.CustomName()).WithLore(s.Lore()...)
+	for k, v := range s.Values() {
+		n = n.WithValue(k, v)
+	}
+	return n
+}
+
+// Mount makes the Player ride the vehicle passed, sitting at an offset relative to the position of the vehicle. False
+// is returned if the Player could not mount the vehicle. See entity.Mount for more information.
+func (p *Player) Mount(vehicle world.Entity, offset mgl64.Vec3) bool {
+	return entity.Mount(p, vehicle, offset)
+}
+
+// Dismount makes the Player stop riding the entity it is currently riding, if any.
+func (p *Player) Dismount() {
+	entity.Dismount(p)
+}
+
 // updateState updates the state of the player to all viewers of the player.
 func (p *Player) updateState() {
 	for _, v := range p.viewers() {
@@ -2700,7 +3658,7 @@ func (p *Player) PunchAir() {
 		return
 	}
 	ctx := event.C()
-	if p.Handler().HandlePunchAir(ctx); ctx.Cancelled() {
+	if p.handler().HandlePunchAir(ctx); ctx.Cancelled() {
 		return
 	}
 	p.SwingArm()
@@ -2715,7 +3673,7 @@ func (p *Player) damageItem(s item.Stack, d int) item.Stack {
 		return s
 	}
 	ctx := event.C()
-	if p.Handler().HandleItemDamage(ctx, s, d); ctx.Cancelled() {
+	if p.handler().HandleItemDamage(ctx, s, d); ctx.Cancelled() {
 		return s
 	}
 	if e, ok := s.Enchantment(enchantment.Unbreaking{}); ok {
@@ -2757,13 +3715,13 @@ func (p *Player) addNewItem(ctx *item.UseContext) {
 }
 
 // canReach checks if a player can reach a position with its current range. The range depends on if the player
-// is either survival or creative mode.
+// is either survival or creative mode. Positions outside the world.Border can never be reached.
 func (p *Player) canReach(pos mgl64.Vec3) bool {
 	const (
 		creativeRange = 14.0
 		survivalRange = 8.0
 	)
-	if !p.GameMode().AllowsInteraction() {
+	if !p.GameMode().AllowsInteraction() || !p.World().Border().Within(pos) {
 		return false
 	}
 	eyes := entity.EyePosition(p)
@@ -2774,34 +3732,78 @@ func (p *Player) canReach(pos mgl64.Vec3) bool {
 	return eyes.Sub(pos).Len() <= survivalRange && !p.Dead()
 }
 
+// canEdit checks if the player is allowed to edit the block at a position. Only operators are able to edit blocks
+// within the spawn protection radius of a world.
+func (p *Player) canEdit(pos cube.Pos) bool {
+	return p.Operator() || !p.World().SpawnProtected(pos)
+}
+
 // Disconnect closes the player and removes it from the world.
 // Disconnect, unlike Close, allows a custom message to be passed to show to the player when it is
 // disconnected. The message is formatted following the rules of fmt.Sprintln without a newline at the end.
+// Unlike Kick, the Handler of the player receives session.DisconnectReasonDisconnected.
 func (p *Player) Disconnect(msg ...any) {
+	p.DisconnectWithReason(session.DisconnectReasonDisconnected, msg...)
+}
+
+// Kick kicks the player from the server, showing the message passed on its disconnection screen. The message is
+// formatted following the rules of fmt.Sprintln without a newline at the end and may be styled using formatting
+// codes, for example using text.Colourf. A message that consists of a translation key only, such as
+// "disconnectionScreen.serverFull", is translated by the client into its own language. If the message is empty,
+// the player is sent to the main menu without a disconnection screen.
+// The Handler of the player receives session.DisconnectReasonKicked.
+func (p *Player) Kick(msg ...any) {
+	p.DisconnectWithReason(session.DisconnectReasonKicked, msg...)
+}
+
+// DisconnectWithReason closes the player and removes it from the world, showing the message passed on its
+// disconnection screen like Kick. The session.DisconnectReason passed is passed to the Handler of the player and
+// returned by DisconnectReason.
+func (p *Player) DisconnectWithReason(reason session.DisconnectReason, msg ...any) {
 	p.once.Do(func() {
-		p.close(format(msg))
+		p.close(reason, format(msg))
 	})
 }
 
+// DisconnectReason returns the reason why the player was disconnected. It may be used in Handler.HandleQuit or
+// after the player has been disconnected. session.DisconnectReasonQuit is returned if the player has not been
+// disconnected.
+func (p *Player) DisconnectReason() session.DisconnectReason {
+	return session.DisconnectReason(p.disconnectReason.Load())
+}
+
 // Close closes the player and removes it from the world.
 // Close disconnects the player with a 'Connection closed.' message. Disconnect should be used to disconnect a
 // player with a custom message.
 func (p *Player) Close() error {
 	p.once.Do(func() {
-		p.close("Connection closed.")
+		p.close(session.DisconnectReasonClosed, "Connection closed.")
 	})
 	return nil
 }
 
 // close closes the player without disconnecting it. It executes code shared by both the closing and the
 // disconnecting of players.
-func (p *Player) close(msg string) {
+func (p *Player) close(reason session.DisconnectReason, msg string) {
 	// If the player is being disconnected while they are dead, we respawn the player
 	// so that the player logic works correctly the next time they join.
 	if p.Dead() && p.session() != nil {
 		p.Respawn()
 	}
-	p.h.Swap(NopHandler{}).HandleQuit()
+	// If the session was already closed, for example because the player timed out, the reason set by the session
+	// takes precedence.
+	reason = p.session().SetDisconnectReason(reason)
+	p.disconnectReason.Store(uint32(reason))
+	// The container is closed while the handler is still set, so that HandleContainerClose is called for it.
+	p.CloseContainer()
+	p.handlerMu.Lock()
+	p.base = NopHandler{}
+	h := p.h.Swap(NopHandler{})
+	p.handlerMu.Unlock()
+	h.HandleQuit()
+
+	entity.Dismount(p)
+	entity.Eject(p)
 
 	if s := p.s.Swap(nil); s != nil {
 		s.Disconnect(msg)
@@ -2819,7 +3821,7 @@ func (p *Player) load(data Data) {
 	p.yaw.Store(data.Yaw)
 	p.pitch.Store(data.Pitch)
 
-	p.health.SetMaxHealth(data.MaxHealth)
+	p.attributes.SetBase(attribute.MaxHealth, data.MaxHealth)
 	p.health.AddHealth(data.Health - p.Health())
 	p.session().SendHealth(p.health)
 
@@ -2850,6 +3852,10 @@ func (p *Player) load(data Data) {
 	for slot, stack := range data.EnderChestInventory {
 		_ = p.enderChest.SetItem(slot, stack)
 	}
+	p.recipes = make(map[string]recipe.Recipe, len(data.UnlockedRecipes))
+	for _, r := range data.UnlockedRecipes {
+		p.recipes[recipe.Key(r)] = r
+	}
 }
 
 // loadInventory loads all the data associated with the player inventory.
@@ -2878,7 +3884,7 @@ func (p *Player) Data() Data {
 		Yaw:             yaw,
 		Pitch:           pitch,
 		Health:          p.Health(),
-		MaxHealth:       p.MaxHealth(),
+		MaxHealth:       p.attributes.Base(attribute.MaxHealth),
 		Hunger:          p.hunger.foodLevel,
 		Experience:      p.Experience(),
 		EnchantmentSeed: p.EnchantmentSeed(),
@@ -2902,6 +3908,7 @@ func (p *Player) Data() Data {
 		Effects:             p.Effects(),
 		FireTicks:           p.fireTicks.Load(),
 		FallDistance:        p.fallDistance.Load(),
+		UnlockedRecipes:     p.UnlockedRecipes(),
 		World:               p.World(),
 	}
 }
@@ -2930,6 +3937,9 @@ func (p *Player) useContext() *item.UseContext {
 	return &item.UseContext{
 		SwapHeldWithArmour: func(i int) {
 			src, dst, srcInv, dstInv := int(p.heldSlot.Load()), i, p.inv, p.armour.Inventory()
+			if srcInv.SlotLocked(src) || dstInv.SlotLocked(dst) {
+				return
+			}
 			srcIt, _ := srcInv.Item(src)
 			dstIt, _ := dstInv.Item(dst)
 
@@ -2944,6 +3954,9 @@ func (p *Player) useContext() *item.UseContext {
 			}
 		},
 		FirstFunc: func(comparable func(item.Stack) bool) (item.Stack, bool) {
+			if offHand, _ := p.offHand.Item(0); !offHand.Empty() && comparable(offHand) {
+				return offHand, true
+			}
 			inv := p.Inventory()
 			s, ok := inv.FirstFunc(comparable)
 			if !ok {
@@ -2955,8 +3968,17 @@ func (p *Player) useContext() *item.UseContext {
 	}
 }
 
-// Handler returns the Handler of the player.
+// Handler returns the Handler of the player, as last passed to Handle. The functions passed to WrapHandler are
+// not applied to the Handler returned.
 func (p *Player) Handler() Handler {
+	p.handlerMu.Lock()
+	defer p.handlerMu.Unlock()
+	return p.base
+}
+
+// handler returns the Handler of the player wrapped by all functions passed to WrapHandler. It is the Handler
+// that events of the player are passed to.
+func (p *Player) handler() Handler {
 	return p.h.Load()
 }
 
@@ -3002,13 +4024,7 @@ func (p *Player) resendBlocks(pos cube.Pos, w *world.World, faces ...cube.Face)
 
 // resendBlock resends the block at a cube.Pos in the world.World passed.
 func (p *Player) resendBlock(pos cube.Pos, w *world.World) {
-	b := w.Block(pos)
-	p.session().ViewBlockUpdate(pos, b, 0)
-	if _, ok := b.(world.Liquid); !ok {
-		if liq, ok := w.Liquid(pos); ok {
-			p.session().ViewBlockUpdate(pos, liq, 1)
-		}
-	}
+	p.session().ResendBlock(pos, w)
 }
 
 // format is a utility function to format a list of values to have spaces between them, but no newline at the
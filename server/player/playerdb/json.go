@@ -32,6 +32,7 @@ func (p *Provider) fromJson(d jsonData, world func(world.Dimension) *world.World
 		Effects:             dataToEffects(d.Effects),
 		FireTicks:           d.FireTicks,
 		FallDistance:        d.FallDistance,
+		UnlockedRecipes:     dataToRecipes(d.UnlockedRecipes),
 		Inventory:           dataToInv(d.Inventory),
 		EnderChestInventory: make([]item.Stack, 27),
 		World:               world(idToDimension(d.Dimension)),
@@ -63,6 +64,7 @@ func (p *Provider) toJson(d player.Data) jsonData {
 		Effects:             effectsToData(d.Effects),
 		FireTicks:           d.FireTicks,
 		FallDistance:        d.FallDistance,
+		UnlockedRecipes:     recipesToData(d.UnlockedRecipes),
 		Inventory:           invToData(d.Inventory),
 		EnderChestInventory: encodeItems(d.EnderChestInventory),
 		Dimension:           uint8(d.World.Dimension().EncodeDimension()),
@@ -88,6 +90,7 @@ type jsonData struct {
 	Effects                          []jsonEffect
 	FireTicks                        int64
 	FallDistance                     float64
+	UnlockedRecipes                  []string
 	Dimension                        uint8
 }
 
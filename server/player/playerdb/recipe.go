@@ -0,0 +1,28 @@
+package playerdb
+
+import "github.com/df-mc/dragonfly/server/item/recipe"
+
+func recipesToData(recipes []recipe.Recipe) []string {
+	data := make([]string, len(recipes))
+	for i, r := range recipes {
+		data[i] = recipe.Key(r)
+	}
+	return data
+}
+
+func dataToRecipes(data []string) []recipe.Recipe {
+	if len(data) == 0 {
+		return nil
+	}
+	registered := make(map[string]recipe.Recipe)
+	for _, r := range recipe.Recipes() {
+		registered[recipe.Key(r)] = r
+	}
+	recipes := make([]recipe.Recipe, 0, len(data))
+	for _, key := range data {
+		if r, ok := registered[key]; ok {
+			recipes = append(recipes, r)
+		}
+	}
+	return recipes
+}
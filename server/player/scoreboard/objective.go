@@ -0,0 +1,87 @@
+package scoreboard
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/world"
+	"strings"
+	"sync"
+)
+
+// DisplaySlot is a slot other than the sidebar that an Objective may be displayed in.
+type DisplaySlot struct {
+	slot
+}
+
+// BelowName is the display slot under the name tag of entities. The score of an entity is shown below its name,
+// followed by the name of the Objective.
+func BelowName() DisplaySlot {
+	return DisplaySlot{0}
+}
+
+// List is the display slot in the player list, which is shown when opening the pause menu. The score of a player is
+// shown next to its name.
+func List() DisplaySlot {
+	return DisplaySlot{1}
+}
+
+type slot uint8
+
+// Uint8 returns the display slot as a uint8.
+func (s slot) Uint8() uint8 {
+	return uint8(s)
+}
+
+// String returns the name of the display slot, as it is used by the client.
+func (s slot) String() string {
+	if s == 0 {
+		return "belowname"
+	}
+	return "list"
+}
+
+// Objective represents a score held by entities, which may be sent to a player in one of the DisplaySlots. Unlike a
+// Scoreboard, an Objective does not hold lines of text, but a score for every entity added to it. An Objective is
+// safe for concurrent use, so that it may be sent to players in different worlds.
+type Objective struct {
+	name string
+
+	mu     sync.RWMutex
+	scores map[world.Entity]int
+}
+
+// NewObjective returns a new objective with the display name passed. The name is formatted according to the rules of
+// fmt.Sprintln. Changing the objective after sending it to a player will not update the objective of the player
+// automatically: Player.SendObjective() must be called again to update it.
+func NewObjective(name ...any) *Objective {
+	return &Objective{name: strings.TrimSuffix(fmt.Sprintln(name...), "\n"), scores: map[world.Entity]int{}}
+}
+
+// Name returns the display name of the objective, as passed during the construction of the objective.
+func (o *Objective) Name() string {
+	return o.name
+}
+
+// Set sets the score of the entity passed. The entity is added to the objective if it did not yet have a score.
+func (o *Objective) Set(e world.Entity, score int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.scores[e] = score
+}
+
+// Remove removes the score of the entity passed from the objective.
+func (o *Objective) Remove(e world.Entity) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.scores, e)
+}
+
+// Scores returns the scores of all entities in the objective.
+func (o *Objective) Scores() map[world.Entity]int {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	m := make(map[world.Entity]int, len(o.scores))
+	for e, score := range o.scores {
+		m[e] = score
+	}
+	return m
+}
@@ -0,0 +1,27 @@
+package skin
+
+// PersonaPiece is a single piece of a skin created using the in-game skin creator (persona), such as the hair, the
+// eyes or the top worn by the skin. The geometry and texture of the pieces are resolved by the client.
+type PersonaPiece struct {
+	// ID is a UUID that uniquely identifies the piece.
+	ID string
+	// Type is the type of the piece, such as 'persona_hair', 'persona_eyes' or 'persona_top'.
+	Type string
+	// PackID is a UUID that identifies the pack that the piece belongs to.
+	PackID string
+	// ProductID is a UUID that identifies the piece in the marketplace. It is empty for default pieces.
+	ProductID string
+	// Default specifies if the piece is one of the default pieces that Steve and Alex skins are made of.
+	Default bool
+}
+
+// PersonaPieceTint holds the colours that a type of PersonaPiece is tinted with, such as the colour of the hair or
+// the iris of the eyes.
+type PersonaPieceTint struct {
+	// PieceType is the type of the PersonaPiece that the tint applies to, such as 'persona_hair'. A piece of this
+	// type must be present in the pieces of the skin.
+	PieceType string
+	// Colours holds up to four colours in ARGB hex notation, such as '#ffa12722', each of which tints a different
+	// part of the piece. Unused colours are set to '#0'.
+	Colours [4]string
+}
@@ -1,6 +1,8 @@
 package skin
 
 import (
+	"encoding/json"
+	"fmt"
 	"image"
 	"image/color"
 )
@@ -11,7 +13,9 @@ import (
 type Skin struct {
 	w, h int
 	// Persona specifies if the skin uses the persona skin system.
-	Persona   bool
+	Persona bool
+	// Premium specifies if the skin was obtained from the marketplace.
+	Premium   bool
 	PlayFabID string
 
 	// Pix holds the raw pixel data of the skin. This is an RGBA byte slice, meaning that every first byte is
@@ -32,6 +36,17 @@ type Skin struct {
 	// Animations holds a list of all animations that the skin has. These animations must be pointed to in the
 	// ModelConfig, in order to display them on the skin.
 	Animations []Animation
+
+	// ArmSize is the size of the arms of the skin. It is either 'wide' or 'slim', and may be left empty for skins
+	// that do not use the persona skin system.
+	ArmSize string
+	// Colour is the base colour of the skin in RGB hex notation, such as '#b37b62'. It is used by persona skins.
+	Colour string
+	// PersonaPieces holds the pieces that a persona skin is composed of. It is empty for skins that do not use the
+	// persona skin system.
+	PersonaPieces []PersonaPiece
+	// PersonaPieceTints holds the tint colours of (some of) the PersonaPieces of the skin.
+	PersonaPieceTints []PersonaPieceTint
 }
 
 // New creates a new skin using the width and height passed. The dimensions passed must be either 64x32,
@@ -74,3 +89,41 @@ func (s Skin) At(x, y int) color.Color {
 		A: s.Pix[offset+3],
 	}
 }
+
+// Validate checks if the skin is valid, so that it may be sent to players. An error is returned if the pixel data of
+// the skin, cape or any of the animations does not match their dimensions, if the model is not valid JSON or if a
+// PersonaPieceTint has a type that none of the PersonaPieces of the skin have.
+func (s Skin) Validate() error {
+	if len(s.Pix) != s.w*s.h*4 {
+		return fmt.Errorf("expected %v bytes of skin data for %vx%v skin, got %v", s.w*s.h*4, s.w, s.h, len(s.Pix))
+	}
+	capeBounds := s.Cape.Bounds().Max
+	if len(s.Cape.Pix) != capeBounds.X*capeBounds.Y*4 {
+		return fmt.Errorf("expected %v bytes of cape data for %vx%v cape, got %v", capeBounds.X*capeBounds.Y*4, capeBounds.X, capeBounds.Y, len(s.Cape.Pix))
+	}
+	for i, anim := range s.Animations {
+		bounds := anim.Bounds().Max
+		if len(anim.Pix) != bounds.X*bounds.Y*4 {
+			return fmt.Errorf("expected %v bytes of data for %vx%v animation %v, got %v", bounds.X*bounds.Y*4, bounds.X, bounds.Y, i, len(anim.Pix))
+		}
+	}
+	if len(s.Model) != 0 && !json.Valid(s.Model) {
+		return fmt.Errorf("model is not valid JSON")
+	}
+	if s.ArmSize != "" && s.ArmSize != "wide" && s.ArmSize != "slim" {
+		return fmt.Errorf("arm size must be either wide or slim, got %v", s.ArmSize)
+	}
+	for _, tint := range s.PersonaPieceTints {
+		found := false
+		for _, piece := range s.PersonaPieces {
+			if piece.Type == tint.PieceType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("tint of persona piece type %v has no matching persona piece", tint.PieceType)
+		}
+	}
+	return nil
+}
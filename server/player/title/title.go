@@ -80,8 +80,8 @@ func (title Title) WithFadeInDuration(d time.Duration) Title {
 	return title
 }
 
-// FadeInDuration returns the duration that the fade-in of the title takes. By default, this is a quarter of
-// a second.
+// FadeInDuration returns the duration that the fade-in of the title takes. By default, this is a single tick
+// (1/20th of a second).
 func (title Title) FadeInDuration() time.Duration {
 	return title.fadeInDuration
 }
@@ -93,9 +93,9 @@ func (title Title) WithFadeOutDuration(d time.Duration) Title {
 	return title
 }
 
-// FadeOutDuration returns the duration that the fade-out of the title takes.By default, this is a quarter of
-// a second.
-func (title *Title) FadeOutDuration() time.Duration {
+// FadeOutDuration returns the duration that the fade-out of the title takes. By default, this is a single tick
+// (1/20th of a second).
+func (title Title) FadeOutDuration() time.Duration {
 	return title.fadeOutDuration
 }
 
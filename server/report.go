@@ -0,0 +1,43 @@
+package server
+
+import (
+	"github.com/df-mc/dragonfly/server/cmd"
+	"github.com/df-mc/dragonfly/server/player"
+)
+
+// registerReportCommand registers the /report command, unless a command with the same name was already registered.
+// Bedrock Edition clients send reports made through the pause menu to Xbox Live rather than to the server, so the
+// command is the way for players to file reports that reach the HandleReport method of their player.Handler.
+func (srv *Server) registerReportCommand() {
+	if _, ok := cmd.ByAlias("report"); ok {
+		return
+	}
+	cmd.Register(cmd.New("report", "Reports a player to the moderators.", nil, report{}))
+}
+
+// report implements the /report command. It files a report against a single player using Player.Report.
+type report struct {
+	Targets []cmd.Target `cmd:"player"`
+	Reason  cmd.Varargs  `cmd:"reason"`
+}
+
+// Allow only allows players to file reports.
+func (report) Allow(src cmd.Source) bool {
+	_, ok := src.(*player.Player)
+	return ok
+}
+
+// Run ...
+func (r report) Run(src cmd.Source, o *cmd.Output) {
+	if len(r.Targets) != 1 {
+		o.Errorf("Exactly one player must be reported at a time.")
+		return
+	}
+	target, ok := r.Targets[0].(*player.Player)
+	if !ok || target == src {
+		o.Errorf("You can only report other players.")
+		return
+	}
+	src.(*player.Player).Report(target, string(r.Reason))
+	o.Printf("Reported %v.", target.Name())
+}
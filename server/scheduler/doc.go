@@ -0,0 +1,8 @@
+// Package scheduler implements a Scheduler that runs tasks after a delay or
+// repeatedly, measured in ticks rather than in wall-clock time. Every
+// world.World has a Scheduler, obtained using World.Scheduler(), that is ticked
+// at the start of every tick of the world, so that tasks run in sync with the
+// blocks and entities of the world. This should be preferred over functions
+// such as time.AfterFunc, which run on a different goroutine at a time
+// unrelated to the ticks of the world.
+package scheduler
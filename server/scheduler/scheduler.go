@@ -0,0 +1,123 @@
+package scheduler
+
+import (
+	"errors"
+	"github.com/df-mc/atomic"
+	"sync"
+)
+
+// Scheduler runs tasks after a delay or repeatedly, measured in ticks. Tasks are run synchronously when the
+// Scheduler is ticked, so that they are aligned with whatever ticks it. A Scheduler is safe for concurrent use: Tasks
+// may be scheduled from any goroutine, including from within other tasks.
+// A nil *Scheduler is a no-op Scheduler: Tasks scheduled in it are cancelled immediately and never run.
+type Scheduler struct {
+	mu      sync.Mutex
+	current int64
+	tasks   []*Task
+}
+
+// New creates a new Scheduler without any tasks.
+func New() *Scheduler {
+	return &Scheduler{}
+}
+
+// RunLater schedules the function passed to be run once after a delay of the amount of ticks passed. A delay of 0 or
+// lower runs the function during the next tick. The Task returned may be used to cancel it.
+func (s *Scheduler) RunLater(delay int, f func()) *Task {
+	return s.schedule(delay, 0, f)
+}
+
+// RunRepeating schedules the function passed to be run after a delay of the amount of ticks passed and every
+// interval ticks afterwards, until the Task returned is cancelled. An error is returned if the interval is 0 or
+// lower.
+func (s *Scheduler) RunRepeating(delay, interval int, f func()) (*Task, error) {
+	if interval <= 0 {
+		return nil, errors.New("scheduler: interval of repeating task must be at least 1 tick")
+	}
+	return s.schedule(delay, interval, f), nil
+}
+
+// Tick advances the Scheduler by a single tick, running all tasks that are due. Tasks are run in the order in which
+// they were scheduled.
+func (s *Scheduler) Tick() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.current++
+	current := s.current
+
+	var due []*Task
+	tasks := s.tasks[:0]
+	for _, t := range s.tasks {
+		if t.Cancelled() {
+			continue
+		}
+		if t.next <= current {
+			due = append(due, t)
+			if t.interval == 0 {
+				continue
+			}
+			t.next = current + t.interval
+		}
+		tasks = append(tasks, t)
+	}
+	for i := len(tasks); i < len(s.tasks); i++ {
+		// Clear the remaining pointers so that removed tasks may be garbage collected.
+		s.tasks[i] = nil
+	}
+	s.tasks = tasks
+	s.mu.Unlock()
+
+	for _, t := range due {
+		if !t.Cancelled() {
+			t.f()
+		}
+	}
+}
+
+// Tasks returns the amount of tasks that are currently scheduled.
+func (s *Scheduler) Tasks() int {
+	if s == nil {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.tasks)
+}
+
+// schedule adds a new Task that runs f after delay ticks and every interval ticks after that, if interval is not 0.
+func (s *Scheduler) schedule(delay, interval int, f func()) *Task {
+	if s == nil {
+		t := &Task{f: f}
+		t.Cancel()
+		return t
+	}
+	if delay < 1 {
+		delay = 1
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := &Task{f: f, next: s.current + int64(delay), interval: int64(interval)}
+	s.tasks = append(s.tasks, t)
+	return t
+}
+
+// Task is a function scheduled to run in a Scheduler. It may be cancelled using Task.Cancel.
+type Task struct {
+	f              func()
+	next, interval int64
+	cancelled      atomic.Bool
+}
+
+// Cancel cancels the Task, so that it is not run again. Cancelling a Task that already ran or that was already
+// cancelled has no effect.
+func (t *Task) Cancel() {
+	t.cancelled.Store(true)
+}
+
+// Cancelled checks if the Task was cancelled.
+func (t *Task) Cancelled() bool {
+	return t.cancelled.Load()
+}
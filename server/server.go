@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"github.com/df-mc/atomic"
 	"github.com/df-mc/dragonfly/server/cmd"
+	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/internal/iteminternal"
 	"github.com/df-mc/dragonfly/server/internal/sliceutil"
-	_ "github.com/df-mc/dragonfly/server/item" // Imported for maintaining correct initialisation order.
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/creative"
 	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/player/economy"
 	"github.com/df-mc/dragonfly/server/player/skin"
 	"github.com/df-mc/dragonfly/server/session"
 	"github.com/df-mc/dragonfly/server/world"
@@ -26,6 +29,7 @@ import (
 	"github.com/sandertv/gophertunnel/minecraft/text"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"math/rand"
 	"os"
 	"os/exec"
@@ -44,7 +48,14 @@ type Server struct {
 
 	once    sync.Once
 	started atomic.Bool
-
+	handler atomic.Value[Handler]
+
+	// reloadMu is held while the worlds are reloaded. Joining players hold it
+	// for reading while they are spawned, so that they are not added to a
+	// world that is being closed. worldMu guards the world, nether and end
+	// fields, which are replaced by ReloadWorlds.
+	reloadMu           sync.RWMutex
+	worldMu            sync.RWMutex
 	world, nether, end *world.World
 
 	customItems []protocol.ItemComponentEntry
@@ -86,10 +97,28 @@ func (srv *Server) Listen() {
 	}
 
 	srv.conf.Log.Infof("Starting Dragonfly for Minecraft v%v...", protocol.CurrentVersion)
+	srv.Handler().HandleListen(srv)
 	srv.startListening()
+	srv.World().Scheduler().RunLater(0, func() {
+		srv.Handler().HandleFirstTick(srv)
+	})
 	go srv.wait()
 }
 
+// Handle changes the Handler of the Server to h, which handles events in the lifecycle of the Server. If h is nil,
+// a NopHandler is used.
+func (srv *Server) Handle(h Handler) {
+	if h == nil {
+		h = NopHandler{}
+	}
+	srv.handler.Store(h)
+}
+
+// Handler returns the Handler currently set on the Server.
+func (srv *Server) Handler() Handler {
+	return srv.handler.Load()
+}
+
 // Accept accepts an incoming player into the server. It blocks until a player
 // connects to the server. A HandleFunc may be passed which is run immediately
 // before a *player.Player is accepted to the Server. This function may be used
@@ -118,21 +147,122 @@ func (srv *Server) Accept(f HandleFunc) bool {
 // world and this world will be read from and written to when the world is
 // edited.
 func (srv *Server) World() *world.World {
+	srv.worldMu.RLock()
+	defer srv.worldMu.RUnlock()
 	return srv.world
 }
 
 // Nether returns the nether world of the server. Players are transported to it
 // when entering a nether portal in the world returned by the World method.
 func (srv *Server) Nether() *world.World {
+	srv.worldMu.RLock()
+	defer srv.worldMu.RUnlock()
 	return srv.nether
 }
 
 // End returns the end world of the server. Players are transported to it when
 // entering an end portal in the world returned by the World method.
 func (srv *Server) End() *world.World {
+	srv.worldMu.RLock()
+	defer srv.worldMu.RUnlock()
 	return srv.end
 }
 
+// ReloadWorlds saves and closes the overworld, nether and end of the server
+// and opens them again using Config.OpenWorldProvider, so that changes made to
+// the world files on disk are loaded without restarting the server. Players in
+// these worlds are moved to the spawn of the fallback world passed while the
+// worlds are reloaded, and are moved back to their previous positions
+// afterwards. Players joining during the reload are spawned once it is done.
+// The world.Handler of each world is detached before the world is closed, so
+// that it does not receive HandleClose, and is attached to the new world.
+// If the world provider could not be opened again, the worlds are opened
+// without a provider and an error is returned.
+func (srv *Server) ReloadWorlds(fallback *world.World) error {
+	if srv.conf.OpenWorldProvider == nil {
+		return fmt.Errorf("reload worlds: world provider cannot be reopened")
+	}
+	srv.reloadMu.Lock()
+	defer srv.reloadMu.Unlock()
+
+	worlds := srv.worlds()
+	if fallback == nil || slices.Contains(worlds, fallback) {
+		return fmt.Errorf("reload worlds: fallback world must be a world that is not reloaded")
+	}
+	type reloadedPlayer struct {
+		p   *player.Player
+		dim world.Dimension
+		pos mgl64.Vec3
+	}
+	var players []reloadedPlayer
+	for _, w := range worlds {
+		// Players are taken from the worlds rather than from srv.Players(),
+		// as players that were spawned but not yet accepted are not in it.
+		for _, e := range w.Entities() {
+			if p, ok := e.(*player.Player); ok {
+				players = append(players, reloadedPlayer{p: p, dim: w.Dimension(), pos: p.Position()})
+				transfer(p, fallback, fallback.Spawn().Vec3Middle())
+			}
+		}
+	}
+	handlers := make([]world.Handler, len(worlds))
+	for i, w := range worlds {
+		handlers[i] = w.Handler()
+		w.Handle(nil)
+	}
+
+	srv.conf.Log.Debugf("Closing worlds for reload...")
+	for _, w := range []*world.World{worlds[2], worlds[1], worlds[0]} {
+		if err := w.Close(); err != nil {
+			srv.conf.Log.Errorf("Error closing %v: %v", w.Dimension(), err)
+		}
+	}
+	prov, err := srv.conf.OpenWorldProvider()
+	if err != nil {
+		prov, err = world.NopProvider{}, fmt.Errorf("reload worlds: open world provider: %w", err)
+	}
+	srv.conf.WorldProvider = prov
+
+	overworld := srv.createWorld(world.Overworld, &srv.nether, &srv.end)
+	nether := srv.createWorld(world.Nether, &srv.world, &srv.end)
+	end := srv.createWorld(world.End, &srv.nether, &srv.world)
+	for i, w := range []*world.World{overworld, nether, end} {
+		w.Handle(handlers[i])
+	}
+	srv.worldMu.Lock()
+	srv.world, srv.nether, srv.end = overworld, nether, end
+	srv.worldMu.Unlock()
+
+	for _, rp := range players {
+		if rp.p.World() == fallback {
+			transfer(rp.p, srv.dimension(rp.dim), rp.pos)
+		}
+	}
+	return err
+}
+
+// worlds returns the overworld, nether and end of the server, in that order.
+func (srv *Server) worlds() []*world.World {
+	srv.worldMu.RLock()
+	defer srv.worldMu.RUnlock()
+	return []*world.World{srv.world, srv.nether, srv.end}
+}
+
+// transfer moves a player to a position in the world passed. Unlike
+// Player.TeleportToWorld, the player is always moved to the world, even if the
+// teleport is cancelled.
+func transfer(p *player.Player, w *world.World, pos mgl64.Vec3) {
+	if !p.TeleportWithCause(w, pos, entity.CustomTeleportCause{}) && p.World() != w {
+		w.AddEntity(p)
+	}
+}
+
+// Economy returns the economy.Economy of the server, as passed to the Config
+// it was created with. It holds the balances of players.
+func (srv *Server) Economy() economy.Economy {
+	return srv.conf.Economy
+}
+
 // MaxPlayerCount returns the maximum amount of players that are allowed to
 // play on the server at the same time. Players trying to join when the server
 // is full will be refused to enter. If the config has a maximum player count
@@ -208,10 +338,11 @@ func (srv *Server) Close() error {
 func (srv *Server) close() {
 	srv.conf.Log.Infof("Server shutting down...")
 	defer srv.conf.Log.Infof("Server stopped.")
+	srv.Handler().HandleShutdown(srv)
 
 	srv.conf.Log.Debugf("Disconnecting players...")
 	for _, p := range srv.Players() {
-		p.Disconnect(text.Colourf("<yellow>%v</yellow>", srv.conf.ShutdownMessage))
+		p.DisconnectWithReason(session.DisconnectReasonShutdown, text.Colourf("<yellow>%v</yellow>", srv.conf.ShutdownMessage))
 	}
 	srv.pwg.Wait()
 
@@ -220,8 +351,15 @@ func (srv *Server) close() {
 		srv.conf.Log.Errorf("Error while closing player provider: %v", err)
 	}
 
+	srv.conf.Log.Debugf("Closing economy...")
+	if err := srv.conf.Economy.Close(); err != nil {
+		srv.conf.Log.Errorf("Error while closing economy: %v", err)
+	}
+
 	srv.conf.Log.Debugf("Closing worlds...")
-	for _, w := range []*world.World{srv.end, srv.nether, srv.world} {
+	srv.reloadMu.Lock()
+	defer srv.reloadMu.Unlock()
+	for _, w := range []*world.World{srv.End(), srv.Nether(), srv.World()} {
 		if err := w.Close(); err != nil {
 			srv.conf.Log.Errorf("Error closing %v: %v", w.Dimension(), err)
 		}
@@ -233,6 +371,7 @@ func (srv *Server) close() {
 			srv.conf.Log.Errorf("Error closing listener: %v", err)
 		}
 	}
+	srv.Handler().HandleShutdownComplete(srv)
 }
 
 // listen makes the Server listen for new connections from the Listener passed.
@@ -288,17 +427,26 @@ func (srv *Server) startListening() {
 
 // makeItemComponents initializes the server's item components map using the
 // registered custom items. It allows item components to be created only once
-// at startup
+// at startup. Custom items that were not yet registered as creative items are
+// added to the creative inventory, in the category returned by their Category
+// method, unless they were registered using world.HideFromCreative.
 func (srv *Server) makeItemComponents() {
 	custom := world.CustomItems()
-	srv.customItems = make([]protocol.ItemComponentEntry, len(custom))
+	srv.customItems = make([]protocol.ItemComponentEntry, 0, len(custom))
 
+	creativeItems := creative.Items()
 	for _, it := range custom {
 		name, _ := it.EncodeItem()
 		srv.customItems = append(srv.customItems, protocol.ItemComponentEntry{
 			Name: name,
 			Data: iteminternal.Components(it),
 		})
+		if !world.HiddenFromCreative(it) && !slices.ContainsFunc(creativeItems, func(s item.Stack) bool {
+			creativeName, _ := s.Item().EncodeItem()
+			return creativeName == name
+		}) {
+			creative.RegisterItem(item.NewStack(it, 1))
+		}
 	}
 }
 
@@ -318,7 +466,7 @@ func (srv *Server) finaliseConn(ctx context.Context, conn session.Conn, l Listen
 	var playerData *player.Data
 	if d, err := srv.conf.PlayerProvider.Load(id, srv.dimension); err == nil {
 		if d.World == nil {
-			d.World = srv.world
+			d.World = srv.World()
 		}
 		data.PlayerPosition = vec64To32(d.Position).Add(mgl32.Vec3{0, 1.62})
 		data.Dimension = int32(d.World.Dimension().EncodeDimension())
@@ -335,7 +483,7 @@ func (srv *Server) finaliseConn(ctx context.Context, conn session.Conn, l Listen
 	}
 	_ = conn.WritePacket(&packet.ItemComponent{Items: srv.customItems})
 	if p, ok := srv.Player(id); ok {
-		p.Disconnect("Logged in from another location.")
+		p.DisconnectWithReason(session.DisconnectReasonDuplicateLogin, "Logged in from another location.")
 	}
 	srv.incoming <- srv.createPlayer(id, conn, playerData)
 }
@@ -352,12 +500,12 @@ func (srv *Server) defaultGameData() minecraft.GameData {
 		WorldName:       srv.conf.Name,
 		BaseGameVersion: protocol.CurrentVersion,
 
-		Time:       int64(srv.world.Time()),
+		Time:       int64(srv.World().Time()),
 		Difficulty: 2,
 
 		PlayerGameMode:    packet.GameTypeCreative,
 		PlayerPermissions: packet.PermissionLevelMember,
-		PlayerPosition:    vec64To32(srv.world.Spawn().Vec3Centre().Add(mgl64.Vec3{0, 1.62})),
+		PlayerPosition:    vec64To32(srv.World().Spawn().Vec3Centre().Add(mgl64.Vec3{0, 1.62})),
 
 		Items:     srv.itemEntries(),
 		GameRules: []protocol.GameRule{{Name: "naturalregeneration", Value: false}},
@@ -372,6 +520,8 @@ func (srv *Server) defaultGameData() minecraft.GameData {
 
 // dimension returns a world by a dimension passed.
 func (srv *Server) dimension(dimension world.Dimension) *world.World {
+	srv.worldMu.RLock()
+	defer srv.worldMu.RUnlock()
 	switch dimension {
 	default:
 		return srv.world
@@ -411,6 +561,7 @@ func (srv *Server) handleSessionClose(c session.Controllable) {
 		return
 	}
 
+	srv.conf.Log.Infof("Player %v disconnected: %v.", p.Name(), p.DisconnectReason())
 	if err := srv.conf.PlayerProvider.Save(p.UUID(), p.Data()); err != nil {
 		srv.conf.Log.Errorf("Error while saving data: %v", err)
 	}
@@ -420,12 +571,27 @@ func (srv *Server) handleSessionClose(c session.Controllable) {
 // createPlayer creates a new player instance using the UUID and connection
 // passed.
 func (srv *Server) createPlayer(id uuid.UUID, conn session.Conn, data *player.Data) *session.Session {
-	w, gm, pos := srv.world, srv.world.DefaultGameMode(), srv.world.Spawn().Vec3Middle()
+	// Hold reloadMu so that the player is not spawned in a world that is
+	// being closed by ReloadWorlds.
+	srv.reloadMu.RLock()
+	defer srv.reloadMu.RUnlock()
+
+	var (
+		w   = srv.World()
+		gm  world.GameMode
+		pos mgl64.Vec3
+	)
 	if data != nil {
+		// The worlds may have been reloaded since the data was loaded, so the
+		// world is looked up again by its dimension.
+		data.World = srv.dimension(data.World.Dimension())
 		w, gm, pos = data.World, data.GameMode, data.Position
+	} else {
+		gm, pos = w.DefaultGameMode(), w.PlayerSpawn(id).Vec3Middle()
 	}
-	s := session.New(conn, srv.conf.MaxChunkRadius, srv.conf.Log, srv.conf.JoinMessage, srv.conf.QuitMessage)
+	s := session.New(conn, srv.conf.MaxChunkRadius, srv.conf.MaxChunksPerTick, srv.conf.Log, srv.conf.JoinMessage, srv.conf.QuitMessage, srv.conf.Timeout)
 	p := player.NewWithSession(conn.IdentityData().DisplayName, conn.IdentityData().XUID, id, srv.parseSkin(conn.ClientData()), s, pos, data)
+	p.SetOperator(slices.Contains(srv.conf.Operators, p.XUID()) || slices.Contains(srv.conf.Operators, p.Name()))
 
 	s.Spawn(p, pos, w, gm, srv.handleSessionClose)
 	srv.pwg.Add(1)
@@ -454,8 +620,15 @@ func (srv *Server) createWorld(dim world.Dimension, nether, end **world.World) *
 		Generator:       srv.conf.Generator(dim),
 		RandomTickSpeed: srv.conf.RandomTickSpeed,
 		ReadOnly:        srv.conf.ReadOnlyWorld,
+		SaveInterval:    srv.conf.WorldSaveInterval,
+		SaveRate:        srv.conf.WorldSaveRate,
 		Entities:        srv.conf.Entities,
+		MobSpawning:     srv.conf.MobSpawning,
+		Physics:         srv.conf.Physics,
+		DeathMessages:   srv.conf.DeathMessages,
 		PortalDestination: func(dim world.Dimension) *world.World {
+			srv.worldMu.RLock()
+			defer srv.worldMu.RUnlock()
 			if dim == world.Nether {
 				return *nether
 			} else if dim == world.End {
@@ -464,6 +637,10 @@ func (srv *Server) createWorld(dim world.Dimension, nether, end **world.World) *
 			return nil
 		},
 	}
+	if dim == world.Overworld {
+		conf.SpawnChunkRadius, conf.SpawnProtectionRadius = srv.conf.SpawnChunkRadius, srv.conf.SpawnProtectionRadius
+		conf.SpawnLocator = srv.conf.SpawnLocator
+	}
 	w := conf.New()
 	logger.Infof(`Opened world "%v".`, w.Name())
 	return w
@@ -481,6 +658,7 @@ func (srv *Server) parseSkin(data login.ClientData) skin.Skin {
 
 	playerSkin := skin.New(data.SkinImageWidth, data.SkinImageHeight)
 	playerSkin.Persona = data.PersonaSkin
+	playerSkin.Premium = data.PremiumSkin
 	playerSkin.Pix = skinData
 	playerSkin.Model = modelData
 	playerSkin.ModelConfig = modelConfig
@@ -507,6 +685,23 @@ func (srv *Server) parseSkin(data login.ClientData) skin.Skin {
 		playerSkin.Animations = append(playerSkin.Animations, anim)
 	}
 
+	playerSkin.ArmSize, playerSkin.Colour = data.ArmSize, data.SkinColour
+	for _, piece := range data.PersonaPieces {
+		playerSkin.PersonaPieces = append(playerSkin.PersonaPieces, skin.PersonaPiece{
+			ID:        piece.PieceID,
+			Type:      piece.PieceType,
+			PackID:    piece.PackID,
+			ProductID: piece.ProductID,
+			Default:   piece.Default,
+		})
+	}
+	for _, tint := range data.PieceTintColours {
+		playerSkin.PersonaPieceTints = append(playerSkin.PersonaPieceTints, skin.PersonaPieceTint{
+			PieceType: tint.PieceType,
+			Colours:   tint.Colours,
+		})
+	}
+
 	return playerSkin
 }
 
@@ -534,6 +729,18 @@ func (srv *Server) itemEntries() []protocol.ItemEntry {
 			RuntimeID: int16(rid),
 		})
 	}
+	for name, rid := range world.ItemRuntimeIDs() {
+		if _, ok := itemRuntimeIDs[name]; ok {
+			continue
+		}
+		if it, ok := world.ItemByName(name, 0); ok {
+			if _, custom := it.(world.CustomItem); !custom {
+				// Items with a new runtime ID that are not custom items, such as spawn eggs of custom entities, are
+				// defined by the resource pack of the server.
+				entries = append(entries, protocol.ItemEntry{Name: name, RuntimeID: int16(rid)})
+			}
+		}
+	}
 	for _, it := range world.CustomItems() {
 		name, _ := it.EncodeItem()
 		rid, _, _ := world.ItemRuntimeID(it)
@@ -6,8 +6,10 @@ import (
 	"github.com/df-mc/dragonfly/server/entity/effect"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/item/recipe"
 	"github.com/df-mc/dragonfly/server/player/chat"
 	"github.com/df-mc/dragonfly/server/player/form"
+	"github.com/df-mc/dragonfly/server/player/menu"
 	"github.com/df-mc/dragonfly/server/player/skin"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
@@ -23,6 +25,7 @@ type Controllable interface {
 	world.Entity
 	item.User
 	form.Submitter
+	menu.Submitter
 	cmd.Source
 	chat.Subscriber
 
@@ -75,6 +78,7 @@ type Controllable interface {
 	Gliding() bool
 	StopGliding()
 	Jump()
+	SwapHeldItems() bool
 
 	StartBreaking(pos cube.Pos, face cube.Face)
 	ContinueBreaking(face cube.Face)
@@ -84,8 +88,15 @@ type Controllable interface {
 	Exhaust(points float64)
 
 	EditSign(pos cube.Pos, text string) error
+	Dismount()
+	EditBook(slot int, pages []string) error
+	SignBook(slot int, title string) error
+	CraftingResult(r recipe.Recipe) ([]item.Stack, bool)
+	CraftingPreview(r recipe.Recipe) ([]item.Stack, bool)
+	RecipeUnlocked(r recipe.Recipe) bool
 
 	EnderChestInventory() *inventory.Inventory
+	CloseContainer()
 
 	// UUID returns the UUID of the controllable. It must be unique for all controllable entities present in
 	// the server.
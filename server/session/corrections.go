@@ -0,0 +1,82 @@
+package session
+
+import (
+	"github.com/df-mc/atomic"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+)
+
+// Corrections holds the amount of times that the state of a client was corrected after the server rejected an action
+// of which the client predicted the outcome, such as breaking a block. Corrections may be used to find out how often
+// clients end up out of sync with the server, for example because of latency or modified clients.
+type Corrections struct {
+	// Blocks is the amount of blocks resent to the client after breaking, placing or using an item on a block was
+	// rejected.
+	Blocks int
+	// Slots is the amount of inventory slots resent to the client after an inventory action was rejected.
+	Slots int
+	// Positions is the amount of times that the position of the client was corrected after its movement was
+	// rejected.
+	Positions int
+}
+
+// corrections holds the counters of the Corrections made to the state of the client of a Session.
+type corrections struct {
+	blocks, slots, positions atomic.Int64
+}
+
+// Corrections returns the Corrections made to the state of the client of the Session since it joined.
+func (s *Session) Corrections() Corrections {
+	return Corrections{
+		Blocks:    int(s.corrections.blocks.Load()),
+		Slots:     int(s.corrections.slots.Load()),
+		Positions: int(s.corrections.positions.Load()),
+	}
+}
+
+// ResendBlock resends the block at the position passed in the world.World passed to the client, including the liquid
+// at that position, if any. ResendBlock is used to correct the client after it predicted a change to a block that
+// the server rejected.
+func (s *Session) ResendBlock(pos cube.Pos, w *world.World) {
+	if s == Nop {
+		return
+	}
+	s.corrections.blocks.Inc()
+
+	b := w.Block(pos)
+	s.ViewBlockUpdate(pos, b, 0)
+	if _, ok := b.(world.Liquid); !ok {
+		if liq, ok := w.Liquid(pos); ok {
+			s.ViewBlockUpdate(pos, liq, 1)
+		}
+	}
+}
+
+// resendSlots resends the items in the slots passed to the client. It is used to correct the client after an
+// inventory action was rejected.
+func (s *Session) resendSlots(slots []protocol.StackRequestSlotInfo) {
+	for _, slot := range slots {
+		inv, ok := s.invByID(int32(slot.ContainerID))
+		if !ok {
+			continue
+		}
+		it, err := inv.Item(int(slot.Slot))
+		if err != nil {
+			continue
+		}
+		s.corrections.slots.Inc()
+		switch inv {
+		case s.inv:
+			s.sendItem(it, int(slot.Slot), protocol.WindowIDInventory)
+		case s.ui:
+			s.sendItem(it, int(slot.Slot), protocol.WindowIDUI)
+		case s.offHand:
+			s.sendItem(it, int(slot.Slot), protocol.WindowIDOffHand)
+		case s.armour.Inventory():
+			s.sendItem(it, int(slot.Slot), protocol.WindowIDArmour)
+		default:
+			s.sendItem(it, int(slot.Slot), s.openedWindowID.Load())
+		}
+	}
+}
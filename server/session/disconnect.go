@@ -0,0 +1,75 @@
+package session
+
+// DisconnectReason is the reason why a Session was disconnected. It is passed to the quit handler of a player and
+// may be used to distinguish players leaving the server from players being kicked or timing out.
+type DisconnectReason uint8
+
+const (
+	// DisconnectReasonQuit is used when the client closed the connection itself, for example by leaving the server
+	// through the pause menu.
+	DisconnectReasonQuit DisconnectReason = iota
+	// DisconnectReasonTimeout is used when the client stopped sending packets and the connection timed out.
+	DisconnectReasonTimeout
+	// DisconnectReasonInvalidPacket is used when the client sent a packet that could not be handled.
+	DisconnectReasonInvalidPacket
+	// DisconnectReasonKicked is used when the player was kicked from the server using Player.Kick.
+	DisconnectReasonKicked
+	// DisconnectReasonTransfer is used when the player was transferred to another server.
+	DisconnectReasonTransfer
+	// DisconnectReasonShutdown is used when the player was disconnected because the server shut down.
+	DisconnectReasonShutdown
+	// DisconnectReasonDuplicateLogin is used when the player was disconnected because another client logged in
+	// with the same account.
+	DisconnectReasonDuplicateLogin
+	// DisconnectReasonClosed is used when the player was closed by the server without a more specific reason,
+	// using Player.Close.
+	DisconnectReasonClosed
+	// DisconnectReasonDisconnected is used when the player was disconnected by the server using Player.Disconnect,
+	// for example to send it to the main menu without considering it a kick.
+	DisconnectReasonDisconnected
+)
+
+// String returns a readable description of the DisconnectReason, such as 'timed out'.
+func (r DisconnectReason) String() string {
+	switch r {
+	case DisconnectReasonQuit:
+		return "quit"
+	case DisconnectReasonTimeout:
+		return "timed out"
+	case DisconnectReasonInvalidPacket:
+		return "invalid packet"
+	case DisconnectReasonKicked:
+		return "kicked"
+	case DisconnectReasonTransfer:
+		return "transferred"
+	case DisconnectReasonShutdown:
+		return "server shutdown"
+	case DisconnectReasonDuplicateLogin:
+		return "logged in from another location"
+	case DisconnectReasonClosed:
+		return "closed"
+	case DisconnectReasonDisconnected:
+		return "disconnected"
+	}
+	return "unknown"
+}
+
+// DisconnectReason returns the reason why the Session was disconnected. DisconnectReasonQuit is returned if the
+// Session has not yet been disconnected.
+func (s *Session) DisconnectReason() DisconnectReason {
+	if r := s.disconnectReason.Load(); r != 0 {
+		return DisconnectReason(r - 1)
+	}
+	return DisconnectReasonQuit
+}
+
+// SetDisconnectReason sets the reason why the Session is disconnected. Only the first reason set is kept, so that
+// the original cause of a disconnect is not overwritten by the closing of the connection that follows it. The reason
+// that the Session ends up with is returned.
+func (s *Session) SetDisconnectReason(r DisconnectReason) DisconnectReason {
+	if s == Nop {
+		return r
+	}
+	s.disconnectReason.CAS(0, uint32(r)+1)
+	return s.DisconnectReason()
+}
@@ -25,7 +25,10 @@ func (s *Session) parseEntityMetadata(e world.Entity) protocol.EntityMetadata {
 	m[protocol.EntityDataKeyEffectAmbience] = byte(0)
 	m[protocol.EntityDataKeyColorIndex] = byte(0)
 
-	m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagHasGravity)
+	if _, ok := e.(*entity.Display); !ok {
+		// Displays are not affected by gravity, so the client should not try to predict it.
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagHasGravity)
+	}
 	m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagClimb)
 	if sn, ok := e.(sneaker); ok && sn.Sneaking() {
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagSneaking)
@@ -58,6 +61,10 @@ func (s *Session) parseEntityMetadata(e world.Entity) protocol.EntityMetadata {
 	if u, ok := e.(using); ok && u.UsingItem() {
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagUsingItem)
 	}
+	if b, ok := e.(blocker); ok && b.Blocking() {
+		// Flags with an index of 64 or higher are stored in the second flags field.
+		m.SetFlag(protocol.EntityDataKeyFlagsTwo, protocol.EntityDataFlagBlocking%64)
+	}
 	if c, ok := e.(arrow); ok && c.Critical() {
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagCritical)
 	}
@@ -80,6 +87,16 @@ func (s *Session) parseEntityMetadata(e world.Entity) protocol.EntityMetadata {
 	} else if o, ok := e.(owned); ok {
 		m[protocol.EntityDataKeyOwner] = int64(s.entityRuntimeID(o.Owner()))
 	}
+	if l, ok := e.(leashed); ok {
+		if h := l.LeashHolder(); h != nil {
+			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagLeashed)
+			m[protocol.EntityDataKeyLeashHolder] = int64(s.entityRuntimeID(h))
+		}
+	}
+	if _, offset, ok := entity.Vehicle(e); ok {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagRiding)
+		m[protocol.EntityDataKeySeatOffset] = vec64To32(offset)
+	}
 	if sc, ok := e.(scaled); ok {
 		m[protocol.EntityDataKeyScale] = float32(sc.Scale())
 	}
@@ -87,6 +104,9 @@ func (s *Session) parseEntityMetadata(e world.Entity) protocol.EntityMetadata {
 		m[protocol.EntityDataKeyFuseTime] = int32(t.Fuse().Milliseconds() / 50)
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagIgnited)
 	}
+	if c, ok := e.(endCrystal); ok && c.ShowBase() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagShowBottom)
+	}
 	if n, ok := e.(named); ok {
 		m[protocol.EntityDataKeyName] = n.NameTag()
 		m[protocol.EntityDataKeyAlwaysShowNameTag] = uint8(1)
@@ -132,26 +152,117 @@ func (s *Session) parseEntityMetadata(e world.Entity) protocol.EntityMetadata {
 	if _, ok := e.Type().(entity.LingeringPotionType); ok {
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagLingering)
 	}
-	if eff, ok := e.(effectBearer); ok && len(eff.Effects()) > 0 {
+	if mob, ok := e.(*entity.Mob); ok {
+		b := mob.Behaviour()
+		if a, ok := b.(ageable); ok && a.Baby() {
+			m[protocol.EntityDataKeyScale] = float32(0.5)
+			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagBaby)
+		}
+		if l, ok := b.(lover); ok && l.InLove() {
+			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagInLove)
+		}
+		if sh, ok := b.(shearable); ok && sh.Sheared() {
+			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagSheared)
+		}
+		if c, ok := b.(coloured); ok {
+			m[protocol.EntityDataKeyColorIndex] = c.Colour().Uint8()
+		}
+		if t, ok := b.(tameable); ok {
+			if t.Tamed() {
+				m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagTamed)
+			}
+			if t.Sitting() {
+				m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagSitting)
+			}
+		}
+		if v, ok := b.(variant); ok {
+			m[protocol.EntityDataKeyVariant] = int32(v.Variant())
+		}
+		if mer, ok := b.(entity.Merchant); ok {
+			m[protocol.EntityDataKeyTradeTier] = int32(mer.Tier())
+			m[protocol.EntityDataKeyMaxTradeTier] = int32(entity.MaxMerchantTier)
+			m[protocol.EntityDataKeyTradeExperience] = int32(mer.Experience())
+		}
+	}
+	if eff, ok := e.(effectBearer); ok {
 		visibleEffects := make([]effect.Effect, 0, len(eff.Effects()))
 		for _, ef := range eff.Effects() {
 			if !ef.ParticlesHidden() {
 				visibleEffects = append(visibleEffects, ef)
 			}
 		}
+		// The colour is always set, even without any visible effects, so that viewers stop showing the particles
+		// of effects that were removed or expired.
+		m[protocol.EntityDataKeyEffectColor], m[protocol.EntityDataKeyEffectAmbience] = int32(0), byte(0)
 		if len(visibleEffects) > 0 {
 			colour, am := effect.ResultingColour(visibleEffects)
 			m[protocol.EntityDataKeyEffectColor] = nbtconv.Int32FromRGBA(colour)
 			if am {
 				m[protocol.EntityDataKeyEffectAmbience] = byte(1)
-			} else {
-				m[protocol.EntityDataKeyEffectAmbience] = byte(0)
 			}
 		}
 	}
+	if md, ok := e.(metadataHolder); ok {
+		applyMetadataOverrides(m, md.Metadata())
+	}
 	return m
 }
 
+// metadataFlags maps an entity.MetadataFlag to the flag in the entity metadata that it overrides.
+var metadataFlags = map[entity.MetadataFlag]uint8{
+	entity.OnFireFlag():    protocol.EntityDataFlagOnFire,
+	entity.SneakingFlag():  protocol.EntityDataFlagSneaking,
+	entity.SprintingFlag(): protocol.EntityDataFlagSprinting,
+	entity.InvisibleFlag(): protocol.EntityDataFlagInvisible,
+	entity.ImmobileFlag():  protocol.EntityDataFlagNoAI,
+}
+
+// applyMetadataOverrides applies the properties overridden in the entity.Metadata passed to the entity metadata m.
+func applyMetadataOverrides(m protocol.EntityMetadata, md *entity.Metadata) {
+	for f, v := range md.Flags() {
+		if flag := metadataFlags[f]; m.Flag(protocol.EntityDataKeyFlags, flag) != v {
+			// SetFlag toggles the flag, so it is only called if the flag does not already have the right value.
+			m.SetFlag(protocol.EntityDataKeyFlags, flag)
+		}
+	}
+	if scale, ok := md.Scale(); ok {
+		m[protocol.EntityDataKeyScale] = float32(scale)
+	}
+	if width, height, ok := md.Size(); ok {
+		m[protocol.EntityDataKeyWidth] = float32(width)
+		m[protocol.EntityDataKeyHeight] = float32(height)
+	}
+}
+
+type metadataHolder interface {
+	Metadata() *entity.Metadata
+}
+
+type ageable interface {
+	Baby() bool
+}
+
+type lover interface {
+	InLove() bool
+}
+
+type shearable interface {
+	Sheared() bool
+}
+
+type tameable interface {
+	Tamed() bool
+	Sitting() bool
+}
+
+type variant interface {
+	Variant() int
+}
+
+type coloured interface {
+	Colour() item.Colour
+}
+
 type sneaker interface {
 	Sneaking() bool
 }
@@ -190,6 +301,10 @@ type owned interface {
 	Owner() world.Entity
 }
 
+type leashed interface {
+	LeashHolder() world.Entity
+}
+
 type named interface {
 	NameTag() string
 }
@@ -224,6 +339,10 @@ type using interface {
 	UsingItem() bool
 }
 
+type blocker interface {
+	Blocking() bool
+}
+
 type arrow interface {
 	Critical() bool
 }
@@ -245,6 +364,10 @@ type tnt interface {
 	Fuse() time.Duration
 }
 
+type endCrystal interface {
+	ShowBase() bool
+}
+
 type living interface {
 	DeathPosition() (mgl64.Vec3, world.Dimension, bool)
 }
@@ -6,7 +6,6 @@ import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
 	"strings"
-	"unicode/utf8"
 )
 
 // BlockActorDataHandler handles an incoming BlockActorData packet from the client, sent for some block entities like
@@ -43,16 +42,6 @@ func (b BlockActorDataHandler) handleSign(pk *packet.BlockActorData, pos cube.Po
 		return fmt.Errorf("sign block actor data 'Text' tag was not a string: %#v", pkText)
 	}
 
-	// Verify that the text was valid. It must be valid UTF8 and not more than 100 characters long.
-	text = strings.TrimRight(text, "\n")
-	if len(text) > 256 {
-		return fmt.Errorf("sign block actor data text was longer than 256 characters")
-	}
-	if !utf8.ValidString(text) {
-		return fmt.Errorf("sign block actor data text was not valid UTF8")
-	}
-	if err := s.c.EditSign(pos, text); err != nil {
-		return err
-	}
-	return nil
+	// The text itself is validated by the Controllable when editing the sign.
+	return s.c.EditSign(pos, strings.TrimRight(text, "\n"))
 }
@@ -67,9 +67,8 @@ func (b BookEditHandler) Handle(p packet.Packet, s *Session) error {
 		}
 		book = book.SwapPages(page, int(pk.SecondaryPageNumber))
 	case packet.BookActionSign:
-		_ = s.inv.SetItem(slot, duplicateStack(it, item.WrittenBook{Title: pk.Title, Author: pk.Author, Pages: book.Pages, Generation: item.OriginalGeneration()}))
-		return nil
+		// The author sent by the client is ignored: The Controllable signs the book with its own name.
+		return s.c.SignBook(slot, pk.Title)
 	}
-	_ = s.inv.SetItem(slot, duplicateStack(it, book))
-	return nil
+	return s.c.EditBook(slot, book.Pages)
 }
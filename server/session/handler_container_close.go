@@ -19,7 +19,7 @@ func (h *ContainerCloseHandler) Handle(p packet.Packet, s *Session) error {
 		s.writePacket(&packet.ContainerClose{WindowID: 0})
 		s.invOpened = false
 	case byte(s.openedWindowID.Load()):
-		s.closeCurrentContainer()
+		s.c.CloseContainer()
 	case 0xff:
 		// TODO: Handle closing the crafting grid.
 	default:
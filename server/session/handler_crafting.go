@@ -3,10 +3,10 @@ package session
 import (
 	"fmt"
 	"github.com/df-mc/dragonfly/server/item"
-	"github.com/df-mc/dragonfly/server/item/creative"
 	"github.com/df-mc/dragonfly/server/item/inventory"
 	"github.com/df-mc/dragonfly/server/item/recipe"
 	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/tag"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
 	"golang.org/x/exp/slices"
 	"math"
@@ -14,10 +14,13 @@ import (
 
 // handleCraft handles the CraftRecipe request action.
 func (h *ItemStackRequestHandler) handleCraft(a *protocol.CraftRecipeStackRequestAction, s *Session) error {
-	craft, ok := s.recipes[a.RecipeNetworkID]
+	craft, ok := s.recipes.Load()[a.RecipeNetworkID]
 	if !ok {
 		return fmt.Errorf("recipe with network id %v does not exist", a.RecipeNetworkID)
 	}
+	if !s.recipeAvailable(craft) {
+		return fmt.Errorf("recipe with network id %v is not unlocked", a.RecipeNetworkID)
+	}
 	_, shaped := craft.(recipe.Shaped)
 	_, shapeless := craft.(recipe.Shapeless)
 	if !shaped && !shapeless {
@@ -26,6 +29,13 @@ func (h *ItemStackRequestHandler) handleCraft(a *protocol.CraftRecipeStackReques
 	if craft.Block() != "crafting_table" {
 		return fmt.Errorf("recipe with network id %v is not a crafting table recipe", a.RecipeNetworkID)
 	}
+	if !craftingGridMatches(s.craftingGrid(), craft) {
+		return fmt.Errorf("recipe %v: items in crafting grid do not form the recipe", a.RecipeNetworkID)
+	}
+	output, ok := s.c.CraftingResult(craft)
+	if !ok {
+		return fmt.Errorf("recipe %v: crafting was cancelled", a.RecipeNetworkID)
+	}
 
 	size := s.craftingSize()
 	offset := s.craftingOffset()
@@ -58,15 +68,18 @@ func (h *ItemStackRequestHandler) handleCraft(a *protocol.CraftRecipeStackReques
 			return fmt.Errorf("recipe %v: could not consume expected item: %v", a.RecipeNetworkID, expected)
 		}
 	}
-	return h.createResults(s, craft.Output()...)
+	return h.createResults(s, output...)
 }
 
 // handleAutoCraft handles the AutoCraftRecipe request action.
 func (h *ItemStackRequestHandler) handleAutoCraft(a *protocol.AutoCraftRecipeStackRequestAction, s *Session) error {
-	craft, ok := s.recipes[a.RecipeNetworkID]
+	craft, ok := s.recipes.Load()[a.RecipeNetworkID]
 	if !ok {
 		return fmt.Errorf("recipe with network id %v does not exist", a.RecipeNetworkID)
 	}
+	if !s.recipeAvailable(craft) {
+		return fmt.Errorf("recipe with network id %v is not unlocked", a.RecipeNetworkID)
+	}
 	_, shaped := craft.(recipe.Shaped)
 	_, shapeless := craft.(recipe.Shapeless)
 	if !shaped && !shapeless {
@@ -76,6 +89,11 @@ func (h *ItemStackRequestHandler) handleAutoCraft(a *protocol.AutoCraftRecipeSta
 		return fmt.Errorf("recipe with network id %v is not a crafting table recipe", a.RecipeNetworkID)
 	}
 
+	result, ok := s.c.CraftingResult(craft)
+	if !ok {
+		return fmt.Errorf("recipe %v: crafting was cancelled", a.RecipeNetworkID)
+	}
+
 	repetitions := int(a.TimesCrafted)
 	input := make([]item.Stack, 0, len(craft.Input()))
 	for _, i := range craft.Input() {
@@ -138,8 +156,8 @@ func (h *ItemStackRequestHandler) handleAutoCraft(a *protocol.AutoCraftRecipeSta
 		}
 	}
 
-	output := make([]item.Stack, 0, len(craft.Output()))
-	for _, o := range craft.Output() {
+	output := make([]item.Stack, 0, len(result))
+	for _, o := range result {
 		count, maxCount := o.Count(), o.MaxCount()
 		total := count * repetitions
 
@@ -159,11 +177,12 @@ func (h *ItemStackRequestHandler) handleCreativeCraft(a *protocol.CraftCreativeS
 	if !s.c.GameMode().CreativeInventory() {
 		return fmt.Errorf("can only craft creative items in gamemode creative/spectator")
 	}
+	items := s.creativeItems.Load()
 	index := a.CreativeItemNetworkID - 1
-	if int(index) >= len(creative.Items()) {
+	if int(index) >= len(items) {
 		return fmt.Errorf("creative item with network ID %v does not exist", index)
 	}
-	it := creative.Items()[index]
+	it := items[index]
 	it = it.Grow(it.MaxCount() - 1)
 	return h.createResults(s, it)
 }
@@ -184,6 +203,125 @@ func (s *Session) craftingOffset() uint32 {
 	return craftingGridSmallOffset
 }
 
+// sendCraftingPreview sends the result of the recipe formed by the items in the crafting grid of the session to the
+// client, so that results changed by the Controllable are shown before the recipe is crafted. An empty result is
+// sent if the items do not form any recipe available to the Controllable. If the items form multiple recipes, the
+// recipe with the lowest network ID is previewed.
+func (s *Session) sendCraftingPreview() {
+	var preview item.Stack
+	if grid := s.craftingGrid(); !empty(grid) {
+		for _, r := range s.craftingRecipes.Load() {
+			if !s.recipeAvailable(r) || !craftingGridMatches(grid, r) {
+				continue
+			}
+			if output, ok := s.c.CraftingPreview(r); ok && len(output) > 0 {
+				preview = output[0]
+			}
+			break
+		}
+	}
+	s.sendItem(preview, craftingResult, protocol.WindowIDUI)
+}
+
+// craftingGrid returns the items in the crafting grid of the session.
+func (s *Session) craftingGrid() []item.Stack {
+	size, offset := int(s.craftingSize()), int(s.craftingOffset())
+	grid := make([]item.Stack, size)
+	for i := range grid {
+		grid[i], _ = s.ui.Item(offset + i)
+	}
+	return grid
+}
+
+// empty checks if all stacks passed are empty.
+func empty(stacks []item.Stack) bool {
+	for _, st := range stacks {
+		if !st.Empty() {
+			return false
+		}
+	}
+	return true
+}
+
+// craftingGridMatches checks if the items in the crafting grid passed form the recipe passed. The items of shaped
+// recipes must be laid out in the shape of the recipe, which may be mirrored horizontally, and the grid may not hold
+// any items that are not part of the recipe.
+func craftingGridMatches(grid []item.Stack, craft recipe.Recipe) bool {
+	if shaped, ok := craft.(recipe.Shaped); ok {
+		return shapedGridMatches(grid, int(math.Sqrt(float64(len(grid)))), shaped)
+	}
+	return shapelessGridMatches(grid, craft.Input())
+}
+
+// shapedGridMatches checks if the items in a square crafting grid with the side passed are laid out in the shape of
+// the recipe.Shaped passed.
+func shapedGridMatches(grid []item.Stack, side int, craft recipe.Shaped) bool {
+	input, width := craft.Input(), craft.Shape().Width()
+	gridX, gridY, gridWidth, gridHeight := stackBounds(grid, side)
+	inputX, inputY, inputWidth, inputHeight := stackBounds(input, width)
+	if gridWidth != inputWidth || gridHeight != inputHeight {
+		return false
+	}
+	for _, mirrored := range []bool{false, true} {
+		matches := true
+		for i := 0; i < gridWidth*gridHeight && matches; i++ {
+			x, y := i%gridWidth, i/gridWidth
+			has := grid[(gridY+y)*side+gridX+x]
+			if mirrored {
+				x = gridWidth - 1 - x
+			}
+			expected := input[(inputY+y)*width+inputX+x]
+			matches = has.Empty() == expected.Empty() && (expected.Empty() || has.Count() >= expected.Count() && matchingStacks(has, expected))
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// stackBounds returns the position and size of the smallest area that holds all non-empty stacks of a grid with the
+// width passed. A size of 0 is returned if all stacks are empty.
+func stackBounds(stacks []item.Stack, width int) (x, y, w, h int) {
+	minX, minY, maxX, maxY := width, len(stacks), -1, -1
+	for i, st := range stacks {
+		if !st.Empty() {
+			minX, minY, maxX, maxY = min(minX, i%width), min(minY, i/width), max(maxX, i%width), max(maxY, i/width)
+		}
+	}
+	if maxX < 0 {
+		return 0, 0, 0, 0
+	}
+	return minX, minY, maxX - minX + 1, maxY - minY + 1
+}
+
+// shapelessGridMatches checks if the items in a crafting grid are exactly the input passed of a shapeless recipe, in
+// any order.
+func shapelessGridMatches(grid, input []item.Stack) bool {
+	used := make([]bool, len(grid))
+	for _, expected := range input {
+		if expected.Empty() {
+			continue
+		}
+		found := false
+		for i, has := range grid {
+			if !used[i] && !has.Empty() && has.Count() >= expected.Count() && matchingStacks(has, expected) {
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for i, has := range grid {
+		if !has.Empty() && !used[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // duplicateStack duplicates an item.Stack with the new item type given.
 func duplicateStack(input item.Stack, newType world.Item) item.Stack {
 	outputStack := item.NewStack(newType, input.Count()).
@@ -198,8 +336,17 @@ func duplicateStack(input item.Stack, newType world.Item) item.Stack {
 	return outputStack
 }
 
+// recipeAvailable checks if the recipe passed may be crafted by the Controllable of the session. If recipes must be
+// unlocked in the world of the Controllable, only recipes it has unlocked are available.
+func (s *Session) recipeAvailable(r recipe.Recipe) bool {
+	return !s.recipesUnlock.Load() || s.c.RecipeUnlocked(r)
+}
+
 // matchingStacks returns true if the two stacks are the same in a crafting scenario.
 func matchingStacks(has, expected item.Stack) bool {
+	if name, ok := expected.Value("tag"); ok {
+		return tag.HasItem(has.Item(), name.(string))
+	}
 	_, variants := expected.Value("variants")
 	if !variants {
 		return has.Comparable(expected)
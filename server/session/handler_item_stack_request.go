@@ -10,6 +10,7 @@ import (
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+	"golang.org/x/exp/slices"
 	"math"
 	"math/rand"
 	"time"
@@ -24,9 +25,15 @@ type ItemStackRequestHandler struct {
 	responseChanges map[int32]map[*inventory.Inventory]map[byte]responseChange
 
 	pendingResults []item.Stack
+	// crafted holds all results created by crafts in the current request, including those that were already
+	// moved to the created output slot. It is used to validate the results reported by the client.
+	crafted []item.Stack
 
 	current       time.Time
 	ignoreDestroy bool
+	// reverts holds functions that revert changes made outside of inventories by the actions of the current
+	// request, such as trades being used. They are called if the request is rejected.
+	reverts []func()
 }
 
 // responseChange represents a change in a specific item stack response. It holds the timestamp of the
@@ -67,12 +74,16 @@ func (h *ItemStackRequestHandler) handleRequest(req protocol.ItemStackRequest, s
 	defer func() {
 		if err != nil {
 			h.reject(req.RequestID, s)
+			s.resendSlots(requestSlots(req))
 			return
 		}
 		h.resolve(req.RequestID, s)
 		h.ignoreDestroy = false
 	}()
 
+	if s.c.Dead() {
+		return fmt.Errorf("client tried changing its inventory while dead")
+	}
 	for _, action := range req.Actions {
 		switch a := action.(type) {
 		case *protocol.TakeStackRequestAction:
@@ -88,6 +99,10 @@ func (h *ItemStackRequestHandler) handleRequest(req protocol.ItemStackRequest, s
 		case *protocol.BeaconPaymentStackRequestAction:
 			err = h.handleBeaconPayment(a, s)
 		case *protocol.CraftRecipeStackRequestAction:
+			if s.openedTrader.Load() != nil {
+				err = h.handleTrade(a, s)
+				break
+			}
 			if s.containerOpened.Load() {
 				var special bool
 				switch s.c.World().Block(s.openedPos.Load()).(type) {
@@ -118,8 +133,10 @@ func (h *ItemStackRequestHandler) handleRequest(req protocol.ItemStackRequest, s
 			err = h.handleMineBlock(a, s)
 		case *protocol.CreateStackRequestAction:
 			err = h.handleCreate(a, s)
-		case *protocol.ConsumeStackRequestAction, *protocol.CraftResultsDeprecatedStackRequestAction:
-			// Don't do anything with this.
+		case *protocol.ConsumeStackRequestAction:
+			err = h.handleConsume(a, s)
+		case *protocol.CraftResultsDeprecatedStackRequestAction:
+			err = h.handleCraftResults(a, s)
 		default:
 			return fmt.Errorf("unhandled stack request action %#v", action)
 		}
@@ -146,6 +163,9 @@ func (h *ItemStackRequestHandler) handleTransfer(from, to protocol.StackRequestS
 	if err := h.verifySlots(s, from, to); err != nil {
 		return fmt.Errorf("source slot out of sync: %w", err)
 	}
+	if h.sameSlot(from, to, s) {
+		return fmt.Errorf("client tried transferring items to the slot they were taken from")
+	}
 	i, _ := h.itemInSlot(from, s)
 	dest, _ := h.itemInSlot(to, s)
 	if !i.Comparable(dest) {
@@ -160,6 +180,9 @@ func (h *ItemStackRequestHandler) handleTransfer(from, to protocol.StackRequestS
 	if dest.Empty() {
 		dest = i.Grow(-math.MaxInt32)
 	}
+	if !h.accepts(to, dest.Grow(int(count)), s) {
+		return fmt.Errorf("client tried placing %v in a slot that does not accept it", i)
+	}
 
 	invA, _ := s.invByID(int32(from.ContainerID))
 	invB, _ := s.invByID(int32(to.ContainerID))
@@ -184,10 +207,24 @@ func (h *ItemStackRequestHandler) handleSwap(a *protocol.SwapStackRequestAction,
 	}
 	i, _ := h.itemInSlot(a.Source, s)
 	dest, _ := h.itemInSlot(a.Destination, s)
+	if !h.accepts(a.Source, dest, s) || !h.accepts(a.Destination, i, s) {
+		return fmt.Errorf("client tried swapping %v and %v, but one of the slots does not accept the item", i, dest)
+	}
 
 	invA, _ := s.invByID(int32(a.Source.ContainerID))
 	invB, _ := s.invByID(int32(a.Destination.ContainerID))
 
+	if h.heldSlot(a.Source, invA, s) && invB == s.offHand || invA == s.offHand && h.heldSlot(a.Destination, invB, s) {
+		// The client swapped the items held in its hands. The Controllable swaps these items itself, so that the
+		// swap may be cancelled.
+		if !s.c.SwapHeldItems() {
+			return fmt.Errorf("swapping held items was cancelled")
+		}
+		h.recordChange(a.Source, invA, i, dest)
+		h.recordChange(a.Destination, invB, dest, i)
+		return nil
+	}
+
 	ctx := event.C()
 	_ = call(ctx, int(a.Source.Slot), i, invA.Handler().HandleTake)
 	_ = call(ctx, int(a.Source.Slot), dest, invA.Handler().HandlePlace)
@@ -282,7 +319,7 @@ func (h *ItemStackRequestHandler) handleMineBlock(a *protocol.MineBlockStackRequ
 // output as usual.
 func (h *ItemStackRequestHandler) handleCreate(a *protocol.CreateStackRequestAction, s *Session) error {
 	slot := int(a.ResultsSlot)
-	if len(h.pendingResults) < slot {
+	if slot >= len(h.pendingResults) {
 		return fmt.Errorf("invalid pending result slot: %v", a.ResultsSlot)
 	}
 
@@ -299,12 +336,50 @@ func (h *ItemStackRequestHandler) handleCreate(a *protocol.CreateStackRequestAct
 	return nil
 }
 
+// handleConsume handles a Consume stack request action, which the client sends for every slot that it consumed
+// items from for a craft, trade or beacon payment. Items are consumed by the server itself when handling these
+// actions, so the client may only consume from slots that the server also changed in the current request.
+func (h *ItemStackRequestHandler) handleConsume(a *protocol.ConsumeStackRequestAction, s *Session) error {
+	inv, ok := s.invByID(int32(a.Source.ContainerID))
+	if !ok {
+		return fmt.Errorf("could not find container with id %v", a.Source.ContainerID)
+	}
+	if _, ok := h.responseChanges[h.currentRequest][inv][a.Source.Slot]; !ok {
+		return fmt.Errorf("client consumed %v items from slot %v in container %v, but no items were consumed from it", a.Count, a.Source.Slot, a.Source.ContainerID)
+	}
+	return nil
+}
+
+// handleCraftResults handles a CraftResultsDeprecated stack request action, which holds the results that the
+// client expects from the craft done earlier in the request. The results are created by the server itself, so the
+// action is only used to check that the client did not expect any results that the server did not create.
+func (h *ItemStackRequestHandler) handleCraftResults(a *protocol.CraftResultsDeprecatedStackRequestAction, s *Session) error {
+	if len(h.crafted) == 0 {
+		return fmt.Errorf("client sent craft results, but nothing was crafted")
+	}
+	for _, res := range a.ResultItems {
+		expected := stackToItem(res)
+		if expected.Empty() {
+			continue
+		}
+		name, meta := expected.Item().EncodeItem()
+		if slices.IndexFunc(h.crafted, func(crafted item.Stack) bool {
+			n, m := crafted.Item().EncodeItem()
+			return n == name && m == meta
+		}) == -1 {
+			return fmt.Errorf("client expected %v as craft result, but it was not crafted", expected)
+		}
+	}
+	return nil
+}
+
 // defaultCreation represents the CreateStackRequestAction used for single-result crafts.
 var defaultCreation = &protocol.CreateStackRequestAction{}
 
 // createResults creates a new craft result and adds it to the list of pending craft results.
 func (h *ItemStackRequestHandler) createResults(s *Session, result ...item.Stack) error {
 	h.pendingResults = append(h.pendingResults, result...)
+	h.crafted = append(h.crafted, result...)
 	if len(result) > 1 {
 		// With multiple results, the client notifies the server on when to create the results.
 		return nil
@@ -336,6 +411,13 @@ func (h *ItemStackRequestHandler) verifySlot(slot protocol.StackRequestSlotInfo,
 	if err != nil {
 		return err
 	}
+	sl := int(slot.Slot)
+	if inv == s.offHand {
+		sl = 0
+	}
+	if inv.SlotLocked(sl) {
+		return fmt.Errorf("slot %v in container %v is locked", slot.Slot, slot.ContainerID)
+	}
 	clientID, err := h.resolveID(inv, slot)
 	if err != nil {
 		return err
@@ -416,6 +498,39 @@ func (h *ItemStackRequestHandler) itemInSlot(slot protocol.StackRequestSlotInfo,
 	return i, nil
 }
 
+// accepts checks if the item stack passed may be put in the slot of a container present in the slot info.
+func (h *ItemStackRequestHandler) accepts(slot protocol.StackRequestSlotInfo, i item.Stack, s *Session) bool {
+	inv, _ := s.invByID(int32(slot.ContainerID))
+
+	sl := int(slot.Slot)
+	if inv == s.offHand {
+		if o, ok := i.Item().(item.OffHand); !i.Empty() && (!ok || !o.OffHand()) {
+			// Clients are only able to put specific items in their off-hand.
+			return false
+		}
+		sl = 0
+	}
+	if _, ok := i.Item().(block.ShulkerBox); ok && inv == s.openedWindow.Load() {
+		if _, ok := s.c.World().Block(s.openedPos.Load()).(block.ShulkerBox); ok && s.containerOpened.Load() {
+			// Shulker boxes cannot be stored inside other shulker boxes.
+			return false
+		}
+	}
+	return inv.Accepts(sl, i)
+}
+
+// heldSlot checks if the slot passed points to the slot of the inventory passed that holds the item in the main hand.
+func (h *ItemStackRequestHandler) heldSlot(slot protocol.StackRequestSlotInfo, inv *inventory.Inventory, s *Session) bool {
+	return inv == s.inv && uint32(slot.Slot) == s.heldSlot.Load()
+}
+
+// sameSlot checks if the two slot infos passed point to the same slot of the same container.
+func (h *ItemStackRequestHandler) sameSlot(a, b protocol.StackRequestSlotInfo, s *Session) bool {
+	invA, _ := s.invByID(int32(a.ContainerID))
+	invB, _ := s.invByID(int32(b.ContainerID))
+	return invA == invB && (a.Slot == b.Slot || invA == s.offHand)
+}
+
 // setItemInSlot sets an item stack in the slot of a container present in the slot info.
 func (h *ItemStackRequestHandler) setItemInSlot(slot protocol.StackRequestSlotInfo, i item.Stack, s *Session) {
 	inv, _ := s.invByID(int32(slot.ContainerID))
@@ -427,7 +542,12 @@ func (h *ItemStackRequestHandler) setItemInSlot(slot protocol.StackRequestSlotIn
 
 	before, _ := inv.Item(sl)
 	_ = inv.SetItem(sl, i)
+	h.recordChange(slot, inv, before, i)
+}
 
+// recordChange records the change of an item stack in the slot of a container present in the slot info, so that it
+// is included in the response to the request and may be reverted if the request fails.
+func (h *ItemStackRequestHandler) recordChange(slot protocol.StackRequestSlotInfo, inv *inventory.Inventory, before, i item.Stack) {
 	respSlot := protocol.StackResponseSlotInfo{
 		Slot:                 slot.Slot,
 		HotbarSlot:           slot.Slot,
@@ -476,7 +596,8 @@ func (h *ItemStackRequestHandler) resolve(id int32, s *Session) {
 	}}})
 
 	h.changes = map[byte]map[byte]changeInfo{}
-	h.pendingResults = nil
+	h.pendingResults, h.crafted = nil, nil
+	h.reverts = nil
 }
 
 // reject rejects the item stack request sent by the client so that it is reverted client-side.
@@ -492,12 +613,42 @@ func (h *ItemStackRequestHandler) reject(id int32, s *Session) {
 	for container, slots := range h.changes {
 		for slot, info := range slots {
 			inv, _ := s.invByID(int32(container))
-			_ = inv.SetItem(int(slot), info.before)
+			sl := int(slot)
+			if inv == s.offHand {
+				sl = 0
+			}
+			_ = inv.SetItem(sl, info.before)
 		}
 	}
+	for i := len(h.reverts) - 1; i >= 0; i-- {
+		h.reverts[i]()
+	}
 
 	h.changes = map[byte]map[byte]changeInfo{}
-	h.pendingResults = nil
+	h.pendingResults, h.crafted = nil, nil
+	h.reverts = nil
+}
+
+// requestSlots returns the slots affected by the actions of the item stack request passed.
+func requestSlots(req protocol.ItemStackRequest) []protocol.StackRequestSlotInfo {
+	var slots []protocol.StackRequestSlotInfo
+	for _, action := range req.Actions {
+		switch a := action.(type) {
+		case *protocol.TakeStackRequestAction:
+			slots = append(slots, a.Source, a.Destination)
+		case *protocol.PlaceStackRequestAction:
+			slots = append(slots, a.Source, a.Destination)
+		case *protocol.SwapStackRequestAction:
+			slots = append(slots, a.Source, a.Destination)
+		case *protocol.DestroyStackRequestAction:
+			slots = append(slots, a.Source)
+		case *protocol.ConsumeStackRequestAction:
+			slots = append(slots, a.Source)
+		case *protocol.DropStackRequestAction:
+			slots = append(slots, a.Source)
+		}
+	}
+	return slots
 }
 
 // call uses an event.Context, slot and item.Stack to call the event handler function passed. An error is returned if
@@ -61,6 +61,11 @@ func (h PlayerAuthInputHandler) handleMovement(pk *packet.PlayerAuthInput, s *Se
 	}
 
 	s.c.Move(deltaPos, deltaYaw, deltaPitch)
+	if expected := s.teleportPos.Load(); expected != nil && expected.ApproxEqual(pos) {
+		// The movement was rejected and the player was teleported back to its old position. Teleports to other
+		// positions, such as those by plugins handling the movement, are not corrections.
+		s.corrections.positions.Inc()
+	}
 	return nil
 }
 
@@ -130,11 +135,15 @@ func (h PlayerAuthInputHandler) handleUseItemData(data protocol.UseItemTransacti
 	defer s.swingingArm.Store(false)
 
 	held, _ := s.c.HeldItems()
+	pos := cube.Pos{int(data.BlockPosition[0]), int(data.BlockPosition[1]), int(data.BlockPosition[2])}
 	if !held.Equal(stackToItem(data.HeldItem.Stack)) {
 		s.log.Debugf("failed processing item interaction from %v (%v): PlayerAuthInput: actual held and client held item mismatch", s.conn.RemoteAddr(), s.c.Name())
+		if data.ActionType == protocol.UseItemActionBreakBlock {
+			// The client already broke the block client-side, so it must be resent.
+			s.ResendBlock(pos, s.c.World())
+		}
 		return nil
 	}
-	pos := cube.Pos{int(data.BlockPosition[0]), int(data.BlockPosition[1]), int(data.BlockPosition[2])}
 
 	// Seems like this is only used for breaking blocks at the moment.
 	switch data.ActionType {
@@ -11,13 +11,7 @@ type RequestChunkRadiusHandler struct{}
 func (*RequestChunkRadiusHandler) Handle(p packet.Packet, s *Session) error {
 	pk := p.(*packet.RequestChunkRadius)
 
-	if pk.ChunkRadius > s.maxChunkRadius {
-		pk.ChunkRadius = s.maxChunkRadius
-	}
-	s.chunkRadius = pk.ChunkRadius
-
-	s.chunkLoader.ChangeRadius(int(pk.ChunkRadius))
-
-	s.writePacket(&packet.ChunkRadiusUpdated{ChunkRadius: s.chunkRadius})
+	s.requestedChunkRadius.Store(pk.ChunkRadius)
+	s.updateChunkRadius()
 	return nil
 }
@@ -16,10 +16,13 @@ const (
 // handleSmithing handles a CraftRecipe stack request action made using a smithing table.
 func (h *ItemStackRequestHandler) handleSmithing(a *protocol.CraftRecipeStackRequestAction, s *Session) error {
 	// First, check the recipe and ensure it is valid for the smithing table.
-	craft, ok := s.recipes[a.RecipeNetworkID]
+	craft, ok := s.recipes.Load()[a.RecipeNetworkID]
 	if !ok {
 		return fmt.Errorf("recipe with network id %v does not exist", a.RecipeNetworkID)
 	}
+	if !s.recipeAvailable(craft) {
+		return fmt.Errorf("recipe with network id %v is not unlocked", a.RecipeNetworkID)
+	}
 	if _, shapeless := craft.(recipe.Smithing); !shapeless {
 		return fmt.Errorf("recipe with network id %v is not a smithing recipe", a.RecipeNetworkID)
 	}
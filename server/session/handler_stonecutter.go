@@ -11,10 +11,13 @@ const stonecutterInputSlot = 0x03
 
 // handleStonecutting handles a CraftRecipe stack request action made using a stonecutter.
 func (h *ItemStackRequestHandler) handleStonecutting(a *protocol.CraftRecipeStackRequestAction, s *Session) error {
-	craft, ok := s.recipes[a.RecipeNetworkID]
+	craft, ok := s.recipes.Load()[a.RecipeNetworkID]
 	if !ok {
 		return fmt.Errorf("recipe with network id %v does not exist", a.RecipeNetworkID)
 	}
+	if !s.recipeAvailable(craft) {
+		return fmt.Errorf("recipe with network id %v is not unlocked", a.RecipeNetworkID)
+	}
 	if _, shapeless := craft.(recipe.Shapeless); !shapeless {
 		return fmt.Errorf("recipe with network id %v is not a shapeless recipe", a.RecipeNetworkID)
 	}
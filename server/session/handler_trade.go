@@ -0,0 +1,144 @@
+package session
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/df-mc/dragonfly/server/entity"
+	"github.com/df-mc/dragonfly/server/internal/nbtconv"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/sandertv/gophertunnel/minecraft/nbt"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+	"math"
+)
+
+const (
+	// tradeInputSlot is the slot index of the first input item in the trading window.
+	tradeInputSlot = 0x04
+	// tradeSecondInputSlot is the slot index of the second input item in the trading window.
+	tradeSecondInputSlot = 0x05
+	// tradeNetworkIDOffset is added to the index of a trade to form the network ID of the trade, so that it does not
+	// overlap with the network IDs of crafting recipes.
+	tradeNetworkIDOffset = 1 << 20
+)
+
+// OpenTrading opens the trading window of the entity.Mob passed, which must have an entity.Merchant behaviour. Any
+// container that was open before is closed first. OpenTrading does nothing if another entity is already trading with
+// the Mob.
+func (s *Session) OpenTrading(m *entity.Mob) {
+	merchant, ok := m.Behaviour().(entity.Merchant)
+	if s == Nop || !ok {
+		return
+	}
+	s.c.CloseContainer()
+	if !merchant.StartTrading(m, s.c) {
+		return
+	}
+	s.nextWindowID()
+	s.containerOpened.Store(true)
+	s.openedWindow.Store(inventory.New(1, nil))
+	s.openedTrader.Store(m)
+	s.openedContainerID.Store(protocol.ContainerTypeTrade)
+	s.sendTrades(m, merchant)
+}
+
+// sendTrades sends the trades of the entity.Merchant passed to the client, opening or updating its trading window.
+func (s *Session) sendTrades(m *entity.Mob, merchant entity.Merchant) {
+	trades := merchant.Trades()
+	recipes := make([]map[string]any, 0, len(trades))
+	for i, t := range trades {
+		maxUses := int32(t.MaxUses)
+		if t.MaxUses == 0 {
+			maxUses = math.MaxInt32
+		}
+		r := map[string]any{
+			"buyA":             nbtconv.WriteItem(t.Input, true),
+			"buyCountA":        int32(t.Input.Count()),
+			"sell":             nbtconv.WriteItem(t.Output, true),
+			"uses":             int32(t.Uses),
+			"maxUses":          maxUses,
+			"tier":             int32(t.Tier),
+			"traderExp":        int32(t.Experience),
+			"rewardExp":        byte(1),
+			"demand":           int32(0),
+			"priceMultiplierA": float32(0),
+			"priceMultiplierB": float32(0),
+			"netId":            int32(i + tradeNetworkIDOffset),
+		}
+		if !t.SecondInput.Empty() {
+			r["buyB"], r["buyCountB"] = nbtconv.WriteItem(t.SecondInput, true), int32(t.SecondInput.Count())
+		}
+		recipes = append(recipes, r)
+	}
+	requirements := make([]map[string]any, 0, entity.MaxMerchantTier+1)
+	for tier := 0; tier <= entity.MaxMerchantTier; tier++ {
+		requirements = append(requirements, map[string]any{fmt.Sprint(tier): int32(entity.MerchantTierExperience(tier))})
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := nbt.NewEncoderWithEncoding(buf, nbt.NetworkLittleEndian).Encode(map[string]any{
+		"Recipes":             recipes,
+		"TierExpRequirements": requirements,
+	}); err != nil {
+		s.log.Errorf("error encoding trades: %v", err)
+		return
+	}
+	s.writePacket(&packet.UpdateTrade{
+		WindowID:         byte(s.openedWindowID.Load()),
+		WindowType:       protocol.ContainerTypeTrade,
+		Size:             int32(len(recipes)),
+		TradeTier:        int32(merchant.Tier()),
+		VillagerUniqueID: int64(s.entityRuntimeID(m)),
+		EntityUniqueID:   selfEntityRuntimeID,
+		DisplayName:      merchant.MerchantName(),
+		NewTradeUI:       true,
+		SerialisedOffers: buf.Bytes(),
+	})
+}
+
+// handleTrade handles a CraftRecipe stack request action made in a trading window.
+func (h *ItemStackRequestHandler) handleTrade(a *protocol.CraftRecipeStackRequestAction, s *Session) error {
+	trader := s.openedTrader.Load()
+	merchant, ok := trader.Behaviour().(entity.Merchant)
+	if !ok {
+		return fmt.Errorf("trader %v is not a merchant", trader.Type().EncodeEntity())
+	}
+	index := int(a.RecipeNetworkID) - tradeNetworkIDOffset
+	trades := merchant.Trades()
+	if index < 0 || index >= len(trades) {
+		return fmt.Errorf("trade with network id %v does not exist", a.RecipeNetworkID)
+	}
+	inputSlot := protocol.StackRequestSlotInfo{ContainerID: protocol.ContainerTradeTwoIngredientOne, Slot: tradeInputSlot}
+	secondInputSlot := protocol.StackRequestSlotInfo{ContainerID: protocol.ContainerTradeTwoIngredientTwo, Slot: tradeSecondInputSlot}
+	input, _ := h.itemInSlot(inputSlot, s)
+	secondInput, _ := h.itemInSlot(secondInputSlot, s)
+
+	t := trades[index]
+	if !tradeInputMatches(input, t.Input) || !tradeInputMatches(secondInput, t.SecondInput) {
+		return fmt.Errorf("input items are not the same as expected by trade %v", index)
+	}
+	if _, ok := merchant.UseTrade(trader, s.c, index); !ok {
+		return fmt.Errorf("trade %v cannot be used", index)
+	}
+	// The price of the trade has been paid at this point, so it is returned if the request ends up being rejected.
+	h.reverts = append(h.reverts, func() {
+		merchant.RevertTrade(trader, s.c, index)
+		s.sendTrades(trader, merchant)
+	})
+	h.setItemInSlot(inputSlot, input.Grow(-t.Input.Count()), s)
+	if !t.SecondInput.Empty() {
+		h.setItemInSlot(secondInputSlot, secondInput.Grow(-t.SecondInput.Count()), s)
+	}
+	// Send the trades again so that the uses of the trade and the tier of the merchant are updated.
+	defer s.sendTrades(trader, merchant)
+	return h.createResults(s, t.Output)
+}
+
+// tradeInputMatches checks if the item stack passed holds at least the input expected by a trade.
+func tradeInputMatches(has, expected item.Stack) bool {
+	if expected.Empty() {
+		return true
+	}
+	return has.Comparable(expected) && has.Count() >= expected.Count()
+}
@@ -0,0 +1,119 @@
+package session
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/player/menu"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+)
+
+// SendMenu sends a menu.Menu to the client, closing any container that was open before. A fake block is shown
+// below the player for as long as the menu is opened, so that the client is able to open a container at its
+// position.
+func (s *Session) SendMenu(m menu.Menu) {
+	if s == Nop {
+		return
+	}
+	s.c.CloseContainer()
+
+	name, properties, id, containerType := menuBlock(m.Type())
+	b, ok := world.BlockByName(name, properties)
+	if !ok {
+		s.log.Errorf("send menu: no block state found for menu block %v", name)
+		return
+	}
+	pos := s.menuPos()
+	blockPos := protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])}
+	s.writePacket(&packet.UpdateBlock{
+		Position:          blockPos,
+		NewBlockRuntimeID: world.BlockRuntimeID(b),
+		Flags:             packet.BlockUpdateNetwork,
+	})
+	s.writePacket(&packet.BlockActorData{
+		Position: blockPos,
+		NBTData: map[string]any{
+			"id":         id,
+			"CustomName": m.Name(),
+			"x":          int32(pos[0]), "y": int32(pos[1]), "z": int32(pos[2]),
+		},
+	})
+
+	inv := inventory.New(m.Type().Size(), nil)
+	for slot, it := range m.Items() {
+		_ = inv.SetItem(slot, it)
+	}
+	inv.Handle(menuHandler{s: s, m: m})
+
+	nextID := s.nextWindowID()
+	s.containerOpened.Store(true)
+	s.openedWindow.Store(inv)
+	s.openedPos.Store(pos)
+	s.openedMenu.Store(&m)
+	s.openedContainerID.Store(uint32(containerType))
+	s.writePacket(&packet.ContainerOpen{
+		WindowID:                nextID,
+		ContainerType:           containerType,
+		ContainerPosition:       blockPos,
+		ContainerEntityUniqueID: -1,
+	})
+	s.sendInv(inv, uint32(nextID))
+}
+
+// closeMenu closes the menu.Menu passed, which the client currently has opened. The fake block shown for the menu
+// is replaced with the actual block at its position again.
+func (s *Session) closeMenu(m menu.Menu) {
+	s.openedMenu.Store(nil)
+
+	pos := s.openedPos.Load()
+	s.ViewBlockUpdate(pos, s.c.World().Block(pos), 0)
+	m.Close(s.c)
+}
+
+// menuPos returns the position at which the fake block of a menu.Menu is shown. It is two blocks below the feet
+// of the player, so that the block is generally hidden from view.
+func (s *Session) menuPos() cube.Pos {
+	pos := cube.PosFromVec3(s.c.Position()).Sub(cube.Pos{0, 2})
+	if r := s.c.World().Range(); pos[1] < r[0] {
+		pos[1] = r[0]
+	}
+	return pos
+}
+
+// menuBlock returns the name and properties of the block shown for a menu.Menu of the menu.Type passed, together
+// with the ID of its block entity and the type of container opened.
+func menuBlock(t menu.Type) (name string, properties map[string]any, id string, containerType byte) {
+	switch t {
+	case menu.Hopper():
+		return "minecraft:hopper", map[string]any{"facing_direction": int32(0), "toggle_bit": uint8(0)}, "Hopper", protocol.ContainerTypeHopper
+	case menu.Dispenser():
+		return "minecraft:dispenser", map[string]any{"facing_direction": int32(0), "triggered_bit": uint8(0)}, "Dispenser", protocol.ContainerTypeDispenser
+	}
+	return "minecraft:chest", map[string]any{"facing_direction": int32(2)}, "Chest", protocol.ContainerTypeContainer
+}
+
+// menuHandler is the inventory.Handler of the inventory of an opened menu.Menu. It prevents items from being
+// moved in or out of the menu and submits the menu when the client clicks one of its slots.
+type menuHandler struct {
+	s *Session
+	m menu.Menu
+}
+
+// HandleTake ...
+func (h menuHandler) HandleTake(ctx *event.Context, slot int, it item.Stack) {
+	ctx.Cancel()
+	h.m.Submit(h.s.c, slot, it)
+}
+
+// HandlePlace ...
+func (h menuHandler) HandlePlace(ctx *event.Context, _ int, _ item.Stack) {
+	ctx.Cancel()
+}
+
+// HandleDrop ...
+func (h menuHandler) HandleDrop(ctx *event.Context, _ int, _ item.Stack) {
+	ctx.Cancel()
+}
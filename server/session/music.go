@@ -0,0 +1,142 @@
+package session
+
+import (
+	"github.com/df-mc/dragonfly/server/entity"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+	"sync"
+	"time"
+)
+
+// musicPlayer keeps track of the music track played to a session and the tracks queued after it.
+type musicPlayer struct {
+	mu      sync.Mutex
+	current *sound.Music
+	end     time.Time
+	queue   []sound.Music
+}
+
+// StopSound stops the sound with the name passed from playing to the client, such as "record.cat".
+func (s *Session) StopSound(name string) {
+	s.writePacket(&packet.StopSound{SoundName: name})
+}
+
+// StopAllSounds stops all sounds currently playing to the client, including music.
+func (s *Session) StopAllSounds() {
+	s.music.mu.Lock()
+	s.music.current, s.music.queue = nil, nil
+	s.music.mu.Unlock()
+
+	s.writePacket(&packet.StopSound{StopAll: true})
+}
+
+// PlayMusic stops the music track currently playing, clears the queue and starts playing the sound.Music passed.
+func (s *Session) PlayMusic(m sound.Music) {
+	s.music.mu.Lock()
+	defer s.music.mu.Unlock()
+
+	s.music.queue = nil
+	s.startMusic(m)
+}
+
+// QueueMusic adds the sound.Music passed to the queue of the client. If no music is currently playing, it starts
+// playing immediately.
+func (s *Session) QueueMusic(m sound.Music) {
+	s.music.mu.Lock()
+	defer s.music.mu.Unlock()
+
+	if s.music.current == nil {
+		s.startMusic(m)
+		return
+	}
+	s.music.queue = append(s.music.queue, m)
+}
+
+// SkipMusic stops the music track currently playing and starts playing the next track in the queue, if any.
+func (s *Session) SkipMusic() {
+	s.music.mu.Lock()
+	defer s.music.mu.Unlock()
+
+	s.nextMusic()
+}
+
+// StopMusic stops the music track currently playing and clears the queue.
+func (s *Session) StopMusic() {
+	s.music.mu.Lock()
+	defer s.music.mu.Unlock()
+
+	s.music.queue = nil
+	s.stopMusic()
+}
+
+// Music returns the music track currently playing to the client and the tracks queued after it. If no track is
+// playing, false is returned.
+func (s *Session) Music() (current sound.Music, queue []sound.Music, ok bool) {
+	s.music.mu.Lock()
+	defer s.music.mu.Unlock()
+
+	if s.music.current == nil {
+		return sound.Music{}, nil, false
+	}
+	return *s.music.current, append([]sound.Music(nil), s.music.queue...), true
+}
+
+// tickMusic starts the next track in the queue if the track currently playing has ended, or restarts the track if
+// it is looping.
+func (s *Session) tickMusic() {
+	s.music.mu.Lock()
+	defer s.music.mu.Unlock()
+
+	current := s.music.current
+	if current == nil || current.Duration <= 0 || time.Now().Before(s.music.end) {
+		return
+	}
+	if current.Loop {
+		s.startMusic(*current)
+		return
+	}
+	s.nextMusic()
+}
+
+// nextMusic stops the track currently playing and starts the first track of the queue. s.music.mu must be held
+// when calling nextMusic.
+func (s *Session) nextMusic() {
+	if len(s.music.queue) == 0 {
+		s.stopMusic()
+		return
+	}
+	next := s.music.queue[0]
+	s.music.queue = s.music.queue[1:]
+	s.startMusic(next)
+}
+
+// startMusic stops the track currently playing and starts playing the sound.Music passed. s.music.mu must be held
+// when calling startMusic.
+func (s *Session) startMusic(m sound.Music) {
+	if s == Nop {
+		return
+	}
+	s.stopMusic()
+	volume, pitch := m.Volume, m.Pitch
+	if volume == 0 {
+		volume = 1
+	}
+	if pitch == 0 {
+		pitch = 1
+	}
+	s.music.current, s.music.end = &m, time.Now().Add(m.Duration)
+	s.writePacket(&packet.PlaySound{
+		SoundName: m.Name,
+		Position:  vec64To32(entity.EyePosition(s.c)),
+		Volume:    float32(volume),
+		Pitch:     float32(pitch),
+	})
+}
+
+// stopMusic stops the track currently playing, if any. s.music.mu must be held when calling stopMusic.
+func (s *Session) stopMusic() {
+	if s.music.current != nil {
+		s.writePacket(&packet.StopSound{SoundName: s.music.current.Name})
+		s.music.current = nil
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"github.com/df-mc/atomic"
 	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/entity"
+	"github.com/df-mc/dragonfly/server/entity/attribute"
 	"github.com/df-mc/dragonfly/server/entity/effect"
 	"github.com/df-mc/dragonfly/server/internal/nbtconv"
 	"github.com/df-mc/dragonfly/server/item"
@@ -45,6 +47,23 @@ func (s *Session) StartShowingEntity(e world.Entity) {
 	s.ViewEntityArmour(e)
 }
 
+// OpenedContainer returns the position of the block container that the player currently has open. False is
+// returned if no container is open.
+func (s *Session) OpenedContainer() (cube.Pos, bool) {
+	if s == Nop || !s.containerOpened.Load() || s.openedTrader.Load() != nil || s.openedMenu.Load() != nil {
+		return cube.Pos{}, false
+	}
+	return s.openedPos.Load(), true
+}
+
+// CloseContainer closes the block container that the player currently has open, if any.
+func (s *Session) CloseContainer() {
+	if s == Nop {
+		return
+	}
+	s.closeCurrentContainer()
+}
+
 // closeCurrentContainer closes the container the player might currently have open.
 func (s *Session) closeCurrentContainer() {
 	if !s.containerOpened.Load() {
@@ -52,6 +71,17 @@ func (s *Session) closeCurrentContainer() {
 	}
 	s.closeWindow()
 
+	if trader := s.openedTrader.Load(); trader != nil {
+		s.openedTrader.Store(nil)
+		if merchant, ok := trader.Behaviour().(entity.Merchant); ok {
+			merchant.StopTrading(trader)
+		}
+		return
+	}
+	if m := s.openedMenu.Load(); m != nil {
+		s.closeMenu(*m)
+		return
+	}
 	pos := s.openedPos.Load()
 	w := s.c.World()
 	b := w.Block(pos)
@@ -91,12 +121,31 @@ func (s *Session) SendRespawn(pos mgl64.Vec3) {
 	})
 }
 
-// sendRecipes sends the current crafting recipes to the session.
-func (s *Session) sendRecipes() {
-	recipes := make([]protocol.Recipe, 0, len(recipe.Recipes()))
-	for index, i := range recipe.Recipes() {
+// SendRecipes sends the crafting recipes available to the Controllable of the session. If recipes must be unlocked
+// in the world the Controllable is in, only the recipes unlocked by the Controllable are sent. Recipes registered
+// using recipe.Register after the Controllable spawned only become available once SendRecipes is called.
+func (s *Session) SendRecipes() {
+	if s == Nop {
+		return
+	}
+	all := recipe.Recipes()
+	networkIDs := make(map[uint32]recipe.Recipe, len(all))
+	recipes := make([]protocol.Recipe, 0, len(all))
+	// crafting holds the recipes crafted in a crafting table in the order of their network IDs, so that the
+	// crafting preview is stable if multiple recipes match the crafting grid.
+	var crafting []recipe.Recipe
+	for index, i := range all {
 		networkID := uint32(index) + 1
-		s.recipes[networkID] = i
+		networkIDs[networkID] = i
+		switch i.(type) {
+		case recipe.Shaped, recipe.Shapeless:
+			if i.Block() == "crafting_table" {
+				crafting = append(crafting, i)
+			}
+		}
+		if !s.recipeAvailable(i) {
+			continue
+		}
 
 		switch i := i.(type) {
 		case recipe.Shapeless:
@@ -131,7 +180,53 @@ func (s *Session) sendRecipes() {
 			})
 		}
 	}
-	s.writePacket(&packet.CraftingData{Recipes: recipes, ClearRecipes: true})
+	potionRecipes, containerRecipes := potionRecipes()
+	s.recipes.Store(networkIDs)
+	s.craftingRecipes.Store(crafting)
+	s.writePacket(&packet.CraftingData{
+		Recipes:                      recipes,
+		PotionRecipes:                potionRecipes,
+		PotionContainerChangeRecipes: containerRecipes,
+		ClearRecipes:                 true,
+	})
+}
+
+// potionRecipes converts the item.PotionMixes and item.PotionContainerChanges registered to their network
+// representation, so that the client is able to tell which items may be brewed in a brewing stand.
+func potionRecipes() ([]protocol.PotionRecipe, []protocol.PotionContainerChangeRecipe) {
+	var mixes []protocol.PotionRecipe
+	for _, mix := range item.PotionMixes() {
+		reagent, reagentMeta, ok := world.ItemRuntimeID(mix.Reagent)
+		if !ok {
+			continue
+		}
+		for _, container := range []world.Item{item.Potion{}, item.SplashPotion{}, item.LingeringPotion{}} {
+			rid, _, _ := world.ItemRuntimeID(container)
+			mixes = append(mixes, protocol.PotionRecipe{
+				InputPotionID:        rid,
+				InputPotionMetadata:  int32(mix.Input.Uint8()),
+				ReagentItemID:        reagent,
+				ReagentItemMetadata:  int32(reagentMeta),
+				OutputPotionID:       rid,
+				OutputPotionMetadata: int32(mix.Output.Uint8()),
+			})
+		}
+	}
+	var changes []protocol.PotionContainerChangeRecipe
+	for _, change := range item.PotionContainerChanges() {
+		input, _, okInput := world.ItemRuntimeID(change.Input)
+		reagent, _, okReagent := world.ItemRuntimeID(change.Reagent)
+		output, _, okOutput := world.ItemRuntimeID(change.Output)
+		if !okInput || !okReagent || !okOutput {
+			continue
+		}
+		changes = append(changes, protocol.PotionContainerChangeRecipe{
+			InputItemID:   input,
+			ReagentItemID: reagent,
+			OutputItemID:  output,
+		})
+	}
+	return mixes, changes
 }
 
 // sendInv sends the inventory passed to the client with the window ID.
@@ -186,19 +281,31 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 		return s.armour.Inventory(), true
 	case protocol.ContainerLevelEntity:
 		if s.containerOpened.Load() {
-			b := s.c.World().Block(s.openedPos.Load())
-			if _, chest := b.(block.Chest); chest {
-				return s.openedWindow.Load(), true
-			} else if _, enderChest := b.(block.EnderChest); enderChest {
+			if s.openedMenu.Load() != nil {
 				return s.openedWindow.Load(), true
 			}
+			b := s.c.World().Block(s.openedPos.Load())
+			switch b.(type) {
+			case block.Chest, block.EnderChest, block.Hopper:
+				return s.blockWindow(b)
+			}
 		}
 	case protocol.ContainerBarrel:
 		if s.containerOpened.Load() {
-			if _, barrel := s.c.World().Block(s.openedPos.Load()).(block.Barrel); barrel {
-				return s.openedWindow.Load(), true
+			if b, barrel := s.c.World().Block(s.openedPos.Load()).(block.Barrel); barrel {
+				return s.blockWindow(b)
+			}
+		}
+	case protocol.ContainerShulkerBox:
+		if s.containerOpened.Load() {
+			if b, shulkerBox := s.c.World().Block(s.openedPos.Load()).(block.ShulkerBox); shulkerBox {
+				return s.blockWindow(b)
 			}
 		}
+	case protocol.ContainerTradeTwoIngredientOne, protocol.ContainerTradeTwoIngredientTwo, protocol.ContainerTradeTwoResultPreview:
+		if s.containerOpened.Load() && s.openedTrader.Load() != nil {
+			return s.ui, true
+		}
 	case protocol.ContainerBeaconPayment:
 		if s.containerOpened.Load() {
 			if _, beacon := s.c.World().Block(s.openedPos.Load()).(block.Beacon); beacon {
@@ -244,14 +351,43 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 	case protocol.ContainerFurnaceIngredient, protocol.ContainerFurnaceFuel, protocol.ContainerFurnaceResult,
 		protocol.ContainerBlastFurnaceIngredient, protocol.ContainerSmokerIngredient:
 		if s.containerOpened.Load() {
-			if _, ok := s.c.World().Block(s.openedPos.Load()).(smelter); ok {
-				return s.openedWindow.Load(), true
+			if b, ok := s.c.World().Block(s.openedPos.Load()).(smelter); ok {
+				return s.blockWindow(b)
+			}
+		}
+	case protocol.ContainerBrewingStandInput, protocol.ContainerBrewingStandResult, protocol.ContainerBrewingStandFuel:
+		if s.containerOpened.Load() {
+			if b, ok := s.c.World().Block(s.openedPos.Load()).(block.BrewingStand); ok {
+				return s.blockWindow(b)
 			}
 		}
 	}
 	return nil, false
 }
 
+// blockWindow returns the inventory of the window opened by the session if it is still the inventory of the block
+// passed, which is the block at the position of the opened container. The block at that position may have been
+// replaced by a different block with its own inventory after the window was opened.
+func (s *Session) blockWindow(b any) (*inventory.Inventory, bool) {
+	inv := s.openedWindow.Load()
+	switch c := b.(type) {
+	case block.EnderChest:
+		return inv, true
+	case block.Chest:
+		if double, ok := c.PairedInventory(); ok && double == inv {
+			return inv, true
+		}
+		if c.Inventory() == inv {
+			return inv, true
+		}
+	case block.Container:
+		if c.Inventory() == inv {
+			return inv, true
+		}
+	}
+	return nil, false
+}
+
 // Disconnect disconnects the client and ultimately closes the session. If the message passed is non-empty,
 // it will be shown to the client.
 func (s *Session) Disconnect(message string) {
@@ -266,19 +402,31 @@ func (s *Session) Disconnect(message string) {
 
 // SendSpeed sends the speed of the player in an UpdateAttributes packet, so that it is updated client-side.
 func (s *Session) SendSpeed(speed float64) {
+	s.SendAttribute(attribute.MovementSpeed, speed)
+}
+
+// SendAttribute sends the value of an attribute of the player in an UpdateAttributes packet, so that it is
+// updated client-side.
+func (s *Session) SendAttribute(a attribute.Attribute, v float64) {
 	s.writePacket(&packet.UpdateAttributes{
 		EntityRuntimeID: selfEntityRuntimeID,
-		Attributes: []protocol.Attribute{{
-			AttributeValue: protocol.AttributeValue{
-				Name:  "minecraft:movement",
-				Value: float32(speed),
-				Max:   math.MaxFloat32,
-			},
-			Default: 0.1,
-		}},
+		Attributes:      []protocol.Attribute{protocolAttribute(a, v)},
 	})
 }
 
+// protocolAttribute converts an attribute.Attribute and its value to a protocol.Attribute.
+func protocolAttribute(a attribute.Attribute, v float64) protocol.Attribute {
+	return protocol.Attribute{
+		AttributeValue: protocol.AttributeValue{
+			Name:  a.Name(),
+			Value: float32(v),
+			Min:   float32(a.Min()),
+			Max:   float32(math.Min(a.Max(), math.MaxFloat32)),
+		},
+		Default: float32(a.Default()),
+	}
+}
+
 // SendFood ...
 func (s *Session) SendFood(food int, saturation, exhaustion float64) {
 	s.writePacket(&packet.UpdateAttributes{
@@ -338,6 +486,7 @@ func (s *Session) SendForm(f form.Form) {
 
 // Transfer transfers the player to a server with the IP and port passed.
 func (s *Session) Transfer(ip net.IP, port int) {
+	s.SetDisconnectReason(DisconnectReasonTransfer)
 	s.writePacket(&packet.Transfer{
 		Address: ip.String(),
 		Port:    uint16(port),
@@ -520,6 +669,23 @@ func skinToProtocol(s skin.Skin) protocol.Skin {
 		protocolAnim.ExpressionType = uint32(animation.AnimationExpression)
 		animations = append(animations, protocolAnim)
 	}
+	pieces := make([]protocol.PersonaPiece, 0, len(s.PersonaPieces))
+	for _, piece := range s.PersonaPieces {
+		pieces = append(pieces, protocol.PersonaPiece{
+			PieceID:   piece.ID,
+			PieceType: piece.Type,
+			PackID:    piece.PackID,
+			Default:   piece.Default,
+			ProductID: piece.ProductID,
+		})
+	}
+	tints := make([]protocol.PersonaPieceTintColour, 0, len(s.PersonaPieceTints))
+	for _, tint := range s.PersonaPieceTints {
+		tints = append(tints, protocol.PersonaPieceTintColour{
+			PieceType: tint.PieceType,
+			Colours:   tint.Colours[:],
+		})
+	}
 
 	return protocol.Skin{
 		PlayFabID:          s.PlayFabID,
@@ -533,9 +699,14 @@ func skinToProtocol(s skin.Skin) protocol.Skin {
 		CapeData:           s.Cape.Pix,
 		SkinGeometry:       s.Model,
 		PersonaSkin:        s.Persona,
+		PremiumSkin:        s.Premium,
 		CapeID:             uuid.New().String(),
 		FullID:             uuid.New().String(),
 		Animations:         animations,
+		ArmSize:            s.ArmSize,
+		SkinColour:         s.Colour,
+		PersonaPieces:      pieces,
+		PieceTintColours:   tints,
 		Trusted:            true,
 		OverrideAppearance: true,
 	}
@@ -771,6 +942,13 @@ func stacksToIngredientItems(inputs []item.Stack) []protocol.ItemDescriptorCount
 			items = append(items, protocol.ItemDescriptorCount{Descriptor: &protocol.InvalidItemDescriptor{}})
 			continue
 		}
+		if name, ok := i.Value("tag"); ok {
+			items = append(items, protocol.ItemDescriptorCount{
+				Descriptor: &protocol.ItemTagItemDescriptor{Tag: name.(string)},
+				Count:      int32(i.Count()),
+			})
+			continue
+		}
 		rid, meta, ok := world.ItemRuntimeID(i.Item())
 		if !ok {
 			panic("should never happen")
@@ -789,16 +967,24 @@ func stacksToIngredientItems(inputs []item.Stack) []protocol.ItemDescriptorCount
 	return items
 }
 
-// creativeItems returns all creative inventory items as protocol item stacks.
-func creativeItems() []protocol.CreativeItem {
-	it := make([]protocol.CreativeItem, 0, len(creative.Items()))
-	for index, i := range creative.Items() {
+// SendCreativeItems sends the items registered to the creative inventory using creative.RegisterItem to the
+// client. Changes made to the creative items after the Controllable spawned only become visible to it once
+// SendCreativeItems is called.
+func (s *Session) SendCreativeItems() {
+	if s == Nop {
+		return
+	}
+	all := creative.Items()
+	s.creativeItems.Store(all)
+
+	it := make([]protocol.CreativeItem, 0, len(all))
+	for index, i := range all {
 		it = append(it, protocol.CreativeItem{
 			CreativeItemNetworkID: uint32(index) + 1,
 			Item:                  deleteDamage(stackFromItem(i)),
 		})
 	}
-	return it
+	s.writePacket(&packet.CreativeContent{Items: it})
 }
 
 // deleteDamage strips the damage from a protocol item.
@@ -815,6 +1001,7 @@ func protocolToSkin(sk protocol.Skin) (s skin.Skin, err error) {
 
 	s = skin.New(int(sk.SkinImageWidth), int(sk.SkinImageHeight))
 	s.Persona = sk.PersonaSkin
+	s.Premium = sk.PremiumSkin
 	s.Pix = sk.SkinData
 	s.Model = sk.SkinGeometry
 	s.PlayFabID = sk.PlayFabID
@@ -850,6 +1037,22 @@ func protocolToSkin(sk protocol.Skin) (s skin.Skin, err error) {
 
 		s.Animations = append(s.Animations, animation)
 	}
+
+	s.ArmSize, s.Colour = sk.ArmSize, sk.SkinColour
+	for _, piece := range sk.PersonaPieces {
+		s.PersonaPieces = append(s.PersonaPieces, skin.PersonaPiece{
+			ID:        piece.PieceID,
+			Type:      piece.PieceType,
+			PackID:    piece.PackID,
+			ProductID: piece.ProductID,
+			Default:   piece.Default,
+		})
+	}
+	for _, tint := range sk.PieceTintColours {
+		t := skin.PersonaPieceTint{PieceType: tint.PieceType}
+		copy(t.Colours[:], tint.Colours)
+		s.PersonaPieceTints = append(s.PersonaPieceTints, t)
+	}
 	return
 }
 
@@ -7,12 +7,15 @@ import (
 	"github.com/df-mc/atomic"
 	"github.com/df-mc/dragonfly/server/block"
 	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/internal/sliceutil"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/inventory"
 	"github.com/df-mc/dragonfly/server/item/recipe"
 	"github.com/df-mc/dragonfly/server/player/chat"
 	"github.com/df-mc/dragonfly/server/player/form"
+	"github.com/df-mc/dragonfly/server/player/menu"
+	"github.com/df-mc/dragonfly/server/player/scoreboard"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/sandertv/gophertunnel/minecraft"
@@ -43,12 +46,23 @@ type Session struct {
 
 	currentScoreboard atomic.Value[string]
 	currentLines      atomic.Value[[]string]
-
-	chunkLoader                 *world.Loader
-	chunkRadius, maxChunkRadius int32
+	objectiveMu       sync.Mutex
+	// objectives holds the entry IDs of the scores last sent for the objective displayed in each display slot.
+	objectives map[scoreboard.DisplaySlot]sentObjective
+
+	chunkLoader *world.Loader
+	chunkRadius atomic.Int32
+	// requestedChunkRadius is the chunk radius last requested by the client. The chunk radius actually used is
+	// this radius, capped by maxChunkRadius.
+	requestedChunkRadius atomic.Int32
+	maxChunkRadius       atomic.Int32
+	// chunksPerTick is the maximum amount of chunks sent to the client every tick.
+	chunksPerTick atomic.Int32
 
 	teleportPos atomic.Value[*mgl64.Vec3]
 
+	corrections corrections
+
 	entityMutex sync.RWMutex
 	// currentEntityRuntimeID holds the runtime ID assigned to the last entity. It is incremented for every
 	// entity spawned to the session.
@@ -57,6 +71,14 @@ type Session struct {
 	entityRuntimeIDs map[world.Entity]uint64
 	entities         map[uint64]world.Entity
 	hiddenEntities   map[world.Entity]struct{}
+	// entityPositions holds the last position of each entity as sent to the session. It is used to decide if
+	// the movement of an entity should be interpolated or not.
+	entityPositions map[world.Entity]mgl64.Vec3
+
+	music musicPlayer
+
+	// disconnectReason holds the DisconnectReason of the session plus one, or 0 if no reason was set yet.
+	disconnectReason atomic.Uint32
 
 	// heldSlot is the slot in the inventory that the controllable is holding.
 	heldSlot                     *atomic.Uint32
@@ -70,9 +92,13 @@ type Session struct {
 	openedContainerID              atomic.Uint32
 	openedWindow                   atomic.Value[*inventory.Inventory]
 	openedPos                      atomic.Value[cube.Pos]
+	openedTrader                   atomic.Value[*entity.Mob]
+	openedMenu                     atomic.Value[*menu.Menu]
 	swingingArm                    atomic.Bool
-
-	recipes map[uint32]recipe.Recipe
+	recipesUnlock                  atomic.Bool
+	recipes                        atomic.Value[map[uint32]recipe.Recipe]
+	craftingRecipes                atomic.Value[[]recipe.Recipe]
+	creativeItems                  atomic.Value[[]item.Stack]
 
 	blobMu                sync.Mutex
 	blobs                 map[uint64][]byte
@@ -80,6 +106,9 @@ type Session struct {
 	invOpened             bool
 
 	joinMessage, quitMessage string
+	// timeout is the duration without packets from the client after which a closed connection is considered to
+	// have timed out.
+	timeout time.Duration
 
 	closeBackground chan struct{}
 }
@@ -139,8 +168,12 @@ var errSelfRuntimeID = errors.New("invalid entity runtime ID: runtime ID for sel
 // packets that it receives.
 // New takes the connection from which to accept packets. It will start handling these packets after a call to
 // Session.Spawn().
-func New(conn Conn, maxChunkRadius int, log Logger, joinMessage, quitMessage string) *Session {
-	r := conn.ChunkRadius()
+// The maxChunksPerTick passed limits how many chunks are sent to the client per tick. Chunks are sent closest
+// first, so that logging in or teleporting doesn't cause a burst of chunk sends.
+// If the connection is closed after no packets were received from the client for the timeout passed, the Session
+// is disconnected with DisconnectReasonTimeout.
+func New(conn Conn, maxChunkRadius, maxChunksPerTick int, log Logger, joinMessage, quitMessage string, timeout time.Duration) *Session {
+	requested, r := conn.ChunkRadius(), conn.ChunkRadius()
 	if r > maxChunkRadius {
 		r = maxChunkRadius
 		_ = conn.WritePacket(&packet.ChunkRadiusUpdated{ChunkRadius: int32(r)})
@@ -155,17 +188,22 @@ func New(conn Conn, maxChunkRadius int, log Logger, joinMessage, quitMessage str
 		entityRuntimeIDs:       map[world.Entity]uint64{},
 		entities:               map[uint64]world.Entity{},
 		hiddenEntities:         map[world.Entity]struct{}{},
+		entityPositions:        map[world.Entity]mgl64.Vec3{},
 		blobs:                  map[uint64][]byte{},
-		chunkRadius:            int32(r),
-		maxChunkRadius:         int32(maxChunkRadius),
+		objectives:             map[scoreboard.DisplaySlot]sentObjective{},
 		conn:                   conn,
 		log:                    log,
 		currentEntityRuntimeID: 1,
 		heldSlot:               atomic.NewUint32(0),
 		joinMessage:            joinMessage,
 		quitMessage:            quitMessage,
+		timeout:                timeout,
 		openedWindow:           *atomic.NewValue(inventory.New(1, nil)),
 	}
+	s.chunkRadius.Store(int32(r))
+	s.requestedChunkRadius.Store(int32(requested))
+	s.maxChunkRadius.Store(int32(maxChunkRadius))
+	s.chunksPerTick.Store(int32(maxChunksPerTick))
 
 	s.registerHandlers()
 	return s
@@ -176,15 +214,14 @@ func New(conn Conn, maxChunkRadius int, log Logger, joinMessage, quitMessage str
 func (s *Session) Spawn(c Controllable, pos mgl64.Vec3, w *world.World, gm world.GameMode, onStop func(controllable Controllable)) {
 	s.onStop = onStop
 	s.c = c
-	s.recipes = make(map[uint32]recipe.Recipe)
 	s.entityRuntimeIDs[c] = selfEntityRuntimeID
 	s.entities[selfEntityRuntimeID] = c
 
-	s.chunkLoader = world.NewLoader(int(s.chunkRadius), w, s)
+	s.chunkLoader = world.NewLoader(int(s.chunkRadius.Load()), w, s)
 	s.chunkLoader.Move(pos)
 	s.writePacket(&packet.NetworkChunkPublisherUpdate{
 		Position: protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])},
-		Radius:   uint32(s.chunkRadius) << 4,
+		Radius:   uint32(s.chunkRadius.Load()) << 4,
 	})
 
 	s.sendAvailableEntities(w)
@@ -207,8 +244,8 @@ func (s *Session) Spawn(c Controllable, pos mgl64.Vec3, w *world.World, gm world
 	s.sendInv(s.ui, protocol.WindowIDUI)
 	s.sendInv(s.offHand, protocol.WindowIDOffHand)
 	s.sendInv(s.armour.Inventory(), protocol.WindowIDArmour)
-	s.writePacket(&packet.CreativeContent{Items: creativeItems()})
-	s.sendRecipes()
+	s.SendCreativeItems()
+	s.SendRecipes()
 }
 
 // Start makes the session start handling incoming packets from the client.
@@ -249,6 +286,8 @@ func (s *Session) close() {
 	_ = s.offHand.Close()
 	_ = s.armour.Close()
 
+	// The controllable closes its container using CloseContainer when it is closed, so that handlers are called.
+	// This only cleans up a container that was left open.
 	s.closeCurrentContainer()
 	_ = s.chunkLoader.Close()
 	s.c.World().RemoveEntity(s.c)
@@ -257,6 +296,7 @@ func (s *Session) close() {
 	s.closePlayerList()
 	s.entityMutex.Lock()
 	s.entityRuntimeIDs, s.entities = map[world.Entity]uint64{}, map[uint64]world.Entity{}
+	s.entityPositions = map[world.Entity]mgl64.Vec3{}
 	s.entityMutex.Unlock()
 
 	if s.quitMessage != "" {
@@ -284,6 +324,47 @@ func (s *Session) Latency() time.Duration {
 	return s.conn.Latency()
 }
 
+// ChunkRadius returns the radius in chunks around the client that chunks are currently sent in.
+func (s *Session) ChunkRadius() int {
+	return int(s.chunkRadius.Load())
+}
+
+// SetMaxChunkRadius changes the maximum chunk radius of the session. If the chunk radius requested by the client
+// is larger than the radius passed, chunks are only sent within the radius passed. If the radius is raised again,
+// the chunk radius requested by the client is restored.
+func (s *Session) SetMaxChunkRadius(r int) {
+	if r < 1 {
+		r = 1
+	}
+	s.maxChunkRadius.Store(int32(r))
+	s.updateChunkRadius()
+}
+
+// SetChunksPerTick changes the maximum amount of chunks sent to the client every tick. Lowering this value
+// spreads out the bandwidth used when the client has to load many chunks at once, for example after a teleport,
+// at the cost of chunks loading more slowly.
+func (s *Session) SetChunksPerTick(n int) {
+	if n < 1 {
+		n = 1
+	}
+	s.chunksPerTick.Store(int32(n))
+}
+
+// updateChunkRadius updates the chunk radius of the session to the radius requested by the client, capped by the
+// maximum chunk radius, and notifies the client of the radius.
+func (s *Session) updateChunkRadius() {
+	r := s.requestedChunkRadius.Load()
+	if limit := s.maxChunkRadius.Load(); r > limit {
+		r = limit
+	} else if r < 1 {
+		r = 1
+	}
+	if s.chunkRadius.Swap(r) != r && s.chunkLoader != nil {
+		s.chunkLoader.ChangeRadius(int(r))
+	}
+	s.writePacket(&packet.ChunkRadiusUpdated{ChunkRadius: r})
+}
+
 // ClientData returns the login.ClientData of the underlying *minecraft.Conn.
 func (s *Session) ClientData() login.ClientData {
 	return s.conn.ClientData()
@@ -304,15 +385,24 @@ func (s *Session) handlePackets() {
 		}
 		_ = s.Close()
 	}()
+	last := time.Now()
 	for {
 		pk, err := s.conn.ReadPacket()
 		if err != nil {
+			// The connection is closed by the network layer without a reason, so a connection on which no packets
+			// were received for a while is assumed to have timed out. Clients send packets every tick otherwise.
+			if time.Since(last) > s.timeout {
+				s.SetDisconnectReason(DisconnectReasonTimeout)
+			}
+			s.SetDisconnectReason(DisconnectReasonQuit)
 			return
 		}
+		last = time.Now()
 		if err := s.handlePacket(pk); err != nil {
 			// An error occurred during the handling of a packet. Print the error and stop handling any more
 			// packets.
 			s.log.Debugf("failed processing packet from %v (%v): %v\n", s.conn.RemoteAddr(), s.c.Name(), err)
+			s.SetDisconnectReason(DisconnectReasonInvalidPacket)
 			return
 		}
 	}
@@ -334,6 +424,7 @@ func (s *Session) background() {
 		select {
 		case <-t.C:
 			s.sendChunks()
+			s.tickMusic()
 
 			if i++; i%20 == 0 {
 				// Enum resending happens relatively often and frequent updates are more important than with full
@@ -352,14 +443,15 @@ func (s *Session) background() {
 	}
 }
 
-// sendChunks sends the next up to 4 chunks to the connection. What chunks are loaded depends on the connection of
-// the chunk loader and the chunks that were previously loaded.
+// sendChunks sends the next chunks to the connection, up to the maximum amount of chunks per tick. What chunks are
+// loaded depends on the position and look direction of the chunk loader and the chunks that were previously loaded.
 func (s *Session) sendChunks() {
 	pos := s.c.Position()
 	s.chunkLoader.Move(pos)
+	s.chunkLoader.Look(s.c.Rotation().Vec3())
 	s.writePacket(&packet.NetworkChunkPublisherUpdate{
 		Position: protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])},
-		Radius:   uint32(s.chunkRadius) << 4,
+		Radius:   uint32(s.chunkRadius.Load()) << 4,
 	})
 
 	const maxChunkTransactions = 8
@@ -371,8 +463,8 @@ func (s *Session) sendChunks() {
 	s.blobMu.Lock()
 	toLoad := maxChunkTransactions - len(s.openChunkTransactions)
 	s.blobMu.Unlock()
-	if toLoad > 4 {
-		toLoad = 4
+	if n := int(s.chunksPerTick.Load()); toLoad > n {
+		toLoad = n
 	}
 	s.chunkLoader.Load(toLoad)
 }
@@ -482,6 +574,9 @@ func (s *Session) handleInterfaceUpdate(slot int, _, item item.Stack) {
 			s.sendEnchantmentOptions(s.c.World(), pos, item)
 		}
 	}
+	if offset := int(s.craftingOffset()); slot >= offset && slot < offset+int(s.craftingSize()) {
+		s.sendCraftingPreview()
+	}
 }
 
 // writePacket writes a packet to the session's connection if it is not Nop.
@@ -524,13 +619,21 @@ func (s *Session) closePlayerList() {
 type actorIdentifier struct {
 	// ID is a unique namespaced identifier for the entity.
 	ID string `nbt:"id"`
+	// BaseID is the identifier of the entity that a custom entity is based on.
+	BaseID string `nbt:"bid,omitempty"`
+	// RuntimeID is the runtime ID of a custom entity.
+	RuntimeID int32 `nbt:"rid,omitempty"`
 }
 
 // sendAvailableEntities sends all registered entities to the player.
 func (s *Session) sendAvailableEntities(w *world.World) {
 	var identifiers []actorIdentifier
 	for _, t := range w.EntityRegistry().Types() {
-		identifiers = append(identifiers, actorIdentifier{ID: t.EncodeEntity()})
+		id := actorIdentifier{ID: t.EncodeEntity()}
+		if c, ok := t.(CustomEntity); ok {
+			id.BaseID, id.RuntimeID = c.BaseEncodeEntity(), c.NetworkRuntimeID()
+		}
+		identifiers = append(identifiers, id)
 	}
 	serializedEntityData, err := nbt.Marshal(map[string]any{"idlist": identifiers})
 	if err != nil {
@@ -71,25 +71,35 @@ func (s *Session) SendScoreboard(sb *scoreboard.Scoreboard) {
 			CriteriaName:  "dummy",
 		})
 		s.currentScoreboard.Store(sb.Name())
-		s.currentLines.Store(append([]string(nil), sb.Lines()...))
-	} else {
-		// Remove all current lines from the scoreboard. We can't replace them without removing them.
-		pk := &packet.SetScore{ActionType: packet.ScoreboardActionRemove}
-		for i := range currentLines {
-			pk.Entries = append(pk.Entries, protocol.ScoreboardEntry{
-				EntryID:       int64(i),
-				ObjectiveName: currentName,
-				Score:         int32(i),
-			})
+		currentLines = nil
+	}
+	lines := sb.Lines()
+	for k, line := range lines {
+		if len(line) == 0 {
+			lines[k] = "§" + colours[k]
 		}
-		if len(pk.Entries) > 0 {
-			s.writePacket(pk)
+	}
+
+	// Only lines that changed are removed and re-added, so that the lines that stay the same don't flicker. We can't
+	// replace lines without removing them first.
+	removed := &packet.SetScore{ActionType: packet.ScoreboardActionRemove}
+	for i, line := range currentLines {
+		if i < len(lines) && lines[i] == line {
+			continue
 		}
+		removed.Entries = append(removed.Entries, protocol.ScoreboardEntry{
+			EntryID:       int64(i),
+			ObjectiveName: currentName,
+			Score:         int32(i),
+		})
+	}
+	if len(removed.Entries) > 0 {
+		s.writePacket(removed)
 	}
 	pk := &packet.SetScore{ActionType: packet.ScoreboardActionModify}
-	for k, line := range sb.Lines() {
-		if len(line) == 0 {
-			line = "§" + colours[k]
+	for k, line := range lines {
+		if k < len(currentLines) && currentLines[k] == line {
+			continue
 		}
 		pk.Entries = append(pk.Entries, protocol.ScoreboardEntry{
 			EntryID:       int64(k),
@@ -102,6 +112,7 @@ func (s *Session) SendScoreboard(sb *scoreboard.Scoreboard) {
 	if len(pk.Entries) > 0 {
 		s.writePacket(pk)
 	}
+	s.currentLines.Store(lines)
 }
 
 // colours holds a list of colour codes to be filled out for empty lines in a scoreboard.
@@ -114,6 +125,85 @@ func (s *Session) RemoveScoreboard() {
 	s.currentLines.Store([]string{})
 }
 
+// sentObjective holds the state of an objective as last sent to the client in a display slot.
+type sentObjective struct {
+	name    string
+	entries map[int64]struct{}
+}
+
+// SendObjective ...
+func (s *Session) SendObjective(o *scoreboard.Objective, slot scoreboard.DisplaySlot) {
+	if s == Nop {
+		return
+	}
+	s.objectiveMu.Lock()
+	defer s.objectiveMu.Unlock()
+
+	// The name of the display slot is used as the name of the objective, so that every slot holds its own objective,
+	// even if two objectives share the same display name.
+	objectiveName := slot.String()
+	current, ok := s.objectives[slot]
+	if !ok || current.name != o.Name() {
+		if ok {
+			s.writePacket(&packet.RemoveObjective{ObjectiveName: objectiveName})
+		}
+		s.writePacket(&packet.SetDisplayObjective{
+			DisplaySlot:   objectiveName,
+			ObjectiveName: objectiveName,
+			DisplayName:   o.Name(),
+			CriteriaName:  "dummy",
+		})
+		current = sentObjective{name: o.Name(), entries: map[int64]struct{}{}}
+	}
+
+	entries := make(map[int64]struct{}, len(current.entries))
+	pk := &packet.SetScore{ActionType: packet.ScoreboardActionModify}
+	for e, score := range o.Scores() {
+		id := int64(s.entityRuntimeID(e))
+		if id == 0 {
+			// The entity is not visible to the session, so it has no ID that the score could be attached to.
+			continue
+		}
+		identity := byte(protocol.ScoreboardIdentityEntity)
+		if _, ok := e.(Controllable); ok {
+			identity = protocol.ScoreboardIdentityPlayer
+		}
+		entries[id] = struct{}{}
+		pk.Entries = append(pk.Entries, protocol.ScoreboardEntry{
+			EntryID:        id,
+			ObjectiveName:  objectiveName,
+			Score:          int32(score),
+			IdentityType:   identity,
+			EntityUniqueID: id,
+		})
+	}
+	removed := &packet.SetScore{ActionType: packet.ScoreboardActionRemove}
+	for id := range current.entries {
+		if _, ok := entries[id]; !ok {
+			removed.Entries = append(removed.Entries, protocol.ScoreboardEntry{EntryID: id, ObjectiveName: objectiveName})
+		}
+	}
+	if len(removed.Entries) > 0 {
+		s.writePacket(removed)
+	}
+	if len(pk.Entries) > 0 {
+		s.writePacket(pk)
+	}
+	current.entries = entries
+	s.objectives[slot] = current
+}
+
+// RemoveObjective ...
+func (s *Session) RemoveObjective(slot scoreboard.DisplaySlot) {
+	s.objectiveMu.Lock()
+	defer s.objectiveMu.Unlock()
+	if _, ok := s.objectives[slot]; !ok {
+		return
+	}
+	s.writePacket(&packet.RemoveObjective{ObjectiveName: slot.String()})
+	delete(s.objectives, slot)
+}
+
 // SendBossBar sends a boss bar to the player with the text passed and the health percentage of the bar.
 // SendBossBar removes any boss bar that might be active before sending the new one.
 func (s *Session) SendBossBar(text string, colour uint8, healthPercentage float64) {
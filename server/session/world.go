@@ -3,6 +3,7 @@ package session
 import (
 	"github.com/df-mc/dragonfly/server/entity/effect"
 	"image/color"
+	"math"
 	"math/rand"
 	"strings"
 	"time"
@@ -10,9 +11,11 @@ import (
 	"github.com/df-mc/dragonfly/server/block"
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/entity"
+	"github.com/df-mc/dragonfly/server/entity/attribute"
 	"github.com/df-mc/dragonfly/server/internal/nbtconv"
 	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/player/skin"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/df-mc/dragonfly/server/world/particle"
 	"github.com/df-mc/dragonfly/server/world/sound"
@@ -31,6 +34,19 @@ type NetworkEncodeableEntity interface {
 	NetworkEncodeEntity() string
 }
 
+// CustomEntity is a world.EntityType with an identifier that is not part of
+// vanilla Minecraft, such as 'myserver:dragon_boss'. Custom entities must be
+// defined in a resource pack sent to clients.
+type CustomEntity interface {
+	// NetworkRuntimeID returns the runtime ID under which the entity type is
+	// registered with clients.
+	NetworkRuntimeID() int32
+	// BaseEncodeEntity returns the type of the vanilla entity that the custom
+	// entity is based on, for example 'minecraft:zombie'. An empty string is
+	// returned if the entity is not based on a vanilla entity.
+	BaseEncodeEntity() string
+}
+
 // OffsetEntity is a world.EntityType that has an additional offset when sent
 // over network. This is mostly the case for older entities such as players and
 // TNT.
@@ -68,7 +84,9 @@ func (s *Session) ViewEntity(e world.Entity) {
 		s.entityRuntimeIDs[e] = runtimeID
 		s.entities[runtimeID] = e
 	}
+	s.entityPositions[e] = e.Position()
 	s.entityMutex.Unlock()
+	defer s.viewEntityLinks(e)
 
 	yaw, pitch := e.Rotation().Elem()
 	metadata := s.parseEntityMetadata(e)
@@ -86,39 +104,13 @@ func (s *Session) ViewEntity(e world.Entity) {
 			}
 		}
 		sessionMu.Unlock()
-		if !actualPlayer {
-			s.writePacket(&packet.PlayerList{ActionType: packet.PlayerListActionAdd, Entries: []protocol.PlayerListEntry{{
-				UUID:           v.UUID(),
-				EntityUniqueID: int64(runtimeID),
-				Username:       v.Name(),
-				Skin:           skinToProtocol(v.Skin()),
-			}}})
-		}
-
-		s.writePacket(&packet.AddPlayer{
-			EntityMetadata:  metadata,
-			EntityRuntimeID: runtimeID,
-			GameType:        gameTypeFromMode(v.GameMode()),
-			HeadYaw:         float32(yaw),
-			Pitch:           float32(pitch),
-			Position:        vec64To32(e.Position()),
-			UUID:            v.UUID(),
-			Username:        v.Name(),
-			Yaw:             float32(yaw),
-			AbilityData: protocol.AbilityData{
-				EntityUniqueID: int64(runtimeID),
-				Layers: []protocol.AbilityLayer{{
-					Type:      protocol.AbilityLayerTypeBase,
-					Abilities: protocol.AbilityCount - 1,
-				}},
-			},
-		})
-		if !actualPlayer {
-			s.writePacket(&packet.PlayerList{ActionType: packet.PlayerListActionRemove, Entries: []protocol.PlayerListEntry{{
-				UUID: v.UUID(),
-			}}})
-		}
+		s.viewPlayerEntity(e, runtimeID, metadata, v.UUID(), v.Name(), v.Skin(), v.GameMode(), actualPlayer)
 		return
+	case *entity.Mob:
+		if npc, ok := v.Behaviour().(*entity.NPCBehaviour); ok {
+			s.viewPlayerEntity(e, runtimeID, metadata, npc.UUID(), v.NameTag(), npc.Skin(), world.GameModeSurvival, false)
+			return
+		}
 	case *entity.Item:
 		s.writePacket(&packet.AddItemActor{
 			EntityUniqueID:  int64(runtimeID),
@@ -131,6 +123,22 @@ func (s *Session) ViewEntity(e world.Entity) {
 		return
 	case *entity.FallingBlock:
 		metadata[protocol.EntityDataKeyVariant] = int32(world.BlockRuntimeID(v.Block()))
+	case *entity.Display:
+		if it, ok := v.Item(); ok {
+			s.writePacket(&packet.AddItemActor{
+				EntityUniqueID:  int64(runtimeID),
+				EntityRuntimeID: runtimeID,
+				Item:            instanceFromItem(it),
+				Position:        vec64To32(v.Position()),
+				EntityMetadata:  metadata,
+			})
+			return
+		}
+		var b world.Block = block.Air{}
+		if bl, ok := v.Block(); ok {
+			b = bl
+		}
+		metadata[protocol.EntityDataKeyVariant] = int32(world.BlockRuntimeID(b))
 	case *entity.Ent:
 		if _, ok := e.Type().(entity.TextType); ok {
 			metadata[protocol.EntityDataKeyVariant] = int32(world.BlockRuntimeID(block.Air{}))
@@ -155,9 +163,69 @@ func (s *Session) ViewEntity(e world.Entity) {
 		Pitch:           float32(pitch),
 		Yaw:             float32(yaw),
 		HeadYaw:         float32(yaw),
+		Attributes:      entityAttributes(e),
 	})
 }
 
+// viewPlayerEntity spawns an entity that is rendered as a player for the session. Unless the entity is already in
+// the player list of the session, it is added to it temporarily, because the client only applies the skin of players
+// in the player list.
+func (s *Session) viewPlayerEntity(e world.Entity, runtimeID uint64, metadata protocol.EntityMetadata, id uuid.UUID, name string, sk skin.Skin, mode world.GameMode, listed bool) {
+	yaw, pitch := e.Rotation().Elem()
+	if !listed {
+		s.writePacket(&packet.PlayerList{ActionType: packet.PlayerListActionAdd, Entries: []protocol.PlayerListEntry{{
+			UUID:           id,
+			EntityUniqueID: int64(runtimeID),
+			Username:       name,
+			Skin:           skinToProtocol(sk),
+		}}})
+	}
+
+	s.writePacket(&packet.AddPlayer{
+		EntityMetadata:  metadata,
+		EntityRuntimeID: runtimeID,
+		GameType:        gameTypeFromMode(mode),
+		HeadYaw:         float32(yaw),
+		Pitch:           float32(pitch),
+		Position:        vec64To32(e.Position()),
+		UUID:            id,
+		Username:        name,
+		Yaw:             float32(yaw),
+		AbilityData: protocol.AbilityData{
+			EntityUniqueID: int64(runtimeID),
+			Layers: []protocol.AbilityLayer{{
+				Type:      protocol.AbilityLayerTypeBase,
+				Abilities: protocol.AbilityCount - 1,
+			}},
+		},
+	})
+	if !listed {
+		s.writePacket(&packet.PlayerList{ActionType: packet.PlayerListActionRemove, Entries: []protocol.PlayerListEntry{{
+			UUID: id,
+		}}})
+	}
+}
+
+// entityAttributes returns the attributes of an entity that has an attribute.Map, such as an entity.Mob, so that
+// they may be sent to viewers when the entity is spawned.
+func entityAttributes(e world.Entity) []protocol.AttributeValue {
+	a, ok := e.(interface{ Attributes() *attribute.Map })
+	if !ok {
+		return nil
+	}
+	values := a.Attributes().Attributes()
+	attributes := make([]protocol.AttributeValue, 0, len(values))
+	for attr, v := range values {
+		value := protocol.AttributeValue{Name: attr.Name(), Value: float32(v), Min: float32(attr.Min()), Max: float32(math.Min(attr.Max(), math.MaxFloat32))}
+		if h, ok := e.(interface{ Health() float64 }); ok && attr == attribute.MaxHealth {
+			// The health attribute holds the current health of the entity, with its maximum health as maximum.
+			value.Value, value.Max = float32(math.Ceil(h.Health())), float32(math.Ceil(v))
+		}
+		attributes = append(attributes, value)
+	}
+	return attributes
+}
+
 // ViewEntityGameMode ...
 func (s *Session) ViewEntityGameMode(e world.Entity) {
 	if s.entityHidden(e) {
@@ -185,6 +253,7 @@ func (s *Session) HideEntity(e world.Entity) {
 		delete(s.entityRuntimeIDs, e)
 		delete(s.entities, id)
 	}
+	delete(s.entityPositions, e)
 	s.entityMutex.Unlock()
 	if !ok {
 		// The entity was already removed some other way. We don't need to send a packet.
@@ -200,10 +269,20 @@ func (s *Session) ViewEntityMovement(e world.Entity, pos mgl64.Vec3, yaw, pitch
 		return
 	}
 
+	s.entityMutex.Lock()
+	last, ok := s.entityPositions[e]
+	s.entityPositions[e] = pos
+	s.entityMutex.Unlock()
+
 	flags := byte(0)
 	if onGround {
 		flags |= packet.MoveFlagOnGround
 	}
+	if ok && last.Sub(pos).Len() > maxInterpolationDistance {
+		// The client interpolates movement over several ticks, which looks like the entity is sliding when it
+		// moves over a large distance at once. The entity is teleported instead.
+		flags |= packet.MoveFlagTeleport
+	}
 	s.writePacket(&packet.MoveActorAbsolute{
 		EntityRuntimeID: id,
 		Position:        vec64To32(pos.Add(entityOffset(e))),
@@ -212,6 +291,10 @@ func (s *Session) ViewEntityMovement(e world.Entity, pos mgl64.Vec3, yaw, pitch
 	})
 }
 
+// maxInterpolationDistance is the maximum distance in blocks that an entity may move in a single movement for the
+// movement to be interpolated by the client. Entities moving further are teleported instead.
+const maxInterpolationDistance = 8
+
 // ViewEntityVelocity ...
 func (s *Session) ViewEntityVelocity(e world.Entity, velocity mgl64.Vec3) {
 	if s.entityHidden(e) {
@@ -236,6 +319,23 @@ func (s *Session) ViewTime(time int) {
 	s.writePacket(&packet.SetTime{Time: int32(time)})
 }
 
+// ViewTimeCycle ...
+func (s *Session) ViewTimeCycle(cycle bool) {
+	s.sendGameRules([]protocol.GameRule{{Name: "dodaylightcycle", Value: cycle}})
+}
+
+// ViewKeepInventory ...
+func (s *Session) ViewKeepInventory(keep bool) {
+	s.sendGameRules([]protocol.GameRule{{Name: "keepinventory", Value: keep}})
+}
+
+// ViewRecipesUnlock ...
+func (s *Session) ViewRecipesUnlock(unlock bool) {
+	if s.recipesUnlock.Swap(unlock) != unlock {
+		s.SendRecipes()
+	}
+}
+
 // ViewEntityTeleport ...
 func (s *Session) ViewEntityTeleport(e world.Entity, position mgl64.Vec3) {
 	id := s.entityRuntimeID(e)
@@ -247,6 +347,10 @@ func (s *Session) ViewEntityTeleport(e world.Entity, position mgl64.Vec3) {
 	if id == selfEntityRuntimeID {
 		s.chunkLoader.Move(position)
 		s.teleportPos.Store(&position)
+	} else {
+		s.entityMutex.Lock()
+		s.entityPositions[e] = position
+		s.entityMutex.Unlock()
 	}
 
 	s.writePacket(&packet.SetActorMotion{EntityRuntimeID: id})
@@ -325,8 +429,13 @@ func (s *Session) ViewEntityArmour(e world.Entity) {
 // ViewItemCooldown ...
 func (s *Session) ViewItemCooldown(item world.Item, duration time.Duration) {
 	name, _ := item.EncodeItem()
+	category := strings.Split(name, ":")[1]
+	if name == "minecraft:chorus_fruit" {
+		// The cooldown category of chorus fruit does not match its name.
+		category = "chorusfruit"
+	}
 	s.writePacket(&packet.ClientStartItemCooldown{
-		Category: strings.Split(name, ":")[1],
+		Category: category,
 		Duration: int32(duration.Milliseconds() / 50),
 	})
 }
@@ -661,6 +770,10 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		pk.SoundType = packet.SoundEventChestClosed
 	case sound.ChestOpen:
 		pk.SoundType = packet.SoundEventChestOpen
+	case sound.ShulkerBoxClose:
+		pk.SoundType = packet.SoundEventShulkerBoxClosed
+	case sound.ShulkerBoxOpen:
+		pk.SoundType = packet.SoundEventShulkerBoxOpen
 	case sound.BarrelClose:
 		pk.SoundType = packet.SoundEventBarrelClose
 	case sound.BarrelOpen:
@@ -694,6 +807,12 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		pk.SoundType = packet.SoundEventBucketEmptyLava
 	case sound.BowShoot:
 		pk.SoundType = packet.SoundEventBow
+	case sound.ShieldBlock:
+		pk.SoundType = packet.SoundEventShieldBlock
+	case sound.CrossbowLoad:
+		pk.SoundType = packet.SoundEventCrossbowLoadingEnd
+	case sound.CrossbowShoot:
+		pk.SoundType = packet.SoundEventCrossbowShoot
 	case sound.ArrowHit:
 		pk.SoundType = packet.SoundEventBowHit
 	case sound.ItemThrow:
@@ -800,6 +919,33 @@ func (s *Session) ViewFurnaceUpdate(prevCookTime, cookTime, prevRemainingFuelTim
 	}
 }
 
+// ViewBrewingUpdate updates a brewing stand for the associated session based on previous times and fuel.
+func (s *Session) ViewBrewingUpdate(prevBrewTime, brewTime time.Duration, prevFuelAmount, fuelAmount, prevFuelTotal, fuelTotal int32) {
+	if prevBrewTime != brewTime {
+		s.writePacket(&packet.ContainerSetData{
+			WindowID: byte(s.openedWindowID.Load()),
+			Key:      packet.ContainerDataBrewingStandBrewTime,
+			Value:    int32(brewTime.Milliseconds() / 50),
+		})
+	}
+
+	if prevFuelAmount != fuelAmount {
+		s.writePacket(&packet.ContainerSetData{
+			WindowID: byte(s.openedWindowID.Load()),
+			Key:      packet.ContainerDataBrewingStandFuelAmount,
+			Value:    fuelAmount,
+		})
+	}
+
+	if prevFuelTotal != fuelTotal {
+		s.writePacket(&packet.ContainerSetData{
+			WindowID: byte(s.openedWindowID.Load()),
+			Key:      packet.ContainerDataBrewingStandFuelTotal,
+			Value:    fuelTotal,
+		})
+	}
+}
+
 // ViewBlockUpdate ...
 func (s *Session) ViewBlockUpdate(pos cube.Pos, b world.Block, layer int) {
 	blockPos := protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])}
@@ -823,7 +969,7 @@ func (s *Session) ViewBlockUpdate(pos cube.Pos, b world.Block, layer int) {
 func (s *Session) ViewEntityAction(e world.Entity, a world.EntityAction) {
 	switch act := a.(type) {
 	case entity.SwingArmAction:
-		if _, ok := e.(Controllable); ok {
+		if _, ok := e.(Controllable); ok || e.Type() == (entity.NPCType{}) {
 			if s.entityRuntimeID(e) == selfEntityRuntimeID && s.swingingArm.Load() {
 				return
 			}
@@ -852,6 +998,31 @@ func (s *Session) ViewEntityAction(e world.Entity, a world.EntityAction) {
 			EntityRuntimeID: s.entityRuntimeID(e),
 			EventType:       packet.ActorEventDeath,
 		})
+	case entity.TotemUseAction:
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       packet.ActorEventTalismanActivate,
+		})
+	case entity.LoveAction:
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       packet.ActorEventLoveHearts,
+		})
+	case entity.TameSucceedAction:
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       packet.ActorEventTamingSucceeded,
+		})
+	case entity.TameFailAction:
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       packet.ActorEventTamingFailed,
+		})
+	case entity.EatGrassAction:
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       packet.ActorEventEatGrass,
+		})
 	case entity.PickedUpAction:
 		s.writePacket(&packet.TakeItemActor{
 			ItemEntityRuntimeID:  s.entityRuntimeID(e),
@@ -892,6 +1063,52 @@ func (s *Session) ViewEntityAction(e world.Entity, a world.EntityAction) {
 	}
 }
 
+// ViewEntityMount ...
+func (s *Session) ViewEntityMount(passenger, vehicle world.Entity, driver bool) {
+	linkType := byte(protocol.EntityLinkPassenger)
+	if driver {
+		linkType = protocol.EntityLinkRider
+	}
+	s.viewEntityLink(passenger, vehicle, linkType)
+}
+
+// ViewEntityDismount ...
+func (s *Session) ViewEntityDismount(passenger, vehicle world.Entity) {
+	s.viewEntityLink(passenger, vehicle, protocol.EntityLinkRemove)
+}
+
+// viewEntityLink sends a link of the type passed between a passenger and its vehicle, if both entities are visible
+// to the Session.
+func (s *Session) viewEntityLink(passenger, vehicle world.Entity, linkType byte) {
+	if s.entityHidden(passenger) || s.entityHidden(vehicle) {
+		return
+	}
+	passengerID, vehicleID := s.entityRuntimeID(passenger), s.entityRuntimeID(vehicle)
+	if passengerID == 0 || vehicleID == 0 {
+		// One of the entities was not yet spawned for the Session. The link is sent once it is.
+		return
+	}
+	s.writePacket(&packet.SetActorLink{EntityLink: protocol.EntityLink{
+		RiddenEntityUniqueID: int64(vehicleID),
+		RiderEntityUniqueID:  int64(passengerID),
+		Type:                 linkType,
+	}})
+}
+
+// viewEntityLinks sends the links of an entity that was just spawned for the Session with its vehicle and
+// passengers.
+func (s *Session) viewEntityLinks(e world.Entity) {
+	if vehicle, _, ok := entity.Vehicle(e); ok {
+		// The passengers are loaded only once, as e may have dismounted in the meantime.
+		if passengers := entity.Passengers(vehicle); len(passengers) > 0 {
+			s.ViewEntityMount(e, vehicle, passengers[0] == e)
+		}
+	}
+	for i, passenger := range entity.Passengers(e) {
+		s.ViewEntityMount(passenger, e, i == 0)
+	}
+}
+
 // ViewEntityState ...
 func (s *Session) ViewEntityState(e world.Entity) {
 	s.writePacket(&packet.SetActorData{
@@ -905,7 +1122,7 @@ func (s *Session) OpenBlockContainer(pos cube.Pos) {
 	if s.containerOpened.Load() && s.openedPos.Load() == pos {
 		return
 	}
-	s.closeCurrentContainer()
+	s.c.CloseContainer()
 
 	w := s.c.World()
 	b := w.Block(pos)
@@ -961,7 +1178,13 @@ func (s *Session) openNormalContainer(b block.Container, pos cube.Pos) {
 
 	nextID := s.nextWindowID()
 	s.containerOpened.Store(true)
-	s.openedWindow.Store(b.Inventory())
+	inv := b.Inventory()
+	if c, ok := b.(block.Chest); ok {
+		if double, ok := c.PairedInventory(); ok {
+			inv = double
+		}
+	}
+	s.openedWindow.Store(inv)
 	s.openedPos.Store(pos)
 
 	var containerType byte
@@ -972,6 +1195,10 @@ func (s *Session) openNormalContainer(b block.Container, pos cube.Pos) {
 		containerType = protocol.ContainerTypeBlastFurnace
 	case block.Smoker:
 		containerType = protocol.ContainerTypeSmoker
+	case block.Hopper:
+		containerType = protocol.ContainerTypeHopper
+	case block.BrewingStand:
+		containerType = protocol.ContainerTypeBrewingStand
 	}
 
 	s.writePacket(&packet.ContainerOpen{
@@ -980,7 +1207,7 @@ func (s *Session) openNormalContainer(b block.Container, pos cube.Pos) {
 		ContainerPosition:       protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])},
 		ContainerEntityUniqueID: -1,
 	})
-	s.sendInv(b.Inventory(), uint32(nextID))
+	s.sendInv(inv, uint32(nextID))
 }
 
 // ViewSlotChange ...
@@ -1052,6 +1279,13 @@ func (s *Session) ViewSkin(e world.Entity) {
 			UUID: v.UUID(),
 			Skin: skinToProtocol(v.Skin()),
 		})
+	case *entity.Mob:
+		if npc, ok := v.Behaviour().(*entity.NPCBehaviour); ok {
+			s.writePacket(&packet.PlayerSkin{
+				UUID: npc.UUID(),
+				Skin: skinToProtocol(npc.Skin()),
+			})
+		}
 	}
 }
 
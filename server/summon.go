@@ -0,0 +1,120 @@
+package server
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/cmd"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// registerSummonCommand registers the /summon command, unless a command with the same name was already registered.
+func (srv *Server) registerSummonCommand() {
+	if _, ok := cmd.ByAlias("summon"); ok {
+		return
+	}
+	cmd.Register(cmd.New("summon", "Summons an entity.", nil, summon{}))
+}
+
+// summon implements the /summon command. It spawns an entity of any world.SpawnableEntityType registered in the
+// world.EntityRegistry of the world of the source. Additional data may be passed as key=value pairs, such as
+// 'name=Steve maxhealth=40 fire=10'.
+type summon struct {
+	Entity   summonEntity              `cmd:"entity"`
+	Position cmd.Optional[mgl64.Vec3]  `cmd:"position"`
+	Data     cmd.Optional[cmd.Varargs] `cmd:"data"`
+}
+
+// Allow only allows operators to summon entities.
+func (summon) Allow(src cmd.Source) bool {
+	return operator(src)
+}
+
+// Run ...
+func (s summon) Run(src cmd.Source, o *cmd.Output) {
+	w := src.World()
+	t, ok := w.EntityRegistry().Lookup(string(s.Entity))
+	if !ok {
+		o.Errorf("Unknown entity type %v.", s.Entity)
+		return
+	}
+	spawnable, ok := t.(world.SpawnableEntityType)
+	if !ok {
+		o.Errorf("Entity type %v cannot be summoned.", s.Entity)
+		return
+	}
+	e := spawnable.Spawn(s.Position.LoadOr(src.Position()))
+
+	data, _ := s.Data.Load()
+	for _, field := range strings.Fields(string(data)) {
+		if err := applySummonData(e, field); err != nil {
+			o.Errorf("Invalid data %v: %v", field, err)
+			return
+		}
+	}
+	w.AddEntity(e)
+	o.Printf("Summoned %v.", s.Entity)
+}
+
+// applySummonData applies a single key=value pair passed to the /summon command to the entity passed.
+func applySummonData(e world.Entity, field string) error {
+	k, v, ok := strings.Cut(field, "=")
+	if !ok {
+		return fmt.Errorf("expected key=value")
+	}
+	switch strings.ToLower(k) {
+	case "name":
+		n, ok := e.(interface{ SetNameTag(s string) })
+		if !ok {
+			return fmt.Errorf("entity cannot have a name")
+		}
+		n.SetNameTag(strings.ReplaceAll(v, "_", " "))
+	case "maxhealth":
+		h, ok := e.(interface{ SetMaxHealth(v float64) })
+		if !ok {
+			return fmt.Errorf("entity does not have health")
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f <= 0 {
+			return fmt.Errorf("maximum health must be a positive number")
+		}
+		h.SetMaxHealth(f)
+	case "fire":
+		f, ok := e.(interface{ SetOnFire(d time.Duration) })
+		if !ok {
+			return fmt.Errorf("entity cannot be set on fire")
+		}
+		seconds, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("fire must be a duration in seconds")
+		}
+		f.SetOnFire(time.Duration(seconds * float64(time.Second)))
+	default:
+		return fmt.Errorf("unknown key %v", k)
+	}
+	return nil
+}
+
+// summonEntity is a cmd.Enum holding the names of all entity types that may be summoned in the world of a
+// cmd.Source.
+type summonEntity string
+
+// Type ...
+func (summonEntity) Type() string {
+	return "EntityType"
+}
+
+// Options ...
+func (summonEntity) Options(src cmd.Source) []string {
+	var names []string
+	for _, t := range src.World().EntityRegistry().Types() {
+		if _, ok := t.(world.SpawnableEntityType); ok {
+			names = append(names, t.EncodeEntity())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -155,6 +155,16 @@ type RandomTicker interface {
 	RandomTick(pos cube.Pos, w *World, r *rand.Rand)
 }
 
+// PrecipitationTicker represents a block that reacts to rain or snow falling on it, such as a cauldron that slowly
+// fills up. While it is raining in a World, a random column in every loaded chunk is picked once every 16 ticks on
+// average, after which the highest block in that column receives a precipitation tick if it is exposed to the rain or
+// snow.
+type PrecipitationTicker interface {
+	// PrecipitationTick handles a precipitation tick of the block at the position passed. Additionally, a rand.Rand
+	// instance is passed which may be used to generate values randomly without locking.
+	PrecipitationTick(pos cube.Pos, w *World, r *rand.Rand)
+}
+
 // ScheduledTicker represents a block that executes an action when it has a block update scheduled, such as
 // when a block adjacent to it is broken.
 type ScheduledTicker interface {
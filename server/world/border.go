@@ -0,0 +1,105 @@
+package world
+
+import (
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"sync"
+	"time"
+)
+
+// Border is a square border centred around a horizontal position in a World. Players are unable to move past the
+// border and cannot edit blocks outside of it. Entities teleported past the border end up at its edge instead.
+type Border struct {
+	// Centre is the horizontal centre of the border, with the X and Z coordinates as first and second value.
+	Centre mgl64.Vec2
+	// Radius is the distance from the centre to each of the four edges of the border. A Border with an infinite
+	// Radius does not limit anything.
+	Radius float64
+}
+
+// Within checks if the position passed is within the Border.
+func (b Border) Within(pos mgl64.Vec3) bool {
+	return b.Distance(pos) >= 0
+}
+
+// Clamp returns the position passed moved horizontally to the closest position within the Border. Positions within
+// the Border are returned unchanged.
+func (b Border) Clamp(pos mgl64.Vec3) mgl64.Vec3 {
+	if math.IsInf(b.Radius, 1) {
+		return pos
+	}
+	pos[0] = math.Max(b.Centre[0]-b.Radius, math.Min(b.Centre[0]+b.Radius, pos[0]))
+	pos[2] = math.Max(b.Centre[1]-b.Radius, math.Min(b.Centre[1]+b.Radius, pos[2]))
+	return pos
+}
+
+// Distance returns the horizontal distance from the position passed to the closest edge of the Border. The distance is
+// positive if the position is within the Border and negative if it is outside of it.
+func (b Border) Distance(pos mgl64.Vec3) float64 {
+	if math.IsInf(b.Radius, 1) {
+		return b.Radius
+	}
+	dx, dz := math.Abs(pos[0]-b.Centre[0]), math.Abs(pos[2]-b.Centre[1])
+	return b.Radius - math.Max(dx, dz)
+}
+
+// border holds the Border of a World and the state of a resize of the Border in progress.
+type border struct {
+	mu sync.Mutex
+	b  Border
+	// target is the radius that the Border is resized to over the remaining ticks.
+	target float64
+	ticks  int64
+}
+
+// Border returns the current Border of the World. If no border was set using SetBorder, the Border returned has an
+// infinite radius.
+func (w *World) Border() Border {
+	if w == nil {
+		return Border{Radius: math.Inf(1)}
+	}
+	w.border.mu.Lock()
+	defer w.border.mu.Unlock()
+	return w.border.b
+}
+
+// SetBorder changes the Border of the World to a square border with the centre and radius passed, stopping any
+// resize that was in progress. Passing an infinite radius removes the border from the World.
+func (w *World) SetBorder(centre mgl64.Vec2, radius float64) {
+	if w == nil {
+		return
+	}
+	w.border.mu.Lock()
+	defer w.border.mu.Unlock()
+	w.border.b = Border{Centre: centre, Radius: math.Max(radius, 0)}
+	w.border.ticks = 0
+}
+
+// ResizeBorder gradually shrinks or expands the Border of the World to the radius passed over the duration passed.
+// The centre of the Border remains unchanged. A duration of 0 or less resizes the Border immediately.
+func (w *World) ResizeBorder(radius float64, duration time.Duration) {
+	if w == nil {
+		return
+	}
+	w.border.mu.Lock()
+	defer w.border.mu.Unlock()
+	radius = math.Max(radius, 0)
+
+	ticks := duration.Milliseconds() / 50
+	if ticks <= 0 || math.IsInf(w.border.b.Radius, 1) {
+		w.border.b.Radius, w.border.ticks = radius, 0
+		return
+	}
+	w.border.target, w.border.ticks = radius, ticks
+}
+
+// tickBorder moves the radius of the Border one tick closer to its target if it is being resized.
+func (w *World) tickBorder() {
+	w.border.mu.Lock()
+	defer w.border.mu.Unlock()
+	if w.border.ticks <= 0 {
+		return
+	}
+	w.border.b.Radius += (w.border.target - w.border.b.Radius) / float64(w.border.ticks)
+	w.border.ticks--
+}
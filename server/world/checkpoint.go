@@ -0,0 +1,142 @@
+package world
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"golang.org/x/exp/slices"
+	"math"
+)
+
+// Checkpoint holds a copy of the blocks, liquids and block entities of a set of chunks at the moment it was created
+// using World.Checkpoint. The chunks may later be restored to this state using World.Rollback, which only rewrites the
+// sub chunks that were changed since the Checkpoint was created. Checkpoints are intended for servers that reset an
+// arena after every match, as restoring a Checkpoint does not require the World to be reloaded.
+//
+// Entities are not part of a Checkpoint and are left untouched by World.Rollback.
+type Checkpoint struct {
+	chunks map[ChunkPos]checkpointChunk
+}
+
+// checkpointChunk holds the state of a single chunk in a Checkpoint.
+type checkpointChunk struct {
+	// sub holds copies of all sub chunks of the chunk, with versions holding the version of each of these sub
+	// chunks at the time they were copied.
+	sub      []*chunk.SubChunk
+	versions []uint64
+	// e holds the encoded NBT of all block entities in the chunk. Block entities are encoded so that restoring them
+	// creates new values, such as new inventories for chests.
+	e map[cube.Pos]map[string]any
+}
+
+// Checkpoint creates a Checkpoint of the chunks at the positions passed. Chunks that are not yet loaded are loaded, or
+// generated if they could not be found in the world save.
+func (w *World) Checkpoint(chunks ...ChunkPos) *Checkpoint {
+	cp := &Checkpoint{chunks: make(map[ChunkPos]checkpointChunk, len(chunks))}
+	if w == nil {
+		return cp
+	}
+	for _, pos := range chunks {
+		c := w.chunk(pos)
+		data := checkpointChunk{
+			sub:      make([]*chunk.SubChunk, len(c.Sub())),
+			versions: slices.Clone(c.subVersions),
+			e:        make(map[cube.Pos]map[string]any, len(c.e)),
+		}
+		for i, sub := range c.Sub() {
+			data.sub[i] = sub.Clone()
+		}
+		for p, b := range c.e {
+			data.e[p] = b.(NBTer).EncodeNBT()
+		}
+		c.Unlock()
+		cp.chunks[pos] = data
+	}
+	return cp
+}
+
+// Chunks returns the positions of all chunks held by the Checkpoint.
+func (cp *Checkpoint) Chunks() []ChunkPos {
+	positions := make([]ChunkPos, 0, len(cp.chunks))
+	for pos := range cp.chunks {
+		positions = append(positions, pos)
+	}
+	return positions
+}
+
+// Rollback restores all chunks of the Checkpoint passed to the state they were in when the Checkpoint was created.
+// All chunks of the Checkpoint are locked before any of them is restored and are only unlocked once all of them are
+// restored, so that no block changes can happen in them and no other goroutine observes a partially restored
+// Checkpoint. Only sub chunks that were changed since the Checkpoint was created are rewritten, after which the chunk
+// is resent to its viewers. Block entities are always restored. Pending block updates and scheduled ticks in the
+// restored chunks are not removed.
+func (w *World) Rollback(cp *Checkpoint) {
+	if w == nil {
+		return
+	}
+	chunks := make(map[ChunkPos]*chunkData, len(cp.chunks))
+	for pos := range cp.chunks {
+		// Make sure all chunks are loaded before locking them, as loading a chunk requires locking its neighbours.
+		c := w.chunk(pos)
+		c.Unlock()
+		chunks[pos] = c
+	}
+	// The chunks are locked while holding chunkMu, like when spreading light, so that no other goroutine is able to
+	// lock multiple chunks at the same time in a different order.
+	w.chunkMu.Lock()
+	for _, c := range chunks {
+		c.Lock()
+	}
+	w.chunkMu.Unlock()
+
+	restored := make([]ChunkPos, 0, len(cp.chunks))
+	for pos, data := range cp.chunks {
+		if w.rollbackChunk(pos, chunks[pos], data) {
+			restored = append(restored, pos)
+		}
+	}
+	for _, c := range chunks {
+		c.Unlock()
+	}
+
+	w.chunkMu.Lock()
+	for _, pos := range restored {
+		w.calculateLight(pos)
+	}
+	w.chunkMu.Unlock()
+}
+
+// rollbackChunk restores the chunk passed to the state held by the checkpointChunk passed. It returns true if any
+// blocks or block entities of the chunk were restored. The chunk must be locked when calling rollbackChunk.
+func (w *World) rollbackChunk(pos ChunkPos, c *chunkData, data checkpointChunk) bool {
+	dirty := len(c.e) != 0 || len(data.e) != 0
+	for i, version := range data.versions {
+		if c.subVersions[i] == version {
+			continue
+		}
+		c.SetSubChunk(int16(i), data.sub[i].Clone())
+		c.subVersions[i] = version
+		dirty = true
+	}
+	if !dirty {
+		return false
+	}
+	c.e = make(map[cube.Pos]Block, len(data.e))
+	for p, m := range data.e {
+		rid := c.Block(uint8(p[0]), int16(p[1]), uint8(p[2]), 0)
+		if b, ok := BlockByRuntimeID(rid); ok {
+			if nbter, ok := b.(NBTer); ok {
+				c.e[p] = nbter.DecodeNBT(m).(Block)
+			}
+		}
+	}
+	// The version of the chunk itself changes, but the sub chunks keep the versions of the Checkpoint, so that a
+	// later Rollback of the same Checkpoint can tell that they were not changed.
+	c.modified()
+
+	chunk.LightArea([]*chunk.Chunk{c.Chunk}, int(pos[0]), int(pos[1])).Fill()
+	c.lightChanged(math.MaxInt)
+	for _, viewer := range c.v {
+		viewer.ViewChunk(pos, c.Chunk, c.e)
+	}
+	return true
+}
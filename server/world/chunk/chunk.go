@@ -160,6 +160,58 @@ func (chunk *Chunk) Compact() {
 	}
 }
 
+// Clone returns a deep copy of the Chunk. The height map of the Chunk is recalculated if needed before it is copied.
+func (chunk *Chunk) Clone() *Chunk {
+	c := &Chunk{
+		r:         chunk.r,
+		air:       chunk.air,
+		heightMap: append(HeightMap(nil), chunk.HeightMap()...),
+		sub:       make([]*SubChunk, len(chunk.sub)),
+		biomes:    make([]*PalettedStorage, len(chunk.biomes)),
+	}
+	for i, sub := range chunk.sub {
+		c.sub[i] = sub.Clone()
+	}
+	for i, biome := range chunk.biomes {
+		c.biomes[i] = biome.clone()
+	}
+	return c
+}
+
+// CloneChanged returns a copy of the Chunk that shares the sub chunks of prev, an earlier copy of the Chunk, at the
+// indices for which changed is false. All other sub chunks and the biomes of the Chunk are cloned. CloneChanged may
+// be used to keep up-to-date copies of a Chunk without cloning the sub chunks that did not change. If prev is nil, a
+// full copy of the Chunk is returned, as with Clone.
+func (chunk *Chunk) CloneChanged(prev *Chunk, changed []bool) *Chunk {
+	if prev == nil || len(prev.sub) != len(chunk.sub) || len(changed) != len(chunk.sub) {
+		return chunk.Clone()
+	}
+	c := &Chunk{
+		r:         chunk.r,
+		air:       chunk.air,
+		heightMap: append(HeightMap(nil), chunk.HeightMap()...),
+		sub:       make([]*SubChunk, len(chunk.sub)),
+		biomes:    make([]*PalettedStorage, len(chunk.biomes)),
+	}
+	for i, sub := range chunk.sub {
+		if changed[i] {
+			c.sub[i] = sub.Clone()
+			continue
+		}
+		c.sub[i] = prev.sub[i]
+	}
+	for i, biome := range chunk.biomes {
+		c.biomes[i] = biome.clone()
+	}
+	return c
+}
+
+// SetSubChunk replaces the SubChunk at the sub chunk Y index passed with the SubChunk passed.
+func (chunk *Chunk) SetSubChunk(index int16, sub *SubChunk) {
+	chunk.sub[index] = sub
+	chunk.recalculateHeightMap = true
+}
+
 // SubChunk finds the correct SubChunk in the Chunk by a Y value.
 func (chunk *Chunk) SubChunk(y int16) *SubChunk {
 	return chunk.sub[chunk.SubIndex(y)]
@@ -16,13 +16,14 @@ type lightArea struct {
 }
 
 // LightArea creates a lightArea with the lower corner of the lightArea at baseX and baseY. The length of the Chunk
-// slice must be a square of a number, so 1, 4, 9 etc.
+// slice must be a square of a number, so 1, 4, 9 etc. Chunks that are not loaded may be passed as nil when only
+// calling Update, in which case light does not spread into them. The centre Chunk may never be nil.
 func LightArea(c []*Chunk, baseX, baseY int) *lightArea {
 	w := int(math.Sqrt(float64(len(c))))
 	if len(c) != w*w {
 		panic("area must have a square chunk area")
 	}
-	return &lightArea{c: c, w: w, baseX: baseX << 4, baseZ: baseY << 4, r: c[0].r}
+	return &lightArea{c: c, w: w, baseX: baseX << 4, baseZ: baseY << 4, r: c[len(c)/2].r}
 }
 
 // Fill executes the light 'filling' stage, where the lightArea is filled with light coming only from the
@@ -62,12 +63,12 @@ func (a *lightArea) setLight(pos cube.Pos, l light, v uint8) {
 }
 
 // neighbours returns all neighbour lightNode of the one passed. If one of these nodes would otherwise fall outside the
-// lightArea, it is not returned.
+// lightArea or inside a nil Chunk, it is not returned.
 func (a *lightArea) neighbours(n lightNode) []lightNode {
 	nodes := make([]lightNode, 0, 6)
 	for _, f := range cube.Faces() {
 		nn := lightNode{pos: n.pos.Side(f), lt: n.lt}
-		if nn.pos[1] <= a.r.Max() && nn.pos[1] >= a.r.Min() && nn.pos[0] >= a.baseX && nn.pos[2] >= a.baseZ && nn.pos[0] < a.baseX+a.w*16 && nn.pos[2] < a.baseZ+a.w*16 {
+		if nn.pos[1] <= a.r.Max() && nn.pos[1] >= a.r.Min() && nn.pos[0] >= a.baseX && nn.pos[2] >= a.baseZ && nn.pos[0] < a.baseX+a.w*16 && nn.pos[2] < a.baseZ+a.w*16 && a.chunk(nn.pos) != nil {
 			nodes = append(nodes, nn)
 		}
 	}
@@ -0,0 +1,93 @@
+package chunk
+
+import (
+	"container/list"
+	"github.com/df-mc/dragonfly/server/block/cube"
+)
+
+// Update incrementally updates the block light and skylight in the lightArea after the block at the cube.Pos
+// passed was changed. Light that originated from the old block, or that passed through the position before, is
+// removed first, after which light is spread again from the new block and from any light surrounding the removed
+// area. The lightArea should be centred around the chunk that the position is in, so that light is able to cross
+// into neighbouring chunks.
+func (a *lightArea) Update(pos cube.Pos) {
+	a.update(pos, BlockLight)
+	a.update(pos, SkyLight)
+}
+
+// update updates light of a specific light type around the cube.Pos passed.
+func (a *lightArea) update(pos cube.Pos, lt light) {
+	removal, queue := list.New(), list.New()
+	var sources []lightNode
+
+	if level := a.light(pos, lt); level > 0 {
+		a.setLight(pos, lt, 0)
+		removal.PushBack(node(pos, level, lt))
+	}
+	for removal.Len() != 0 {
+		n := removal.Remove(removal.Front()).(lightNode)
+		for _, neighbour := range a.neighbours(n) {
+			level := a.light(neighbour.pos, lt)
+			if level == 0 {
+				continue
+			}
+			if level < n.level || (lt == SkyLight && level == 15 && n.level == 15 && neighbour.pos[1] < n.pos[1]) {
+				// The light at this neighbour came from the removed light, so it needs to be removed too.
+				a.setLight(neighbour.pos, lt, 0)
+				removal.PushBack(node(neighbour.pos, level, lt))
+				continue
+			}
+			// This neighbour has light from a different source, which will need to be spread into the area that
+			// light was removed from.
+			sources = append(sources, node(neighbour.pos, level, lt))
+		}
+	}
+
+	// Light surrounding the changed block may now be able to spread into it if it stopped blocking light.
+	for _, neighbour := range a.neighbours(node(pos, 0, lt)) {
+		if level := a.light(neighbour.pos, lt); level > 0 {
+			sources = append(sources, node(neighbour.pos, level, lt))
+		}
+	}
+	switch lt {
+	case BlockLight:
+		if level := a.highest(pos, LightBlocks); level > 0 {
+			queue.PushBack(node(pos, level, lt))
+		}
+	case SkyLight:
+		if pos[1] == a.r.Max() {
+			if filter := a.highest(pos, FilteringBlocks); filter < 15 {
+				queue.PushBack(node(pos, 15-filter, lt))
+			}
+		}
+	}
+
+	for _, n := range sources {
+		a.spreadNeighbours(n, queue)
+	}
+	for queue.Len() != 0 {
+		n := queue.Remove(queue.Front()).(lightNode)
+		if a.light(n.pos, n.lt) >= n.level {
+			continue
+		}
+		a.setLight(n.pos, n.lt, n.level)
+		a.spreadNeighbours(n, queue)
+	}
+}
+
+// spreadNeighbours adds nodes to the queue passed for all neighbours of the lightNode that the light of the node is
+// able to spread into. Unlike regular propagation, skylight with a level of 15 spreads downwards without losing any
+// light through blocks that do not filter light, as the column below is directly exposed to the sky.
+func (a *lightArea) spreadNeighbours(n lightNode, queue *list.List) {
+	for _, neighbour := range a.neighbours(n) {
+		filtering := a.highest(neighbour.pos, FilteringBlocks)
+		filter := filtering + 1
+		if n.lt == SkyLight && n.level == 15 && filtering == 0 && neighbour.pos[1] < n.pos[1] {
+			filter = 0
+		}
+		if n.level > filter && a.light(neighbour.pos, n.lt) < n.level-filter {
+			neighbour.level = n.level - filter
+			queue.PushBack(neighbour)
+		}
+	}
+}
@@ -42,6 +42,13 @@ func (palette *Palette) Add(v uint32) (index int16, resize bool) {
 	return i, false
 }
 
+// set sets the value at the index passed in the Palette.
+func (palette *Palette) set(i int, v uint32) {
+	// Reset last runtime ID as the value it points to may have changed.
+	palette.last = math.MaxUint32
+	palette.values[i] = v
+}
+
 // Replace calls the function passed for each value present in the Palette. The value returned by the
 // function replaces the value present at the index of the value passed.
 func (palette *Palette) Replace(f func(v uint32) uint32) {
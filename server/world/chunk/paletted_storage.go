@@ -1,6 +1,7 @@
 package chunk
 
 import (
+	"golang.org/x/exp/slices"
 	"reflect"
 	"unsafe"
 )
@@ -37,6 +38,12 @@ type PalettedStorage struct {
 	// indices contains all indices in the PalettedStorage. This slice has a variable size, but may not be changed
 	// unless the whole PalettedStorage is resized, including the Palette.
 	indices []uint32
+
+	// counts holds the amount of times every index of the Palette is used in the PalettedStorage. It is nil until
+	// the PalettedStorage is first changed, after which it is kept up to date when values are set. unused is the
+	// amount of values in counts that are 0.
+	counts []uint16
+	unused int
 }
 
 // newPalettedStorage creates a new block storage using the uint32 slice as the indices and the palette passed.
@@ -54,6 +61,15 @@ func newPalettedStorage(indices []uint32, palette *Palette) *PalettedStorage {
 	return &PalettedStorage{filledBitsPerIndex: filledBitsPerIndex, indexMask: indexMask, indicesStart: indicesStart, bitsPerIndex: bitsPerIndex, indices: indices, palette: palette}
 }
 
+// clone returns a deep copy of the PalettedStorage.
+func (storage *PalettedStorage) clone() *PalettedStorage {
+	p := *storage.palette
+	p.values = slices.Clone(p.values)
+	c := newPalettedStorage(append(make([]uint32, 0, len(storage.indices)), storage.indices...), &p)
+	c.counts, c.unused = slices.Clone(storage.counts), storage.unused
+	return c
+}
+
 // emptyStorage creates a PalettedStorage filled completely with a value v.
 func emptyStorage(v uint32) *PalettedStorage {
 	return newPalettedStorage([]uint32{}, newPalette(0, []uint32{v}))
@@ -70,20 +86,67 @@ func (storage *PalettedStorage) At(x, y, z byte) uint32 {
 }
 
 // Set sets a value at a specific x, y and z. The Palette and PalettedStorage are expanded
-// automatically to make space for the value, should that be needed.
+// automatically to make space for the value, should that be needed. If a value is no longer used anywhere in the
+// PalettedStorage as a result, its index in the Palette may be reused by a new value. The PalettedStorage is never
+// shrunk by Set, so that changing a block back and forth does not resize it every time: It is shrunk when the chunk
+// holding it is compacted, which happens before it is saved.
 func (storage *PalettedStorage) Set(x, y, z byte, v uint32) {
+	x, y, z = x&15, y&15, z&15
+	if storage.counts == nil {
+		storage.count()
+	}
 	index := storage.palette.Index(v)
 	if index == -1 {
 		// The runtime ID was not yet available in the palette. We add it, then check if the block storage
 		// needs to be resized for the palette pointers to fit.
 		index = storage.addNew(v)
 	}
-	storage.setPaletteIndex(x&15, y&15, z&15, uint16(index))
+	prev := storage.paletteIndex(x, y, z)
+	if prev == uint16(index) {
+		return
+	}
+	storage.setPaletteIndex(x, y, z, uint16(index))
+	if storage.counts[index] == 0 {
+		storage.unused--
+	}
+	storage.counts[index]++
+	if storage.counts[prev]--; storage.counts[prev] == 0 {
+		storage.unused++
+	}
+}
+
+// count counts the amount of times every index of the Palette is used in the PalettedStorage.
+func (storage *PalettedStorage) count() {
+	storage.counts = make([]uint16, storage.palette.Len())
+	for x := byte(0); x < 16; x++ {
+		for y := byte(0); y < 16; y++ {
+			for z := byte(0); z < 16; z++ {
+				storage.counts[storage.paletteIndex(x, y, z)]++
+			}
+		}
+	}
+	storage.unused = 0
+	for _, n := range storage.counts {
+		if n == 0 {
+			storage.unused++
+		}
+	}
 }
 
-// addNew adds a new value to the PalettedStorage's Palette and returns its index. If needed, the storage is resized.
+// addNew adds a new value to the PalettedStorage's Palette and returns its index. An index of the Palette that is no
+// longer used is reused if possible. If needed, the storage is resized.
 func (storage *PalettedStorage) addNew(v uint32) int16 {
+	if storage.unused > 0 {
+		for i, n := range storage.counts {
+			if n == 0 {
+				storage.palette.set(i, v)
+				return int16(i)
+			}
+		}
+	}
 	index, resize := storage.palette.Add(v)
+	storage.counts = append(storage.counts, 0)
+	storage.unused++
 	if resize {
 		storage.resize(storage.palette.size)
 	}
@@ -130,6 +193,7 @@ func (storage *PalettedStorage) resize(newPaletteSize paletteSize) {
 	// Construct a new storage and set all values in there manually. We can't easily do this in a better
 	// way, because all values will be at a different index with a different length.
 	newStorage := newPalettedStorage(make([]uint32, newPaletteSize.uint32s()), storage.palette)
+	newStorage.counts, newStorage.unused = storage.counts, storage.unused
 	for x := byte(0); x < 16; x++ {
 		for y := byte(0); y < 16; y++ {
 			for z := byte(0); z < 16; z++ {
@@ -145,27 +209,29 @@ func (storage *PalettedStorage) resize(newPaletteSize paletteSize) {
 // relatively heavy task which should only happen right before the sub chunk holding this PalettedStorage is
 // saved to disk. compact also shrinks the palette size if possible.
 func (storage *PalettedStorage) compact() {
-	usedIndices := make([]bool, storage.palette.Len())
-	for x := byte(0); x < 16; x++ {
-		for y := byte(0); y < 16; y++ {
-			for z := byte(0); z < 16; z++ {
-				usedIndices[storage.paletteIndex(x, y, z)] = true
-			}
-		}
+	if storage.counts == nil {
+		storage.count()
+	}
+	if storage.unused == 0 {
+		// Every value in the palette is used, so there is nothing to compact.
+		return
 	}
-	newRuntimeIDs := make([]uint32, 0, len(usedIndices))
-	conversion := make([]uint16, len(usedIndices))
+	newRuntimeIDs := make([]uint32, 0, len(storage.counts)-storage.unused)
+	newCounts := make([]uint16, 0, len(storage.counts)-storage.unused)
+	conversion := make([]uint16, len(storage.counts))
 
-	for index, set := range usedIndices {
-		if set {
+	for index, n := range storage.counts {
+		if n != 0 {
 			conversion[index] = uint16(len(newRuntimeIDs))
 			newRuntimeIDs = append(newRuntimeIDs, storage.palette.values[index])
+			newCounts = append(newCounts, n)
 		}
 	}
 	// Construct a new storage and set all values in there manually. We can't easily do this in a better
 	// way, because all values will be at a different index with a different length.
 	size := paletteSizeFor(len(newRuntimeIDs))
 	newStorage := newPalettedStorage(make([]uint32, size.uint32s()), newPalette(size, newRuntimeIDs))
+	newStorage.counts = newCounts
 
 	for x := byte(0); x < 16; x++ {
 		for y := byte(0); y < 16; y++ {
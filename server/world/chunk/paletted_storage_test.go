@@ -0,0 +1,98 @@
+package chunk
+
+import (
+	"testing"
+)
+
+// TestPalettedStorageSet checks that values set in a PalettedStorage are read back correctly, also after the
+// storage is resized and compacted.
+func TestPalettedStorageSet(t *testing.T) {
+	s := emptyStorage(0)
+	for i := 0; i < 4096; i++ {
+		x, y, z := byte(i>>8), byte(i>>4)&15, byte(i)&15
+		s.Set(x, y, z, uint32(i%300))
+	}
+	check := func() {
+		for i := 0; i < 4096; i++ {
+			x, y, z := byte(i>>8), byte(i>>4)&15, byte(i)&15
+			if v := s.At(x, y, z); v != uint32(i%300) {
+				t.Fatalf("value at %v %v %v: expected %v, got %v", x, y, z, i%300, v)
+			}
+		}
+	}
+	check()
+	s.compact()
+	check()
+}
+
+// TestPalettedStorageCompact checks that compacting a PalettedStorage removes unused values from its Palette and
+// shrinks it.
+func TestPalettedStorageCompact(t *testing.T) {
+	s := emptyStorage(0)
+	for i := 0; i < 64; i++ {
+		s.Set(byte(i>>4), 0, byte(i&15), uint32(i+1))
+	}
+	for i := 0; i < 64; i++ {
+		s.Set(byte(i>>4), 0, byte(i&15), 0)
+	}
+	if s.bitsPerIndex == 0 {
+		t.Fatalf("storage shrunk before being compacted")
+	}
+	s.compact()
+	if s.palette.Len() != 1 || s.bitsPerIndex != 0 {
+		t.Fatalf("expected storage with a single value after compacting, got %v values and %v bits per index", s.palette.Len(), s.bitsPerIndex)
+	}
+	if v := s.At(0, 0, 0); v != 0 {
+		t.Fatalf("expected 0 after compacting, got %v", v)
+	}
+}
+
+// BenchmarkPalettedStorageAt benchmarks reading values from a PalettedStorage holding 16 different values.
+func BenchmarkPalettedStorageAt(b *testing.B) {
+	s := filledStorage(16)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.At(byte(i>>8), byte(i>>4), byte(i))
+	}
+}
+
+// BenchmarkPalettedStorageSet benchmarks setting values in a PalettedStorage, which grows its Palette until it holds
+// 16 different values.
+func BenchmarkPalettedStorageSet(b *testing.B) {
+	s := emptyStorage(0)
+	for i := 0; i < b.N; i++ {
+		s.Set(byte(i>>8), byte(i>>4), byte(i), uint32(i%16))
+	}
+}
+
+// BenchmarkPalettedStorageToggle benchmarks setting a single value back and forth between a value that is unique in
+// the PalettedStorage and one that is not, which adds and removes a value from its Palette every time.
+func BenchmarkPalettedStorageToggle(b *testing.B) {
+	s := filledStorage(2)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Set(0, 0, 0, uint32(100+i%2))
+	}
+}
+
+// BenchmarkPalettedStorageCompact benchmarks compacting a PalettedStorage that has half of its Palette unused.
+func BenchmarkPalettedStorageCompact(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		s := filledStorage(32)
+		for j := 0; j < 4096; j++ {
+			s.Set(byte(j>>8), byte(j>>4), byte(j), uint32(j%16))
+		}
+		b.StartTimer()
+		s.compact()
+	}
+}
+
+// filledStorage returns a PalettedStorage filled with n different values.
+func filledStorage(n int) *PalettedStorage {
+	s := emptyStorage(0)
+	for i := 0; i < 4096; i++ {
+		s.Set(byte(i>>8), byte(i>>4), byte(i), uint32(i%n))
+	}
+	return s
+}
@@ -1,5 +1,7 @@
 package chunk
 
+import "golang.org/x/exp/slices"
+
 // SubChunk is a cube of blocks located in a chunk. It has a size of 16x16x16 blocks and forms part of a stack
 // that forms a Chunk.
 type SubChunk struct {
@@ -88,6 +90,29 @@ func (sub *SubChunk) SkyLight(x, y, z byte) uint8 {
 	return (sub.skyLight[index>>1] >> ((index & 1) << 2)) & 0xf
 }
 
+// Clone returns a deep copy of the SubChunk, including its light.
+func (sub *SubChunk) Clone() *SubChunk {
+	c := &SubChunk{
+		air:        sub.air,
+		storages:   make([]*PalettedStorage, len(sub.storages)),
+		blockLight: cloneLight(sub.blockLight),
+		skyLight:   cloneLight(sub.skyLight),
+	}
+	for i, storage := range sub.storages {
+		c.storages[i] = storage.clone()
+	}
+	return c
+}
+
+// cloneLight returns a copy of the light slice passed. Completely lit or completely dark slices are shared by sub
+// chunks and copied when changed, so they are returned as is.
+func cloneLight(light []byte) []byte {
+	if ptr := &light[0]; ptr == fullLightPtr || ptr == noLightPtr {
+		return light
+	}
+	return slices.Clone(light)
+}
+
 // Compact cleans the garbage from all block storages that sub chunk contains, so that they may be
 // cleanly written to a database.
 func (sub *SubChunk) compact() {
@@ -3,7 +3,10 @@ package world
 import (
 	"github.com/df-mc/atomic"
 	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/scheduler"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	"math"
 	"math/rand"
 	"time"
 )
@@ -27,7 +30,16 @@ type Config struct {
 	// used will be NopGenerator, which generates completely empty chunks.
 	Generator Generator
 	// ReadOnly specifies if the World should be read-only, meaning no new data will be written to the Provider.
+	// Blocks may still be changed, but these changes are lost when the chunk they are in is unloaded. To keep
+	// changes until the World is closed without writing them to disk, a MemoryProvider may be used instead.
 	ReadOnly bool
+	// SaveInterval is the interval at which chunks modified since they were last saved are written to the Provider
+	// while they remain loaded. If set to 0, chunks are only saved when they are unloaded or when the World is
+	// closed.
+	SaveInterval time.Duration
+	// SaveRate is the maximum amount of chunks written to the Provider per second when saving modified chunks at
+	// the SaveInterval. If set to 0, there is no limit.
+	SaveRate int
 	// RandomTickSpeed specifies the rate at which blocks should be ticked in the World. By default, each sub chunk has
 	// 3 blocks randomly ticked per sub chunk, so the default value is 3. Setting this value to -1 or lower will stop
 	// random ticking altogether, while setting it higher results in faster ticking.
@@ -39,6 +51,27 @@ type Config struct {
 	// Entities is an EntityRegistry with all entity types registered that may
 	// be added to the World.
 	Entities EntityRegistry
+	// SpawnChunkRadius is the radius in chunks around the spawn of the World that is kept loaded at all times, even if
+	// no players are nearby. Blocks and entities in these chunks keep being ticked. If set to 0, no chunks are kept
+	// loaded.
+	SpawnChunkRadius int
+	// SpawnProtectionRadius is the radius in blocks around the spawn of the World in which blocks may only be edited
+	// by operators. If set to 0, spawn protection is disabled.
+	SpawnProtectionRadius int
+	// SpawnLocator is the SpawnLocator used to select the position that players without a spawn position of their
+	// own spawn at. If set to nil, a SpreadSpawnLocator with a Radius of 0 is used, which spawns players on a safe
+	// surface at the spawn of the World.
+	SpawnLocator SpawnLocator
+	// Physics is the Physics profile that entities in the World use to compute their movement. If nil,
+	// DefaultPhysics is used. Fields of a non-nil Physics are used as they are, so that setting a field such as
+	// EntityPushing or Drag to 0 disables it. The Physics may be changed later using World.SetPhysics.
+	Physics *Physics
+	// MobSpawning holds the settings of the natural spawning of entities in the World. If MobSpawning.Spawns is
+	// nil, no entities spawn naturally. Mob spawning may be toggled later using World.SetMobSpawning.
+	MobSpawning MobSpawning
+	// DeathMessages specifies if a message is broadcast in the global chat when a player in the World dies. Death
+	// messages are disabled by default.
+	DeathMessages bool
 }
 
 // Logger is a logger implementation that may be passed to the Log field of Config. World will send errors and debug
@@ -63,9 +96,16 @@ func (conf Config) New() *World {
 	if conf.Generator == nil {
 		conf.Generator = NopGenerator{}
 	}
+	if conf.SpawnLocator == nil {
+		conf.SpawnLocator = SpreadSpawnLocator{}
+	}
 	if conf.RandomTickSpeed == 0 {
 		conf.RandomTickSpeed = 3
 	}
+	if conf.Physics == nil {
+		p := DefaultPhysics()
+		conf.Physics = &p
+	}
 	if conf.RandSource == nil {
 		conf.RandSource = rand.NewSource(time.Now().Unix())
 	}
@@ -73,6 +113,8 @@ func (conf Config) New() *World {
 	w := &World{
 		scheduledUpdates: make(map[cube.Pos]int64),
 		entities:         make(map[Entity]ChunkPos),
+		entityIDs:        make(map[uuid.UUID]Entity),
+		biomeChanges:     make(map[ChunkPos]struct{}),
 		viewers:          make(map[*Loader]Viewer),
 		chunks:           make(map[ChunkPos]*chunkData),
 		closing:          make(chan struct{}),
@@ -82,10 +124,23 @@ func (conf Config) New() *World {
 		conf:             conf,
 		ra:               conf.Dim.Range(),
 		set:              s,
+		border:           border{b: Border{Radius: math.Inf(1)}},
+		scheduler:        scheduler.New(),
 	}
 	w.weather, w.ticker = weather{w: w}, ticker{w: w}
+	w.physics.p.Store(*conf.Physics)
+	w.spawning.conf = conf.MobSpawning.withDefaults()
+	w.spawning.enabled.Store(true)
+	if conf.SpawnChunkRadius > 0 {
+		w.spawnLoader = NewLoader(conf.SpawnChunkRadius, w, NopViewer{})
+		w.spawnLoader.Move(s.Spawn.Vec3())
+	}
 
 	go w.tickLoop()
 	go w.chunkCacheJanitor()
+	if conf.SaveInterval > 0 && !conf.ReadOnly {
+		w.running.Add(1)
+		go w.autoSave()
+	}
 	return w
 }
@@ -51,6 +51,16 @@ type SaveableEntityType interface {
 	EncodeNBT(e Entity) map[string]any
 }
 
+// SpawnableEntityType is an EntityType that is able to create a new Entity
+// of its type at a position without any additional data. It is used by spawn
+// eggs and the summon command to spawn entities.
+type SpawnableEntityType interface {
+	EntityType
+	// Spawn creates a new Entity of the EntityType at the position passed.
+	// The Entity is not added to a World yet.
+	Spawn(pos mgl64.Vec3) Entity
+}
+
 // TickerEntity represents an entity that has a Tick method which should be called every time the entity is
 // ticked every 20th of a second.
 type TickerEntity interface {
@@ -87,6 +97,18 @@ type HealingSource interface {
 	HealingSource()
 }
 
+// TeleportCause represents the cause of an entity being teleported, such as a
+// portal or an ender pearl. It also influences how the entity is teleported.
+type TeleportCause interface {
+	// ConservesMotion specifies if the velocity of an entity is kept when it
+	// is teleported, rather than being reset.
+	ConservesMotion() bool
+	// Cooldown is the duration after being teleported during which an entity
+	// cannot be teleported again by a TeleportCause of the same type. If 0, no
+	// cooldown is applied.
+	Cooldown() time.Duration
+}
+
 // EntityRegistry is a mapping that EntityTypes may be registered to. It is used
 // for loading entities from disk in a World's Provider.
 type EntityRegistry struct {
@@ -106,11 +128,13 @@ type EntityRegistryConfig struct {
 	Arrow              func(pos, vel mgl64.Vec3, yaw, pitch, damage float64, owner Entity, critical, disallowPickup, obtainArrowOnPickup bool, punchLevel int, tip any) Entity
 	Egg                func(pos, vel mgl64.Vec3, owner Entity) Entity
 	EnderPearl         func(pos, vel mgl64.Vec3, owner Entity) Entity
+	EndCrystal         func(pos mgl64.Vec3, showBase bool) Entity
 	Firework           func(pos mgl64.Vec3, yaw, pitch float64, attached bool, firework Item, owner Entity) Entity
 	LingeringPotion    func(pos, vel mgl64.Vec3, t any, owner Entity) Entity
 	Snowball           func(pos, vel mgl64.Vec3, owner Entity) Entity
 	SplashPotion       func(pos, vel mgl64.Vec3, t any, owner Entity) Entity
 	Lightning          func(pos mgl64.Vec3) Entity
+	LeashKnot          func(pos cube.Pos) Entity
 }
 
 // New creates an EntityRegistry using conf and the EntityTypes passed.
@@ -139,6 +163,13 @@ func (reg EntityRegistry) Lookup(name string) (EntityType, bool) {
 	return t, ok
 }
 
+// With returns a copy of the EntityRegistry with the EntityTypes passed
+// registered in addition to the EntityTypes already registered. With panics if
+// an EntityType with the same name is already registered.
+func (reg EntityRegistry) With(ent ...EntityType) EntityRegistry {
+	return reg.conf.New(append(reg.Types(), ent...))
+}
+
 // Types returns all EntityTypes passed upon construction of the EntityRegistry.
 func (reg EntityRegistry) Types() []EntityType {
 	return maps.Values(reg.ent)
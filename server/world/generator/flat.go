@@ -13,18 +13,16 @@ type Flat struct {
 	// layers is a list of block runtime ID layers placed by the Flat generator. The layers are ordered in a way where
 	// the last element in the slice is placed as the bottom-most block of the chunk.
 	layers []uint32
-	// n is the amount of layers in the slice above.
-	n int16
 }
 
 // NewFlat creates a new Flat generator. Chunks generated are completely filled with the world.Biome passed. layers is a
 // list of block layers placed by the Flat generator. The layers are ordered in a way where the last element in the
-// slice is placed as the bottom-most block of the chunk.
+// slice is placed as the bottom-most block of the chunk. Layers that do not fit in the height of a chunk are not
+// placed.
 func NewFlat(biome world.Biome, layers []world.Block) Flat {
 	f := Flat{
 		biome:  uint32(biome.EncodeBiome()),
 		layers: make([]uint32, len(layers)),
-		n:      int16(len(layers)),
 	}
 	for i, b := range layers {
 		f.layers[i] = world.BlockRuntimeID(b)
@@ -32,18 +30,33 @@ func NewFlat(biome world.Biome, layers []world.Block) Flat {
 	return f
 }
 
+// BiomeAt ...
+func (f Flat) BiomeAt(int, int) world.Biome {
+	b, _ := world.BiomeByID(int(f.biome))
+	return b
+}
+
 // GenerateChunk ...
 func (f Flat) GenerateChunk(_ world.ChunkPos, chunk *chunk.Chunk) {
-	min, max := int16(chunk.Range().Min()), int16(chunk.Range().Max())
+	min, n := chunk.Range().Min(), len(f.layers)
+	if h := chunk.Range().Height(); n > h {
+		n = h
+	}
 
 	for x := uint8(0); x < 16; x++ {
 		for z := uint8(0); z < 16; z++ {
-			for y := int16(0); y <= max; y++ {
-				if y < f.n {
-					chunk.SetBlock(x, min+y, z, 0, f.layers[f.n-y-1])
-				}
-				chunk.SetBiome(x, min+y, z, f.biome)
+			for y := 0; y < n; y++ {
+				chunk.SetBlock(x, int16(min+y), z, 0, f.layers[len(f.layers)-y-1])
 			}
+			fillBiome(chunk, x, z, f.biome)
 		}
 	}
 }
+
+// fillBiome sets the biome of the column at the X and Z coordinates in the chunk passed. Biomes are stored in three
+// dimensions, so the full height of the chunk must be filled for the biome to be the same everywhere in the column.
+func fillBiome(c *chunk.Chunk, x, z uint8, biome uint32) {
+	for y := c.Range().Min(); y <= c.Range().Max(); y++ {
+		c.SetBiome(x, int16(y), z, biome)
+	}
+}
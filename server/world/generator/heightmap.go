@@ -0,0 +1,183 @@
+package generator
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/biome"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"image"
+	"image/color"
+	_ "image/png"
+	"os"
+)
+
+// HeightmapConfig holds the blocks and settings used by a Heightmap generator to build terrain.
+type HeightmapConfig struct {
+	// MinHeight is the Y coordinate of the surface where the heightmap is black. MaxHeight is the Y coordinate of the
+	// surface where the heightmap is white. Shades of grey in between are scaled linearly between the two.
+	MinHeight, MaxHeight int
+	// Surface is the block placed at the top of every column, such as grass.
+	Surface world.Block
+	// Filler is the block placed in the FillerDepth blocks directly below the Surface block, such as dirt.
+	Filler world.Block
+	// FillerDepth is the amount of Filler blocks placed below the Surface block. If set to 0, three Filler blocks are
+	// placed.
+	FillerDepth int
+	// Base is the block that fills the rest of every column below the Filler blocks, such as stone.
+	Base world.Block
+	// Water is the block that fills columns of which the surface is below the SeaLevel up to the SeaLevel. If set to
+	// nil, no water is placed.
+	Water world.Block
+	// SeaLevel is the Y coordinate up to which Water is placed.
+	SeaLevel int
+	// Biome is the world.Biome used for all columns that are not covered by the biome map or of which the colour is
+	// not present in Biomes. If set to nil, biome.Plains is used.
+	Biome world.Biome
+	// Biomes maps colours of the biome map to the world.Biome used for columns of that colour.
+	Biomes map[color.RGBA]world.Biome
+}
+
+// Heightmap is a generator that builds terrain from a heightmap image and, optionally, a biome map image. Every pixel
+// of the images corresponds to one column of blocks, with the top-left pixel placed at X and Z coordinate 0. The
+// brightness of a pixel in the heightmap decides the height of the surface of its column, while the colour of a pixel
+// in the biome map decides the biome. Columns outside the heightmap are left empty. Heightmap may be constructed by
+// calling NewHeightmap or LoadHeightmap.
+type Heightmap struct {
+	height, biomes image.Image
+	conf           HeightmapConfig
+
+	surface, filler, base, water uint32
+	biome                        uint32
+	biomeColours                 map[color.RGBA]uint32
+}
+
+// NewHeightmap creates a new Heightmap generator using the heightmap and biome map images passed. The biome map may be
+// nil, in which case HeightmapConfig.Biome is used for every column. The Surface, Filler and Base blocks of the
+// HeightmapConfig must not be nil.
+func NewHeightmap(height, biomes image.Image, conf HeightmapConfig) Heightmap {
+	if conf.FillerDepth == 0 {
+		conf.FillerDepth = 3
+	}
+	if conf.Biome == nil {
+		conf.Biome = biome.Plains{}
+	}
+	h := Heightmap{
+		height:       height,
+		biomes:       biomes,
+		conf:         conf,
+		surface:      world.BlockRuntimeID(conf.Surface),
+		filler:       world.BlockRuntimeID(conf.Filler),
+		base:         world.BlockRuntimeID(conf.Base),
+		biome:        uint32(conf.Biome.EncodeBiome()),
+		biomeColours: make(map[color.RGBA]uint32, len(conf.Biomes)),
+	}
+	if conf.Water != nil {
+		h.water = world.BlockRuntimeID(conf.Water)
+	}
+	for c, b := range conf.Biomes {
+		h.biomeColours[c] = uint32(b.EncodeBiome())
+	}
+	return h
+}
+
+// LoadHeightmap reads the heightmap and biome map images from the files at the paths passed and creates a Heightmap
+// generator using them. biomePath may be empty to use HeightmapConfig.Biome for every column. The images may be of any
+// format registered with the image package, such as PNG.
+func LoadHeightmap(heightPath, biomePath string, conf HeightmapConfig) (Heightmap, error) {
+	height, err := readImage(heightPath)
+	if err != nil {
+		return Heightmap{}, fmt.Errorf("read heightmap: %w", err)
+	}
+	var biomes image.Image
+	if biomePath != "" {
+		if biomes, err = readImage(biomePath); err != nil {
+			return Heightmap{}, fmt.Errorf("read biome map: %w", err)
+		}
+	}
+	return NewHeightmap(height, biomes, conf), nil
+}
+
+// readImage opens and decodes the image at the path passed.
+func readImage(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	return img, err
+}
+
+// BiomeAt ...
+func (h Heightmap) BiomeAt(x, z int) world.Biome {
+	b, _ := world.BiomeByID(int(h.biomeAt(x, z)))
+	return b
+}
+
+// GenerateChunk ...
+func (h Heightmap) GenerateChunk(pos world.ChunkPos, chunk *chunk.Chunk) {
+	min, max := chunk.Range().Min(), chunk.Range().Max()
+
+	for x := uint8(0); x < 16; x++ {
+		for z := uint8(0); z < 16; z++ {
+			px, pz := int(pos[0])*16+int(x), int(pos[1])*16+int(z)
+			surface, ok := h.surfaceHeight(px, pz)
+			if !ok {
+				continue
+			}
+			surface = clamp(surface, min, max)
+			for y := min; y <= surface; y++ {
+				rid := h.base
+				if y == surface {
+					rid = h.surface
+				} else if y >= surface-h.conf.FillerDepth {
+					rid = h.filler
+				}
+				chunk.SetBlock(x, int16(y), z, 0, rid)
+			}
+			if h.conf.Water != nil {
+				for y := surface + 1; y <= clamp(h.conf.SeaLevel, min, max); y++ {
+					chunk.SetBlock(x, int16(y), z, 0, h.water)
+				}
+			}
+			fillBiome(chunk, x, z, h.biomeAt(px, pz))
+		}
+	}
+}
+
+// surfaceHeight returns the Y coordinate of the surface of the column at the X and Z coordinates passed. False is
+// returned if the column is not covered by the heightmap.
+func (h Heightmap) surfaceHeight(x, z int) (int, bool) {
+	p := h.height.Bounds().Min.Add(image.Pt(x, z))
+	if !p.In(h.height.Bounds()) {
+		return 0, false
+	}
+	brightness := int(color.Gray16Model.Convert(h.height.At(p.X, p.Y)).(color.Gray16).Y)
+	return h.conf.MinHeight + brightness*(h.conf.MaxHeight-h.conf.MinHeight)/0xffff, true
+}
+
+// biomeAt returns the encoded biome of the column at the X and Z coordinates passed.
+func (h Heightmap) biomeAt(x, z int) uint32 {
+	if h.biomes == nil {
+		return h.biome
+	}
+	p := h.biomes.Bounds().Min.Add(image.Pt(x, z))
+	if !p.In(h.biomes.Bounds()) {
+		return h.biome
+	}
+	if b, ok := h.biomeColours[color.RGBAModel.Convert(h.biomes.At(p.X, p.Y)).(color.RGBA)]; ok {
+		return b
+	}
+	return h.biome
+}
+
+// clamp clamps v between the minimum and maximum passed.
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
@@ -0,0 +1,109 @@
+package noise
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"math"
+)
+
+// CaveCarver carves caves out of terrain using three-dimensional noise. Blocks are carved where the absolute value of
+// the noise is below the Threshold, which produces long, winding tunnels.
+type CaveCarver struct {
+	// Noise is the noise function used to carve the caves.
+	Noise Noise
+	// Frequency is the factor by which block coordinates are multiplied before being passed to the Noise. Lower
+	// values produce wider caves. If set to 0, a frequency of 1/32 is used.
+	Frequency float64
+	// Threshold is the value that the absolute value of the Noise must be below for a block to be carved. Higher
+	// values produce more caves. If set to 0, a threshold of 0.05 is used.
+	Threshold float64
+	// MinY and MaxY are the lowest and highest Y coordinates at which blocks may be carved. If both are 0, blocks may
+	// be carved at any height.
+	MinY, MaxY int
+}
+
+// Carved checks if the block at the X, Y and Z coordinates passed is carved by the CaveCarver.
+func (c CaveCarver) Carved(x, y, z int) bool {
+	if (c.MinY != 0 || c.MaxY != 0) && (y < c.MinY || y > c.MaxY) {
+		return false
+	}
+	f, threshold := c.Frequency, c.Threshold
+	if f == 0 {
+		f = 1.0 / 32
+	}
+	if threshold == 0 {
+		threshold = 0.05
+	}
+	return math.Abs(c.Noise.Noise3D(float64(x)*f, float64(y)*f, float64(z)*f)) < threshold
+}
+
+// Carve replaces all blocks in the chunk passed that are carved by the CaveCarver with the block passed, typically
+// air. Blocks at the bottom of the chunk are never carved.
+func (c CaveCarver) Carve(pos world.ChunkPos, ch *chunk.Chunk, b world.Block) {
+	rid := world.BlockRuntimeID(b)
+	min := ch.Range().Min()
+	for x := uint8(0); x < 16; x++ {
+		for z := uint8(0); z < 16; z++ {
+			bx, bz := int(pos[0])*16+int(x), int(pos[1])*16+int(z)
+			for y := int(ch.HighestBlock(x, z)); y > min; y-- {
+				if c.Carved(bx, y, bz) {
+					ch.SetBlock(x, int16(y), z, 0, rid)
+				}
+			}
+		}
+	}
+}
+
+// RiverCarver carves rivers into the surface of terrain using two-dimensional noise. Rivers follow the lines where
+// the noise is close to zero, with the river being deepest at its centre.
+type RiverCarver struct {
+	// Noise is the noise function used to carve the rivers.
+	Noise Noise
+	// Frequency is the factor by which block coordinates are multiplied before being passed to the Noise. Lower
+	// values produce longer rivers that are further apart. If set to 0, a frequency of 1/256 is used.
+	Frequency float64
+	// Width is the value that the absolute value of the Noise must be below for a column to be part of a river.
+	// Higher values produce wider rivers. If set to 0, a width of 0.02 is used.
+	Width float64
+	// Depth is the depth of a river at its centre. If set to 0, rivers are 4 blocks deep.
+	Depth int
+}
+
+// CarveDepth returns the amount of blocks carved below the surface of the column at the X and Z coordinates passed. 0
+// is returned if the column is not part of a river.
+func (r RiverCarver) CarveDepth(x, z int) int {
+	f, width, depth := r.Frequency, r.Width, r.Depth
+	if f == 0 {
+		f = 1.0 / 256
+	}
+	if width == 0 {
+		width = 0.02
+	}
+	if depth == 0 {
+		depth = 4
+	}
+	v := math.Abs(r.Noise.Noise2D(float64(x)*f, float64(z)*f))
+	if v >= width {
+		return 0
+	}
+	return int(math.Ceil(float64(depth) * (1 - v/width)))
+}
+
+// Carve carves the rivers of the RiverCarver into the chunk passed. Carved blocks are replaced with the block passed,
+// typically water, up to the original surface of the column.
+func (r RiverCarver) Carve(pos world.ChunkPos, ch *chunk.Chunk, b world.Block) {
+	rid := world.BlockRuntimeID(b)
+	min := ch.Range().Min()
+	for x := uint8(0); x < 16; x++ {
+		for z := uint8(0); z < 16; z++ {
+			depth := r.CarveDepth(int(pos[0])*16+int(x), int(pos[1])*16+int(z))
+			if depth == 0 {
+				continue
+			}
+			surface := int(ch.HighestBlock(x, z))
+			for y := surface; y > surface-depth && y > min; y-- {
+				ch.SetBlock(x, int16(y), z, 0, rid)
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Package noise implements seeded noise primitives, splines and carvers that may be composed by world generators.
+//
+// All primitives in this package are seed-stable: Constructing a primitive with the same seed always produces the
+// same values, regardless of the platform the server runs on. The output of a primitive for a given seed is
+// guaranteed not to change as long as Version does not change, so that worlds generated using this package continue
+// to generate the same terrain after the server is updated. If the output of a primitive ever needs to change, Version
+// is incremented.
+package noise
+
+// Version is the version of the noise algorithms implemented in this package. The values produced by the primitives in
+// this package for a given seed remain the same for as long as Version is unchanged.
+const Version = 1
+
+// Noise is a noise function that produces continuous, pseudo-random values in the range [-1, 1] for any coordinates.
+type Noise interface {
+	// Noise2D returns the value of the noise at the X and Z coordinates passed.
+	Noise2D(x, z float64) float64
+	// Noise3D returns the value of the noise at the X, Y and Z coordinates passed.
+	Noise3D(x, y, z float64) float64
+}
+
+// random is a SplitMix64 pseudo-random number generator. It is used over math/rand so that the values generated for a
+// seed are defined entirely by this package.
+type random struct {
+	state uint64
+}
+
+// newRandom creates a new random seeded with the seed passed.
+func newRandom(seed int64) *random {
+	return &random{state: uint64(seed)}
+}
+
+// next returns the next pseudo-random uint64.
+func (r *random) next() uint64 {
+	r.state += 0x9e3779b97f4a7c15
+	z := r.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// float returns a pseudo-random float64 in the range [0, 1).
+func (r *random) float() float64 {
+	return float64(r.next()>>11) / (1 << 53)
+}
+
+// intn returns a pseudo-random int in the range [0, n).
+func (r *random) intn(n int) int {
+	return int(r.next() % uint64(n))
+}
+
+// permutation returns a pseudo-random permutation of the numbers 0-255, repeated once so that it may be indexed
+// without wrapping.
+func (r *random) permutation() (perm [512]uint8) {
+	for i := 0; i < 256; i++ {
+		perm[i] = uint8(i)
+	}
+	for i := 255; i > 0; i-- {
+		j := r.intn(i + 1)
+		perm[i], perm[j] = perm[j], perm[i]
+	}
+	copy(perm[256:], perm[:256])
+	return perm
+}
+
+// floor returns the largest integer smaller than or equal to v.
+func floor(v float64) int {
+	i := int(v)
+	if v < float64(i) {
+		return i - 1
+	}
+	return i
+}
+
+// lerp linearly interpolates between a and b using t.
+func lerp(t, a, b float64) float64 {
+	return a + t*(b-a)
+}
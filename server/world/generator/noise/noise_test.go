@@ -0,0 +1,45 @@
+package noise
+
+import (
+	"math"
+	"testing"
+)
+
+// goldenNoise holds noise values produced with a seed of 42 at fixed coordinates. The values must never change, as
+// any change would alter the terrain of worlds generated with the same seed.
+var goldenNoise = []struct {
+	x, y, z              float64
+	perlin2D, perlin3D   float64
+	simplex2D, simplex3D float64
+	octave2D, octave3D   float64
+}{
+	{0.5, 1.25, -3.75, 0.07771425799701748, -0.2858477990621199, -0.6278764552423461, -0.4177161008230453, -0.06172020506212974, -0.05640182416274494},
+	{12.3, -4.1, 7.9, -0.19820675300277657, -0.3371010293771238, -0.2324128442503734, -0.013822541432098165, 0.10721963367638736, -0.026976526076606187},
+	{-100.7, 20.2, 55.5, -0.13768533786071557, 0.31146316657663403, 0.05859774407602081, 0.6016210962963223, 0.17842426923755747, 0.032733926441907},
+}
+
+// TestNoiseSeedStability checks that the Perlin, Simplex and Octave noise functions produce the same values for the
+// same seed as they always have.
+func TestNoiseSeedStability(t *testing.T) {
+	p, s, o := NewPerlin(42), NewSimplex(42), NewOctave(42, 4, 0.5, 2)
+	check := func(name string, expected, got float64) {
+		if math.Abs(expected-got) > 1e-9 {
+			t.Fatalf("%v: expected %v, got %v", name, expected, got)
+		}
+	}
+	for _, g := range goldenNoise {
+		check("Perlin.Noise2D", g.perlin2D, p.Noise2D(g.x, g.z))
+		check("Perlin.Noise3D", g.perlin3D, p.Noise3D(g.x, g.y, g.z))
+		check("Simplex.Noise2D", g.simplex2D, s.Noise2D(g.x, g.z))
+		check("Simplex.Noise3D", g.simplex3D, s.Noise3D(g.x, g.y, g.z))
+		check("Octave.Noise2D", g.octave2D, o.Noise2D(g.x, g.z))
+		check("Octave.Noise3D", g.octave3D, o.Noise3D(g.x, g.y, g.z))
+	}
+}
+
+// TestNoiseSeedDifference checks that noise functions created with different seeds produce different values.
+func TestNoiseSeedDifference(t *testing.T) {
+	if a, b := NewPerlin(1).Noise2D(0.5, 0.5), NewPerlin(2).Noise2D(0.5, 0.5); a == b {
+		t.Fatalf("expected different values for different seeds, got %v for both", a)
+	}
+}
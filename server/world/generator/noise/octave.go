@@ -0,0 +1,50 @@
+package noise
+
+// Octave is a fractal noise function that sums multiple octaves of Perlin noise, each with a higher frequency and a
+// lower amplitude than the previous one. Octave noise produces more detailed terrain than a single Perlin noise
+// function. Octave may be constructed by calling NewOctave.
+type Octave struct {
+	octaves                 []Perlin
+	persistence, lacunarity float64
+	// norm is the sum of the amplitudes of all octaves, used to keep the noise in the range [-1, 1].
+	norm float64
+}
+
+// NewOctave creates a new Octave noise function with the amount of octaves passed, using the seed passed. persistence
+// is the factor by which the amplitude of every octave is multiplied compared to the previous one, and lacunarity is
+// the factor by which its frequency is multiplied. A persistence of 0.5 and a lacunarity of 2 are commonly used.
+func NewOctave(seed int64, octaves int, persistence, lacunarity float64) Octave {
+	if octaves < 1 {
+		octaves = 1
+	}
+	o := Octave{octaves: make([]Perlin, octaves), persistence: persistence, lacunarity: lacunarity}
+	r, amplitude := newRandom(seed), 1.0
+	for i := range o.octaves {
+		o.octaves[i] = NewPerlin(int64(r.next()))
+		o.norm += amplitude
+		amplitude *= persistence
+	}
+	return o
+}
+
+// Noise2D ...
+func (o Octave) Noise2D(x, z float64) float64 {
+	var sum float64
+	amplitude, frequency := 1.0, 1.0
+	for _, p := range o.octaves {
+		sum += p.Noise2D(x*frequency, z*frequency) * amplitude
+		amplitude, frequency = amplitude*o.persistence, frequency*o.lacunarity
+	}
+	return sum / o.norm
+}
+
+// Noise3D ...
+func (o Octave) Noise3D(x, y, z float64) float64 {
+	var sum float64
+	amplitude, frequency := 1.0, 1.0
+	for _, p := range o.octaves {
+		sum += p.Noise3D(x*frequency, y*frequency, z*frequency) * amplitude
+		amplitude, frequency = amplitude*o.persistence, frequency*o.lacunarity
+	}
+	return sum / o.norm
+}
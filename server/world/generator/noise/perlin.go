@@ -0,0 +1,74 @@
+package noise
+
+// Perlin is an implementation of Ken Perlin's improved gradient noise. Perlin noise is smooth and suited for terrain
+// heights and densities. Perlin may be constructed by calling NewPerlin.
+type Perlin struct {
+	perm [512]uint8
+	// offset is added to all coordinates, so that the noise is not always zero at the origin for every seed.
+	offset [3]float64
+}
+
+// NewPerlin creates a new Perlin noise function using the seed passed.
+func NewPerlin(seed int64) Perlin {
+	r := newRandom(seed)
+	return Perlin{
+		offset: [3]float64{r.float() * 256, r.float() * 256, r.float() * 256},
+		perm:   r.permutation(),
+	}
+}
+
+// Noise2D ...
+func (p Perlin) Noise2D(x, z float64) float64 {
+	return p.Noise3D(x, 0, z)
+}
+
+// Noise3D ...
+func (p Perlin) Noise3D(x, y, z float64) float64 {
+	x, y, z = x+p.offset[0], y+p.offset[1], z+p.offset[2]
+	fx, fy, fz := floor(x), floor(y), floor(z)
+	x, y, z = x-float64(fx), y-float64(fy), z-float64(fz)
+	xi, yi, zi := fx&255, fy&255, fz&255
+	u, v, w := fade(x), fade(y), fade(z)
+
+	a := int(p.perm[xi]) + yi
+	aa, ab := int(p.perm[a])+zi, int(p.perm[a+1])+zi
+	b := int(p.perm[xi+1]) + yi
+	ba, bb := int(p.perm[b])+zi, int(p.perm[b+1])+zi
+
+	return lerp(w,
+		lerp(v,
+			lerp(u, gradient(p.perm[aa], x, y, z), gradient(p.perm[ba], x-1, y, z)),
+			lerp(u, gradient(p.perm[ab], x, y-1, z), gradient(p.perm[bb], x-1, y-1, z)),
+		),
+		lerp(v,
+			lerp(u, gradient(p.perm[aa+1], x, y, z-1), gradient(p.perm[ba+1], x-1, y, z-1)),
+			lerp(u, gradient(p.perm[ab+1], x, y-1, z-1), gradient(p.perm[bb+1], x-1, y-1, z-1)),
+		),
+	)
+}
+
+// fade applies the quintic fade curve 6t^5 - 15t^4 + 10t^3 to t.
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+// gradient returns the dot product of the gradient selected by the hash passed and the vector x, y, z.
+func gradient(hash uint8, x, y, z float64) float64 {
+	h := hash & 15
+	u, v := y, z
+	if h < 8 {
+		u = x
+	}
+	if h < 4 {
+		v = y
+	} else if h == 12 || h == 14 {
+		v = x
+	}
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+	return u + v
+}
@@ -0,0 +1,105 @@
+package noise
+
+import "math"
+
+// Simplex is an implementation of simplex noise. Simplex noise has fewer directional artifacts than Perlin noise and is
+// cheaper to compute in three dimensions. Simplex may be constructed by calling NewSimplex.
+type Simplex struct {
+	perm [512]uint8
+}
+
+// NewSimplex creates a new Simplex noise function using the seed passed.
+func NewSimplex(seed int64) Simplex {
+	return Simplex{perm: newRandom(seed).permutation()}
+}
+
+var (
+	// simplexGradients holds the gradients used by Simplex noise.
+	simplexGradients = [12][3]float64{
+		{1, 1, 0}, {-1, 1, 0}, {1, -1, 0}, {-1, -1, 0},
+		{1, 0, 1}, {-1, 0, 1}, {1, 0, -1}, {-1, 0, -1},
+		{0, 1, 1}, {0, -1, 1}, {0, 1, -1}, {0, -1, -1},
+	}
+	// f2, g2, f3 and g3 are the skewing and unskewing factors used in two and three dimensions.
+	f2, g2 = 0.5 * (math.Sqrt(3) - 1), (3 - math.Sqrt(3)) / 6
+	f3, g3 = 1.0 / 3.0, 1.0 / 6.0
+)
+
+// Noise2D ...
+func (s Simplex) Noise2D(x, z float64) float64 {
+	skew := (x + z) * f2
+	i, j := floor(x+skew), floor(z+skew)
+	unskew := float64(i+j) * g2
+	x0, z0 := x-(float64(i)-unskew), z-(float64(j)-unskew)
+
+	i1, j1 := 0, 1
+	if x0 > z0 {
+		i1, j1 = 1, 0
+	}
+	x1, z1 := x0-float64(i1)+g2, z0-float64(j1)+g2
+	x2, z2 := x0-1+2*g2, z0-1+2*g2
+
+	ii, jj := i&255, j&255
+	return 70 * (s.corner2D(s.perm[ii+int(s.perm[jj])], x0, z0) +
+		s.corner2D(s.perm[ii+i1+int(s.perm[jj+j1])], x1, z1) +
+		s.corner2D(s.perm[ii+1+int(s.perm[jj+1])], x2, z2))
+}
+
+// corner2D returns the contribution of a single corner of a two-dimensional simplex.
+func (s Simplex) corner2D(hash uint8, x, z float64) float64 {
+	t := 0.5 - x*x - z*z
+	if t < 0 {
+		return 0
+	}
+	g := simplexGradients[hash%12]
+	t *= t
+	return t * t * (g[0]*x + g[1]*z)
+}
+
+// Noise3D ...
+func (s Simplex) Noise3D(x, y, z float64) float64 {
+	skew := (x + y + z) * f3
+	i, j, k := floor(x+skew), floor(y+skew), floor(z+skew)
+	unskew := float64(i+j+k) * g3
+	x0, y0, z0 := x-(float64(i)-unskew), y-(float64(j)-unskew), z-(float64(k)-unskew)
+
+	var i1, j1, k1, i2, j2, k2 int
+	if x0 >= y0 {
+		if y0 >= z0 {
+			i1, j1, k1, i2, j2, k2 = 1, 0, 0, 1, 1, 0
+		} else if x0 >= z0 {
+			i1, j1, k1, i2, j2, k2 = 1, 0, 0, 1, 0, 1
+		} else {
+			i1, j1, k1, i2, j2, k2 = 0, 0, 1, 1, 0, 1
+		}
+	} else {
+		if y0 < z0 {
+			i1, j1, k1, i2, j2, k2 = 0, 0, 1, 0, 1, 1
+		} else if x0 < z0 {
+			i1, j1, k1, i2, j2, k2 = 0, 1, 0, 0, 1, 1
+		} else {
+			i1, j1, k1, i2, j2, k2 = 0, 1, 0, 1, 1, 0
+		}
+	}
+	x1, y1, z1 := x0-float64(i1)+g3, y0-float64(j1)+g3, z0-float64(k1)+g3
+	x2, y2, z2 := x0-float64(i2)+2*g3, y0-float64(j2)+2*g3, z0-float64(k2)+2*g3
+	x3, y3, z3 := x0-1+3*g3, y0-1+3*g3, z0-1+3*g3
+
+	ii, jj, kk := i&255, j&255, k&255
+	p := s.perm
+	return 32 * (s.corner3D(p[ii+int(p[jj+int(p[kk])])], x0, y0, z0) +
+		s.corner3D(p[ii+i1+int(p[jj+j1+int(p[kk+k1])])], x1, y1, z1) +
+		s.corner3D(p[ii+i2+int(p[jj+j2+int(p[kk+k2])])], x2, y2, z2) +
+		s.corner3D(p[ii+1+int(p[jj+1+int(p[kk+1])])], x3, y3, z3))
+}
+
+// corner3D returns the contribution of a single corner of a three-dimensional simplex.
+func (s Simplex) corner3D(hash uint8, x, y, z float64) float64 {
+	t := 0.6 - x*x - y*y - z*z
+	if t < 0 {
+		return 0
+	}
+	g := simplexGradients[hash%12]
+	t *= t
+	return t * t * (g[0]*x + g[1]*y + g[2]*z)
+}
@@ -0,0 +1,63 @@
+package noise
+
+import (
+	"golang.org/x/exp/slices"
+)
+
+// SplinePoint is a single control point of a Spline.
+type SplinePoint struct {
+	// Location is the input value at which the Spline passes through Value.
+	Location float64
+	// Value is the output of the Spline at Location.
+	Value float64
+	// Derivative is the slope of the Spline at Location.
+	Derivative float64
+}
+
+// Spline is a cubic Hermite spline that maps an input value, typically a noise value, to an output value, such as a
+// terrain height. Splines are used to shape noise, for example to turn continentalness noise into flat oceans, steep
+// coasts and mountainous inland terrain. Spline may be constructed by calling NewSpline.
+type Spline struct {
+	points []SplinePoint
+}
+
+// NewSpline creates a new Spline passing through the SplinePoints passed. The points are sorted by their Location.
+func NewSpline(points ...SplinePoint) Spline {
+	points = slices.Clone(points)
+	slices.SortFunc(points, func(a, b SplinePoint) bool {
+		return a.Location < b.Location
+	})
+	return Spline{points: points}
+}
+
+// At returns the value of the Spline at the location passed. Locations before the first point or after the last point
+// are extrapolated linearly using the Derivative of that point. If the Spline has no points, At returns 0.
+func (s Spline) At(location float64) float64 {
+	n := len(s.points)
+	if n == 0 {
+		return 0
+	}
+	if first := s.points[0]; location <= first.Location {
+		return first.Value + first.Derivative*(location-first.Location)
+	}
+	if last := s.points[n-1]; location >= last.Location {
+		return last.Value + last.Derivative*(location-last.Location)
+	}
+	i, _ := slices.BinarySearchFunc(s.points, location, func(p SplinePoint, location float64) int {
+		if p.Location < location {
+			return -1
+		} else if p.Location > location {
+			return 1
+		}
+		return 0
+	})
+	if s.points[i].Location == location {
+		return s.points[i].Value
+	}
+	a, b := s.points[i-1], s.points[i]
+	width := b.Location - a.Location
+	t := (location - a.Location) / width
+
+	t2, t3 := t*t, t*t*t
+	return (2*t3-3*t2+1)*a.Value + (t3-2*t2+t)*width*a.Derivative + (-2*t3+3*t2)*b.Value + (t3-t2)*width*b.Derivative
+}
@@ -0,0 +1,120 @@
+package generator
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+)
+
+// Structures is a world.Generator that places world.Structures on top of the terrain generated by another
+// world.Generator. Every structure is placed once in every region of Spacing by Spacing chunks, in a chunk of the
+// region that is picked randomly based on the Seed and the name of the structure. Structures implements
+// world.StructureGenerator, so that the structures it places may be found using World.LocateStructure.
+//
+// Structures are placed in the north-west corner of their chunk, on top of the highest block in the centre of the
+// structure. Parts of a structure that do not fit in a single chunk are not placed, and block entity data of the
+// blocks in a structure is not kept.
+type Structures struct {
+	world.Generator
+	// Seed is the seed used to decide in which chunk of a region every structure is placed.
+	Seed int64
+	// Spacing is the width and length in chunks of the regions in which a structure is placed once. If Spacing is
+	// 0 or less, it defaults to 32.
+	Spacing int
+	// Structures maps the names of structures, as passed to World.LocateStructure, to the world.Structure placed.
+	Structures map[string]world.Structure
+}
+
+// StructureAt ...
+func (s Structures) StructureAt(name string, pos world.ChunkPos) (cube.Pos, bool) {
+	if _, ok := s.Structures[name]; !ok || s.structureChunk(name, pos) != pos {
+		return cube.Pos{}, false
+	}
+	return cube.Pos{int(pos[0]) << 4, 0, int(pos[1]) << 4}, true
+}
+
+// GenerateChunk ...
+func (s Structures) GenerateChunk(pos world.ChunkPos, c *chunk.Chunk) {
+	if s.Generator != nil {
+		s.Generator.GenerateChunk(pos, c)
+	}
+	names := make([]string, 0, len(s.Structures))
+	for name := range s.Structures {
+		if s.structureChunk(name, pos) == pos {
+			names = append(names, name)
+		}
+	}
+	// Sort the names so that structures placed in the same chunk always overlap in the same way.
+	sort.Strings(names)
+	for _, name := range names {
+		place(c, s.Structures[name])
+	}
+}
+
+// structureChunk returns the chunk in which the structure with the name passed is placed in the region that the
+// chunk at the position passed is in.
+func (s Structures) structureChunk(name string, pos world.ChunkPos) world.ChunkPos {
+	spacing := int32(s.Spacing)
+	if spacing <= 0 {
+		spacing = 32
+	}
+	rx, rz := floorDiv(pos[0], spacing), floorDiv(pos[1], spacing)
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	r := rand.New(rand.NewSource(s.Seed ^ int64(h.Sum64()) ^ int64(rx)*341873128712 ^ int64(rz)*132897987541))
+	return world.ChunkPos{rx*spacing + r.Int31n(spacing), rz*spacing + r.Int31n(spacing)}
+}
+
+// place places the world.Structure passed in the north-west corner of the chunk passed, on top of the highest block
+// in the centre of the structure.
+func place(c *chunk.Chunk, st world.Structure) {
+	dim := st.Dimensions()
+	width, length := min(dim[0], 16), min(dim[2], 16)
+	if width <= 0 || length <= 0 {
+		return
+	}
+	r := c.Range()
+	baseY := int(c.HighestBlock(uint8(width/2), uint8(length/2))) + 1
+
+	air, _ := world.BlockByName("minecraft:air", nil)
+	blockAt := func(x, y, z int) world.Block {
+		if x < 0 || x > 15 || z < 0 || z > 15 || baseY+y < r[0] || baseY+y > r[1] {
+			return air
+		}
+		b, _ := world.BlockByRuntimeID(c.Block(uint8(x), int16(baseY+y), uint8(z), 0))
+		return b
+	}
+	for y := 0; y < dim[1] && baseY+y <= r[1]; y++ {
+		for x := 0; x < width; x++ {
+			for z := 0; z < length; z++ {
+				b, l := st.At(x, y, z, blockAt)
+				if b != nil {
+					c.SetBlock(uint8(x), int16(baseY+y), uint8(z), 0, world.BlockRuntimeID(b))
+				}
+				if l != nil {
+					c.SetBlock(uint8(x), int16(baseY+y), uint8(z), 1, world.BlockRuntimeID(l))
+				}
+			}
+		}
+	}
+}
+
+// floorDiv divides a by b, rounding the result down rather than towards 0.
+func floorDiv(a, b int32) int32 {
+	if a < 0 {
+		return (a - b + 1) / b
+	}
+	return a / b
+}
+
+// min returns the smaller of two integers.
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,142 @@
+package generator
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/biome"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"github.com/df-mc/dragonfly/server/world/generator/noise"
+	"math"
+)
+
+// TerrainConfig holds the blocks and settings used by a Terrain generator to build terrain.
+type TerrainConfig struct {
+	// Seed is the seed of the noise used to generate the terrain. Worlds generated with the same seed always have the
+	// same terrain.
+	Seed int64
+	// Surface is the block placed at the top of every column above the SeaLevel, such as grass.
+	Surface world.Block
+	// Filler is the block placed in the three blocks directly below the surface, such as dirt. Columns with a surface
+	// below the SeaLevel also use the Filler block as surface.
+	Filler world.Block
+	// Base is the block that fills the rest of every column below the Filler blocks, such as stone.
+	Base world.Block
+	// Water is the block that fills columns of which the surface is below the SeaLevel up to the SeaLevel. If set to
+	// nil, no water is placed.
+	Water world.Block
+	// SeaLevel is the Y coordinate up to which Water is placed. If set to 0, a sea level of 62 is used.
+	SeaLevel int
+	// Biome is the world.Biome used for all columns. If set to nil, biome.Plains is used.
+	Biome world.Biome
+	// Amplified makes the hills and mountains of the terrain three times as high.
+	Amplified bool
+	// Caves makes the Terrain carve winding caves out of the terrain below the SeaLevel.
+	Caves bool
+	// Rivers makes the Terrain carve rivers into the surface of the terrain. Rivers are filled with Water, or with air
+	// if Water is nil.
+	Rivers bool
+}
+
+// Terrain is a generator that builds rolling hills, mountains and oceans using the noise package. Terrain may be
+// constructed by calling NewTerrain.
+type Terrain struct {
+	conf TerrainConfig
+
+	continentalness, detail noise.Octave
+	shape                   noise.Spline
+
+	caves  noise.CaveCarver
+	rivers noise.RiverCarver
+
+	surface, filler, base, water uint32
+	biome                        uint32
+}
+
+// NewTerrain creates a new Terrain generator using the TerrainConfig passed. The Surface, Filler and Base blocks of
+// the TerrainConfig must not be nil.
+func NewTerrain(conf TerrainConfig) Terrain {
+	if conf.SeaLevel == 0 {
+		conf.SeaLevel = 62
+	}
+	if conf.Biome == nil {
+		conf.Biome = biome.Plains{}
+	}
+	sea := float64(conf.SeaLevel)
+	t := Terrain{
+		conf:            conf,
+		continentalness: noise.NewOctave(conf.Seed, 4, 0.5, 2),
+		detail:          noise.NewOctave(conf.Seed+1, 3, 0.5, 2),
+		shape: noise.NewSpline(
+			noise.SplinePoint{Location: -0.6, Value: sea - 30},
+			noise.SplinePoint{Location: -0.2, Value: sea - 8, Derivative: 40},
+			noise.SplinePoint{Location: 0, Value: sea + 2, Derivative: 30},
+			noise.SplinePoint{Location: 0.2, Value: sea + 12, Derivative: 60},
+			noise.SplinePoint{Location: 0.5, Value: sea + 45},
+		),
+		surface: world.BlockRuntimeID(conf.Surface),
+		filler:  world.BlockRuntimeID(conf.Filler),
+		base:    world.BlockRuntimeID(conf.Base),
+		biome:   uint32(conf.Biome.EncodeBiome()),
+		caves:   noise.CaveCarver{Noise: noise.NewOctave(conf.Seed+2, 2, 0.5, 2), MinY: math.MinInt32, MaxY: conf.SeaLevel - 10},
+		rivers:  noise.RiverCarver{Noise: noise.NewSimplex(conf.Seed + 3)},
+	}
+	if conf.Water != nil {
+		t.water = world.BlockRuntimeID(conf.Water)
+	}
+	return t
+}
+
+// BiomeAt ...
+func (t Terrain) BiomeAt(int, int) world.Biome {
+	return t.conf.Biome
+}
+
+// GenerateChunk ...
+func (t Terrain) GenerateChunk(pos world.ChunkPos, chunk *chunk.Chunk) {
+	min, max := chunk.Range().Min(), chunk.Range().Max()
+
+	for x := uint8(0); x < 16; x++ {
+		for z := uint8(0); z < 16; z++ {
+			surface := clamp(t.surfaceHeight(int(pos[0])*16+int(x), int(pos[1])*16+int(z)), min, max)
+			top := t.surface
+			if surface < t.conf.SeaLevel {
+				top = t.filler
+			}
+			for y := min; y <= surface; y++ {
+				rid := t.base
+				if y == surface {
+					rid = top
+				} else if y >= surface-3 {
+					rid = t.filler
+				}
+				chunk.SetBlock(x, int16(y), z, 0, rid)
+			}
+			if t.conf.Water != nil {
+				for y := surface + 1; y <= clamp(t.conf.SeaLevel, min, max); y++ {
+					chunk.SetBlock(x, int16(y), z, 0, t.water)
+				}
+			}
+			fillBiome(chunk, x, z, t.biome)
+		}
+	}
+	air, _ := world.BlockByName("minecraft:air", nil)
+	if t.conf.Rivers {
+		if t.conf.Water != nil {
+			t.rivers.Carve(pos, chunk, t.conf.Water)
+		} else {
+			t.rivers.Carve(pos, chunk, air)
+		}
+	}
+	if t.conf.Caves {
+		t.caves.Carve(pos, chunk, air)
+	}
+}
+
+// surfaceHeight returns the Y coordinate of the surface of the column at the X and Z coordinates passed.
+func (t Terrain) surfaceHeight(x, z int) int {
+	fx, fz := float64(x), float64(z)
+	h := t.shape.At(t.continentalness.Noise2D(fx/512, fz/512)) + t.detail.Noise2D(fx/64, fz/64)*6
+	if sea := float64(t.conf.SeaLevel); t.conf.Amplified && h > sea {
+		h = sea + (h-sea)*3
+	}
+	return int(h)
+}
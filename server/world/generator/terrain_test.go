@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"testing"
+)
+
+// TestTerrainSeedStability checks that a Terrain generator produces the same surface heights and caves for the same
+// seed as it always has, so that existing worlds keep generating the same terrain.
+func TestTerrainSeedStability(t *testing.T) {
+	tr := testTerrain(true)
+	for _, c := range []struct{ x, z, height int }{{0, 0, 67}, {100, -250, 81}, {-1000, 3000, 73}, {12345, 678, 71}} {
+		if h := tr.surfaceHeight(c.x, c.z); h != c.height {
+			t.Fatalf("surface height at %v %v: expected %v, got %v", c.x, c.z, c.height, h)
+		}
+	}
+	for _, c := range []struct {
+		pos world.ChunkPos
+		air int
+	}{{world.ChunkPos{0, 0}, 6004}, {world.ChunkPos{5, -3}, 5142}, {world.ChunkPos{-20, 40}, 4112}} {
+		if n := undergroundAir(tr, c.pos); n != c.air {
+			t.Fatalf("air blocks below sea level in chunk %v: expected %v, got %v", c.pos, c.air, n)
+		}
+	}
+}
+
+// TestTerrainNoCaves checks that a Terrain generator does not carve caves if they are disabled.
+func TestTerrainNoCaves(t *testing.T) {
+	if n := undergroundAir(testTerrain(false), world.ChunkPos{0, 0}); n != 0 {
+		t.Fatalf("expected no air blocks below sea level without caves, got %v", n)
+	}
+}
+
+// testTerrain returns a Terrain generator with a seed of 42, optionally carving caves and rivers.
+func testTerrain(carve bool) Terrain {
+	return NewTerrain(TerrainConfig{
+		Seed:    42,
+		Surface: block.Grass{},
+		Filler:  block.Dirt{},
+		Base:    block.Stone{},
+		Water:   block.Water{Still: true, Depth: 8},
+		Caves:   carve,
+		Rivers:  carve,
+	})
+}
+
+// undergroundAir generates the chunk at the position passed using the Terrain passed and returns the amount of air
+// blocks in it between the bottom of the chunk and 10 blocks below the sea level.
+func undergroundAir(tr Terrain, pos world.ChunkPos) int {
+	air, _ := world.BlockByName("minecraft:air", nil)
+	rid := world.BlockRuntimeID(air)
+	c := chunk.New(rid, world.Overworld.Range())
+	tr.GenerateChunk(pos, c)
+
+	n := 0
+	for x := uint8(0); x < 16; x++ {
+		for z := uint8(0); z < 16; z++ {
+			for y := c.Range().Min(); y <= tr.conf.SeaLevel-10; y++ {
+				if c.Block(x, int16(y), z, 0) == rid {
+					n++
+				}
+			}
+		}
+	}
+	return n
+}
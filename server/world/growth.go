@@ -0,0 +1,50 @@
+package world
+
+import (
+	"math"
+	"sync"
+)
+
+// growth holds the growth rate modifiers of blocks in a World, indexed by the name of the block.
+type growth struct {
+	mu        sync.RWMutex
+	modifiers map[string]float64
+}
+
+// SetGrowthModifier sets the modifier of the growth rate of the block passed, such as a crop, in the World. The chance
+// that the block grows during a random tick is multiplied by the modifier, so that a modifier of 2 makes a crop grow
+// twice as fast and a modifier of 0 stops it from growing naturally. The modifier applies to all blocks with the same
+// name as the block passed, regardless of their properties. A modifier of 1 restores the default growth rate.
+func (w *World) SetGrowthModifier(b Block, modifier float64) {
+	if w == nil {
+		return
+	}
+	name, _ := b.EncodeBlock()
+
+	w.growth.mu.Lock()
+	defer w.growth.mu.Unlock()
+	if modifier == 1 {
+		delete(w.growth.modifiers, name)
+		return
+	}
+	if w.growth.modifiers == nil {
+		w.growth.modifiers = make(map[string]float64)
+	}
+	w.growth.modifiers[name] = math.Max(modifier, 0)
+}
+
+// GrowthModifier returns the modifier of the growth rate of the block passed in the World, as set using
+// SetGrowthModifier. If no modifier was set for the block, 1 is returned.
+func (w *World) GrowthModifier(b Block) float64 {
+	if w == nil {
+		return 1
+	}
+	name, _ := b.EncodeBlock()
+
+	w.growth.mu.RLock()
+	defer w.growth.mu.RUnlock()
+	if m, ok := w.growth.modifiers[name]; ok {
+		return m
+	}
+	return 1
+}
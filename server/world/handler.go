@@ -35,10 +35,30 @@ type Handler interface {
 	// wood, that can be broken by fire. HandleBlockBurn is often succeeded by HandleFireSpread, when fire spreads to
 	// the position of the original block and the event.Context is not cancelled in HandleBlockBurn.
 	HandleBlockBurn(ctx *event.Context, pos cube.Pos)
+	// HandleBoneMeal handles bone meal being used on the Block at a position in the World. ctx.Cancel() may be called
+	// to prevent the default behaviour of the block, for example to apply custom growth behaviour instead. The bone
+	// meal is not consumed if the event is cancelled.
+	HandleBoneMeal(ctx *event.Context, pos cube.Pos, b Block)
+	// HandleExplosion handles an explosion at a position in the World. The entities and blocks affected by the
+	// explosion may be altered by changing the slices that the pointers passed point to. itemDropChance holds the
+	// chance, between 0 and 1, that a destroyed block drops its items and spawnFire specifies if the explosion
+	// starts fires. ctx.Cancel() may be called to prevent the explosion from affecting any entities or blocks.
+	HandleExplosion(ctx *event.Context, position mgl64.Vec3, entities *[]Entity, blocks *[]cube.Pos, itemDropChance *float64, spawnFire *bool)
 	// HandleEntitySpawn handles an entity being spawned into a World through a call to World.AddEntity.
 	HandleEntitySpawn(e Entity)
 	// HandleEntityDespawn handles an entity being despawned from a World through a call to World.RemoveEntity.
 	HandleEntityDespawn(e Entity)
+	// HandleEntityTeleport handles an entity other than a player being teleported from the World to a position in
+	// the World after, with the TeleportCause passed. ctx.Cancel() may be called to cancel the teleport.
+	HandleEntityTeleport(ctx *event.Context, e Entity, after *World, pos mgl64.Vec3, cause TeleportCause)
+	// HandleDawn handles the sun starting to rise in the World, which happens when the time of the day reaches
+	// 23000. HandleDawn is only called when the time advances naturally, not when it is changed using
+	// World.SetTime.
+	HandleDawn()
+	// HandleDusk handles the sun starting to set in the World, which happens when the time of the day reaches
+	// 12000. HandleDusk is only called when the time advances naturally, not when it is changed using
+	// World.SetTime.
+	HandleDusk()
 	// HandleClose handles the World being closed. HandleClose may be used as a moment to finish code running on other
 	// goroutines that operates on the World specifically. HandleClose is called directly before the World stops
 	// ticking and before any chunks are saved to disk.
@@ -59,6 +79,13 @@ func (NopHandler) HandleLiquidHarden(*event.Context, cube.Pos, Block, Block, Blo
 func (NopHandler) HandleSound(*event.Context, Sound, mgl64.Vec3)                      {}
 func (NopHandler) HandleFireSpread(*event.Context, cube.Pos, cube.Pos)                {}
 func (NopHandler) HandleBlockBurn(*event.Context, cube.Pos)                           {}
-func (NopHandler) HandleEntitySpawn(Entity)                                           {}
-func (NopHandler) HandleEntityDespawn(Entity)                                         {}
-func (NopHandler) HandleClose()                                                       {}
+func (NopHandler) HandleBoneMeal(*event.Context, cube.Pos, Block)                     {}
+func (NopHandler) HandleExplosion(*event.Context, mgl64.Vec3, *[]Entity, *[]cube.Pos, *float64, *bool) {
+}
+func (NopHandler) HandleEntitySpawn(Entity)   {}
+func (NopHandler) HandleEntityDespawn(Entity) {}
+func (NopHandler) HandleEntityTeleport(*event.Context, Entity, *World, mgl64.Vec3, TeleportCause) {
+}
+func (NopHandler) HandleDawn()  {}
+func (NopHandler) HandleDusk()  {}
+func (NopHandler) HandleClose() {}
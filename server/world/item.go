@@ -17,7 +17,11 @@ type Item interface {
 }
 
 // CustomItem represents an item that is non-vanilla and requires a resource pack and extra steps to show it
-// to the client.
+// to the client. Custom items registered using RegisterItem are added to the resource pack of the server and
+// to the creative inventory automatically, unless the HideFromCreative option is passed. Note that custom items
+// were previously not added to the creative inventory by default, so servers that add them manually should pass
+// HideFromCreative to avoid duplicate entries. Behaviour such as a maximum stack size, consuming or using the
+// item as a tool or weapon is added by implementing the respective interfaces of the item package.
 type CustomItem interface {
 	Item
 	// Name is the name that will be displayed on the item to all clients.
@@ -28,10 +32,39 @@ type CustomItem interface {
 	Category() category.Category
 }
 
+// ItemOption is an option that may be passed to RegisterItem to change how an item is registered.
+type ItemOption func(o *itemOptions)
+
+// itemOptions holds the options set using ItemOptions passed to RegisterItem.
+type itemOptions struct {
+	hideFromCreative bool
+	newRuntimeID     bool
+}
+
+// HideFromCreative returns an ItemOption that prevents a CustomItem from being added to the creative inventory
+// automatically. The item may still be added manually using creative.RegisterItem.
+func HideFromCreative() ItemOption {
+	return func(o *itemOptions) {
+		o.hideFromCreative = true
+	}
+}
+
+// NewRuntimeID returns an ItemOption that assigns a new runtime ID to an item that is not a CustomItem, but has a
+// name unknown to the server, such as the spawn egg of a custom entity defined in a resource pack.
+func NewRuntimeID() ItemOption {
+	return func(o *itemOptions) {
+		o.newRuntimeID = true
+	}
+}
+
 // RegisterItem registers an item with the ID and meta passed. Once registered, items may be obtained from an
-// ID and metadata value using itemByID().
+// ID and metadata value using itemByID(). ItemOptions may be passed to change how the item is registered.
 // If an item with the ID and meta passed already exists, RegisterItem panics.
-func RegisterItem(item Item) {
+func RegisterItem(item Item, opts ...ItemOption) {
+	var o itemOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
 	name, meta := item.EncodeItem()
 	h := itemHash{name: name, meta: meta}
 
@@ -44,6 +77,14 @@ func RegisterItem(item Item) {
 		itemNamesToRuntimeIDs[name] = nextRID
 
 		customItems = append(customItems, c)
+		if o.hideFromCreative {
+			hiddenCustomItems[name] = struct{}{}
+		}
+	}
+	if _, ok := itemNamesToRuntimeIDs[name]; !ok && o.newRuntimeID {
+		nextRID := int32(len(itemNamesToRuntimeIDs))
+		itemRuntimeIDsToNames[nextRID] = name
+		itemNamesToRuntimeIDs[name] = nextRID
 	}
 	if _, ok := itemNamesToRuntimeIDs[name]; !ok {
 		panic(fmt.Sprintf("item name %v does not have a runtime ID", name))
@@ -65,6 +106,8 @@ var (
 	items = map[itemHash]Item{}
 	// customItems holds a list of all registered custom items.
 	customItems []CustomItem
+	// hiddenCustomItems holds the names of custom items registered using the HideFromCreative option.
+	hiddenCustomItems = map[string]struct{}{}
 	// itemRuntimeIDsToNames holds a map to translate item runtime IDs to string IDs.
 	itemRuntimeIDsToNames = map[int32]string{}
 	// itemNamesToRuntimeIDs holds a map to translate item string IDs to runtime IDs.
@@ -125,3 +168,11 @@ func Items() []Item {
 func CustomItems() []CustomItem {
 	return customItems
 }
+
+// HiddenFromCreative checks if the CustomItem passed was registered using the HideFromCreative option, meaning it
+// is not added to the creative inventory automatically.
+func HiddenFromCreative(it CustomItem) bool {
+	name, _ := it.EncodeItem()
+	_, ok := hiddenCustomItems[name]
+	return ok
+}
@@ -0,0 +1,93 @@
+package world
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"sync"
+)
+
+// liquidFlow caches the flow paths calculated for liquids in a World, so that liquids ticked repeatedly in an
+// unchanged landscape, such as large lakes, don't need to recalculate the same paths every tick.
+type liquidFlow struct {
+	mu sync.Mutex
+	m  map[liquidFlowKey]liquidFlowEntry
+}
+
+// maxLiquidFlowEntries is the maximum amount of entries held by the liquidFlow cache of a World. If this amount is
+// exceeded, the cache is cleared completely.
+const maxLiquidFlowEntries = 4096
+
+// liquidFlowKey is the key of an entry in the liquidFlow cache.
+type liquidFlowKey struct {
+	pos cube.Pos
+	l   Liquid
+}
+
+// liquidFlowEntry is an entry in the liquidFlow cache. It holds the versions of all chunks covered by the search that
+// produced the paths, so that the entry is invalidated if any block in those chunks, including neighbouring chunks
+// of the chunk that the liquid is in, changes.
+type liquidFlowEntry struct {
+	min, max ChunkPos
+	versions []uint64
+	paths    any
+}
+
+// LiquidFlow returns the flow paths of the liquid passed at a position in the World, as calculated by the calculate
+// function. The result of calculate is cached in the World until a block in any of the chunks within radius blocks
+// horizontally of the position is changed, after which calculate is called again.
+func LiquidFlow[T any](w *World, pos cube.Pos, l Liquid, radius int, calculate func() T) T {
+	if w == nil {
+		return calculate()
+	}
+	key := liquidFlowKey{pos: pos, l: l}
+	min, max := ChunkPos{int32((pos[0] - radius) >> 4), int32((pos[2] - radius) >> 4)}, ChunkPos{int32((pos[0] + radius) >> 4), int32((pos[2] + radius) >> 4)}
+	versions := make([]uint64, 0, (max[0]-min[0]+1)*(max[1]-min[1]+1))
+	for x := min[0]; x <= max[0]; x++ {
+		for z := min[1]; z <= max[1]; z++ {
+			versions = append(versions, w.ChunkVersion(ChunkPos{x, z}))
+		}
+	}
+
+	w.liquidFlow.mu.Lock()
+	entry, ok := w.liquidFlow.m[key]
+	w.liquidFlow.mu.Unlock()
+	if ok && entry.min == min && entry.max == max && equalVersions(entry.versions, versions) {
+		if paths, ok := entry.paths.(T); ok {
+			return paths
+		}
+	}
+
+	paths := calculate()
+
+	w.liquidFlow.mu.Lock()
+	defer w.liquidFlow.mu.Unlock()
+	if w.liquidFlow.m == nil || len(w.liquidFlow.m) >= maxLiquidFlowEntries {
+		w.liquidFlow.m = make(map[liquidFlowKey]liquidFlowEntry)
+	}
+	w.liquidFlow.m[key] = liquidFlowEntry{min: min, max: max, versions: versions, paths: paths}
+	return paths
+}
+
+// forgetLiquidFlow removes all cached liquid flow paths starting in the chunk at the position passed. It is called
+// when a chunk is unloaded, as the chunk gets a new version when it is loaded again.
+func (w *World) forgetLiquidFlow(pos ChunkPos) {
+	w.liquidFlow.mu.Lock()
+	defer w.liquidFlow.mu.Unlock()
+	for key := range w.liquidFlow.m {
+		if (ChunkPos{int32(key.pos[0] >> 4), int32(key.pos[2] >> 4)}) == pos {
+			delete(w.liquidFlow.m, key)
+		}
+	}
+}
+
+// equalVersions checks if the two slices of chunk versions passed are equal.
+func equalVersions(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
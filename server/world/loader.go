@@ -2,6 +2,7 @@ package world
 
 import (
 	"github.com/go-gl/mathgl/mgl64"
+	"golang.org/x/exp/slices"
 	"math"
 	"sync"
 )
@@ -14,10 +15,15 @@ type Loader struct {
 	w      *World
 	viewer Viewer
 
-	mu        sync.RWMutex
-	pos       ChunkPos
-	loadQueue []ChunkPos
-	loaded    map[ChunkPos]*chunkData
+	mu  sync.RWMutex
+	pos ChunkPos
+	// vec is the exact position that the Loader was last moved to.
+	vec mgl64.Vec3
+	// look is the normalised horizontal direction that the Loader is looking in. queueLook holds the direction that
+	// was used when populating the load queue.
+	look, queueLook mgl64.Vec2
+	loadQueue       []ChunkPos
+	loaded          map[ChunkPos]*chunkData
 
 	closed bool
 }
@@ -64,6 +70,7 @@ func (l *Loader) Move(pos mgl64.Vec3) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
+	l.vec = pos
 	chunkPos := chunkPosFromVec3(pos)
 	if chunkPos == l.pos {
 		return
@@ -73,6 +80,23 @@ func (l *Loader) Move(pos mgl64.Vec3) {
 	l.populateLoadQueue()
 }
 
+// Look changes the direction that the Loader is looking in. Chunks in front of the Loader are loaded before chunks
+// at the same distance behind it, so that chunks that are visible are sent first. The load queue is only re-sorted
+// if the direction changed significantly since the previous re-sort.
+func (l *Loader) Look(dir mgl64.Vec3) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	look := mgl64.Vec2{dir[0], dir[2]}
+	if look.Len() == 0 {
+		return
+	}
+	l.look = look.Normalize()
+	if len(l.loadQueue) != 0 && l.look.Dot(l.queueLook) < math.Cos(math.Pi/4) {
+		l.sortLoadQueue()
+	}
+}
+
 // Load loads n chunks around the centre of the chunk, starting with the middle and working outwards. For
 // every chunk loaded, the Viewer passed through construction in New has its ViewChunk method called.
 // Load does nothing for n <= 0.
@@ -165,18 +189,14 @@ func (l *Loader) evictUnused() {
 
 // populateLoadQueue populates the load queue of the loader. This method is called once to create the order in
 // which chunks around the position the loader is now in should be loaded. Chunks are ordered to be loaded
-// from the middle outwards.
+// from the middle outwards, with chunks in the direction that the loader is looking in being loaded first.
 func (l *Loader) populateLoadQueue() {
-	// We'll first load the chunk positions to load in a map indexed by the distance to the center (basically,
-	// what precedence it should have), and put them in the loadQueue in that order.
-	queue := map[int32][]ChunkPos{}
+	l.loadQueue = l.loadQueue[:0]
 
 	r := int32(l.r)
 	for x := -r; x <= r; x++ {
 		for z := -r; z <= r; z++ {
-			distance := math.Sqrt(float64(x*x) + float64(z*z))
-			chunkDistance := int32(math.Round(distance))
-			if chunkDistance > r {
+			if int32(math.Round(math.Sqrt(float64(x*x)+float64(z*z)))) > r {
 				// The chunk was outside the chunk radius.
 				continue
 			}
@@ -185,16 +205,29 @@ func (l *Loader) populateLoadQueue() {
 				// The chunk was already loaded, so we don't need to do anything.
 				continue
 			}
-			if m, ok := queue[chunkDistance]; ok {
-				queue[chunkDistance] = append(m, pos)
-				continue
-			}
-			queue[chunkDistance] = []ChunkPos{pos}
+			l.loadQueue = append(l.loadQueue, pos)
 		}
 	}
+	l.sortLoadQueue()
+}
 
-	l.loadQueue = l.loadQueue[:0]
-	for i := int32(0); i < r; i++ {
-		l.loadQueue = append(l.loadQueue, queue[i]...)
+// lookWeight is the factor by which the distance of chunks directly in front of a Loader is reduced when sorting
+// the load queue.
+const lookWeight = 0.25
+
+// sortLoadQueue sorts the load queue so that the chunks closest to the loader are loaded first. Chunks in front of
+// the loader are weighted as if they were closer than those behind it.
+func (l *Loader) sortLoadQueue() {
+	l.queueLook = l.look
+	priority := func(pos ChunkPos) float64 {
+		offset := mgl64.Vec2{float64(pos[0] - l.pos[0]), float64(pos[1] - l.pos[1])}
+		dist := offset.Len()
+		if dist == 0 {
+			return 0
+		}
+		return dist * (1 - lookWeight*offset.Mul(1/dist).Dot(l.look))
 	}
+	slices.SortStableFunc(l.loadQueue, func(a, b ChunkPos) bool {
+		return priority(a) < priority(b)
+	})
 }
@@ -0,0 +1,183 @@
+package world
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+)
+
+// BiomeGenerator is a Generator that is able to tell which Biome it generates at a column without generating the
+// chunk that the column is in. World.LocateBiome uses it to search terrain that was not yet generated.
+type BiomeGenerator interface {
+	Generator
+	// BiomeAt returns the Biome that the Generator generates at the X and Z coordinates passed. BiomeAt may return
+	// nil if the Biome generated is not registered.
+	BiomeAt(x, z int) Biome
+}
+
+// StructureGenerator is a Generator that generates structures, such as villages, and is able to tell where it
+// generates them without generating the chunks that they are in. World.LocateStructure uses it to find structures.
+type StructureGenerator interface {
+	Generator
+	// StructureAt returns the position of the structure with the name passed that the Generator generates in the
+	// chunk at the ChunkPos passed. Only the X and Z coordinates of the position are used. False is returned if no
+	// such structure is generated in the chunk.
+	StructureAt(name string, pos ChunkPos) (cube.Pos, bool)
+}
+
+// LocateBiome searches for the closest column with the Biome passed, starting at the origin and searching in a
+// spiral up to radius chunks away. If the Generator of the World implements BiomeGenerator, terrain that was not yet
+// generated is searched as well. Otherwise, only chunks that are currently loaded are searched. The position returned
+// has the Y coordinate of the origin. False is returned if no column with the Biome was found.
+func (w *World) LocateBiome(b Biome, origin cube.Pos, radius int) (cube.Pos, bool) {
+	if w == nil {
+		return cube.Pos{}, false
+	}
+	id := b.EncodeBiome()
+	g, generated := w.conf.Generator.(BiomeGenerator)
+	y := origin[1]
+	if y < w.ra.Min() {
+		y = w.ra.Min()
+	} else if y > w.ra.Max() {
+		y = w.ra.Max()
+	}
+
+	return w.spiral(origin, radius, func(pos ChunkPos) (cube.Pos, bool) {
+		// Sample the centre of the chunk: Biomes rarely change within a single chunk.
+		x, z := int(pos[0])<<4+8, int(pos[1])<<4+8
+		target := cube.Pos{x, origin[1], z}
+		if generated {
+			// Generators may return nil for columns of which the Biome is not registered.
+			gb := g.BiomeAt(x, z)
+			return target, gb != nil && gb.EncodeBiome() == id
+		}
+		c, ok := w.chunkFromCache(pos)
+		if !ok {
+			return target, false
+		}
+		found := int(c.Biome(8, int16(y), 8)) == id
+		c.Unlock()
+		return target, found
+	})
+}
+
+// LocateStructure searches for the closest structure with the name passed, starting at the origin and searching in
+// a spiral up to radius chunks away. Structures can only be located if the Generator of the World implements
+// StructureGenerator, such as generator.Structures. The position returned has the Y coordinate of the origin. False
+// is returned if no structure with the name was found.
+func (w *World) LocateStructure(name string, origin cube.Pos, radius int) (cube.Pos, bool) {
+	if w == nil {
+		return cube.Pos{}, false
+	}
+	g, ok := w.conf.Generator.(StructureGenerator)
+	if !ok {
+		return cube.Pos{}, false
+	}
+	return w.spiral(origin, radius, func(pos ChunkPos) (cube.Pos, bool) {
+		found, ok := g.StructureAt(name, pos)
+		found[1] = origin[1]
+		return found, ok
+	})
+}
+
+// spiral calls f for the chunks around the origin in rings of increasing size, up to radius chunks away, and returns
+// the position found by f that is closest to the origin. Once a position is found, rings are searched until no ring
+// can hold a position closer to the origin, as positions in a ring are not necessarily closer than those in the next.
+func (w *World) spiral(origin cube.Pos, radius int, f func(pos ChunkPos) (cube.Pos, bool)) (cube.Pos, bool) {
+	centre := chunkPosFromBlockPos(origin)
+	var (
+		closest cube.Pos
+		dist    = -1
+	)
+	check := func(pos ChunkPos) {
+		if found, ok := f(pos); ok {
+			dx, dz := found[0]-origin[0], found[2]-origin[2]
+			if d := dx*dx + dz*dz; dist == -1 || d < dist {
+				closest, dist = found, d
+			}
+		}
+	}
+	for r := int32(0); r <= int32(radius); r++ {
+		if nearest := (int(r) - 1) * 16; dist != -1 && nearest > 0 && nearest*nearest > dist {
+			// All chunks in this ring and the rings after it are at least nearest blocks away from the origin, so
+			// none of them can hold a position closer than the one already found.
+			break
+		}
+		if r == 0 {
+			check(centre)
+		}
+		for i := -r; i < r; i++ {
+			// Walk over the four edges of the ring, so that every chunk of the ring is checked exactly once.
+			check(ChunkPos{centre[0] + i, centre[1] - r})
+			check(ChunkPos{centre[0] + r, centre[1] + i})
+			check(ChunkPos{centre[0] - i, centre[1] + r})
+			check(ChunkPos{centre[0] - r, centre[1] - i})
+		}
+	}
+	return closest, dist != -1
+}
+
+// LocateBlock searches for the block closest to the origin for which f returns true, up to radius blocks away from
+// the origin horizontally, over the full height of the World. Chunks in the area that are not yet loaded are loaded
+// or generated. Sub chunks that do not hold any matching block are skipped without checking every block in them,
+// making LocateBlock considerably faster than calling World.Block for every position in the area. f is passed blocks
+// without any block entity data. False is returned if no matching block was found.
+func (w *World) LocateBlock(origin cube.Pos, radius int, f func(b Block) bool) (cube.Pos, bool) {
+	if w == nil {
+		return cube.Pos{}, false
+	}
+	var (
+		found   cube.Pos
+		closest = -1
+		matches = map[uint32]bool{}
+	)
+	match := func(rid uint32) bool {
+		m, ok := matches[rid]
+		if !ok {
+			b, _ := BlockByRuntimeID(rid)
+			m = f(b)
+			matches[rid] = m
+		}
+		return m
+	}
+	minX, maxX, minZ, maxZ := origin[0]-radius, origin[0]+radius, origin[2]-radius, origin[2]+radius
+	for cx := int32(minX >> 4); cx <= int32(maxX>>4); cx++ {
+		for cz := int32(minZ >> 4); cz <= int32(maxZ>>4); cz++ {
+			c := w.chunk(ChunkPos{cx, cz})
+			for index, sub := range c.Sub() {
+				if sub.Empty() {
+					continue
+				}
+				layer, contains := sub.Layer(0), false
+				for i := 0; i < layer.Palette().Len(); i++ {
+					if match(layer.Palette().Value(uint16(i))) {
+						contains = true
+						break
+					}
+				}
+				if !contains {
+					continue
+				}
+				baseY := int(c.SubY(int16(index)))
+				for x := 0; x < 16; x++ {
+					for z := 0; z < 16; z++ {
+						pos := cube.Pos{int(cx)<<4 + x, 0, int(cz)<<4 + z}
+						if pos[0] < minX || pos[0] > maxX || pos[2] < minZ || pos[2] > maxZ {
+							continue
+						}
+						for y := 0; y < 16; y++ {
+							if !match(layer.At(uint8(x), uint8(y), uint8(z))) {
+								continue
+							}
+							pos[1] = baseY + y
+							d := pos.Sub(origin)
+							if dist := d[0]*d[0] + d[1]*d[1] + d[2]*d[2]; closest == -1 || dist < closest {
+								found, closest = pos, dist
+							}
+						}
+					}
+				}
+			}
+			c.Unlock()
+		}
+	}
+	return found, closest != -1
+}
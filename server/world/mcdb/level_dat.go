@@ -102,6 +102,7 @@ type data struct {
 	RainLevel                      float32        `nbt:"rainLevel"`
 	RainTime                       int32          `nbt:"rainTime"`
 	RandomTickSpeed                int32          `nbt:"randomtickspeed"`
+	RecipesUnlock                  bool           `nbt:"recipesunlock"`
 	RequiresCopiedPackRemovalCheck bool           `nbt:"requiresCopiedPackRemovalCheck"`
 	SendCommandFeedback            bool           `nbt:"sendcommandfeedback"`
 	ServerChunkTickRange           int32          `nbt:"serverChunkTickRange"`
@@ -172,6 +172,10 @@ func (p *Provider) loadSettings() {
 		DefaultGameMode: p.loadDefaultGameMode(),
 		Difficulty:      p.loadDifficulty(),
 		TickRange:       p.d.ServerChunkTickRange,
+		RecipesUnlock:   p.d.RecipesUnlock,
+		KeepInventory:   p.d.KeepInventory,
+
+		NaturalRegeneration: p.d.NaturalRegeneration,
 	}
 }
 
@@ -193,6 +197,9 @@ func (p *Provider) SaveSettings(s *world.Settings) {
 	}
 	p.d.CurrentTick = s.CurrentTick
 	p.d.ServerChunkTickRange = s.TickRange
+	p.d.RecipesUnlock = s.RecipesUnlock
+	p.d.KeepInventory = s.KeepInventory
+	p.d.NaturalRegeneration = s.NaturalRegeneration
 	p.saveDefaultGameMode(s.DefaultGameMode)
 	p.saveDifficulty(s.Difficulty)
 }
@@ -0,0 +1,164 @@
+package world
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"github.com/google/uuid"
+	"sync"
+)
+
+// Compile time check to make sure MemoryProvider implements Provider.
+var _ Provider = (*MemoryProvider)(nil)
+
+// MemoryProvider implements a Provider that keeps all data written to it in memory. Data that has not been written
+// to the MemoryProvider is read from an underlying Provider, which is never written to. A World using a
+// MemoryProvider therefore keeps changes made to it, also when chunks are unloaded, but never writes them back to
+// disk: All changes are lost when the World is closed.
+type MemoryProvider struct {
+	p Provider
+
+	mu           sync.Mutex
+	chunks       map[memoryChunkPos]*chunk.Chunk
+	entities     map[memoryChunkPos][]map[string]any
+	blockNBT     map[memoryChunkPos][]map[string]any
+	playerSpawns map[uuid.UUID]cube.Pos
+}
+
+// memoryChunkPos is the key used by a MemoryProvider to store data of a chunk in a specific Dimension.
+type memoryChunkPos struct {
+	pos ChunkPos
+	dim Dimension
+}
+
+// NewMemoryProvider creates a MemoryProvider that reads data not held in memory from the Provider passed. If nil is
+// passed, NopProvider is used, so that all data is newly generated.
+func NewMemoryProvider(p Provider) *MemoryProvider {
+	if p == nil {
+		p = NopProvider{}
+	}
+	return &MemoryProvider{
+		p:            p,
+		chunks:       make(map[memoryChunkPos]*chunk.Chunk),
+		entities:     make(map[memoryChunkPos][]map[string]any),
+		blockNBT:     make(map[memoryChunkPos][]map[string]any),
+		playerSpawns: make(map[uuid.UUID]cube.Pos),
+	}
+}
+
+// Settings returns the settings of the underlying Provider.
+func (m *MemoryProvider) Settings() *Settings {
+	return m.p.Settings()
+}
+
+// SaveSettings does nothing. The Settings returned by Settings are already held in memory.
+func (m *MemoryProvider) SaveSettings(*Settings) {}
+
+// LoadPlayerSpawnPosition loads the spawn position of a player from memory, or from the underlying Provider if it was
+// not changed.
+func (m *MemoryProvider) LoadPlayerSpawnPosition(uuid uuid.UUID) (cube.Pos, bool, error) {
+	m.mu.Lock()
+	pos, ok := m.playerSpawns[uuid]
+	m.mu.Unlock()
+	if ok {
+		return pos, true, nil
+	}
+	return m.p.LoadPlayerSpawnPosition(uuid)
+}
+
+// SavePlayerSpawnPosition stores the spawn position of a player in memory.
+func (m *MemoryProvider) SavePlayerSpawnPosition(uuid uuid.UUID, pos cube.Pos) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.playerSpawns[uuid] = pos
+	return nil
+}
+
+// LoadChunk loads a copy of a chunk held in memory, or loads the chunk from the underlying Provider if it was never
+// saved to the MemoryProvider.
+func (m *MemoryProvider) LoadChunk(pos ChunkPos, dim Dimension) (*chunk.Chunk, bool, error) {
+	m.mu.Lock()
+	c, ok := m.chunks[memoryChunkPos{pos: pos, dim: dim}]
+	m.mu.Unlock()
+	if ok {
+		return c.Clone(), true, nil
+	}
+	return m.p.LoadChunk(pos, dim)
+}
+
+// SaveChunk stores a copy of the chunk passed in memory.
+func (m *MemoryProvider) SaveChunk(pos ChunkPos, c *chunk.Chunk, dim Dimension) error {
+	cp := c.Clone()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.chunks[memoryChunkPos{pos: pos, dim: dim}] = cp
+	return nil
+}
+
+// LoadEntities decodes the entities held in memory for a chunk, or loads them from the underlying Provider if they
+// were never saved to the MemoryProvider.
+func (m *MemoryProvider) LoadEntities(pos ChunkPos, dim Dimension, reg EntityRegistry) ([]Entity, error) {
+	m.mu.Lock()
+	data, ok := m.entities[memoryChunkPos{pos: pos, dim: dim}]
+	m.mu.Unlock()
+	if !ok {
+		return m.p.LoadEntities(pos, dim, reg)
+	}
+	entities := make([]Entity, 0, len(data))
+	for _, x := range data {
+		name, _ := x["identifier"].(string)
+		t, ok := reg.Lookup(name)
+		if !ok {
+			continue
+		}
+		if s, ok := t.(SaveableEntityType); ok {
+			if e := s.DecodeNBT(x); e != nil {
+				entities = append(entities, e)
+			}
+		}
+	}
+	return entities, nil
+}
+
+// SaveEntities encodes the entities passed and stores them in memory. Entities are encoded, rather than stored
+// directly, because the World closes the entities of a chunk when it is unloaded.
+func (m *MemoryProvider) SaveEntities(pos ChunkPos, entities []Entity, dim Dimension) error {
+	data := make([]map[string]any, 0, len(entities))
+	for _, e := range entities {
+		if t, ok := e.Type().(SaveableEntityType); ok {
+			x := t.EncodeNBT(e)
+			x["identifier"] = t.EncodeEntity()
+			data = append(data, x)
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entities[memoryChunkPos{pos: pos, dim: dim}] = data
+	return nil
+}
+
+// LoadBlockNBT loads the block NBT held in memory for a chunk, or loads it from the underlying Provider if it was
+// never saved to the MemoryProvider.
+func (m *MemoryProvider) LoadBlockNBT(pos ChunkPos, dim Dimension) ([]map[string]any, error) {
+	m.mu.Lock()
+	data, ok := m.blockNBT[memoryChunkPos{pos: pos, dim: dim}]
+	m.mu.Unlock()
+	if ok {
+		return data, nil
+	}
+	return m.p.LoadBlockNBT(pos, dim)
+}
+
+// SaveBlockNBT stores the block NBT passed in memory.
+func (m *MemoryProvider) SaveBlockNBT(pos ChunkPos, data []map[string]any, dim Dimension) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blockNBT[memoryChunkPos{pos: pos, dim: dim}] = data
+	return nil
+}
+
+// Close closes the underlying Provider.
+func (m *MemoryProvider) Close() error {
+	return m.p.Close()
+}
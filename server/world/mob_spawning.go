@@ -0,0 +1,388 @@
+package world
+
+import (
+	"github.com/df-mc/atomic"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"sync"
+)
+
+// SpawnCategory is a category of entities that spawn naturally. Every category has its own conditions that a
+// position must meet for entities to spawn there and its own cap on the amount of entities spawned.
+type SpawnCategory uint8
+
+const (
+	// SpawnCategoryMonster is the category of hostile entities, such as zombies. Monsters spawn in dark places, in
+	// which no block light is present and sky light is at most 7, and do not spawn on peaceful difficulty.
+	SpawnCategoryMonster SpawnCategory = iota
+	// SpawnCategoryCreature is the category of passive entities, such as cows. Creatures spawn on grass in light
+	// levels of at least 9 and spawn much less frequently than monsters.
+	SpawnCategoryCreature
+)
+
+// interval returns the amount of ticks between attempts to spawn entities of the SpawnCategory.
+func (c SpawnCategory) interval() int64 {
+	if c == SpawnCategoryCreature {
+		return 400
+	}
+	return 1
+}
+
+// SpawnEntry is an entry in the spawn list of a Biome. It specifies an entity that may spawn naturally in that
+// Biome and how often it does so.
+type SpawnEntry struct {
+	// Category is the SpawnCategory of the entity. It decides the conditions under which the entity spawns.
+	Category SpawnCategory
+	// Weight is the relative chance that the entry is selected out of all entries of the same SpawnCategory in a
+	// Biome.
+	Weight int
+	// MinGroup and MaxGroup are the minimum and maximum amount of entities that spawn together in a group. If
+	// MaxGroup is 0, entities spawn on their own.
+	MinGroup, MaxGroup int
+	// New creates the entity at the position passed.
+	New func(pos mgl64.Vec3) Entity
+}
+
+// MobSpawning holds the settings of the natural spawning of entities in a World. Entities spawn in loaded chunks
+// around players, at positions that meet the conditions of their SpawnCategory, and despawn once they are too far
+// away from any player.
+type MobSpawning struct {
+	// Spawns returns the spawn list of the Biome passed. If Spawns is nil, no entities spawn naturally.
+	Spawns func(b Biome) []SpawnEntry
+	// Caps holds the maximum amount of naturally spawned entities of each SpawnCategory per player in the World. If
+	// left empty, the cap is 30 for monsters and 10 for creatures.
+	Caps map[SpawnCategory]int
+	// MinDistance and MaxDistance are the minimum and maximum horizontal distance in blocks from a player at which
+	// entities spawn. If left as 0, the MinDistance is 24 and the MaxDistance is 44.
+	MinDistance, MaxDistance float64
+	// DespawnDistance is the distance in blocks from the nearest player at which naturally spawned entities
+	// despawn immediately. Entities further away than 32 blocks additionally have a small chance to despawn every
+	// tick. If left as 0, the DespawnDistance is 128.
+	DespawnDistance float64
+}
+
+// withDefaults returns the MobSpawning with default values set for any fields left empty.
+func (s MobSpawning) withDefaults() MobSpawning {
+	if len(s.Caps) == 0 {
+		s.Caps = map[SpawnCategory]int{SpawnCategoryMonster: 30, SpawnCategoryCreature: 10}
+	}
+	if s.MinDistance == 0 {
+		s.MinDistance = 24
+	}
+	if s.MaxDistance == 0 {
+		s.MaxDistance = 44
+	}
+	if s.DespawnDistance == 0 {
+		s.DespawnDistance = 128
+	}
+	return s
+}
+
+// mobSpawning holds the state of the natural spawning of entities in a World.
+type mobSpawning struct {
+	conf    MobSpawning
+	enabled atomic.Bool
+
+	mu sync.Mutex
+	// spawned holds all entities that were spawned naturally and are still in the World, together with the
+	// SpawnCategory they were spawned with.
+	spawned map[Entity]SpawnCategory
+}
+
+// MobSpawning checks if entities spawn naturally in the World.
+func (w *World) MobSpawning() bool {
+	if w == nil {
+		return false
+	}
+	return w.spawning.enabled.Load()
+}
+
+// SetMobSpawning changes if entities spawn naturally in the World. Entities are only ever spawned if the World
+// was created with a Config that has MobSpawning.Spawns set. Entities that were spawned naturally before mob
+// spawning was disabled still despawn when far away from players.
+func (w *World) SetMobSpawning(enabled bool) {
+	if w == nil {
+		return
+	}
+	w.spawning.enabled.Store(enabled)
+}
+
+// Despawnable checks if the entity passed was spawned naturally, meaning it despawns when it is far away from
+// players.
+func (w *World) Despawnable(e Entity) bool {
+	if w == nil {
+		return false
+	}
+	w.spawning.mu.Lock()
+	defer w.spawning.mu.Unlock()
+	_, ok := w.spawning.spawned[e]
+	return ok
+}
+
+// SpawnCategory returns the SpawnCategory that the entity passed was spawned naturally with. False is returned if
+// the entity was not spawned naturally or was made persistent using Persist.
+func (w *World) SpawnCategory(e Entity) (SpawnCategory, bool) {
+	if w == nil {
+		return 0, false
+	}
+	w.spawning.mu.Lock()
+	defer w.spawning.mu.Unlock()
+	c, ok := w.spawning.spawned[e]
+	return c, ok
+}
+
+// Persist prevents the entity passed from despawning when it is far away from players, for example because it was
+// given a name or was leashed. Persist does nothing if the entity was not spawned naturally.
+func (w *World) Persist(e Entity) {
+	if w == nil {
+		return
+	}
+	w.spawning.mu.Lock()
+	defer w.spawning.mu.Unlock()
+	delete(w.spawning.spawned, e)
+}
+
+// NaturalEntity is an Entity that keeps track of the SpawnCategory it was spawned naturally with when it is saved,
+// so that it continues to despawn like other naturally spawned entities after being loaded again.
+type NaturalEntity interface {
+	Entity
+	// NaturalSpawnCategory returns the SpawnCategory that the entity was spawned naturally with at the time it was
+	// saved. False is returned if the entity was not spawned naturally or was made persistent.
+	NaturalSpawnCategory() (SpawnCategory, bool)
+}
+
+// load registers the entities passed that were loaded from a Provider and that were spawned naturally before they
+// were saved.
+func (s *mobSpawning) load(entities []Entity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range entities {
+		n, ok := e.(NaturalEntity)
+		if !ok {
+			continue
+		}
+		if c, ok := n.NaturalSpawnCategory(); ok {
+			if s.spawned == nil {
+				s.spawned = make(map[Entity]SpawnCategory)
+			}
+			s.spawned[e] = c
+		}
+	}
+}
+
+// tickMobSpawning despawns naturally spawned entities that are too far away from players and attempts to spawn
+// new entities around the players in the World.
+func (t ticker) tickMobSpawning(loaders []*Loader, tick int64) {
+	conf := t.w.spawning.conf
+	if conf.Spawns == nil {
+		return
+	}
+	players := make([]mgl64.Vec3, 0, len(loaders))
+	for _, l := range loaders {
+		if l == t.w.spawnLoader {
+			// The spawn loader doesn't represent a player, so it doesn't cause entities to spawn or stay.
+			continue
+		}
+		l.mu.RLock()
+		players = append(players, l.vec)
+		l.mu.RUnlock()
+	}
+	counts := t.despawnEntities(players)
+	if len(players) == 0 || !t.w.spawning.enabled.Load() {
+		return
+	}
+	peaceful := t.w.Difficulty() == DifficultyPeaceful
+	for c := SpawnCategoryMonster; c <= SpawnCategoryCreature; c++ {
+		if tick%c.interval() != 0 || (c == SpawnCategoryMonster && peaceful) {
+			continue
+		}
+		for _, pos := range players {
+			if counts[c] >= conf.Caps[c]*len(players) {
+				break
+			}
+			counts[c] += t.spawnGroup(c, pos, players)
+		}
+	}
+}
+
+// despawnEntities removes naturally spawned entities that are too far away from all players passed and forgets
+// about entities that are no longer in the World. The amount of naturally spawned entities remaining in each
+// SpawnCategory is returned.
+func (t ticker) despawnEntities(players []mgl64.Vec3) map[SpawnCategory]int {
+	conf, counts := t.w.spawning.conf, make(map[SpawnCategory]int)
+
+	var despawn []Entity
+	t.w.spawning.mu.Lock()
+	t.w.entityMu.RLock()
+	for e, c := range t.w.spawning.spawned {
+		if _, ok := t.w.entities[e]; !ok {
+			// The entity was removed from the World, for example because it died.
+			delete(t.w.spawning.spawned, e)
+			continue
+		}
+		dist := math.Inf(1)
+		for _, pos := range players {
+			dist = math.Min(dist, pos.Sub(e.Position()).Len())
+		}
+		if dist > conf.DespawnDistance || (dist > 32 && t.w.r.Intn(800) == 0) {
+			delete(t.w.spawning.spawned, e)
+			despawn = append(despawn, e)
+			continue
+		}
+		counts[c]++
+	}
+	t.w.entityMu.RUnlock()
+	t.w.spawning.mu.Unlock()
+
+	for _, e := range despawn {
+		t.w.RemoveEntity(e)
+		_ = e.Close()
+	}
+	return counts
+}
+
+// spawnGroup attempts to spawn a group of entities of the SpawnCategory passed at a random position around the
+// player position passed. The amount of entities spawned is returned.
+func (t ticker) spawnGroup(c SpawnCategory, player mgl64.Vec3, players []mgl64.Vec3) int {
+	conf, r := t.w.spawning.conf, t.w.r
+
+	angle, dist := r.Float64()*math.Pi*2, conf.MinDistance+r.Float64()*(conf.MaxDistance-conf.MinDistance)
+	x, z := int(math.Floor(player[0]+math.Cos(angle)*dist)), int(math.Floor(player[2]+math.Sin(angle)*dist))
+	if !t.w.ChunkLoaded(chunkPosFromBlockPos(cube.Pos{x, 0, z})) {
+		// Don't generate new chunks just to spawn entities in them.
+		return 0
+	}
+	minY, maxY := t.w.Range()[0], t.w.HighestBlock(x, z)+1
+	start := cube.Pos{x, minY + r.Intn(maxY-minY+1), z}
+
+	entry, ok := t.selectEntry(c, t.w.Biome(start))
+	if !ok {
+		return 0
+	}
+	n := entry.MinGroup
+	if entry.MaxGroup > entry.MinGroup {
+		n += r.Intn(entry.MaxGroup - entry.MinGroup + 1)
+	}
+	if n <= 0 {
+		n = 1
+	}
+
+	spawned := 0
+	for i := 0; i < n*4 && spawned < n; i++ {
+		pos := start.Add(cube.Pos{r.Intn(11) - 5, 0, r.Intn(11) - 5})
+		if !t.w.ChunkLoaded(chunkPosFromBlockPos(pos)) || !t.spawnable(c, pos, players) {
+			continue
+		}
+		e := entry.New(mgl64.Vec3{float64(pos[0]) + 0.5, float64(pos[1]), float64(pos[2]) + 0.5})
+		if e == nil {
+			continue
+		}
+		t.w.spawning.mu.Lock()
+		if t.w.spawning.spawned == nil {
+			t.w.spawning.spawned = make(map[Entity]SpawnCategory)
+		}
+		t.w.spawning.spawned[e] = c
+		t.w.spawning.mu.Unlock()
+
+		t.w.AddEntity(e)
+		spawned++
+	}
+	return spawned
+}
+
+// selectEntry selects a random SpawnEntry with the SpawnCategory passed from the spawn list of a Biome, taking into
+// account the weight of every entry. False is returned if the Biome has no entries with the SpawnCategory.
+func (t ticker) selectEntry(c SpawnCategory, b Biome) (SpawnEntry, bool) {
+	var (
+		entries []SpawnEntry
+		total   int
+	)
+	for _, entry := range t.w.spawning.conf.Spawns(b) {
+		if entry.Category == c && entry.Weight > 0 && entry.New != nil {
+			entries = append(entries, entry)
+			total += entry.Weight
+		}
+	}
+	if total == 0 {
+		return SpawnEntry{}, false
+	}
+	n := t.w.r.Intn(total)
+	for _, entry := range entries {
+		if n -= entry.Weight; n < 0 {
+			return entry, true
+		}
+	}
+	return SpawnEntry{}, false
+}
+
+// spawnable checks if an entity of the SpawnCategory passed is able to spawn at a position. The position must have
+// a solid block below it, enough space above it and meet the light conditions of the SpawnCategory, and it must not
+// be too close to any of the players passed.
+func (t ticker) spawnable(c SpawnCategory, pos cube.Pos, players []mgl64.Vec3) bool {
+	if pos.OutOfBounds(t.w.Range()) || pos.Side(cube.FaceDown).OutOfBounds(t.w.Range()) {
+		return false
+	}
+	vec := pos.Vec3Centre()
+	for _, p := range players {
+		if p.Sub(vec).Len() < t.w.spawning.conf.MinDistance {
+			return false
+		}
+	}
+	below := pos.Side(cube.FaceDown)
+	if !t.w.Block(below).Model().FaceSolid(below, cube.FaceUp, t.w) {
+		return false
+	}
+	for _, p := range []cube.Pos{pos, pos.Side(cube.FaceUp)} {
+		if len(t.w.Block(p).Model().BBox(p, t.w)) != 0 {
+			return false
+		}
+		if _, ok := t.w.Liquid(p); ok {
+			return false
+		}
+	}
+	switch c {
+	case SpawnCategoryMonster:
+		return t.w.blockLight(pos) == 0 && int(t.w.SkyLight(pos))-t.w.skyDarkening() <= 7
+	case SpawnCategoryCreature:
+		name, _ := t.w.Block(below).EncodeBlock()
+		return name == "minecraft:grass" && t.w.Light(pos) >= 9
+	}
+	return false
+}
+
+// blockLight returns the light level at the position passed that is emitted by blocks, such as torches, ignoring
+// any sky light.
+func (w *World) blockLight(pos cube.Pos) uint8 {
+	if pos.OutOfBounds(w.Range()) {
+		return 0
+	}
+	c := w.chunk(chunkPosFromBlockPos(pos))
+	defer c.Unlock()
+	return c.SubChunk(int16(pos[1])).BlockLight(uint8(pos[0]&0xf), uint8(pos[1]&0xf), uint8(pos[2]&0xf))
+}
+
+// skyDarkening returns the amount by which the sky light in the World is currently reduced as a result of the time
+// of day and the weather. The value ranges from 0 during a clear day to 11 at night.
+func (w *World) skyDarkening() int {
+	if !w.Dimension().TimeCycle() {
+		return 0
+	}
+	d := math.Mod(float64(w.Time())/24000-0.25, 1)
+	if d < 0 {
+		d++
+	}
+	angle := (d*2 + (0.5 - math.Cos(d*math.Pi)/2)) / 3
+
+	h := 1 - math.Max(0, math.Min(1, 1-(math.Cos(angle*math.Pi*2)*2+0.5)))
+	w.set.Lock()
+	raining, thundering := w.set.Raining, w.set.Raining && w.set.Thundering
+	w.set.Unlock()
+	if raining {
+		h *= 1 - 5.0/16
+	}
+	if thundering {
+		h *= 1 - 5.0/16
+	}
+	return int((1 - h) * 11)
+}
@@ -0,0 +1,170 @@
+package world
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+)
+
+// BlockState is a single entry of the block palette used by the server. It holds the name and properties of a block
+// state together with the runtime ID that the state is identified with in chunks and packets.
+type BlockState struct {
+	// RuntimeID is the runtime ID of the block state, which is its index in the palette.
+	RuntimeID uint32
+	// Name is the name of the block state, such as 'minecraft:stone'.
+	Name string
+	// Properties holds the properties of the block state. Properties must not be modified.
+	Properties map[string]any
+}
+
+// BlockPalette returns all block states known to the server, ordered by their runtime IDs, so that the runtime ID of
+// every BlockState equals its index in the slice returned. The palette is the same for every server running the same
+// version of Dragonfly and may be used by external tools, such as proxies, to map runtime IDs identically.
+func BlockPalette() []BlockState {
+	palette := make([]BlockState, len(blocks))
+	for rid, b := range blocks {
+		name, properties := b.EncodeBlock()
+		palette[rid] = BlockState{RuntimeID: uint32(rid), Name: name, Properties: properties}
+	}
+	return palette
+}
+
+// BlockStateHash returns the network hash of a block state with the name and properties passed. Unlike runtime IDs,
+// this hash does not depend on the order of the block palette and remains the same between game versions as long
+// as the block state itself does not change. It is equal to the hash the client uses when block network ID hashes
+// are enabled: The 32-bit FNV-1a hash of the little-endian NBT encoding of the name and the sorted properties.
+// An error is returned if one of the properties is not a bool, uint8, int32 or string.
+func BlockStateHash(name string, properties map[string]any) (uint32, error) {
+	buf := make([]byte, 0, 128)
+	buf = append(buf, nbtTagCompound, 0, 0)
+	buf = appendNBTString(buf, "name", name)
+
+	buf = append(buf, nbtTagCompound)
+	buf = appendNBTName(buf, "states")
+	keys := make([]string, 0, len(properties))
+	for k := range properties {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch v := properties[k].(type) {
+		case bool:
+			var b byte
+			if v {
+				b = 1
+			}
+			buf = append(buf, nbtTagByte)
+			buf = append(appendNBTName(buf, k), b)
+		case uint8:
+			buf = append(buf, nbtTagByte)
+			buf = append(appendNBTName(buf, k), v)
+		case int32:
+			buf = append(buf, nbtTagInt)
+			buf = append(appendNBTName(buf, k), byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+		case string:
+			buf = appendNBTString(buf, k, v)
+		default:
+			return 0, fmt.Errorf("invalid block property type %T for property %v", v, k)
+		}
+	}
+	buf = append(buf, nbtTagEnd, nbtTagEnd)
+
+	h := fnv.New32a()
+	_, _ = h.Write(buf)
+	return h.Sum32(), nil
+}
+
+// BlockRuntimeIDToHash converts a block runtime ID to the network hash of the block state it points to, as returned by
+// BlockStateHash. False is returned if no block state with the runtime ID exists or if its hash could not be computed.
+func BlockRuntimeIDToHash(rid uint32) (uint32, bool) {
+	hashes, runtimeIDs := computeStateHashes()
+	if rid >= uint32(len(hashes)) {
+		return 0, false
+	}
+	h := hashes[rid]
+	if other, ok := runtimeIDs[h]; !ok || other != rid {
+		return 0, false
+	}
+	return h, true
+}
+
+// BlockHashToRuntimeID converts the network hash of a block state, as returned by BlockStateHash, to the runtime ID of
+// that block state. False is returned if no block state with the hash exists.
+func BlockHashToRuntimeID(hash uint32) (uint32, bool) {
+	_, runtimeIDs := computeStateHashes()
+	rid, ok := runtimeIDs[hash]
+	return rid, ok
+}
+
+var (
+	// stateHashMu protects stateHashes and hashRuntimeIDs.
+	stateHashMu sync.Mutex
+	// stateHashes holds the network hashes of all block states, indexed by their runtime IDs.
+	stateHashes []uint32
+	// hashRuntimeIDs maps the network hashes of all block states to their runtime IDs.
+	hashRuntimeIDs map[uint32]uint32
+)
+
+// computeStateHashes returns the network hashes of all block states registered, indexed by their runtime IDs, and a
+// map of those hashes to the runtime IDs. The hashes are computed the first time they are needed, and again if more
+// block states were registered since. Block states with properties that cannot be hashed are left out of the map.
+func computeStateHashes() ([]uint32, map[uint32]uint32) {
+	stateHashMu.Lock()
+	defer stateHashMu.Unlock()
+	if len(stateHashes) == len(blocks) {
+		return stateHashes, hashRuntimeIDs
+	}
+	stateHashes, hashRuntimeIDs = make([]uint32, len(blocks)), make(map[uint32]uint32, len(blocks))
+	for rid, b := range blocks {
+		if h, err := BlockStateHash(b.EncodeBlock()); err == nil {
+			stateHashes[rid], hashRuntimeIDs[h] = h, uint32(rid)
+		}
+	}
+	return stateHashes, hashRuntimeIDs
+}
+
+// ItemRuntimeIDs returns a map of all item names known to the server, including those of custom items, with the
+// network runtime IDs they are identified with.
+func ItemRuntimeIDs() map[string]int32 {
+	m := make(map[string]int32, len(itemNamesToRuntimeIDs))
+	for name, rid := range itemNamesToRuntimeIDs {
+		m[name] = rid
+	}
+	return m
+}
+
+// ItemNameToRuntimeID converts the name of an item, such as 'minecraft:apple', to its network runtime ID. False is
+// returned if no item with the name exists.
+func ItemNameToRuntimeID(name string) (int32, bool) {
+	rid, ok := itemNamesToRuntimeIDs[name]
+	return rid, ok
+}
+
+// ItemRuntimeIDToName converts the network runtime ID of an item to its name. False is returned if no item with the
+// runtime ID exists.
+func ItemRuntimeIDToName(rid int32) (string, bool) {
+	name, ok := itemRuntimeIDsToNames[rid]
+	return name, ok
+}
+
+// Tag types used to encode block states in BlockStateHash.
+const (
+	nbtTagEnd      = 0
+	nbtTagByte     = 1
+	nbtTagInt      = 3
+	nbtTagString   = 8
+	nbtTagCompound = 10
+)
+
+// appendNBTName appends a little-endian NBT tag name to buf.
+func appendNBTName(buf []byte, name string) []byte {
+	buf = append(buf, byte(len(name)), byte(len(name)>>8))
+	return append(buf, name...)
+}
+
+// appendNBTString appends a little-endian NBT string tag with a name and value to buf.
+func appendNBTString(buf []byte, name, v string) []byte {
+	buf = append(buf, nbtTagString)
+	return appendNBTName(appendNBTName(buf, name), v)
+}
@@ -0,0 +1,92 @@
+package world
+
+import (
+	"github.com/df-mc/atomic"
+)
+
+// Physics is a profile of the physics constants that entities in a World use to compute their movement. Every entity
+// type has its own gravity and drag, which are multiplied by the Gravity and Drag of the Physics of the World it is
+// in. Physics may be changed at runtime using World.SetPhysics, for example to lower the gravity of a World during an
+// event. Players are moved by their client and are not affected by Physics, apart from jumping through Player.Jump and
+// being knocked back.
+type Physics struct {
+	// Gravity is the multiplier applied to the gravity of entities. A Gravity of 1 is the default, while a Gravity of
+	// 0.17 roughly matches the gravity on the moon.
+	Gravity float64
+	// Drag is the multiplier applied to the air drag of entities. A Drag of 1 is the default, while a Drag of 0 makes
+	// entities keep their velocity while moving through air.
+	Drag float64
+	// TerminalVelocity is the maximum speed in blocks per tick at which entities may fall. If set to 0, the falling
+	// speed of entities is limited only by their drag.
+	TerminalVelocity float64
+	// JumpVelocity is the upwards velocity in blocks per tick that entities get when they jump.
+	JumpVelocity float64
+	// LiquidGravity is the multiplier applied to the gravity of entities while they are in a liquid. It is applied
+	// on top of Gravity. LiquidGravity, LiquidDrag and LavaDrag only apply to entities that opt in to liquid
+	// physics, such as mobs.
+	LiquidGravity float64
+	// LiquidDrag is the drag applied to the velocity of entities while they are in a liquid, in place of their air
+	// drag. A LiquidDrag of 0.2 removes 20% of the velocity of an entity every tick.
+	LiquidDrag float64
+	// LavaDrag is the drag applied to the velocity of entities while they are in lava, in place of LiquidDrag. Lava
+	// is thicker than water, so entities move through it more slowly.
+	LavaDrag float64
+	// SwimVelocity is the upwards velocity in blocks per tick that mobs get when they swim up to stay afloat in
+	// water.
+	SwimVelocity float64
+	// EntityPushing is the strength with which entities that collide are pushed apart every tick. If set to 0,
+	// entities do not push each other. Players are moved by their client and push themselves away from other entities.
+	EntityPushing float64
+	// KnockBackForce is the horizontal velocity in blocks per tick with which entities attacked by a player are knocked
+	// back. It may be changed for a single attack in player.Handler.HandleAttackEntity.
+	KnockBackForce float64
+	// KnockBackHeight is the vertical velocity in blocks per tick with which entities attacked by a player are
+	// knocked back. It may be changed for a single attack in player.Handler.HandleAttackEntity.
+	KnockBackHeight float64
+	// KnockBackFriction is the fraction of the velocity of an entity that is removed when it is knocked back, before
+	// the knock back is added to it. A KnockBackFriction of 1 replaces the velocity of the entity with the knock back,
+	// while a KnockBackFriction of 0.5 keeps half of its velocity, so that consecutive hits stack up.
+	KnockBackFriction float64
+}
+
+// DefaultPhysics returns the Physics that a World has by default, which matches the physics of vanilla Minecraft.
+func DefaultPhysics() Physics {
+	return Physics{
+		Gravity:       1,
+		Drag:          1,
+		JumpVelocity:  0.42,
+		LiquidGravity: 0.25,
+		LiquidDrag:    0.2,
+		LavaDrag:      0.5,
+		SwimVelocity:  0.1,
+		EntityPushing: 0.05,
+
+		KnockBackForce:    0.45,
+		KnockBackHeight:   0.3608,
+		KnockBackFriction: 1,
+	}
+}
+
+// physics holds the Physics of a World.
+type physics struct {
+	p atomic.Value[Physics]
+}
+
+// Physics returns the Physics currently used by entities in the World. If no Physics was set in the Config of the
+// World or using SetPhysics, DefaultPhysics is returned.
+func (w *World) Physics() Physics {
+	if w == nil {
+		return DefaultPhysics()
+	}
+	return w.physics.p.Load()
+}
+
+// SetPhysics changes the Physics used by entities in the World. The change takes effect on the next tick of every
+// entity. Fields of p left as 0 are not set to their default, so SetPhysics may be used to disable drag or entity
+// pushing altogether. DefaultPhysics may be used as a starting point to change only some of the fields.
+func (w *World) SetPhysics(p Physics) {
+	if w == nil {
+		return
+	}
+	w.physics.p.Store(p)
+}
@@ -0,0 +1,125 @@
+package world
+
+import (
+	"github.com/df-mc/dragonfly/server/internal/sliceutil"
+	"github.com/go-gl/mathgl/mgl64"
+	"golang.org/x/exp/slices"
+	"sync"
+)
+
+// rides holds the vehicles and passengers of all entities in a World that are currently riding another entity or
+// that are being ridden.
+type rides struct {
+	mu sync.Mutex
+	// vehicles maps passengers to the seat that they are sitting in.
+	vehicles map[Entity]seat
+	// passengers maps vehicles to their passengers in the order in which they mounted.
+	passengers map[Entity][]Entity
+}
+
+// seat is the seat of a passenger in a vehicle.
+type seat struct {
+	vehicle Entity
+	offset  mgl64.Vec3
+}
+
+// Mount makes the passenger ride the vehicle, sitting at an offset relative to the position of the vehicle. If the
+// passenger was already riding an entity, it is dismounted from it first. A vehicle may have multiple passengers,
+// of which the first one is the driver, and a vehicle may itself ride another entity, so that entities may be
+// stacked. Mount returns false if either of the entities is not in the World or if the vehicle is (indirectly)
+// riding the passenger.
+func (w *World) Mount(passenger, vehicle Entity, offset mgl64.Vec3) bool {
+	if w == nil || passenger == vehicle || passenger.World() != w || vehicle.World() != w {
+		return false
+	}
+	w.Dismount(passenger)
+
+	w.rides.mu.Lock()
+	for v, ok := w.rides.vehicles[vehicle]; ok; v, ok = w.rides.vehicles[v.vehicle] {
+		if v.vehicle == passenger {
+			// The vehicle is riding the passenger, so mounting would result in a loop.
+			w.rides.mu.Unlock()
+			return false
+		}
+	}
+	if w.rides.vehicles == nil {
+		w.rides.vehicles, w.rides.passengers = make(map[Entity]seat), make(map[Entity][]Entity)
+	}
+	w.rides.vehicles[passenger] = seat{vehicle: vehicle, offset: offset}
+	w.rides.passengers[vehicle] = append(w.rides.passengers[vehicle], passenger)
+	driver := len(w.rides.passengers[vehicle]) == 1
+	w.rides.mu.Unlock()
+
+	for _, v := range w.Viewers(vehicle.Position()) {
+		v.ViewEntityMount(passenger, vehicle, driver)
+		v.ViewEntityState(passenger)
+	}
+	return true
+}
+
+// Dismount makes the passenger stop riding its vehicle. Dismount does nothing if the passenger is not riding an
+// entity in the World.
+func (w *World) Dismount(passenger Entity) {
+	if w == nil {
+		return
+	}
+	w.rides.mu.Lock()
+	s, ok := w.rides.vehicles[passenger]
+	if !ok {
+		w.rides.mu.Unlock()
+		return
+	}
+	w.rides.unseat(passenger, s)
+	w.rides.mu.Unlock()
+
+	for _, v := range w.Viewers(s.vehicle.Position()) {
+		v.ViewEntityDismount(passenger, s.vehicle)
+		v.ViewEntityState(passenger)
+	}
+}
+
+// Eject dismounts all passengers of the vehicle passed.
+func (w *World) Eject(vehicle Entity) {
+	for _, passenger := range w.Passengers(vehicle) {
+		w.Dismount(passenger)
+	}
+}
+
+// Vehicle returns the entity that the passenger passed is riding and the offset of its seat. False is returned if
+// the passenger is not riding any entity in the World.
+func (w *World) Vehicle(passenger Entity) (vehicle Entity, offset mgl64.Vec3, ok bool) {
+	if w == nil {
+		return nil, mgl64.Vec3{}, false
+	}
+	w.rides.mu.Lock()
+	defer w.rides.mu.Unlock()
+	s, ok := w.rides.vehicles[passenger]
+	return s.vehicle, s.offset, ok
+}
+
+// Passengers returns the passengers riding the vehicle passed. The first passenger, if any, is the driver.
+func (w *World) Passengers(vehicle Entity) []Entity {
+	if w == nil {
+		return nil
+	}
+	w.rides.mu.Lock()
+	defer w.rides.mu.Unlock()
+	return slices.Clone(w.rides.passengers[vehicle])
+}
+
+// unlinkRides dismounts the entity passed from its vehicle and ejects all of its passengers. It is called when an
+// entity is removed from the World, so that no ride state is kept for entities that are no longer in it.
+func (w *World) unlinkRides(e Entity) {
+	w.Dismount(e)
+	w.Eject(e)
+}
+
+// unseat removes the seat of the passenger passed from the rides. r.mu must be held when calling unseat.
+func (r *rides) unseat(passenger Entity, s seat) {
+	delete(r.vehicles, passenger)
+	if remaining := sliceutil.DeleteVal(r.passengers[s.vehicle], passenger); len(remaining) > 0 {
+		r.passengers[s.vehicle] = remaining
+	} else {
+		delete(r.passengers, s.vehicle)
+	}
+}
@@ -41,6 +41,14 @@ type Settings struct {
 	// TickRange is the radius in chunks around a Viewer that has its blocks and entities ticked when the world is
 	// ticked. If set to 0, blocks and entities will never be ticked.
 	TickRange int32
+	// RecipesUnlock specifies if players must unlock recipes before they are able to craft them. If set to false,
+	// all recipes are available to players.
+	RecipesUnlock bool
+	// KeepInventory specifies if players keep their items and experience when they die instead of dropping them.
+	KeepInventory bool
+	// NaturalRegeneration specifies if players regenerate health when their food bar is full enough. Players in
+	// worlds with the peaceful difficulty always regenerate health.
+	NaturalRegeneration bool
 }
 
 // defaultSettings returns the default Settings for a new World.
@@ -52,5 +60,7 @@ func defaultSettings() *Settings {
 		TimeCycle:       true,
 		WeatherCycle:    true,
 		TickRange:       6,
+
+		NaturalRegeneration: true,
 	}
 }
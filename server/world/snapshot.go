@@ -0,0 +1,150 @@
+package world
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+)
+
+// Snapshot is an in-memory copy of a cuboid area of blocks in a World, including liquids and block entities. A
+// Snapshot may be taken using World.Snapshot and implements Structure, so that it may be pasted back into a World
+// using World.BuildStructure, which writes the blocks in batches per chunk. Snapshots may be rotated and mirrored
+// before they are pasted. Only the positions of blocks are transformed: The orientation of blocks themselves, such as
+// the direction that stairs are facing, is left unchanged.
+//
+// Snapshots are useful for resetting minigame arenas: A Snapshot of an arena may be taken once and pasted back at
+// the same position every time the arena needs to be restored.
+type Snapshot struct {
+	dim     [3]int
+	blocks  []Block
+	liquids []Liquid
+}
+
+// Snapshot copies all blocks, liquids and block entities in the cuboid between the two corners passed, both
+// inclusive, into a Snapshot. Chunks that are not yet loaded are loaded, or generated if not found in the
+// world save.
+func (w *World) Snapshot(a, b cube.Pos) Snapshot {
+	minPos := cube.Pos{min(a[0], b[0]), min(a[1], b[1]), min(a[2], b[2])}
+	maxPos := cube.Pos{max(a[0], b[0]), max(a[1], b[1]), max(a[2], b[2])}
+	s := newSnapshot([3]int{maxPos[0] - minPos[0] + 1, maxPos[1] - minPos[1] + 1, maxPos[2] - minPos[2] + 1})
+	if w == nil {
+		return s
+	}
+
+	for chunkX := minPos[0] >> 4; chunkX <= maxPos[0]>>4; chunkX++ {
+		for chunkZ := minPos[2] >> 4; chunkZ <= maxPos[2]>>4; chunkZ++ {
+			// Like in BuildStructure, blocks are read per chunk so that every chunk only needs to be locked once.
+			c := w.chunk(ChunkPos{int32(chunkX), int32(chunkZ)})
+			for x := max(minPos[0], chunkX<<4); x <= min(maxPos[0], chunkX<<4+15); x++ {
+				for z := max(minPos[2], chunkZ<<4); z <= min(maxPos[2], chunkZ<<4+15); z++ {
+					for y := minPos[1]; y <= maxPos[1]; y++ {
+						pos := cube.Pos{x, y, z}
+						i := s.index(x-minPos[0], y-minPos[1], z-minPos[2])
+
+						s.blocks[i] = copyBlockEntity(w.blockInChunk(c, pos))
+						if pos.OutOfBounds(w.Range()) {
+							continue
+						}
+						if liq, ok := BlockByRuntimeID(c.Block(uint8(x), int16(y), uint8(z), 1)); ok {
+							s.liquids[i], _ = liq.(Liquid)
+						}
+					}
+				}
+			}
+			c.Unlock()
+		}
+	}
+	return s
+}
+
+// newSnapshot creates an empty Snapshot with the dimensions passed.
+func newSnapshot(dim [3]int) Snapshot {
+	n := dim[0] * dim[1] * dim[2]
+	return Snapshot{dim: dim, blocks: make([]Block, n), liquids: make([]Liquid, n)}
+}
+
+// Dimensions returns the width, height and length of the Snapshot.
+func (s Snapshot) Dimensions() [3]int {
+	return s.dim
+}
+
+// At returns the block and liquid at the position passed relative to the lowest corner of the Snapshot. Block
+// entities are copied, so that every paste of the Snapshot gets its own state, such as the inventory of a chest.
+func (s Snapshot) At(x, y, z int, _ func(x, y, z int) Block) (Block, Liquid) {
+	i := s.index(x, y, z)
+	return copyBlockEntity(s.blocks[i]), s.liquids[i]
+}
+
+// copyBlockEntity returns a deep copy of the block passed if it is a block entity, by encoding and decoding its NBT.
+// This way, the copy never shares state, such as an inventory, with the original. Other blocks are returned as is.
+func copyBlockEntity(b Block) Block {
+	if nbter, ok := b.(NBTer); ok {
+		if c, ok := nbter.DecodeNBT(nbter.EncodeNBT()).(Block); ok {
+			return c
+		}
+	}
+	return b
+}
+
+// Rotate returns a copy of the Snapshot rotated clockwise around the Y axis by 90 degrees the amount of times
+// passed. A negative amount rotates the Snapshot counter-clockwise.
+func (s Snapshot) Rotate(times int) Snapshot {
+	times = ((times % 4) + 4) % 4
+	for ; times > 0; times-- {
+		r := newSnapshot([3]int{s.dim[2], s.dim[1], s.dim[0]})
+		for x := 0; x < s.dim[0]; x++ {
+			for y := 0; y < s.dim[1]; y++ {
+				for z := 0; z < s.dim[2]; z++ {
+					from, to := s.index(x, y, z), r.index(s.dim[2]-1-z, y, x)
+					r.blocks[to], r.liquids[to] = s.blocks[from], s.liquids[from]
+				}
+			}
+		}
+		s = r
+	}
+	return s
+}
+
+// Mirror returns a copy of the Snapshot mirrored along the cube.Axis passed. Mirroring along cube.X flips the
+// Snapshot so that the blocks at the lowest X coordinate end up at the highest X coordinate, and vice versa.
+func (s Snapshot) Mirror(axis cube.Axis) Snapshot {
+	r := newSnapshot(s.dim)
+	for x := 0; x < s.dim[0]; x++ {
+		for y := 0; y < s.dim[1]; y++ {
+			for z := 0; z < s.dim[2]; z++ {
+				mx, my, mz := x, y, z
+				switch axis {
+				case cube.X:
+					mx = s.dim[0] - 1 - x
+				case cube.Y:
+					my = s.dim[1] - 1 - y
+				case cube.Z:
+					mz = s.dim[2] - 1 - z
+				}
+				from, to := s.index(x, y, z), r.index(mx, my, mz)
+				r.blocks[to], r.liquids[to] = s.blocks[from], s.liquids[from]
+			}
+		}
+	}
+	return r
+}
+
+// index returns the index in the blocks and liquids slices of the position relative to the lowest corner of the
+// Snapshot.
+func (s Snapshot) index(x, y, z int) int {
+	return (y*s.dim[2]+z)*s.dim[0] + x
+}
+
+// min returns the smaller of the two integers passed.
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// max returns the bigger of the two integers passed.
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -45,6 +45,12 @@ type ChestOpen struct{ sound }
 // ChestClose is played when a chest is closed.
 type ChestClose struct{ sound }
 
+// ShulkerBoxOpen is played when a shulker box is opened.
+type ShulkerBoxOpen struct{ sound }
+
+// ShulkerBoxClose is played when a shulker box is closed.
+type ShulkerBoxClose struct{ sound }
+
 // BarrelOpen is played when a barrel is opened.
 type BarrelOpen struct{ sound }
 
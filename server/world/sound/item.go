@@ -47,6 +47,15 @@ type BucketEmpty struct {
 // BowShoot is a sound played when a bow is shot.
 type BowShoot struct{ sound }
 
+// ShieldBlock is a sound played when a shield blocks an attack.
+type ShieldBlock struct{ sound }
+
+// CrossbowLoad is a sound played when a crossbow is done loading.
+type CrossbowLoad struct{ sound }
+
+// CrossbowShoot is a sound played when a crossbow is shot.
+type CrossbowShoot struct{ sound }
+
 // ArrowHit is a sound played when an arrow hits ground.
 type ArrowHit struct{ sound }
 
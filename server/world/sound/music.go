@@ -0,0 +1,23 @@
+package sound
+
+import "time"
+
+// Music is a background music track that may be played to a player using player.Player.PlayMusic. Unlike the other
+// sounds in this package, Music is not a world.Sound: It is played to a single player only. The track is played at
+// the eye position of the player at the time it starts, so it becomes quieter if the player moves far away from that
+// position while it is playing.
+type Music struct {
+	// Name is the name of the sound of the track as defined in the resource packs of the client, such as
+	// "record.cat" or "music.game". Custom resource packs may define their own tracks.
+	Name string
+	// Duration is the length of the track. It is used to determine when the next track in the queue should start
+	// playing. A Duration of 0 means the track is never followed up by another track.
+	Duration time.Duration
+	// Volume is the volume of the track. A Volume of 0 is treated as 1.
+	Volume float64
+	// Pitch is the pitch of the track. A Pitch of 0 is treated as 1.
+	Pitch float64
+	// Loop specifies if the track should be repeated once it ends, until another track is played or the music is
+	// stopped. Tracks queued after a looping track are only played once the loop is ended by skipping the track.
+	Loop bool
+}
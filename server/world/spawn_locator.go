@@ -0,0 +1,67 @@
+package world
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/google/uuid"
+	"math/rand"
+)
+
+// SpawnLocator selects the position that players spawn at in a World if they do not have a spawn position of their
+// own, such as when joining for the first time or when respawning without a bed. Implementations may be used to
+// spread players out, spawn players of the same team together or spawn players at a random position in the
+// wilderness.
+type SpawnLocator interface {
+	// SpawnLocation returns the position in the World passed that the player with the UUID passed should spawn at.
+	SpawnLocation(w *World, id uuid.UUID) cube.Pos
+}
+
+// SpreadSpawnLocator is the default SpawnLocator of a World. It spawns players at a random, safe position on the
+// surface within a radius around the spawn of the World.
+type SpreadSpawnLocator struct {
+	// Radius is the horizontal radius in blocks around the spawn of the World that players may be spawned in. If
+	// set to 0, players always spawn in the same column as the spawn of the World.
+	Radius int
+}
+
+// spreadAttempts is the amount of random positions that a SpreadSpawnLocator tries before falling back to the
+// spawn of the World.
+const spreadAttempts = 16
+
+// SpawnLocation returns a random safe position on the surface within the Radius of the SpreadSpawnLocator around the
+// spawn of the World. If no safe position could be found, the spawn of the World is returned.
+func (s SpreadSpawnLocator) SpawnLocation(w *World, _ uuid.UUID) cube.Pos {
+	spawn := w.Spawn()
+	for i := 0; i < spreadAttempts; i++ {
+		x, z := spawn[0], spawn[2]
+		if s.Radius > 0 {
+			x, z = x+rand.Intn(s.Radius*2+1)-s.Radius, z+rand.Intn(s.Radius*2+1)-s.Radius
+		}
+		pos := cube.Pos{x, w.highestObstructingBlock(x, z) + 1, z}
+		if w.safeSpawn(pos) {
+			return pos
+		}
+		if s.Radius == 0 {
+			break
+		}
+	}
+	return spawn
+}
+
+// safeSpawn checks if a player is able to spawn at the position passed without suffocating, drowning or falling.
+func (w *World) safeSpawn(pos cube.Pos) bool {
+	below := pos.Side(cube.FaceDown)
+	if below[1] < w.Range()[0] || !w.Block(below).Model().FaceSolid(below, cube.FaceUp, w) {
+		return false
+	}
+	for _, p := range []cube.Pos{below, pos, pos.Side(cube.FaceUp)} {
+		if _, ok := w.Liquid(p); ok {
+			return false
+		}
+	}
+	for _, p := range []cube.Pos{pos, pos.Side(cube.FaceUp)} {
+		if len(w.Block(p).Model().BBox(p, w)) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+package world
+
+import (
+	"sort"
+)
+
+// Stats holds statistics on the resources used by a World at the time Stats were collected. Stats may be used to
+// find out what is using resources, for example to display them in an admin panel.
+type Stats struct {
+	// Chunks is the amount of chunks currently loaded.
+	Chunks int
+	// Viewers is the amount of viewers currently viewing the World.
+	Viewers int
+	// Entities holds the amount of entities in the World, indexed by the name of their EntityType.
+	Entities map[string]int
+	// BlockEntities is the amount of block entities in loaded chunks. TickingBlockEntities is the amount of these
+	// block entities that are ticked every tick, which are those implementing TickerBlock.
+	BlockEntities, TickingBlockEntities int
+	// ScheduledUpdates is the amount of block updates that are scheduled but have not yet been performed.
+	ScheduledUpdates int
+	// ScheduledTasks is the amount of tasks pending in the Scheduler of the World.
+	ScheduledTasks int
+}
+
+// ChunkStats holds statistics on the resources used by a single chunk loaded in a World.
+type ChunkStats struct {
+	// Pos is the position of the chunk.
+	Pos ChunkPos
+	// Viewers is the amount of viewers currently viewing the chunk.
+	Viewers int
+	// Entities holds the amount of entities in the chunk, indexed by the name of their EntityType.
+	Entities map[string]int
+	// BlockEntities is the amount of block entities in the chunk. TickingBlockEntities is the amount of these
+	// block entities that are ticked every tick, which are those implementing TickerBlock.
+	BlockEntities, TickingBlockEntities int
+	// ScheduledUpdates is the amount of block updates scheduled in the chunk that have not yet been performed.
+	ScheduledUpdates int
+}
+
+// Stats collects statistics on the resources currently used by the World. Stats is relatively expensive to call, as
+// it goes over all chunks loaded, so it should not be called every tick.
+func (w *World) Stats() Stats {
+	if w == nil {
+		return Stats{}
+	}
+	stats := Stats{Entities: make(map[string]int), ScheduledTasks: w.scheduler.Tasks()}
+	for _, c := range w.ChunkStats() {
+		stats.Chunks++
+		stats.BlockEntities += c.BlockEntities
+		stats.TickingBlockEntities += c.TickingBlockEntities
+		stats.ScheduledUpdates += c.ScheduledUpdates
+		for name, n := range c.Entities {
+			stats.Entities[name] += n
+		}
+	}
+	w.viewersMu.Lock()
+	stats.Viewers = len(w.viewers)
+	w.viewersMu.Unlock()
+	return stats
+}
+
+// ChunkStats collects statistics on the resources currently used by every chunk loaded in the World. The ChunkStats
+// returned are sorted by the amount of entities in the chunk, so that the chunks with the most entities come first.
+// Like Stats, ChunkStats should not be called every tick.
+func (w *World) ChunkStats() []ChunkStats {
+	if w == nil {
+		return nil
+	}
+	w.chunkMu.Lock()
+	stats := make([]ChunkStats, 0, len(w.chunks))
+	for pos, c := range w.chunks {
+		c.Lock()
+		s := ChunkStats{Pos: pos, Viewers: len(c.v), Entities: make(map[string]int), BlockEntities: len(c.e)}
+		for _, b := range c.e {
+			if _, ok := b.(TickerBlock); ok {
+				s.TickingBlockEntities++
+			}
+		}
+		for _, e := range c.entities {
+			s.Entities[e.Type().EncodeEntity()]++
+		}
+		c.Unlock()
+		stats = append(stats, s)
+	}
+	w.chunkMu.Unlock()
+
+	scheduled := make(map[ChunkPos]int)
+	w.updateMu.Lock()
+	for pos := range w.scheduledUpdates {
+		scheduled[chunkPosFromBlockPos(pos)]++
+	}
+	w.updateMu.Unlock()
+
+	for i := range stats {
+		stats[i].ScheduledUpdates = scheduled[stats[i].Pos]
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		return entityCount(stats[i]) > entityCount(stats[j])
+	})
+	return stats
+}
+
+// entityCount returns the total amount of entities in the chunk that the ChunkStats passed were collected for.
+func entityCount(s ChunkStats) (n int) {
+	for _, count := range s.Entities {
+		n += count
+	}
+	return n
+}
@@ -0,0 +1,82 @@
+package tag
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+var (
+	//go:embed vanilla
+	vanillaFS embed.FS
+)
+
+// jsonTag is the JSON representation of a single tag.
+type jsonTag struct {
+	// Replace specifies if the values of the tag replace any values previously registered for the tag, rather than
+	// being added to them.
+	Replace bool `json:"replace"`
+	// Values holds the names of the blocks or items in the tag, or names of other tags prefixed with '#'.
+	Values []string `json:"values"`
+}
+
+// LoadDirectory loads all tags from the directory at the path passed. Block tags are read from the 'blocks'
+// subdirectory and item tags from the 'items' subdirectory. Inside of these, the first directory is the namespace of
+// the tag and the remaining path, without the '.json' extension, is its name. The file 'blocks/minecraft/logs.json'
+// therefore holds the block tag "minecraft:logs". A file holds a JSON object with a 'values' list and an optional
+// 'replace' field:
+//
+//	{"replace": false, "values": ["minecraft:log", "minecraft:log2", "#minecraft:crimson_stems"]}
+func LoadDirectory(dir string) error {
+	return Load(os.DirFS(dir))
+}
+
+// Load loads all tags from the fs.FS passed, using the same layout as LoadDirectory. Directories that do not exist in
+// the fs.FS are ignored.
+func Load(fsys fs.FS) error {
+	if err := blocks.load(fsys, "blocks"); err != nil {
+		return fmt.Errorf("load block tags: %w", err)
+	}
+	if err := items.load(fsys, "items"); err != nil {
+		return fmt.Errorf("load item tags: %w", err)
+	}
+	return nil
+}
+
+// load loads all tags in the directory passed in the fs.FS into the registry.
+func (r *registry) load(fsys fs.FS, dir string) error {
+	if _, err := fs.Stat(fsys, dir); err != nil {
+		return nil
+	}
+	return fs.WalkDir(fsys, dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(p) != ".json" {
+			return err
+		}
+		namespace, name, ok := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(p, dir+"/"), ".json"), "/")
+		if !ok {
+			return fmt.Errorf("tag %v is not in a namespace directory", p)
+		}
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		var t jsonTag
+		if err := json.Unmarshal(data, &t); err != nil {
+			return fmt.Errorf("decode tag %v: %w", p, err)
+		}
+		r.register(namespace+":"+name, t.Values, t.Replace)
+		return nil
+	})
+}
+
+// init loads the vanilla tags embedded in the package.
+func init() {
+	fsys, _ := fs.Sub(vanillaFS, "vanilla")
+	if err := Load(fsys); err != nil {
+		panic(err)
+	}
+}
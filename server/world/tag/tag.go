@@ -0,0 +1,162 @@
+// Package tag implements data-driven tags of blocks and items, such as "#minecraft:logs" or "#minecraft:planks". A
+// tag groups blocks or items by their name, so that recipes and plugins are able to check if a block or item is part
+// of a category without having to know about every type that belongs to it.
+package tag
+
+import (
+	"github.com/df-mc/dragonfly/server/world"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"strings"
+	"sync"
+)
+
+// registry holds the values of all tags of one kind, either blocks or items.
+type registry struct {
+	mu sync.RWMutex
+	// tags maps the name of a tag to its values. A value is either the name of a block or item, or the name of
+	// another tag prefixed with '#'.
+	tags map[string][]string
+}
+
+var (
+	blocks = &registry{tags: map[string][]string{}}
+	items  = &registry{tags: map[string][]string{}}
+)
+
+// RegisterBlocks adds the values passed to the block tag with the name passed, creating the tag if it did not yet
+// exist. A value is either the name of a block, such as "minecraft:planks", or the name of another block tag prefixed
+// with '#', such as "#minecraft:logs", which includes all blocks of that tag.
+func RegisterBlocks(name string, values ...string) {
+	blocks.register(name, values, false)
+}
+
+// RegisterItems adds the values passed to the item tag with the name passed, creating the tag if it did not yet exist.
+// A value is either the name of an item, such as "minecraft:coal", or the name of another item tag prefixed with '#',
+// such as "#minecraft:coals", which includes all items of that tag.
+func RegisterItems(name string, values ...string) {
+	items.register(name, values, false)
+}
+
+// HasBlock checks if the world.Block passed is part of the block tag with the name passed. False is returned if no
+// block tag with the name exists.
+func HasBlock(b world.Block, name string) bool {
+	n, _ := b.EncodeBlock()
+	return blocks.contains(name, n)
+}
+
+// HasItem checks if the world.Item passed is part of the item tag with the name passed. False is returned if no item
+// tag with the name exists.
+func HasItem(i world.Item, name string) bool {
+	n, _ := i.EncodeItem()
+	return items.contains(name, n)
+}
+
+// Blocks returns the names of all blocks that are part of the block tag with the name passed, resolving any tags it
+// references. False is returned if no block tag with the name exists.
+func Blocks(name string) ([]string, bool) {
+	return blocks.resolve(name)
+}
+
+// Items returns the names of all items that are part of the item tag with the name passed, resolving any tags it
+// references. False is returned if no item tag with the name exists.
+func Items(name string) ([]string, bool) {
+	return items.resolve(name)
+}
+
+// BlockTags returns the names of all block tags that are currently registered.
+func BlockTags() []string {
+	return blocks.names()
+}
+
+// ItemTags returns the names of all item tags that are currently registered.
+func ItemTags() []string {
+	return items.names()
+}
+
+// register adds values to the tag with the name passed. If replace is true, any values that the tag previously had
+// are removed first.
+func (r *registry) register(name string, values []string, replace bool) {
+	name = strings.TrimPrefix(name, "#")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if replace {
+		r.tags[name] = nil
+	}
+	for _, v := range values {
+		if !slices.Contains(r.tags[name], v) {
+			r.tags[name] = append(r.tags[name], v)
+		}
+	}
+}
+
+// contains checks if the tag with the name passed holds a block or item with the name v.
+func (r *registry) contains(name, v string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.containsRecursive(strings.TrimPrefix(name, "#"), v, map[string]struct{}{})
+}
+
+// containsRecursive checks if the tag passed or any tag that it references holds v. Tags already present in visited
+// are not checked again, so that tags referencing each other do not cause infinite recursion.
+func (r *registry) containsRecursive(name, v string, visited map[string]struct{}) bool {
+	if _, ok := visited[name]; ok {
+		return false
+	}
+	visited[name] = struct{}{}
+
+	for _, value := range r.tags[name] {
+		if strings.HasPrefix(value, "#") {
+			if r.containsRecursive(value[1:], v, visited) {
+				return true
+			}
+			continue
+		}
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve returns all block or item names held by the tag passed and any of the tags it references.
+func (r *registry) resolve(name string) ([]string, bool) {
+	name = strings.TrimPrefix(name, "#")
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if _, ok := r.tags[name]; !ok {
+		return nil, false
+	}
+	var values []string
+	r.resolveRecursive(name, &values, map[string]struct{}{})
+	return values, true
+}
+
+// resolveRecursive appends all values of the tag passed to values, resolving referenced tags recursively.
+func (r *registry) resolveRecursive(name string, values *[]string, visited map[string]struct{}) {
+	if _, ok := visited[name]; ok {
+		return
+	}
+	visited[name] = struct{}{}
+
+	for _, value := range r.tags[name] {
+		if strings.HasPrefix(value, "#") {
+			r.resolveRecursive(value[1:], values, visited)
+			continue
+		}
+		if !slices.Contains(*values, value) {
+			*values = append(*values, value)
+		}
+	}
+}
+
+// names returns the names of all tags in the registry in alphabetical order.
+func (r *registry) names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := maps.Keys(r.tags)
+	slices.Sort(names)
+	return names
+}
@@ -32,8 +32,16 @@ func (t ticker) tickLoop() {
 	}
 }
 
+// spawnChunksPerTick is the maximum amount of chunks around the spawn of a World that are loaded every tick.
+const spawnChunksPerTick = 8
+
 // tick performs a tick on the World and updates the time, weather, blocks and entities that require updates.
 func (t ticker) tick() {
+	// Tasks are run regardless of whether the World has viewers, so that their delays are always measured in
+	// actual ticks.
+	t.w.scheduler.Tick()
+	t.w.resendBiomes()
+
 	viewers, loaders := t.w.allViewers()
 
 	t.w.set.Lock()
@@ -52,8 +60,18 @@ func (t ticker) tick() {
 	}
 
 	rain, thunder, tick, tim := t.w.set.Raining, t.w.set.Thundering && t.w.set.Raining, t.w.set.CurrentTick, int(t.w.set.Time)
+	timeCycle := t.w.advance && t.w.set.TimeCycle && t.w.conf.Dim.TimeCycle()
 	t.w.set.Unlock()
 
+	if timeCycle {
+		switch tim % 24000 {
+		case 23000:
+			t.w.Handler().HandleDawn()
+		case 12000:
+			t.w.Handler().HandleDusk()
+		}
+	}
+
 	if tick%20 == 0 {
 		for _, viewer := range viewers {
 			if t.w.conf.Dim.TimeCycle() {
@@ -68,10 +86,21 @@ func (t ticker) tick() {
 		t.w.tickLightning()
 	}
 
+	t.w.tickBorder()
+	if t.w.spawnLoader != nil {
+		// Load the chunks around the spawn gradually, so that creating the World or moving its spawn doesn't
+		// cause a lag spike.
+		t.w.spawnLoader.Load(spawnChunksPerTick)
+	}
+	t.tickMobSpawning(loaders, tick)
 	t.tickEntities(tick)
 	t.tickBlocksRandomly(loaders, tick)
 	t.tickScheduledBlocks(tick)
 	t.performNeighbourUpdates()
+
+	if t.w.views.subscribers.Load() > 0 {
+		t.w.updateView(tick)
+	}
 }
 
 // tickScheduledBlocks executes scheduled block updates in chunks that are currently loaded.
@@ -126,11 +155,16 @@ func (t ticker) tickBlocksRandomly(loaders []*Loader, tick int64) {
 		g             randUint4
 		blockEntities []cube.Pos
 		randomBlocks  []cube.Pos
+		// precipitation holds the x and z coordinates of columns that receive a precipitation tick.
+		precipitation [][2]int
 	)
 	if r == 0 {
 		// NOP if the simulation distance is 0.
 		return
 	}
+	t.w.set.Lock()
+	raining := t.w.set.Raining
+	t.w.set.Unlock()
 
 	loaded := make([]ChunkPos, 0, len(loaders))
 	for _, loader := range loaders {
@@ -151,6 +185,9 @@ func (t ticker) tickBlocksRandomly(loaders []*Loader, tick int64) {
 		blockEntities = append(blockEntities, maps.Keys(c.e)...)
 
 		cx, cz := int(pos[0]<<4), int(pos[1]<<4)
+		if raining && t.w.r.Intn(16) == 0 {
+			precipitation = append(precipitation, [2]int{cx + int(g.uint4(t.w.r)), cz + int(g.uint4(t.w.r))})
+		}
 
 		// We generate up to j random positions for every sub chunk.
 		for j := 0; j < t.w.conf.RandomTickSpeed; j++ {
@@ -183,6 +220,14 @@ func (t ticker) tickBlocksRandomly(loaders []*Loader, tick int64) {
 			rb.RandomTick(pos, t.w, t.w.r)
 		}
 	}
+	for _, column := range precipitation {
+		pos := cube.Pos{column[0], t.w.highestObstructingBlock(column[0], column[1]), column[1]}
+		if pt, ok := t.w.Block(pos).(PrecipitationTicker); ok {
+			if above := pos.Side(cube.FaceUp); t.w.RainingAt(above) || t.w.SnowingAt(above) {
+				pt.PrecipitationTick(pos, t.w, t.w.r)
+			}
+		}
+	}
 	for _, pos := range blockEntities {
 		if tb, ok := t.w.Block(pos).(TickerBlock); ok {
 			tb.Tick(tick, pos, t.w)
@@ -248,6 +293,7 @@ func (t ticker) tickEntities(tick int64) {
 			if old, ok := t.w.chunks[lastPos]; ok {
 				old.Lock()
 				old.entities = sliceutil.DeleteVal(old.entities, e)
+				old.em = true
 				viewers = slices.Clone(old.v)
 				old.Unlock()
 			}
@@ -260,6 +306,7 @@ func (t ticker) tickEntities(tick int64) {
 	for _, move := range entitiesToMove {
 		move.after.Lock()
 		move.after.entities = append(move.after.entities, move.e)
+		move.after.em = true
 		viewersAfter := move.after.v
 		move.after.Unlock()
 
@@ -0,0 +1,221 @@
+package world
+
+import (
+	"github.com/df-mc/atomic"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/world/chunk"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"sync"
+)
+
+// View is a read-only view of the blocks, liquids and biomes of all chunks loaded in a World at the end of a tick. A
+// View may be obtained using World.View and is safe to use from any goroutine without blocking the World, which
+// makes it suited for generators, entity AI and other systems that run asynchronously. Reading from a View never
+// loads chunks: Positions in chunks that were not loaded when the View was created are read as air.
+//
+// Block entities, such as chests, returned by a View are shared with the World and must not be modified.
+type View struct {
+	tick   int64
+	r      cube.Range
+	chunks map[ChunkPos]*viewChunk
+}
+
+// viewChunk is a copy of a chunk held by a View. Chunks that are not changed between two ticks are shared between
+// the Views of these ticks, as are the sub chunks of changed chunks that did not change themselves.
+type viewChunk struct {
+	*chunk.Chunk
+	e map[cube.Pos]Block
+
+	version, lightVersion      uint64
+	subVersions, lightVersions []uint64
+}
+
+// views holds the most recent View of a World.
+type views struct {
+	mu sync.Mutex
+	// subscribers is the amount of subscriptions made using World.SubscribeView. The View of the World is only
+	// updated every tick while it is larger than 0.
+	subscribers atomic.Int64
+	current     atomic.Value[*View]
+}
+
+// View returns a read-only View of the World. If the View of the World is subscribed to using SubscribeView, View
+// returns the state of the World as it was at the end of the most recent tick and never blocks. Otherwise, a View of
+// the current state of the World is created, reusing the chunks of the previous View that did not change.
+func (w *World) View() *View {
+	if w == nil {
+		return &View{chunks: map[ChunkPos]*viewChunk{}}
+	}
+	w.set.Lock()
+	tick := w.set.CurrentTick
+	w.set.Unlock()
+	if v := w.views.current.Load(); v != nil && (w.views.subscribers.Load() > 0 || v.tick == tick) {
+		return v
+	}
+	return w.updateView(tick)
+}
+
+// SubscribeView makes the World update its View at the end of every tick, so that View returns the state of the
+// World at the end of the most recent tick without blocking. Only the sub chunks that changed during a tick are
+// copied into the next View. The function returned must be called once the View is no longer needed, after which
+// the World stops updating it if no other subscriptions remain.
+func (w *World) SubscribeView() (unsubscribe func()) {
+	if w == nil {
+		return func() {}
+	}
+	if w.views.subscribers.Inc() == 1 {
+		w.View()
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			if w.views.subscribers.Dec() == 0 {
+				// Nothing is subscribed to the View anymore, so it doesn't need to keep all chunks in memory.
+				w.views.mu.Lock()
+				w.views.current.Store(nil)
+				w.views.mu.Unlock()
+			}
+		})
+	}
+}
+
+// updateView creates a new View of the World at the tick passed and stores it as the current View of the World.
+func (w *World) updateView(tick int64) *View {
+	w.views.mu.Lock()
+	defer w.views.mu.Unlock()
+
+	w.chunkMu.Lock()
+	chunks := maps.Clone(w.chunks)
+	w.chunkMu.Unlock()
+
+	prev := w.views.current.Load()
+	v := &View{tick: tick, r: w.Range(), chunks: make(map[ChunkPos]*viewChunk, len(chunks))}
+	for pos, c := range chunks {
+		var vc *viewChunk
+		if prev != nil {
+			vc = prev.chunks[pos]
+		}
+		c.Lock()
+		if vc != nil && vc.version == c.version && vc.lightVersion == c.lightVersion {
+			c.Unlock()
+			v.chunks[pos] = vc
+			continue
+		}
+		v.chunks[pos] = newViewChunk(c, vc)
+		c.Unlock()
+	}
+	w.views.current.Store(v)
+	return v
+}
+
+// newViewChunk creates a viewChunk holding a copy of the chunkData passed. Sub chunks of the chunkData that did not
+// change since the previous viewChunk of the chunk passed, if not nil, was created are shared with it rather than
+// copied. The chunkData must be locked when calling newViewChunk.
+func newViewChunk(c *chunkData, prev *viewChunk) *viewChunk {
+	vc := &viewChunk{
+		version:       c.version,
+		lightVersion:  c.lightVersion,
+		subVersions:   slices.Clone(c.subVersions),
+		lightVersions: slices.Clone(c.lightVersions),
+	}
+	if prev == nil {
+		vc.Chunk, vc.e = c.Chunk.Clone(), maps.Clone(c.e)
+		return vc
+	}
+	changed := make([]bool, len(c.subVersions))
+	for i := range changed {
+		changed[i] = i >= len(prev.subVersions) || prev.subVersions[i] != c.subVersions[i] || prev.lightVersions[i] != c.lightVersions[i]
+	}
+	vc.Chunk = c.Chunk.CloneChanged(prev.Chunk, changed)
+	if vc.e = prev.e; prev.version != c.version {
+		vc.e = maps.Clone(c.e)
+	}
+	return vc
+}
+
+// Tick returns the tick of the World at which the View was created.
+func (v *View) Tick() int64 {
+	return v.tick
+}
+
+// Range returns the range in blocks of the World that the View was created from.
+func (v *View) Range() cube.Range {
+	return v.r
+}
+
+// Loaded checks if the chunk at the position passed was loaded when the View was created.
+func (v *View) Loaded(pos ChunkPos) bool {
+	_, ok := v.chunks[pos]
+	return ok
+}
+
+// Block returns the block at the position passed. Air is returned if the chunk of the position was not loaded when
+// the View was created.
+func (v *View) Block(pos cube.Pos) Block {
+	c, ok := v.chunks[chunkPosFromBlockPos(pos)]
+	if !ok || pos.OutOfBounds(v.r) {
+		return air()
+	}
+	rid := c.Block(uint8(pos[0]), int16(pos[1]), uint8(pos[2]), 0)
+	if nbtBlocks[rid] {
+		if b, ok := c.e[pos]; ok {
+			return b
+		}
+	}
+	b, _ := BlockByRuntimeID(rid)
+	return b
+}
+
+// Liquid returns the liquid at the position passed, either in the first or the second layer of the block. If no
+// liquid is present at the position, false is returned.
+func (v *View) Liquid(pos cube.Pos) (Liquid, bool) {
+	c, ok := v.chunks[chunkPosFromBlockPos(pos)]
+	if !ok || pos.OutOfBounds(v.r) {
+		return nil, false
+	}
+	x, y, z := uint8(pos[0]), int16(pos[1]), uint8(pos[2])
+	for layer := uint8(0); layer < 2; layer++ {
+		if b, ok := BlockByRuntimeID(c.Block(x, y, z, layer)); ok {
+			if liq, ok := b.(Liquid); ok {
+				return liq, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Biome returns the Biome at the position passed. Ocean is returned if the chunk of the position was not loaded when
+// the View was created.
+func (v *View) Biome(pos cube.Pos) Biome {
+	c, ok := v.chunks[chunkPosFromBlockPos(pos)]
+	if !ok || pos.OutOfBounds(v.r) {
+		return ocean()
+	}
+	if b, ok := BiomeByID(int(c.Biome(uint8(pos[0]), int16(pos[1]), uint8(pos[2])))); ok {
+		return b
+	}
+	return ocean()
+}
+
+// HighestBlock looks up the highest non-air block at the x and z coordinates passed. The minimum height of the World
+// is returned if the chunk of the column was not loaded when the View was created.
+func (v *View) HighestBlock(x, z int) int {
+	c, ok := v.chunks[ChunkPos{int32(x >> 4), int32(z >> 4)}]
+	if !ok {
+		return v.r[0]
+	}
+	return int(c.HighestBlock(uint8(x), uint8(z)))
+}
+
+// Light returns the light level at the position passed, as it was when the View was created.
+func (v *View) Light(pos cube.Pos) uint8 {
+	c, ok := v.chunks[chunkPosFromBlockPos(pos)]
+	if !ok || pos[1] < v.r[0] {
+		return 0
+	}
+	if pos[1] > v.r[1] {
+		return 15
+	}
+	return c.Light(uint8(pos[0]), int16(pos[1]), uint8(pos[2]))
+}
@@ -31,12 +31,22 @@ type Viewer interface {
 	ViewEntityTeleport(e Entity, pos mgl64.Vec3)
 	// ViewFurnaceUpdate updates a furnace for the associated session based on previous times.
 	ViewFurnaceUpdate(prevCookTime, cookTime, prevRemainingFuelTime, remainingFuelTime, prevMaxFuelTime, maxFuelTime time.Duration)
+	// ViewBrewingUpdate updates a brewing stand for the associated session based on previous times and fuel.
+	ViewBrewingUpdate(prevBrewTime, brewTime time.Duration, prevFuelAmount, fuelAmount, prevFuelTotal, fuelTotal int32)
 	// ViewChunk views the chunk passed at a particular position. It is called for every chunk loaded using
 	// the world.Loader.
 	ViewChunk(pos ChunkPos, c *chunk.Chunk, blockEntities map[cube.Pos]Block)
 	// ViewTime views the time of the world. It is called every time the time is changed or otherwise every
 	// second.
 	ViewTime(t int)
+	// ViewTimeCycle views if the time of the world is currently cycling. If false, the viewer should stop advancing
+	// the time on its own until the next call to ViewTime.
+	ViewTimeCycle(cycle bool)
+	// ViewRecipesUnlock views if recipes must be unlocked before they may be crafted in the world. If true, the
+	// viewer should only show the recipes it has unlocked.
+	ViewRecipesUnlock(unlock bool)
+	// ViewKeepInventory views if players in the world keep their items and experience when they die.
+	ViewKeepInventory(keep bool)
 	// ViewEntityItems views the items currently held by an entity that is able to equip items.
 	ViewEntityItems(e Entity)
 	// ViewEntityArmour views the items currently equipped as armour by the entity.
@@ -44,6 +54,11 @@ type Viewer interface {
 	// ViewEntityAction views an action performed by an entity. Available actions may be found in the `action`
 	// package, and include things such as swinging an arm.
 	ViewEntityAction(e Entity, a EntityAction)
+	// ViewEntityMount views an entity starting to ride another entity. driver is true if the passenger is the
+	// first passenger of the vehicle and controls it.
+	ViewEntityMount(passenger, vehicle Entity, driver bool)
+	// ViewEntityDismount views an entity no longer riding another entity.
+	ViewEntityDismount(passenger, vehicle Entity)
 	// ViewEntityState views the current state of an entity. It is called whenever an entity changes its
 	// physical appearance, for example when sprinting.
 	ViewEntityState(e Entity)
@@ -83,10 +98,15 @@ func (NopViewer) ViewEntityVelocity(Entity, mgl64.Vec3)
 func (NopViewer) ViewEntityTeleport(Entity, mgl64.Vec3)                         {}
 func (NopViewer) ViewChunk(ChunkPos, *chunk.Chunk, map[cube.Pos]Block)          {}
 func (NopViewer) ViewTime(int)                                                  {}
+func (NopViewer) ViewTimeCycle(bool)                                            {}
+func (NopViewer) ViewRecipesUnlock(bool)                                        {}
+func (NopViewer) ViewKeepInventory(bool)                                        {}
 func (NopViewer) ViewEntityItems(Entity)                                        {}
 func (NopViewer) ViewEntityArmour(Entity)                                       {}
 func (NopViewer) ViewEntityAction(Entity, EntityAction)                         {}
 func (NopViewer) ViewEntityState(Entity)                                        {}
+func (NopViewer) ViewEntityMount(Entity, Entity, bool)                          {}
+func (NopViewer) ViewEntityDismount(Entity, Entity)                             {}
 func (NopViewer) ViewParticle(mgl64.Vec3, Particle)                             {}
 func (NopViewer) ViewSound(mgl64.Vec3, Sound)                                   {}
 func (NopViewer) ViewBlockUpdate(cube.Pos, Block, int)                          {}
@@ -97,3 +117,5 @@ func (NopViewer) ViewWorldSpawn(cube.Pos)
 func (NopViewer) ViewWeather(bool, bool)                                        {}
 func (NopViewer) ViewFurnaceUpdate(time.Duration, time.Duration, time.Duration, time.Duration, time.Duration, time.Duration) {
 }
+func (NopViewer) ViewBrewingUpdate(time.Duration, time.Duration, int32, int32, int32, int32) {
+}
@@ -20,6 +20,27 @@ func (w weather) StartWeatherCycle() {
 	w.enableWeatherCycle(true)
 }
 
+// Raining checks if it is currently raining in the World. Unlike RainingAt, Raining does not take into account if
+// rain can actually fall at any position, which depends on the biome and blocks above.
+func (w weather) Raining() bool {
+	if w.w == nil || !w.w.Dimension().WeatherCycle() {
+		return false
+	}
+	w.w.set.Lock()
+	defer w.w.set.Unlock()
+	return w.w.set.Raining
+}
+
+// Thundering checks if it is currently thundering in the World. Thunder only occurs if it is also raining.
+func (w weather) Thundering() bool {
+	if w.w == nil || !w.w.Dimension().WeatherCycle() {
+		return false
+	}
+	w.w.set.Lock()
+	defer w.w.set.Unlock()
+	return w.w.set.Raining && w.w.set.Thundering
+}
+
 // SnowingAt checks if it is snowing at a specific cube.Pos in the World. True is returned if the temperature in the
 // biome at that position is sufficiently low, if it is raining and if it's above the top-most obstructing block.
 func (w weather) SnowingAt(pos cube.Pos) bool {
@@ -2,6 +2,8 @@ package world
 
 import (
 	"fmt"
+	"hash/fnv"
+	"math"
 	"math/rand"
 	"sync"
 	"time"
@@ -10,6 +12,7 @@ import (
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/event"
 	"github.com/df-mc/dragonfly/server/internal/sliceutil"
+	"github.com/df-mc/dragonfly/server/scheduler"
 	"github.com/df-mc/dragonfly/server/world/chunk"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/google/uuid"
@@ -52,9 +55,17 @@ type World struct {
 	// entities holds a map of entities currently loaded and the last ChunkPos that the Entity was in.
 	// These are tracked so that a call to RemoveEntity can find the correct entity.
 	entities map[Entity]ChunkPos
+	// entityIDs holds the entities in the entities map that have a UUID, indexed by that UUID, so that they may
+	// be looked up using EntityByUUID.
+	entityIDs map[uuid.UUID]Entity
 
 	r *rand.Rand
 
+	biomeMu sync.Mutex
+	// biomeChanges holds the positions of chunks of which biomes were changed using SetBiome. These chunks are
+	// resent to their viewers in the next tick.
+	biomeChanges map[ChunkPos]struct{}
+
 	updateMu sync.Mutex
 	// scheduledUpdates is a map of tick time values indexed by the block position at which an update is
 	// scheduled. If the current tick exceeds the tick value passed, the block update will be performed
@@ -64,6 +75,19 @@ type World struct {
 
 	viewersMu sync.Mutex
 	viewers   map[*Loader]Viewer
+	// spawnLoader is a Loader that keeps the chunks around the spawn of the World loaded. It is nil if
+	// Config.SpawnChunkRadius is 0.
+	spawnLoader *Loader
+
+	border     border
+	growth     growth
+	views      views
+	physics    physics
+	spawning   mobSpawning
+	liquidFlow liquidFlow
+	rides      rides
+
+	scheduler *scheduler.Scheduler
 }
 
 // New creates a new initialised world. The world may be used right away, but it will not be saved or loaded
@@ -143,6 +167,7 @@ func (w *World) Biome(pos cube.Pos) Biome {
 	b, ok := BiomeByID(id)
 	if !ok {
 		w.conf.Log.Errorf("could not find biome by ID %v", id)
+		return ocean()
 	}
 	return b
 }
@@ -206,6 +231,9 @@ func (w *World) highestObstructingBlock(x, z int) int {
 
 // SetOpts holds several parameters that may be set to disable updates in the World of different kinds as a result of
 // a call to SetBlock.
+// Setting all fields to true makes SetBlock change only the block itself, which is useful for map editing where the
+// surrounding blocks should be left untouched: No liquid will start flowing, no blocks will fall and light will not
+// be recalculated. World.UpdateBlock may be called afterwards to apply these updates explicitly.
 type SetOpts struct {
 	// DisableBlockUpdates makes SetBlock not update any neighbouring blocks as a result of the SetBlock call.
 	DisableBlockUpdates bool
@@ -213,6 +241,9 @@ type SetOpts struct {
 	// layer, if it already was on the second layer). Disabling this is not strongly recommended unless performance is
 	// very important or where it is known no liquid can be present anyway.
 	DisableLiquidDisplacement bool
+	// DisableLightUpdates makes SetBlock not recalculate the light around the block changed, even if the block
+	// emits or blocks light differently than the block previously at the position.
+	DisableLightUpdates bool
 }
 
 // SetBlock writes a block to the position passed. If a chunk is not yet loaded at that position, the chunk is
@@ -240,12 +271,10 @@ func (w *World) SetBlock(pos cube.Pos, b Block, opts *SetOpts) {
 
 	rid := BlockRuntimeID(b)
 
-	var before uint32
-	if rid != airRID && !opts.DisableLiquidDisplacement {
-		before = c.Block(x, y, z, 0)
-	}
+	before := c.Block(x, y, z, 0)
+	lightChanged := chunk.LightBlocks[before] != chunk.LightBlocks[rid] || chunk.FilteringBlocks[before] != chunk.FilteringBlocks[rid]
 
-	c.m = true
+	c.modifiedAt(y)
 	c.SetBlock(x, y, z, 0, rid)
 	if nbtBlocks[rid] {
 		c.e[pos] = b
@@ -286,24 +315,103 @@ func (w *World) SetBlock(pos cube.Pos, b Block, opts *SetOpts) {
 	for _, viewer := range viewers {
 		viewer.ViewBlockUpdate(pos, b, 0)
 	}
+	if lightChanged && !opts.DisableLightUpdates {
+		w.updateLight(pos)
+	}
 
 	if !opts.DisableBlockUpdates {
 		w.doBlockUpdatesAround(pos)
 	}
 }
 
+// UpdateBlock recalculates the light around the position passed and updates the block at that position and all of
+// its neighbours, as if the block at the position was just changed. Liquids will start flowing and blocks affected by
+// gravity will start falling as a result.
+// UpdateBlock may be used to apply the updates that were disabled by passing SetOpts to SetBlock.
+func (w *World) UpdateBlock(pos cube.Pos) {
+	if w == nil || pos.OutOfBounds(w.Range()) {
+		return
+	}
+	w.updateLight(pos)
+	w.doBlockUpdatesAround(pos)
+}
+
+// ChunkVersion returns the version of the chunk at the position passed. The version changes every time a block or
+// liquid in the chunk is changed and is unique across all chunks and worlds, also when a chunk is unloaded and loaded
+// again. It may therefore be used to check if a chunk was changed since its version was last obtained, for example to
+// invalidate results computed from the blocks in the chunk.
+// If the chunk is not yet loaded, it is first loaded or generated if it could not be found in the world save.
+func (w *World) ChunkVersion(pos ChunkPos) uint64 {
+	if w == nil {
+		return 0
+	}
+	c := w.chunk(pos)
+	defer c.Unlock()
+	return c.version
+}
+
+// ChunkLoaded checks if the chunk at the position passed is currently loaded. Unlike most other methods of World,
+// ChunkLoaded never loads or generates the chunk, so it may be used to avoid loading chunks when reading blocks.
+func (w *World) ChunkLoaded(pos ChunkPos) bool {
+	if w == nil {
+		return false
+	}
+	w.chunkMu.Lock()
+	defer w.chunkMu.Unlock()
+	_, ok := w.chunks[pos]
+	return ok
+}
+
 // SetBiome sets the biome at the position passed. If a chunk is not yet loaded at that position, the chunk is
 // first loaded or generated if it could not be found in the world save.
+// The client only reads biomes from full chunk data, so viewers of the chunk have the chunk resent to them in
+// order for the new grass, foliage and water colours to show up. Chunks are resent at most once per tick, so that
+// setting the biomes of many blocks in the same chunk does not resend it for every block.
 func (w *World) SetBiome(pos cube.Pos, b Biome) {
 	if w == nil || pos.OutOfBounds(w.Range()) {
 		// Fast way out.
 		return
 	}
-	c := w.chunk(chunkPosFromBlockPos(pos))
+	chunkPos := chunkPosFromBlockPos(pos)
+	c := w.chunk(chunkPos)
 	defer c.Unlock()
 
-	c.m = true
-	c.SetBiome(uint8(pos[0]), int16(pos[1]), uint8(pos[2]), uint32(b.EncodeBiome()))
+	id := uint32(b.EncodeBiome())
+	if c.Biome(uint8(pos[0]), int16(pos[1]), uint8(pos[2])) == id {
+		// The biome didn't actually change, so there's no need to resend the chunk.
+		return
+	}
+	c.modified()
+	c.SetBiome(uint8(pos[0]), int16(pos[1]), uint8(pos[2]), id)
+
+	w.biomeMu.Lock()
+	w.biomeChanges[chunkPos] = struct{}{}
+	w.biomeMu.Unlock()
+}
+
+// resendBiomes resends the chunks of which biomes were changed using SetBiome since the last call to resendBiomes
+// to their viewers. It is called every tick.
+func (w *World) resendBiomes() {
+	w.biomeMu.Lock()
+	if len(w.biomeChanges) == 0 {
+		w.biomeMu.Unlock()
+		return
+	}
+	changes := w.biomeChanges
+	w.biomeChanges = make(map[ChunkPos]struct{})
+	w.biomeMu.Unlock()
+
+	for pos := range changes {
+		c, ok := w.chunkFromCache(pos)
+		if !ok {
+			// The chunk was unloaded, so it no longer has any viewers.
+			continue
+		}
+		for _, viewer := range c.v {
+			viewer.ViewChunk(pos, c.Chunk, c.e)
+		}
+		c.Unlock()
+	}
 }
 
 // BuildStructure builds a Structure passed at a specific position in the world. Unlike SetBlock, it takes a
@@ -386,16 +494,33 @@ func (w *World) BuildStructure(pos cube.Pos, s Structure) {
 				}
 			}
 			c.SetBlock(0, 0, 0, 0, c.Block(0, 0, 0, 0)) // Make sure the heightmap is recalculated.
-			c.m = true
+			c.modified()
+			for i := range subs {
+				if baseY := int(c.SubY(int16(i))); baseY+15 >= pos[1] && baseY < maxY {
+					c.subVersions[i] = c.version
+				}
+			}
 
 			// After setting all blocks of the structure within a single chunk, we show the new chunk to all
 			// viewers once, and unlock it.
+			// The light in the chunk is calculated from scratch, after which light from the neighbouring chunks is
+			// spread into it again below.
+			chunk.LightArea([]*chunk.Chunk{c.Chunk}, chunkX, chunkZ).Fill()
+			c.lightChanged(math.MaxInt)
+
 			for _, viewer := range c.v {
 				viewer.ViewChunk(chunkPos, c.Chunk, c.e)
 			}
 			c.Unlock()
 		}
 	}
+	w.chunkMu.Lock()
+	for chunkX := pos[0] >> 4; chunkX <= maxX>>4; chunkX++ {
+		for chunkZ := pos[2] >> 4; chunkZ <= maxZ>>4; chunkZ++ {
+			w.calculateLight(ChunkPos{int32(chunkX), int32(chunkZ)})
+		}
+	}
+	w.chunkMu.Unlock()
 }
 
 // Liquid attempts to return any liquid block at the position passed. This liquid may be in the foreground or
@@ -443,7 +568,9 @@ func (w *World) SetLiquid(pos cube.Pos, b Liquid) {
 	c := w.chunk(chunkPos)
 	if b == nil {
 		w.removeLiquids(c, pos)
+		c.modifiedAt(int16(pos[1]))
 		c.Unlock()
+		w.updateLight(pos)
 		w.doBlockUpdatesAround(pos)
 		return
 	}
@@ -466,9 +593,10 @@ func (w *World) SetLiquid(pos cube.Pos, b Liquid) {
 			v.ViewBlockUpdate(pos, b, 1)
 		}
 	}
-	c.m = true
+	c.modifiedAt(y)
 	c.Unlock()
 
+	w.updateLight(pos)
 	w.doBlockUpdatesAround(pos)
 }
 
@@ -612,8 +740,95 @@ func (w *World) enableTimeCycle(v bool) {
 		return
 	}
 	w.set.Lock()
-	defer w.set.Unlock()
 	w.set.TimeCycle = v
+	w.set.Unlock()
+
+	viewers, _ := w.allViewers()
+	for _, viewer := range viewers {
+		viewer.ViewTimeCycle(v && w.Dimension().TimeCycle())
+	}
+}
+
+// RecipesUnlock checks if players in the World must unlock recipes before they are able to craft them.
+func (w *World) RecipesUnlock() bool {
+	if w == nil {
+		return false
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	return w.set.RecipesUnlock
+}
+
+// SetRecipesUnlock changes if players in the World must unlock recipes before they are able to craft them. If
+// set to false, all recipes are available to players.
+func (w *World) SetRecipesUnlock(v bool) {
+	if w == nil {
+		return
+	}
+	w.set.Lock()
+	w.set.RecipesUnlock = v
+	w.set.Unlock()
+
+	viewers, _ := w.allViewers()
+	for _, viewer := range viewers {
+		viewer.ViewRecipesUnlock(v)
+	}
+}
+
+// KeepInventory checks if players in the World keep their items and experience when they die.
+func (w *World) KeepInventory() bool {
+	if w == nil {
+		return false
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	return w.set.KeepInventory
+}
+
+// SetKeepInventory changes if players in the World keep their items and experience when they die instead of
+// dropping them.
+func (w *World) SetKeepInventory(v bool) {
+	if w == nil {
+		return
+	}
+	w.set.Lock()
+	w.set.KeepInventory = v
+	w.set.Unlock()
+
+	viewers, _ := w.allViewers()
+	for _, viewer := range viewers {
+		viewer.ViewKeepInventory(v)
+	}
+}
+
+// DeathMessages checks if a message is broadcast in the global chat when a player in the World dies, as set in
+// Config.DeathMessages.
+func (w *World) DeathMessages() bool {
+	if w == nil {
+		return false
+	}
+	return w.conf.DeathMessages
+}
+
+// NaturalRegeneration checks if players in the World regenerate health when their food bar is full enough.
+func (w *World) NaturalRegeneration() bool {
+	if w == nil {
+		return true
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	return w.set.NaturalRegeneration
+}
+
+// SetNaturalRegeneration changes if players in the World regenerate health when their food bar is full enough.
+// Players in worlds with the peaceful difficulty always regenerate health.
+func (w *World) SetNaturalRegeneration(v bool) {
+	if w == nil {
+		return
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	w.set.NaturalRegeneration = v
 }
 
 // Temperature returns the temperature in the World at a specific position. Higher altitudes and different biomes
@@ -678,10 +893,12 @@ func (w *World) AddEntity(e Entity) {
 	chunkPos := chunkPosFromVec3(e.Position())
 	w.entityMu.Lock()
 	w.entities[e] = chunkPos
+	w.indexEntity(e)
 	w.entityMu.Unlock()
 
 	c := w.chunk(chunkPos)
 	c.entities = append(c.entities, e)
+	c.em = true
 	viewers := slices.Clone(c.v)
 	c.Unlock()
 
@@ -724,17 +941,23 @@ func (w *World) RemoveEntity(e Entity) {
 	delete(entityWorlds, e)
 	worldsMu.Unlock()
 
+	w.unlinkRides(e)
+
 	c, ok := w.chunkFromCache(chunkPos)
 	if !ok {
 		// The chunk wasn't loaded, so we can't remove any entity from the chunk.
 		return
 	}
 	c.entities = sliceutil.DeleteVal(c.entities, e)
+	c.em = true
 	viewers := slices.Clone(c.v)
 	c.Unlock()
 
 	w.entityMu.Lock()
 	delete(w.entities, e)
+	if u, ok := e.(uuidEntity); ok && w.entityIDs[u.UUID()] == e {
+		delete(w.entityIDs, u.UUID())
+	}
 	w.entityMu.Unlock()
 
 	for _, v := range viewers {
@@ -791,6 +1014,31 @@ func (w *World) Entities() []Entity {
 	return m
 }
 
+// EntityByUUID looks up the entity in the World with the UUID passed, such as a player. Only entities that have
+// a UUID() uuid.UUID method may be found. False is returned if no such entity is in the World.
+func (w *World) EntityByUUID(id uuid.UUID) (Entity, bool) {
+	if w == nil {
+		return nil, false
+	}
+	w.entityMu.RLock()
+	defer w.entityMu.RUnlock()
+	e, ok := w.entityIDs[id]
+	return e, ok
+}
+
+// uuidEntity is an Entity that is identified by a UUID.
+type uuidEntity interface {
+	UUID() uuid.UUID
+}
+
+// indexEntity adds the entity passed to the entityIDs of the World if it has a UUID. w.entityMu must be held
+// when calling indexEntity.
+func (w *World) indexEntity(e Entity) {
+	if u, ok := e.(uuidEntity); ok {
+		w.entityIDs[u.UUID()] = e
+	}
+}
+
 // OfEntity attempts to return a world that an entity is currently in. If the entity was not currently added
 // to a world, the world returned is nil and the bool returned is false.
 func OfEntity(e Entity) (*World, bool) {
@@ -825,13 +1073,31 @@ func (w *World) SetSpawn(pos cube.Pos) {
 	w.set.Spawn = pos
 	w.set.Unlock()
 
+	if w.spawnLoader != nil {
+		w.spawnLoader.Move(pos.Vec3())
+	}
 	viewers, _ := w.allViewers()
 	for _, viewer := range viewers {
 		viewer.ViewWorldSpawn(pos)
 	}
 }
 
-// PlayerSpawn returns the spawn position of a player with a UUID in this World.
+// SpawnProtected checks if a position is within the spawn protection radius of the World, as set in
+// Config.SpawnProtectionRadius. Only operators are able to edit blocks at positions that are spawn protected.
+func (w *World) SpawnProtected(pos cube.Pos) bool {
+	if w == nil || w.conf.SpawnProtectionRadius <= 0 {
+		return false
+	}
+	w.set.Lock()
+	spawn := w.set.Spawn
+	w.set.Unlock()
+
+	r := w.conf.SpawnProtectionRadius
+	return pos[0] >= spawn[0]-r && pos[0] <= spawn[0]+r && pos[2] >= spawn[2]-r && pos[2] <= spawn[2]+r
+}
+
+// PlayerSpawn returns the spawn position of a player with a UUID in this World. If the player does not have a spawn
+// position in the World, the position is selected by the SpawnLocator of the World.
 func (w *World) PlayerSpawn(uuid uuid.UUID) cube.Pos {
 	if w == nil {
 		return cube.Pos{}
@@ -839,10 +1105,10 @@ func (w *World) PlayerSpawn(uuid uuid.UUID) cube.Pos {
 	pos, exist, err := w.conf.Provider.LoadPlayerSpawnPosition(uuid)
 	if err != nil {
 		w.conf.Log.Errorf("failed to get player spawn: %v", err)
-		return w.Spawn()
+		return w.conf.SpawnLocator.SpawnLocation(w, uuid)
 	}
 	if !exist {
-		return w.Spawn()
+		return w.conf.SpawnLocator.SpawnLocation(w, uuid)
 	}
 	return pos
 }
@@ -850,7 +1116,7 @@ func (w *World) PlayerSpawn(uuid uuid.UUID) cube.Pos {
 // SetPlayerSpawn sets the spawn position of a player with a UUID in this World. If the player has a spawn in the world,
 // the player will be teleported to this location on respawn.
 func (w *World) SetPlayerSpawn(uuid uuid.UUID, pos cube.Pos) {
-	if w == nil {
+	if w == nil || w.conf.ReadOnly {
 		return
 	}
 	if err := w.conf.Provider.SavePlayerSpawnPosition(uuid, pos); err != nil {
@@ -1021,6 +1287,10 @@ func (w *World) close() {
 	close(w.closing)
 	w.running.Wait()
 
+	if w.spawnLoader != nil {
+		_ = w.spawnLoader.Close()
+	}
+
 	w.conf.Log.Debugf("Saving chunks in memory to disk...")
 
 	w.chunkMu.Lock()
@@ -1070,8 +1340,11 @@ func (w *World) addWorldViewer(l *Loader) {
 	w.viewersMu.Unlock()
 	l.viewer.ViewTime(w.Time())
 	w.set.Lock()
-	raining, thundering := w.set.Raining, w.set.Raining && w.set.Thundering
+	raining, thundering, timeCycle, recipesUnlock, keepInv := w.set.Raining, w.set.Raining && w.set.Thundering, w.set.TimeCycle, w.set.RecipesUnlock, w.set.KeepInventory
 	w.set.Unlock()
+	l.viewer.ViewTimeCycle(timeCycle && w.Dimension().TimeCycle())
+	l.viewer.ViewRecipesUnlock(recipesUnlock)
+	l.viewer.ViewKeepInventory(keepInv)
 	l.viewer.ViewWeather(raining, thundering)
 	l.viewer.ViewWorldSpawn(w.Spawn())
 }
@@ -1138,6 +1411,17 @@ func (w *World) Handler() Handler {
 	return w.handler.Load()
 }
 
+// Scheduler returns the scheduler.Scheduler of the World. It is ticked at the start of every tick of the World, so
+// tasks scheduled in it run on the same goroutine as the ticking of blocks and entities. Unlike other parts of the
+// World, the Scheduler is also ticked if no players are in the World. If the World is nil, a nil Scheduler is
+// returned, which never runs any tasks scheduled in it.
+func (w *World) Scheduler() *scheduler.Scheduler {
+	if w == nil {
+		return nil
+	}
+	return w.scheduler
+}
+
 // chunkFromCache attempts to fetch a chunk at the chunk position passed from the cache. If not found, the
 // chunk returned is nil and false is returned.
 func (w *World) chunkFromCache(pos ChunkPos) (*chunkData, bool) {
@@ -1256,8 +1540,10 @@ func (w *World) loadChunk(pos ChunkPos) (*chunkData, error) {
 	w.entityMu.Lock()
 	for _, e := range ent {
 		w.entities[e] = pos
+		w.indexEntity(e)
 	}
 	w.entityMu.Unlock()
+	w.spawning.load(ent)
 
 	blockEntities, err := w.provider().LoadBlockNBT(pos, w.conf.Dim)
 	if err != nil {
@@ -1267,6 +1553,9 @@ func (w *World) loadChunk(pos ChunkPos) (*chunkData, error) {
 
 	data.Lock()
 	w.chunkMu.Unlock()
+	// Hashing the block entities and entities is relatively expensive, so it is done after releasing the chunk
+	// cache lock, with only the chunk itself locked.
+	data.blockEntityHash, data.entityHash = nbtHash(data.blockEntityNBT()), nbtHash(data.entityNBT())
 	return data, nil
 }
 
@@ -1289,7 +1578,7 @@ func (w *World) calculateLight(centre ChunkPos) {
 // spreadLight spreads the light from the chunk passed at the position passed to all neighbours if each of
 // them is loaded.
 func (w *World) spreadLight(pos ChunkPos) {
-	chunks := make([]*chunk.Chunk, 0, 9)
+	data, chunks := make([]*chunkData, 0, 9), make([]*chunk.Chunk, 0, 9)
 	for z := int32(-1); z <= 1; z++ {
 		for x := int32(-1); x <= 1; x++ {
 			neighbour, ok := w.chunks[ChunkPos{pos[0] + x, pos[1] + z}]
@@ -1297,7 +1586,7 @@ func (w *World) spreadLight(pos ChunkPos) {
 				// Not all surrounding chunks existed: Stop spreading light as we can't do it completely yet.
 				return
 			}
-			chunks = append(chunks, neighbour.Chunk)
+			data, chunks = append(data, neighbour), append(chunks, neighbour.Chunk)
 		}
 	}
 	for _, neighbour := range chunks {
@@ -1306,11 +1595,43 @@ func (w *World) spreadLight(pos ChunkPos) {
 	// All chunks of the current one are present, so we can spread the light from this chunk
 	// to all chunks.
 	chunk.LightArea(chunks, int(pos[0])-1, int(pos[1])-1).Spread()
-	for _, neighbour := range chunks {
+	for _, neighbour := range data {
+		neighbour.lightChanged(math.MaxInt)
 		neighbour.Unlock()
 	}
 }
 
+// updateLight updates the light around a block position after the block at that position was changed. Light is
+// able to spread into and out of all loaded chunks surrounding the chunk of the position.
+func (w *World) updateLight(pos cube.Pos) {
+	centre := chunkPosFromBlockPos(pos)
+
+	w.chunkMu.Lock()
+	defer w.chunkMu.Unlock()
+	if _, ok := w.chunks[centre]; !ok {
+		return
+	}
+
+	data, chunks := make([]*chunkData, 0, 9), make([]*chunk.Chunk, 0, 9)
+	for z := int32(-1); z <= 1; z++ {
+		for x := int32(-1); x <= 1; x++ {
+			if neighbour, ok := w.chunks[ChunkPos{centre[0] + x, centre[1] + z}]; ok {
+				neighbour.Lock()
+				data, chunks = append(data, neighbour), append(chunks, neighbour.Chunk)
+				continue
+			}
+			// The neighbour is not loaded: Light simply doesn't spread into it. Once it is loaded, light is spread
+			// between the chunks as usual.
+			chunks = append(chunks, nil)
+		}
+	}
+	chunk.LightArea(chunks, int(centre[0])-1, int(centre[1])-1).Update(pos)
+	for _, c := range data {
+		c.lightChanged(pos[1] + 15)
+		c.Unlock()
+	}
+}
+
 // loadIntoBlocks loads the block entity data passed into blocks located in a specific chunk. The blocks that
 // have NBT will then be stored into memory.
 func (w *World) loadIntoBlocks(c *chunkData, blockEntityData []map[string]any) {
@@ -1335,43 +1656,104 @@ func (w *World) loadIntoBlocks(c *chunkData, blockEntityData []map[string]any) {
 // the provider.
 func (w *World) saveChunk(pos ChunkPos, c *chunkData) {
 	c.Lock()
-	if !w.conf.ReadOnly {
-		if len(c.e) > 0 || c.m {
-			c.Compact()
-			if err := w.provider().SaveChunk(pos, c.Chunk, w.conf.Dim); err != nil {
-				w.conf.Log.Errorf("error saving chunk %v to provider: %v", pos, err)
-			}
+	w.writeChunk(pos, c)
+	ent := c.entities
+	c.entities = nil
+	c.Unlock()
 
-			m := make([]map[string]any, 0, len(c.e))
-			for pos, b := range c.e {
-				if n, ok := b.(NBTer); ok {
-					data := n.EncodeNBT()
-					data["x"], data["y"], data["z"] = int32(pos[0]), int32(pos[1]), int32(pos[2])
-					m = append(m, data)
-				}
-			}
-			if err := w.provider().SaveBlockNBT(pos, m, w.conf.Dim); err != nil {
-				w.conf.Log.Errorf("error saving block NBT in chunk %v to provider: %v", pos, err)
-			}
+	for _, e := range ent {
+		_ = e.Close()
+	}
+}
+
+// writeChunk writes the blocks, block entities and entities of the chunkData passed to the provider of the World
+// and marks the chunk as no longer modified. The chunkData must be locked when calling writeChunk.
+func (w *World) writeChunk(pos ChunkPos, c *chunkData) {
+	if w.conf.ReadOnly {
+		return
+	}
+	blockEntities, entities := c.blockEntityNBT(), c.entityNBT()
+	blockEntityHash, entityHash := nbtHash(blockEntities), nbtHash(entities)
+	if c.m || blockEntityHash != c.blockEntityHash {
+		c.Compact()
+		if err := w.provider().SaveChunk(pos, c.Chunk, w.conf.Dim); err != nil {
+			w.conf.Log.Errorf("error saving chunk %v to provider: %v", pos, err)
+		}
+		if err := w.provider().SaveBlockNBT(pos, blockEntities, w.conf.Dim); err != nil {
+			w.conf.Log.Errorf("error saving block NBT in chunk %v to provider: %v", pos, err)
 		}
+	}
+	if c.em || entityHash != c.entityHash {
+		if err := w.provider().SaveEntities(pos, c.saveableEntities(), w.conf.Dim); err != nil {
+			w.conf.Log.Errorf("error saving entities in chunk %v to provider: %v", pos, err)
+		}
+	}
+	c.m, c.em = false, false
+	c.blockEntityHash, c.entityHash = blockEntityHash, entityHash
+}
+
+// autoSave runs until the world is closed, periodically writing all chunks that were modified since they were last
+// saved to the provider. At most Config.SaveRate chunks are written per second, so that saving does not put a lot of
+// load on the provider at once.
+func (w *World) autoSave() {
+	t := time.NewTicker(w.conf.SaveInterval)
+	defer t.Stop()
 
-		s := make([]Entity, 0, len(c.entities))
-		for _, e := range c.entities {
-			if _, ok := e.Type().(SaveableEntityType); ok {
-				s = append(s, e)
+	for {
+		select {
+		case <-t.C:
+			if !w.saveModifiedChunks() {
+				w.running.Done()
+				return
 			}
+		case <-w.closing:
+			w.running.Done()
+			return
 		}
-		if err := w.provider().SaveEntities(pos, s, w.conf.Dim); err != nil {
-			w.conf.Log.Errorf("error saving entities in chunk %v to provider: %v", pos, err)
+	}
+}
+
+// saveModifiedChunks writes all chunks that were modified since they were last saved to the provider, limited to
+// Config.SaveRate chunks per second. False is returned if the World was closed while saving.
+func (w *World) saveModifiedChunks() bool {
+	w.chunkMu.Lock()
+	chunks := maps.Clone(w.chunks)
+	w.chunkMu.Unlock()
+
+	toSave := make(map[ChunkPos]*chunkData)
+	for pos, c := range chunks {
+		if c.dirty() {
+			toSave[pos] = c
 		}
 	}
-	ent := c.entities
-	c.entities = nil
-	c.Unlock()
 
-	for _, e := range ent {
-		_ = e.Close()
+	var limit <-chan time.Time
+	if w.conf.SaveRate > 0 {
+		t := time.NewTicker(time.Second / time.Duration(w.conf.SaveRate))
+		defer t.Stop()
+		limit = t.C
 	}
+	for pos, c := range toSave {
+		if limit != nil {
+			select {
+			case <-limit:
+			case <-w.closing:
+				// All chunks are saved when the World is closed, so there's no need to continue here.
+				return false
+			}
+		}
+		w.chunkMu.Lock()
+		if w.chunks[pos] != c {
+			// The chunk was unloaded in the meantime, and thus already saved.
+			w.chunkMu.Unlock()
+			continue
+		}
+		c.Lock()
+		w.chunkMu.Unlock()
+		w.writeChunk(pos, c)
+		c.Unlock()
+	}
+	return true
 }
 
 // chunkCacheJanitor runs until the world is running, cleaning chunks that are no longer in use from the cache.
@@ -1391,6 +1773,7 @@ func (w *World) chunkCacheJanitor() {
 				c.Unlock()
 				if v == 0 {
 					chunksToRemove[pos] = c
+					w.forgetLiquidFlow(pos)
 					delete(w.chunks, pos)
 					if w.lastPos == pos {
 						w.lastChunk = nil
@@ -1414,11 +1797,25 @@ func (w *World) chunkCacheJanitor() {
 // by the mutex present in the chunk.Chunk held.
 type chunkData struct {
 	*chunk.Chunk
-	m        bool
-	e        map[cube.Pos]Block
-	v        []Viewer
-	l        []*Loader
-	entities []Entity
+	// m specifies if the blocks of the chunk were modified since it was last saved. em specifies the same for the
+	// entities in the chunk.
+	m, em bool
+	// blockEntityHash and entityHash hold the nbtHash of the block entities and entities of the chunk at the time it
+	// was last loaded or saved. They are used to find out if either changed without the chunk being marked modified.
+	blockEntityHash, entityHash uint64
+	// version is changed to a new, unique value every time a block or liquid in the chunk changes.
+	version uint64
+	// subVersions holds the version of the chunk at the time that each of its sub chunks was last changed.
+	subVersions []uint64
+	// lightVersion and lightVersions are changed every time the light in the chunk, or in each of its sub chunks
+	// respectively, may have changed without the blocks in it changing, such as when light spreads into it from a
+	// neighbouring chunk.
+	lightVersion  uint64
+	lightVersions []uint64
+	e             map[cube.Pos]Block
+	v             []Viewer
+	l             []*Loader
+	entities      []Entity
 }
 
 // BlockEntities returns the block entities of the chunk.
@@ -1442,7 +1839,117 @@ func (c *chunkData) Entities() []Entity {
 	return slices.Clone(c.entities)
 }
 
+// saveableEntities returns all entities in the chunk with a SaveableEntityType.
+func (c *chunkData) saveableEntities() []Entity {
+	s := make([]Entity, 0, len(c.entities))
+	for _, e := range c.entities {
+		if _, ok := e.Type().(SaveableEntityType); ok {
+			s = append(s, e)
+		}
+	}
+	return s
+}
+
+// blockEntityNBT encodes all block entities in the chunk to NBT data holding their position.
+func (c *chunkData) blockEntityNBT() []map[string]any {
+	return blockEntityNBT(c.e)
+}
+
+// entityNBT encodes all entities in the chunk with a SaveableEntityType to NBT data.
+func (c *chunkData) entityNBT() []map[string]any {
+	return entityNBT(c.entities)
+}
+
+// blockEntityNBT encodes the block entities passed to NBT data holding their position.
+func blockEntityNBT(e map[cube.Pos]Block) []map[string]any {
+	m := make([]map[string]any, 0, len(e))
+	for pos, b := range e {
+		if n, ok := b.(NBTer); ok {
+			data := n.EncodeNBT()
+			data["x"], data["y"], data["z"] = int32(pos[0]), int32(pos[1]), int32(pos[2])
+			m = append(m, data)
+		}
+	}
+	return m
+}
+
+// entityNBT encodes the entities passed that have a SaveableEntityType to NBT data.
+func entityNBT(entities []Entity) []map[string]any {
+	m := make([]map[string]any, 0, len(entities))
+	for _, e := range entities {
+		if t, ok := e.Type().(SaveableEntityType); ok {
+			m = append(m, t.EncodeNBT(e))
+		}
+	}
+	return m
+}
+
+// dirty checks if the chunk holds any data that changed since it was last saved. Block entities and entities may
+// change without the chunk being marked modified, so their NBT data is compared with the data last saved. The chunk
+// is only locked to take a snapshot of its data: The data is encoded and hashed after releasing the lock.
+func (c *chunkData) dirty() bool {
+	c.Lock()
+	if c.m || c.em {
+		c.Unlock()
+		return true
+	}
+	blockEntities, entities := maps.Clone(c.e), slices.Clone(c.entities)
+	blockEntityHash, entityHash := c.blockEntityHash, c.entityHash
+	c.Unlock()
+
+	return nbtHash(blockEntityNBT(blockEntities)) != blockEntityHash || nbtHash(entityNBT(entities)) != entityHash
+}
+
+// nbtHash returns a hash of the NBT data passed that does not depend on the order of the data. 0 is returned if the
+// data is empty.
+func nbtHash(data []map[string]any) uint64 {
+	var sum uint64
+	for _, m := range data {
+		h := fnv.New64a()
+		// fmt prints maps sorted by key, so the same data always produces the same hash.
+		_, _ = fmt.Fprint(h, m)
+		sum += h.Sum64()
+	}
+	return sum
+}
+
+// chunkVersion is the counter used to produce unique chunk versions. It is shared between all worlds so that versions
+// remain unique when chunks are unloaded and loaded again.
+var chunkVersion atomic.Uint64
+
+// modified marks the chunkData as modified, so that it is saved when unloaded, and changes its version.
+func (c *chunkData) modified() {
+	c.m = true
+	c.version = chunkVersion.Inc()
+}
+
+// modifiedAt marks the chunkData as modified and changes the version of the sub chunk that the Y coordinate passed
+// is in.
+func (c *chunkData) modifiedAt(y int16) {
+	c.modified()
+	c.subVersions[c.SubIndex(y)] = c.version
+}
+
+// lightChanged changes the light versions of the chunkData for all sub chunks up to the Y coordinate passed. Light
+// changes spread at most 15 blocks upwards, but sky light may spread down any distance, so all sub chunks below the
+// Y coordinate are changed as well.
+func (c *chunkData) lightChanged(maxY int) {
+	c.lightVersion = chunkVersion.Inc()
+	n := len(c.lightVersions)
+	if maxY <= c.Range().Max() {
+		n = int(c.SubIndex(int16(maxY))) + 1
+	}
+	for i := 0; i < n && i < len(c.lightVersions); i++ {
+		c.lightVersions[i] = c.lightVersion
+	}
+}
+
 // newChunkData returns a new chunkData wrapper around the chunk.Chunk passed.
 func newChunkData(c *chunk.Chunk) *chunkData {
-	return &chunkData{Chunk: c, e: map[cube.Pos]Block{}}
+	version, n := chunkVersion.Inc(), len(c.Sub())
+	data := &chunkData{Chunk: c, e: map[cube.Pos]Block{}, version: version, subVersions: make([]uint64, n), lightVersion: version, lightVersions: make([]uint64, n)}
+	for i := range data.subVersions {
+		data.subVersions[i], data.lightVersions[i] = version, version
+	}
+	return data
 }